@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/service"
+	"github.com/Edbeer/restapi/internal/storage/psql"
+	redisrepo "github.com/Edbeer/restapi/internal/storage/redis"
+	"github.com/Edbeer/restapi/pkg/db/postgres"
+	"github.com/Edbeer/restapi/pkg/db/redis"
+	"github.com/Edbeer/restapi/pkg/logger"
+)
+
+// Rebuild news title and author name suggestions index
+func main() {
+	cfg := config.GetConfig()
+	logger := logger.NewApiLogger(cfg)
+	logger.InitLogger()
+
+	// postgresql
+	psqlClient, err := postgres.NewPsqlDB(cfg)
+	if err != nil {
+		logger.Fatalf("Postgresql init: %s", err)
+	}
+	defer psqlClient.Close()
+
+	// redis
+	redisClient := redis.NewRedisClient(cfg)
+	defer redisClient.Close()
+
+	suggestService := service.NewSuggestService(cfg,
+		psql.NewSuggestStorage(psqlClient),
+		redisrepo.NewSuggestStorage(redisClient),
+		logger,
+	)
+
+	logger.Info("Rebuilding suggestions index")
+	if err := suggestService.Rebuild(context.Background()); err != nil {
+		logger.Fatalf("Rebuild suggestions index: %v", err)
+	}
+	logger.Info("Suggestions index rebuilt")
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/service"
+	"github.com/Edbeer/restapi/internal/storage/psql"
+	redisrepo "github.com/Edbeer/restapi/internal/storage/redis"
+	"github.com/Edbeer/restapi/pkg/db/postgres"
+	"github.com/Edbeer/restapi/pkg/db/redis"
+	"github.com/Edbeer/restapi/pkg/logger"
+)
+
+// Import WordPress eXtended RSS export, re-runs skip imported entities
+func main() {
+	file := flag.String("file", "", "path of WordPress export")
+	flag.Parse()
+
+	cfg := config.GetConfig()
+	logger := logger.NewApiLogger(cfg)
+	logger.InitLogger()
+
+	if *file == "" {
+		logger.Fatal("WordPress export is required: -file export.xml")
+	}
+	export, err := os.Open(*file)
+	if err != nil {
+		logger.Fatalf("Open WordPress export: %v", err)
+	}
+	defer export.Close()
+
+	// postgresql
+	psqlClient, err := postgres.NewPsqlDB(cfg)
+	if err != nil {
+		logger.Fatalf("Postgresql init: %s", err)
+	}
+	defer psqlClient.Close()
+
+	// redis
+	redisClient := redis.NewRedisClient(cfg)
+	defer redisClient.Close()
+
+	services := service.NewService(service.Deps{
+		Logger:       logger,
+		Config:       cfg,
+		PsqlStorage:  psql.NewStorage(psqlClient),
+		RedisStorage: redisrepo.NewStorage(redisClient, cfg),
+	})
+
+	logger.Infof("Importing WordPress export %s", *file)
+	report, err := services.WordPress.Import(context.Background(), export)
+	if err != nil {
+		logger.Fatalf("Import WordPress export: %v", err)
+	}
+	for _, importErr := range report.Errors {
+		logger.Warnf("%s %s: %s", importErr.Kind, importErr.SourceID, importErr.Error)
+	}
+	logger.Infof("WordPress export of %s imported, users: %+v, categories: %+v, news: %+v, comments: %+v",
+		report.Source, report.Users, report.Categories, report.News, report.Comments)
+}
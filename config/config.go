@@ -9,12 +9,20 @@ import (
 
 // Config
 type Config struct {
-	Server   ServerConfig   `yaml:"server"`
-	Logger   Logger         `yaml:"logger"`
-	Postgres PostgresConfig `yaml:"postgres"`
-	Redis    RedisConfig    `yaml:"redis"`
-	Session  SessionConfig  `yaml:"session"`
-	Cookie   CookieConfig   `yaml:"cookie"`
+	Server    ServerConfig    `yaml:"server"`
+	Logger    Logger          `yaml:"logger"`
+	Postgres  PostgresConfig  `yaml:"postgres"`
+	Redis     RedisConfig     `yaml:"redis"`
+	Session   SessionConfig   `yaml:"session"`
+	Cookie    CookieConfig    `yaml:"cookie"`
+	Scheduler SchedulerConfig `yaml:"scheduler"`
+	Feeds     FeedsConfig     `yaml:"feeds"`
+	Sitemaps  SitemapsConfig  `yaml:"sitemaps"`
+	Pages     PagesConfig     `yaml:"pages"`
+	Views     ViewsConfig     `yaml:"views"`
+	Reactions ReactionsConfig `yaml:"reactions"`
+	Related   RelatedConfig   `yaml:"related"`
+	Transfer  TransferConfig  `yaml:"transfer"`
 }
 
 // Server config struct
@@ -28,6 +36,8 @@ type ServerConfig struct {
 	SSL               bool   `yaml:"SSL"`
 	CtxDefaultTimeout int    `yaml:"CtxDefaultTimeout"`
 	CSRF              bool   `yaml:"CSRF"`
+	// writes of news, comments and users without If-Match get 428
+	RequireIfMatch bool `yaml:"RequireIfMatch"`
 }
 
 // Postgresql config
@@ -75,6 +85,71 @@ type CookieConfig struct {
 	HTTPOnly bool   `yaml:"HTTPOnly"`
 }
 
+// Background jobs config, intervals in seconds
+type SchedulerConfig struct {
+	PublishInterval            int `yaml:"PublishInterval" env-default:"30"`
+	ViewsFlushInterval         int `yaml:"ViewsFlushInterval" env-default:"60"`
+	ReactionsReconcileInterval int `yaml:"ReactionsReconcileInterval" env-default:"3600"`
+	RelatedRefreshInterval     int `yaml:"RelatedRefreshInterval" env-default:"60"`
+}
+
+// Syndication feeds config, cache ttl in seconds
+type FeedsConfig struct {
+	Title       string `yaml:"Title" env-default:"News"`
+	Description string `yaml:"Description"`
+	BaseURL     string `yaml:"BaseURL" env-default:"http://localhost:5000"`
+	Size        int    `yaml:"Size" env-default:"50"`
+	CacheTTL    int    `yaml:"CacheTTL" env-default:"600"`
+}
+
+// Sitemaps config, latest news window in hours, cache ttl in seconds
+type SitemapsConfig struct {
+	BaseURL         string `yaml:"BaseURL" env-default:"http://localhost:5000"`
+	PublicationName string `yaml:"PublicationName" env-default:"News"`
+	Language        string `yaml:"Language" env-default:"en"`
+	PageSize        int    `yaml:"PageSize" env-default:"50000"`
+	LatestWindow    int    `yaml:"LatestWindow" env-default:"48"`
+	CacheTTL        int    `yaml:"CacheTTL" env-default:"86400"`
+}
+
+// Server rendered pages config, templates are reloaded on every render
+// when Reload is set, cache ttl in seconds
+type PagesConfig struct {
+	TemplatesDir string `yaml:"TemplatesDir" env-default:"templates"`
+	SiteName     string `yaml:"SiteName" env-default:"News"`
+	BaseURL      string `yaml:"BaseURL" env-default:"http://localhost:5000"`
+	TwitterSite  string `yaml:"TwitterSite"`
+	Reload       bool   `yaml:"Reload"`
+	PageSize     int    `yaml:"PageSize" env-default:"20"`
+	CacheTTL     int    `yaml:"CacheTTL" env-default:"60"`
+}
+
+// News views config, trending ranking cache ttl in seconds
+type ViewsConfig struct {
+	TrendingSize int `yaml:"TrendingSize" env-default:"10"`
+	CacheTTL     int `yaml:"CacheTTL" env-default:"60"`
+}
+
+// Reactions config, kinds of emoji reactions allowed besides like
+type ReactionsConfig struct {
+	Kinds []string `yaml:"Kinds" env-default:"love,laugh,wow,sad,angry"`
+}
+
+// Related news config, neighbors of news are precomputed and kept for cache ttl in seconds
+type RelatedConfig struct {
+	Size      int `yaml:"Size" env-default:"5"`
+	Neighbors int `yaml:"Neighbors" env-default:"10"`
+	BatchSize int `yaml:"BatchSize" env-default:"100"`
+	CacheTTL  int `yaml:"CacheTTL" env-default:"604800"`
+}
+
+// News import and export config, imported news are inserted in batches,
+// uploads are limited to max upload size in megabytes
+type TransferConfig struct {
+	BatchSize     int `yaml:"BatchSize" env-default:"500"`
+	MaxUploadSize int `yaml:"MaxUploadSize" env-default:"32"`
+}
+
 var (
 	config *Config
 	once   sync.Once
@@ -45,10 +45,29 @@ const docTemplate = `{
                         "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "format": "orderBy",
-                        "description": "filter name",
-                        "name": "orderBy",
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of another page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count total of the list",
+                        "name": "total",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "filter",
+                        "description": "conditions field:operator:value separated by commas, e.g. category:eq:tech",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "sort",
+                        "description": "fields separated by commas, descending with -, e.g. -created_at,title",
+                        "name": "sort",
                         "in": "query"
                     }
                 ],
@@ -57,6 +76,12 @@ const docTemplate = `{
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/entity.UsersList"
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "RFC 8288 links of next and prev pages"
+                            }
                         }
                     },
                     "500": {
@@ -70,7 +95,7 @@ const docTemplate = `{
         },
         "/auth/find": {
             "get": {
-                "description": "Find user by name",
+                "description": "Typo-tolerant users search by first name, last name and email ordered by similarity, admin only",
                 "consumes": [
                     "application/json"
                 ],
@@ -80,13 +105,63 @@ const docTemplate = `{
                 "tags": [
                     "Auth"
                 ],
-                "summary": "Find by name",
+                "summary": "Find users",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "username",
-                        "description": "username",
+                        "description": "name or email",
                         "name": "name",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "role",
+                        "description": "role filter",
+                        "name": "role",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "country",
+                        "description": "country filter",
+                        "name": "country",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "city",
+                        "description": "city filter",
+                        "name": "city",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "created_from",
+                        "description": "created at from, RFC3339 or YYYY-MM-DD",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "created_to",
+                        "description": "created at to, RFC3339 or YYYY-MM-DD",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "page",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "size",
+                        "description": "number of elements per page",
+                        "name": "size",
                         "in": "query"
                     }
                 ],
@@ -253,6 +328,12 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy",
+                        "name": "If-None-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -260,6 +341,18 @@ const docTemplate = `{
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/entity.User"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "entity tag of the user"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "cached copy is current",
+                        "schema": {
+                            "type": "string"
                         }
                     },
                     "500": {
@@ -289,6 +382,12 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the user, required in strict mode",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -296,6 +395,24 @@ const docTemplate = `{
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/entity.User"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "entity tag of the updated user"
+                            }
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
                         }
                     }
                 }
@@ -319,6 +436,12 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the user, required in strict mode",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -328,63 +451,38 @@ const docTemplate = `{
                             "type": "string"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "412": {
+                        "description": "Precondition Failed",
                         "schema": {
                             "$ref": "#/definitions/httpe.RestError"
                         }
-                    }
-                }
-            }
-        },
-        "/comments": {
-            "post": {
-                "description": "create new comment",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Comments"
-                ],
-                "summary": "Create new comment",
-                "responses": {
-                    "201": {
-                        "description": "Created",
+                    },
+                    "428": {
+                        "description": "Precondition Required",
                         "schema": {
-                            "$ref": "#/definitions/entity.Comment"
+                            "$ref": "#/definitions/httpe.RestError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
-                        "schema": {}
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
                     }
                 }
             }
         },
-        "/comments/byNewsId/{id}": {
+        "/bookmarks": {
             "get": {
-                "description": "Get all comment by news id",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get bookmarked news of current user, newest bookmarks first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Comments"
+                    "Bookmarks"
                 ],
-                "summary": "Get comments by news",
+                "summary": "Get bookmarks",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "news_id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "type": "integer",
                         "format": "page",
@@ -398,115 +496,66 @@ const docTemplate = `{
                         "description": "number of elements per page",
                         "name": "size",
                         "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "format": "orderBy",
-                        "description": "filter name",
-                        "name": "orderBy",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entity.CommentsList"
+                            "$ref": "#/definitions/entity.NewsList"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {}
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
                     }
                 }
             }
         },
-        "/comments/{id}": {
-            "get": {
-                "description": "Get comment by id",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+        "/bookmarks/{news_id}": {
+            "put": {
+                "description": "Bookmark published news for current user",
                 "tags": [
-                    "Comments"
+                    "Bookmarks"
                 ],
-                "summary": "Get comment",
+                "summary": "Bookmark news",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "comment_id",
-                        "name": "id",
+                        "type": "string",
+                        "description": "news id",
+                        "name": "news_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "ok",
                         "schema": {
-                            "$ref": "#/definitions/entity.Comment"
+                            "type": "string"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {}
-                    }
-                }
-            },
-            "put": {
-                "description": "update new comment",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Comments"
-                ],
-                "summary": "Update comment",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "comment_id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/entity.Comment"
+                            "$ref": "#/definitions/httpe.RestError"
                         }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {}
                     }
                 }
             },
             "delete": {
-                "description": "delete comment",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Remove bookmark of current user",
                 "tags": [
-                    "Comments"
+                    "Bookmarks"
                 ],
-                "summary": "Delete comment",
+                "summary": "Remove bookmark",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "comment_id",
-                        "name": "id",
+                        "type": "string",
+                        "description": "news id",
+                        "name": "news_id",
                         "in": "path",
                         "required": true
                     }
@@ -517,17 +566,13 @@ const docTemplate = `{
                         "schema": {
                             "type": "string"
                         }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {}
                     }
                 }
             }
         },
-        "/news": {
+        "/categories": {
             "get": {
-                "description": "Get all news with pagination",
+                "description": "Get categories tree ordered by position",
                 "consumes": [
                     "application/json"
                 ],
@@ -535,45 +580,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "News"
-                ],
-                "summary": "Get all news",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "format": "page",
-                        "description": "page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "format": "size",
-                        "description": "number of elements per page",
-                        "name": "size",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "format": "orderBy",
-                        "description": "filter name",
-                        "name": "orderBy",
-                        "in": "query"
-                    }
+                    "Categories"
                 ],
+                "summary": "Get categories",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entity.NewsList"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.Category"
+                            }
                         }
                     }
                 }
-            }
-        },
-        "/news/create": {
+            },
             "post": {
-                "description": "Create news handler",
+                "description": "Create category, admin only",
                 "consumes": [
                     "application/json"
                 ],
@@ -581,22 +604,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "News"
+                    "Categories"
+                ],
+                "summary": "Create category",
+                "parameters": [
+                    {
+                        "description": "category",
+                        "name": "category",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.Category"
+                        }
+                    }
                 ],
-                "summary": "Create news",
                 "responses": {
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/entity.News"
+                            "$ref": "#/definitions/entity.Category"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
                         }
                     }
                 }
             }
         },
-        "/news/search": {
+        "/categories/{slug}": {
             "get": {
-                "description": "Search news by title",
+                "description": "Get category by slug",
                 "consumes": [
                     "application/json"
                 ],
@@ -604,30 +644,155 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "News"
+                    "Categories"
                 ],
-                "summary": "Search by title",
+                "summary": "Get category",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "format": "page",
-                        "description": "page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "format": "size",
-                        "description": "number of elements per page",
-                        "name": "size",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "format": "orderBy",
-                        "description": "filter name",
-                        "name": "orderBy",
-                        "in": "query"
+                        "type": "string",
+                        "description": "category slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Category"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update category, admin only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Categories"
+                ],
+                "summary": "Update category",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "category slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "category",
+                        "name": "category",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.Category"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Category"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete category without subcategories, admin only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Categories"
+                ],
+                "summary": "Delete category",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "category slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "ok",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            }
+        },
+        "/categories/{slug}/news": {
+            "get": {
+                "description": "Get published news of category, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Categories"
+                ],
+                "summary": "Get news by category",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "category slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "include news of subcategories",
+                        "name": "descendants",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "page",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "size",
+                        "description": "number of elements per page",
+                        "name": "size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -640,9 +805,36 @@ const docTemplate = `{
                 }
             }
         },
-        "/news/{id}": {
+        "/comments": {
+            "post": {
+                "description": "create new comment",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comments"
+                ],
+                "summary": "Create new comment",
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Comment"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/comments/byNewsId/{id}": {
             "get": {
-                "description": "Get by id news handler",
+                "description": "Get all comment by news id",
                 "consumes": [
                     "application/json"
                 ],
@@ -650,9 +842,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "News"
+                    "Comments"
                 ],
-                "summary": "Get by id news",
+                "summary": "Get comments by news",
                 "parameters": [
                     {
                         "type": "integer",
@@ -660,19 +852,135 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "page",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "size",
+                        "description": "number of elements per page",
+                        "name": "size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of another page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count total of the list",
+                        "name": "total",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "filter",
+                        "description": "conditions field:operator:value separated by commas, e.g. category:eq:tech",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "sort",
+                        "description": "fields separated by commas, descending with -, e.g. -created_at,title",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "message format: markdown, html or text",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entity.News"
+                            "$ref": "#/definitions/entity.CommentsList"
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "RFC 8288 links of next and prev pages"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/comments/{comments_id}": {
+            "get": {
+                "description": "Get comment by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comments"
+                ],
+                "summary": "Get comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "comment id",
+                        "name": "comments_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "message format: markdown, html or text",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Comment"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "entity tag of the comment"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "cached copy is current",
+                        "schema": {
+                            "type": "string"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
                     }
                 }
             },
             "put": {
-                "description": "Update news handler",
+                "description": "update new comment",
                 "consumes": [
                     "application/json"
                 ],
@@ -680,133 +988,3431 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "News"
+                    "Comments"
                 ],
-                "summary": "Update news",
+                "summary": "Update comment",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "news_id",
-                        "name": "id",
+                        "type": "string",
+                        "description": "comment id",
+                        "name": "comments_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the comment, required in strict mode",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entity.News"
+                            "$ref": "#/definitions/entity.Comment"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "entity tag of the updated comment"
+                            }
                         }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {}
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete by id news handler",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "News"
-                ],
-                "summary": "Delete news",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "news_id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+            },
+            "delete": {
+                "description": "delete comment",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comments"
+                ],
+                "summary": "Delete comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "comment id",
+                        "name": "comments_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the comment, required in strict mode",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "ok",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {}
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/comments/{comments_id}/reactions": {
+            "get": {
+                "description": "Reaction counts of comment with reactions of current user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reactions"
+                ],
+                "summary": "Get comment reactions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "comment id",
+                        "name": "comments_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Reactions"
+                        }
+                    }
+                }
+            }
+        },
+        "/comments/{comments_id}/reactions/{kind}": {
+            "put": {
+                "description": "Add reaction of current user on comment, likes are counted in comment likes",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reactions"
+                ],
+                "summary": "React on comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "comment id",
+                        "name": "comments_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "reaction kind",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Reactions"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove reaction of current user from comment",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reactions"
+                ],
+                "summary": "Remove comment reaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "comment id",
+                        "name": "comments_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "reaction kind",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Reactions"
+                        }
+                    }
+                }
+            }
+        },
+        "/invitations": {
+            "get": {
+                "description": "Get pending invitations of current user to contribute to news, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Get invitations",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.NewsContributor"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            }
+        },
+        "/news": {
+            "get": {
+                "description": "Get all news with pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Get all news",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "page",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "size",
+                        "description": "number of elements per page",
+                        "name": "size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of another page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count total of the list",
+                        "name": "total",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "filter",
+                        "description": "conditions field:operator:value separated by commas, e.g. category:eq:tech",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "sort",
+                        "description": "fields separated by commas, descending with -, e.g. -created_at,title",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "fields",
+                        "description": "fields of news separated by commas, e.g. news_id,title,author",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "include",
+                        "description": "relations to embed: author, comment_count",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "content format: markdown, html or text",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "preferred locale, overrides Accept-Language",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "preferred locales",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.NewsList"
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "RFC 8288 links of next and prev pages"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/news/by-slug/{slug}": {
+            "get": {
+                "description": "Get news by slug, old slugs redirect to the current one",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Get news by slug",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "news slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "content format: markdown, html or text",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "preferred locale, overrides Accept-Language",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "preferred locales",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.NewsBase"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "entity tag of the news"
+                            }
+                        }
+                    },
+                    "301": {
+                        "description": "redirect to the current slug",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "cached copy is current",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/create": {
+            "post": {
+                "description": "Create news handler",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Create news",
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entity.News"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/export": {
+            "get": {
+                "description": "Stream all news as NDJSON or CSV, admin only. Comments are added with comments=true.",
+                "produces": [
+                    "application/x-ndjson",
+                    "text/csv"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Export news",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ndjson or csv, ndjson by default",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "export comments of news",
+                        "name": "comments",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/import": {
+            "post": {
+                "description": "Import news from NDJSON or CSV as file upload or request body, admin only. Format is taken from format query, file extension or content type. Dry run validates the upload without saving news.",
+                "consumes": [
+                    "multipart/form-data",
+                    "application/x-ndjson",
+                    "text/csv"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Import news",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ndjson or csv",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "validate only",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "file",
+                        "description": "upload",
+                        "name": "file",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.ImportReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/import/wordpress": {
+            "post": {
+                "description": "Import posts, categories, tags, authors and approved comments from WordPress eXtended RSS export as file upload or request body, admin only. Authors and commenters become placeholder users, entities imported before are skipped.",
+                "consumes": [
+                    "multipart/form-data",
+                    "application/xml"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Import WordPress export",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "WordPress export",
+                        "name": "file",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.WordPressReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/review-queue": {
+            "get": {
+                "description": "Get news waiting for review, longest waiting first, for editors only",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Get review queue",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "page",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "size",
+                        "description": "number of elements per page",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.NewsList"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/search": {
+            "get": {
+                "description": "Full-text search of news by title, content and category ranked by relevance",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Search news",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "q",
+                        "description": "websearch query",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "lang",
+                        "description": "text search configuration",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "page",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "size",
+                        "description": "number of elements per page",
+                        "name": "size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of another page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count total of the list",
+                        "name": "total",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "filter",
+                        "description": "conditions field:operator:value separated by commas, e.g. category:eq:tech",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "sort",
+                        "description": "fields separated by commas, descending with -, e.g. -created_at,title",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "fields",
+                        "description": "fields of news separated by commas, e.g. news_id,title,author",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "include",
+                        "description": "relations to embed: author, comment_count",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "content format: markdown, html or text",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.NewsSearchList"
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "RFC 8288 links of next and prev pages"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/news/trending": {
+            "get": {
+                "description": "Published news ranked by unique views within window, recent views weigh more",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Get trending news",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "window: 1h, 24h or 7d, 24h by default",
+                        "name": "window",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of news",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TrendingList"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{id}": {
+            "get": {
+                "description": "Get by id news handler",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Get by id news",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "news_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "content format: markdown, html or text",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "preferred locale, overrides Accept-Language",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "preferred locales",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.News"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "entity tag of the news"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "cached copy is current",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update news. Edits of published or scheduled news by contributors move it to pending_review,\nso it is offline until an editor approves it again, editors' edits go live right away.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Update news",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "news_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the news, required in strict mode",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.News"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "entity tag of the updated news"
+                            }
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete by id news handler",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Delete news",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "news_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the news, required in strict mode",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "ok",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{id}/revisions": {
+            "get": {
+                "description": "Get revisions of news without content, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Get news revisions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "news_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "page",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "size",
+                        "description": "number of elements per page",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.NewsRevisionsList"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{id}/revisions/diff": {
+            "get": {
+                "description": "Word-level diff of title and content between two revisions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Diff news revisions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "news_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "from",
+                        "description": "old revision number",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "to",
+                        "description": "new revision number",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.NewsRevisionDiff"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{id}/revisions/{revision}": {
+            "get": {
+                "description": "Get single revision of news with content",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Get news revision",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "news_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "revision number",
+                        "name": "revision",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.NewsRevision"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{id}/revisions/{revision}/rollback": {
+            "post": {
+                "description": "Restore title and content of a previous revision as a new revision.\nRollbacks of published or scheduled news by contributors move it to pending_review like edits.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Roll back news",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "news_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "revision number",
+                        "name": "revision",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.News"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{news_id}/contributors": {
+            "get": {
+                "description": "Get co-authors and other contributors of news, pending invitations are visible to the authors only",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Get news contributors",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "news id",
+                        "name": "news_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.NewsContributor"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Invite user to contribute to news as author, editor or photographer, only the author of news can invite. Contributors edit news once they accept.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Invite contributor",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "news id",
+                        "name": "news_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "user id and role",
+                        "name": "contributor",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.NewsContributor"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entity.NewsContributor"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{news_id}/contributors/accept": {
+            "post": {
+                "description": "Accept invitation of current user to contribute to news",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Accept invitation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "news id",
+                        "name": "news_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.NewsContributor"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{news_id}/contributors/{user_id}": {
+            "delete": {
+                "description": "Remove contributor or invitation of news by the author, contributors decline invitations or leave themselves",
+                "tags": [
+                    "News"
+                ],
+                "summary": "Remove contributor",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "news id",
+                        "name": "news_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "user id",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "ok",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{news_id}/reactions": {
+            "get": {
+                "description": "Reaction counts of news with reactions of current user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reactions"
+                ],
+                "summary": "Get news reactions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "news id",
+                        "name": "news_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Reactions"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{news_id}/reactions/{kind}": {
+            "put": {
+                "description": "Add reaction of current user on news, once per kind",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reactions"
+                ],
+                "summary": "React on news",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "news id",
+                        "name": "news_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "reaction kind",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Reactions"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove reaction of current user from news",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reactions"
+                ],
+                "summary": "Remove news reaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "news id",
+                        "name": "news_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "reaction kind",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Reactions"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{news_id}/related": {
+            "get": {
+                "description": "Published news similar to news by title, content, tags and category, most related first. Latest news of the same category fill up missing ones.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Get related news",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "news id",
+                        "name": "news_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of news, 5 by default",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.RelatedList"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{news_id}/review": {
+            "post": {
+                "description": "Approve news pending review, request changes or reject it, for editors only. Approved news get published or scheduled, the author is notified of decision.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Review news",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "news id",
+                        "name": "news_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "action: approved, changes_requested or rejected, comment is required unless approved",
+                        "name": "review",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.NewsReview"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.News"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{news_id}/reviews": {
+            "get": {
+                "description": "Get submissions and editor decisions of news, oldest first, visible to the author and editors",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Get news review history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "news id",
+                        "name": "news_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.NewsReview"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{news_id}/submit": {
+            "post": {
+                "description": "Submit draft or news with requested changes for review by editors, only the authors can submit news",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Submit news for review",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "news id",
+                        "name": "news_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "optional comment for editors",
+                        "name": "review",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/entity.NewsReview"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.News"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{news_id}/translations": {
+            "get": {
+                "description": "Get translations of news by locale, translations of unpublished news are visible to the authors only",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Get news translations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "news id",
+                        "name": "news_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.NewsTranslation"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Add translation of news to another locale than the original one, only the authors of news can translate it, editors once it is published or scheduled",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Translate news",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "news id",
+                        "name": "news_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "translation",
+                        "name": "translation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.NewsTranslation"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entity.NewsTranslation"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{news_id}/translations/{locale}": {
+            "put": {
+                "description": "Replace title and content of translation, only the authors of news can update it, editors once it is published or scheduled",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Update news translation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "news id",
+                        "name": "news_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "locale",
+                        "name": "locale",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "translation",
+                        "name": "translation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.NewsTranslation"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.NewsTranslation"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete translation, only the authors of news can delete it, editors once it is published or scheduled",
+                "tags": [
+                    "News"
+                ],
+                "summary": "Delete news translation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "news id",
+                        "name": "news_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "locale",
+                        "name": "locale",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "ok",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications": {
+            "get": {
+                "description": "Get notifications of current user, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Get notifications",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "unread notifications only",
+                        "name": "unread",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "page",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "size",
+                        "description": "number of elements per page",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.NotificationsList"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/read": {
+            "put": {
+                "description": "Mark all notifications of current user read",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Mark all notifications read",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "integer"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/{notification_id}/read": {
+            "put": {
+                "description": "Mark notification of current user read",
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Mark notification read",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "notification id",
+                        "name": "notification_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "ok",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            }
+        },
+        "/reading-lists": {
+            "get": {
+                "description": "Get reading lists of current user by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bookmarks"
+                ],
+                "summary": "Get reading lists",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.ReadingList"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create named reading list of current user, public lists can be read by anyone",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bookmarks"
+                ],
+                "summary": "Create reading list",
+                "parameters": [
+                    {
+                        "description": "reading list",
+                        "name": "list",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.ReadingList"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entity.ReadingList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            }
+        },
+        "/reading-lists/{list_id}": {
+            "get": {
+                "description": "Get reading list with its published news in list order, private lists are visible to their owner only",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bookmarks"
+                ],
+                "summary": "Get reading list",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "reading list id",
+                        "name": "list_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "page",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "size",
+                        "description": "number of elements per page",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.ReadingListNews"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Rename reading list or change its sharing, owner only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bookmarks"
+                ],
+                "summary": "Update reading list",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "reading list id",
+                        "name": "list_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "new name or sharing",
+                        "name": "list",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.ReadingListUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.ReadingList"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete reading list with its items, owner only",
+                "tags": [
+                    "Bookmarks"
+                ],
+                "summary": "Delete reading list",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "reading list id",
+                        "name": "list_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "ok",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            }
+        },
+        "/reading-lists/{list_id}/items/{news_id}": {
+            "put": {
+                "description": "Add published news to the end of reading list, owner only",
+                "tags": [
+                    "Bookmarks"
+                ],
+                "summary": "Add news to reading list",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "reading list id",
+                        "name": "list_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "news id",
+                        "name": "news_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "ok",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove news from reading list, owner only",
+                "tags": [
+                    "Bookmarks"
+                ],
+                "summary": "Remove news from reading list",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "reading list id",
+                        "name": "list_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "news id",
+                        "name": "news_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "ok",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            }
+        },
+        "/reading-lists/{list_id}/order": {
+            "put": {
+                "description": "Move listed news to the start of reading list in the given order, owner only",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bookmarks"
+                ],
+                "summary": "Reorder reading list",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "reading list id",
+                        "name": "list_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "news order",
+                        "name": "order",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.ReadingListOrder"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "ok",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            }
+        },
+        "/suggest": {
+            "get": {
+                "description": "Top news title and author name completions for prefix ranked by popularity",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Suggest"
+                ],
+                "summary": "Search-as-you-type suggestions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "q",
+                        "description": "prefix",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "type",
+                        "description": "news or authors, both by default",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "limit",
+                        "description": "number of suggestions per type",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.SuggestList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            }
+        },
+        "/suggest/rebuild": {
+            "post": {
+                "description": "Rebuild news title and author name suggestions index from database, admin only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Suggest"
+                ],
+                "summary": "Rebuild suggestions index",
+                "responses": {
+                    "200": {
+                        "description": "ok",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags": {
+            "get": {
+                "description": "Get tags with published news counts, most used first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Get tags",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "page",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "size",
+                        "description": "number of elements per page",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TagsList"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/{slug}": {
+            "put": {
+                "description": "Rename tag, admin only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Rename tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "tag slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Tag"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/{slug}/merge": {
+            "post": {
+                "description": "Move news of tag to the target tag and delete it, admin only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Merge tags",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "tag slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "target tag",
+                        "name": "merge",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.TagMerge"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Tag"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpe.RestError"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/{slug}/news": {
+            "get": {
+                "description": "Get published news of tag, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Get news by tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "tag slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "page",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "size",
+                        "description": "number of elements per page",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.NewsList"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "entity.Category": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "category_id": {
+                    "type": "string"
+                },
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Category"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1024
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "slug": {
+                    "type": "string",
+                    "maxLength": 80
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.Comment": {
+            "type": "object",
+            "required": [
+                "author_id",
+                "message",
+                "news_id"
+            ],
+            "properties": {
+                "author_id": {
+                    "type": "string"
+                },
+                "comment_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "likes": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string",
+                    "minLength": 5
+                },
+                "message_html": {
+                    "type": "string"
+                },
+                "news_id": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.CommentBase": {
+            "type": "object",
+            "required": [
+                "author",
+                "author_id",
+                "message"
+            ],
+            "properties": {
+                "author": {
+                    "type": "string"
+                },
+                "author_id": {
+                    "type": "string"
+                },
+                "avatar_url": {
+                    "type": "string"
+                },
+                "comment_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "likes": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string",
+                    "minLength": 5
+                },
+                "message_html": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.CommentsList": {
+            "type": "object",
+            "properties": {
+                "comments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.CommentBase"
+                    }
+                },
+                "has_more": {
+                    "type": "boolean"
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "prev_cursor": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "total_count": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.DiffChunk": {
+            "type": "object",
+            "properties": {
+                "op": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.ImportCount": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "skipped": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.ImportError": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "row": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.ImportReport": {
+            "type": "object",
+            "properties": {
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.ImportError"
+                    }
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "imported": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.News": {
+            "type": "object",
+            "required": [
+                "author_id",
+                "content",
+                "tags",
+                "title"
+            ],
+            "properties": {
+                "author": {
+                    "$ref": "#/definitions/entity.NewsAuthor"
+                },
+                "author_id": {
+                    "type": "string"
+                },
+                "bookmarked": {
+                    "type": "boolean"
+                },
+                "category": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "comment_count": {
+                    "type": "integer"
+                },
+                "content": {
+                    "type": "string",
+                    "minLength": 20
+                },
+                "content_html": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "image_url": {
+                    "type": "string",
+                    "maxLength": 512
+                },
+                "language": {
+                    "type": "string"
+                },
+                "locale": {
+                    "type": "string"
+                },
+                "locales": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "news_id": {
+                    "type": "string"
+                },
+                "publish_at": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "draft",
+                        "scheduled",
+                        "published",
+                        "archived"
+                    ]
+                },
+                "tags": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "minLength": 10
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.NewsAuthor": {
+            "type": "object",
+            "properties": {
+                "avatar": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.NewsBase": {
+            "type": "object",
+            "required": [
+                "content",
+                "title"
+            ],
+            "properties": {
+                "author": {
+                    "type": "string"
+                },
+                "author_id": {
+                    "type": "string"
+                },
+                "bookmarked": {
+                    "type": "boolean"
+                },
+                "category": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "content": {
+                    "type": "string",
+                    "minLength": 20
+                },
+                "content_html": {
+                    "type": "string"
+                },
+                "contributors": {
+                    "description": "co-authors who accepted invitation",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.NewsContributor"
+                    }
+                },
+                "image_url": {
+                    "type": "string",
+                    "maxLength": 512
+                },
+                "language": {
+                    "type": "string"
+                },
+                "locale": {
+                    "type": "string"
+                },
+                "locales": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "news_id": {
+                    "type": "string"
+                },
+                "publish_at": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Tag"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "minLength": 10
+                },
+                "translations": {
+                    "description": "translations are kept with cached news and dropped once a locale is selected",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.NewsTranslation"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                },
+                "views": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.NewsContributor": {
+            "type": "object",
+            "required": [
+                "role",
+                "user_id"
+            ],
+            "properties": {
+                "accepted_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "invited_by": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "news_id": {
+                    "type": "string"
+                },
+                "news_title": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "author",
+                        "editor",
+                        "photographer"
+                    ]
+                },
+                "status": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.NewsList": {
+            "type": "object",
+            "properties": {
+                "has_more": {
+                    "type": "boolean"
+                },
+                "news": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.News"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "prev_cursor": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "total_count": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.NewsReview": {
+            "type": "object",
+            "required": [
+                "action"
+            ],
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "enum": [
+                        "submitted",
+                        "approved",
+                        "changes_requested",
+                        "rejected"
+                    ]
+                },
+                "comment": {
+                    "type": "string",
+                    "maxLength": 2000
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "news_id": {
+                    "type": "string"
+                },
+                "review_id": {
+                    "type": "string"
+                },
+                "reviewer": {
+                    "type": "string"
+                },
+                "reviewer_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.NewsRevision": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "editor": {
+                    "type": "string"
+                },
+                "editor_id": {
+                    "type": "string"
+                },
+                "news_id": {
+                    "type": "string"
+                },
+                "revision": {
+                    "type": "integer"
+                },
+                "revision_id": {
+                    "type": "string"
+                },
+                "rollback_of": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.NewsRevisionDiff": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.DiffChunk"
+                    }
+                },
+                "from": {
+                    "type": "integer"
+                },
+                "news_id": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.DiffChunk"
+                    }
+                },
+                "to": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.NewsRevisionsList": {
+            "type": "object",
+            "properties": {
+                "has_more": {
+                    "type": "boolean"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "revisions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.NewsRevision"
+                    }
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "total_count": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.NewsSearch": {
+            "type": "object",
+            "required": [
+                "author_id",
+                "content",
+                "tags",
+                "title"
+            ],
+            "properties": {
+                "author": {
+                    "$ref": "#/definitions/entity.NewsAuthor"
+                },
+                "author_id": {
+                    "type": "string"
+                },
+                "bookmarked": {
+                    "type": "boolean"
+                },
+                "category": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "comment_count": {
+                    "type": "integer"
+                },
+                "content": {
+                    "type": "string",
+                    "minLength": 20
+                },
+                "content_highlight": {
+                    "type": "string"
+                },
+                "content_html": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "image_url": {
+                    "type": "string",
+                    "maxLength": 512
+                },
+                "language": {
+                    "type": "string"
+                },
+                "locale": {
+                    "type": "string"
+                },
+                "locales": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "news_id": {
+                    "type": "string"
+                },
+                "publish_at": {
+                    "type": "string"
+                },
+                "rank": {
+                    "type": "number"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "draft",
+                        "scheduled",
+                        "published",
+                        "archived"
+                    ]
+                },
+                "tags": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "minLength": 10
+                },
+                "title_highlight": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.NewsSearchList": {
+            "type": "object",
+            "properties": {
+                "has_more": {
+                    "type": "boolean"
+                },
+                "news": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.NewsSearch"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "prev_cursor": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "total_count": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.NewsTranslation": {
+            "type": "object",
+            "required": [
+                "content",
+                "locale",
+                "title"
+            ],
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "minLength": 20
+                },
+                "content_html": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "locale": {
+                    "type": "string"
+                },
+                "news_id": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 250,
+                    "minLength": 10
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.Notification": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "news_id": {
+                    "type": "string"
+                },
+                "notification_id": {
+                    "type": "string"
+                },
+                "read_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.NotificationsList": {
+            "type": "object",
+            "properties": {
+                "has_more": {
+                    "type": "boolean"
+                },
+                "notifications": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Notification"
+                    }
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "total_count": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                },
+                "unread": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.Reactions": {
+            "type": "object",
+            "properties": {
+                "counts": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
                     }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "ok",
-                        "schema": {
-                            "type": "string"
-                        }
+                },
+                "mine": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "entity.Comment": {
+        },
+        "entity.ReadingList": {
             "type": "object",
             "required": [
-                "author_id",
-                "message",
-                "news_id"
+                "name"
             ],
             "properties": {
-                "author_id": {
-                    "type": "string"
-                },
-                "comment_id": {
-                    "type": "string"
-                },
                 "created_at": {
                     "type": "string"
                 },
-                "likes": {
+                "is_public": {
+                    "type": "boolean"
+                },
+                "items_count": {
                     "type": "integer"
                 },
-                "message": {
+                "list_id": {
+                    "type": "string"
+                },
+                "name": {
                     "type": "string",
-                    "minLength": 5
+                    "maxLength": 64
                 },
-                "news_id": {
+                "updated_at": {
                     "type": "string"
                 },
-                "updated_at": {
+                "user_id": {
                     "type": "string"
                 }
             }
         },
-        "entity.CommentBase": {
+        "entity.ReadingListNews": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "$ref": "#/definitions/entity.ReadingList"
+                },
+                "news": {
+                    "$ref": "#/definitions/entity.NewsList"
+                }
+            }
+        },
+        "entity.ReadingListOrder": {
             "type": "object",
             "required": [
-                "author",
-                "author_id",
-                "message"
+                "news_ids"
             ],
             "properties": {
-                "author": {
-                    "type": "string"
+                "news_ids": {
+                    "type": "array",
+                    "maxItems": 1000,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "entity.ReadingListUpdate": {
+            "type": "object",
+            "properties": {
+                "is_public": {
+                    "type": "boolean"
                 },
-                "author_id": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 64
+                }
+            }
+        },
+        "entity.RelatedList": {
+            "type": "object",
+            "properties": {
+                "news": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.News"
+                    }
+                },
+                "news_id": {
                     "type": "string"
+                }
+            }
+        },
+        "entity.SuggestList": {
+            "type": "object",
+            "properties": {
+                "authors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Suggestion"
+                    }
                 },
-                "avatar_url": {
+                "news": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Suggestion"
+                    }
+                },
+                "prefix": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.Suggestion": {
+            "type": "object",
+            "properties": {
+                "id": {
                     "type": "string"
                 },
-                "comment_id": {
+                "score": {
+                    "type": "number"
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.Tag": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "created_at": {
                     "type": "string"
                 },
-                "likes": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 32
+                },
+                "news_count": {
                     "type": "integer"
                 },
-                "message": {
-                    "type": "string",
-                    "minLength": 5
+                "slug": {
+                    "type": "string"
                 },
-                "updated_at": {
+                "tag_id": {
                     "type": "string"
                 }
             }
         },
-        "entity.CommentsList": {
+        "entity.TagMerge": {
+            "type": "object",
+            "required": [
+                "target"
+            ],
+            "properties": {
+                "target": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.TagsList": {
             "type": "object",
             "properties": {
-                "comments": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/entity.CommentBase"
-                    }
-                },
                 "has_more": {
                     "type": "boolean"
                 },
@@ -816,6 +4422,12 @@ const docTemplate = `{
                 "size": {
                     "type": "integer"
                 },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Tag"
+                    }
+                },
                 "total_count": {
                     "type": "integer"
                 },
@@ -824,25 +4436,55 @@ const docTemplate = `{
                 }
             }
         },
-        "entity.News": {
+        "entity.TrendingList": {
+            "type": "object",
+            "properties": {
+                "news": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.TrendingNews"
+                    }
+                },
+                "window": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.TrendingNews": {
             "type": "object",
             "required": [
                 "author_id",
                 "content",
+                "tags",
                 "title"
             ],
             "properties": {
+                "author": {
+                    "$ref": "#/definitions/entity.NewsAuthor"
+                },
                 "author_id": {
                     "type": "string"
                 },
+                "bookmarked": {
+                    "type": "boolean"
+                },
                 "category": {
                     "type": "string",
-                    "maxLength": 10
+                    "maxLength": 64
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "comment_count": {
+                    "type": "integer"
                 },
                 "content": {
                     "type": "string",
                     "minLength": 20
                 },
+                "content_html": {
+                    "type": "string"
+                },
                 "created_at": {
                     "type": "string"
                 },
@@ -850,40 +4492,57 @@ const docTemplate = `{
                     "type": "string",
                     "maxLength": 512
                 },
+                "language": {
+                    "type": "string"
+                },
+                "locale": {
+                    "type": "string"
+                },
+                "locales": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "news_id": {
                     "type": "string"
                 },
-                "title": {
-                    "type": "string",
-                    "minLength": 10
+                "publish_at": {
+                    "type": "string"
                 },
-                "updated_at": {
+                "score": {
+                    "type": "number"
+                },
+                "slug": {
                     "type": "string"
-                }
-            }
-        },
-        "entity.NewsList": {
-            "type": "object",
-            "properties": {
-                "has_more": {
-                    "type": "boolean"
                 },
-                "news": {
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "draft",
+                        "scheduled",
+                        "published",
+                        "archived"
+                    ]
+                },
+                "tags": {
                     "type": "array",
+                    "maxItems": 10,
                     "items": {
-                        "$ref": "#/definitions/entity.News"
+                        "type": "string"
                     }
                 },
-                "page": {
-                    "type": "integer"
+                "title": {
+                    "type": "string",
+                    "minLength": 10
                 },
-                "size": {
-                    "type": "integer"
+                "updated_at": {
+                    "type": "string"
                 },
-                "total_count": {
+                "version": {
                     "type": "integer"
                 },
-                "total_pages": {
+                "views": {
                     "type": "integer"
                 }
             }
@@ -948,6 +4607,9 @@ const docTemplate = `{
                 },
                 "user_id": {
                     "type": "string"
+                },
+                "version": {
+                    "type": "integer"
                 }
             }
         },
@@ -957,9 +4619,15 @@ const docTemplate = `{
                 "has_more": {
                     "type": "boolean"
                 },
+                "next_cursor": {
+                    "type": "string"
+                },
                 "page": {
                     "type": "integer"
                 },
+                "prev_cursor": {
+                    "type": "string"
+                },
                 "size": {
                     "type": "integer"
                 },
@@ -977,6 +4645,46 @@ const docTemplate = `{
                 }
             }
         },
+        "entity.WordPressError": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "source_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.WordPressReport": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "$ref": "#/definitions/entity.ImportCount"
+                },
+                "comments": {
+                    "$ref": "#/definitions/entity.ImportCount"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.WordPressError"
+                    }
+                },
+                "news": {
+                    "$ref": "#/definitions/entity.ImportCount"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "users": {
+                    "$ref": "#/definitions/entity.ImportCount"
+                }
+            }
+        },
         "httpe.RestError": {
             "type": "object",
             "properties": {
@@ -15,6 +15,19 @@ require (
 
 require github.com/golang/mock v1.6.0
 
+require (
+	github.com/gosimple/slug v1.13.1
+	github.com/microcosm-cc/bluemonday v1.0.18
+	github.com/sergi/go-diff v1.3.1
+	github.com/yuin/goldmark v1.5.5
+)
+
+require (
+	github.com/aymerick/douceur v0.2.0 // indirect
+	github.com/gorilla/css v1.0.0 // indirect
+	github.com/gosimple/unidecode v1.0.1 // indirect
+)
+
 require (
 	github.com/KyleBanks/depth v1.2.1 // indirect
 	github.com/alicebob/gopher-json v0.0.0-20200520072559-a9ecdc9d1d3a // indirect
@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Named reading list of user
+type ReadingList struct {
+	ListID     uuid.UUID `json:"list_id" db:"list_id"`
+	UserID     uuid.UUID `json:"user_id" db:"user_id"`
+	Name       string    `json:"name" db:"name" validate:"required,lte=64"`
+	IsPublic   bool      `json:"is_public" db:"is_public"`
+	ItemsCount int       `json:"items_count" db:"items_count"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Rename or sharing change of reading list, empty name and missing is_public
+// keep the current values
+type ReadingListUpdate struct {
+	ListID   uuid.UUID `json:"-"`
+	Name     string    `json:"name" validate:"lte=64"`
+	IsPublic *bool     `json:"is_public"`
+}
+
+// Reading list with its news in list order
+type ReadingListNews struct {
+	List *ReadingList `json:"list"`
+	News *NewsList    `json:"news"`
+}
+
+// New order of reading list, news missing from it go after the listed ones
+type ReadingListOrder struct {
+	NewsIDs []uuid.UUID `json:"news_ids" validate:"required,min=1,max=1000"`
+}
@@ -0,0 +1,20 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// News category, categories form a tree through parent id
+type Category struct {
+	CategoryID  uuid.UUID   `json:"category_id" db:"category_id" validate:"omitempty,uuid"`
+	ParentID    *uuid.UUID  `json:"parent_id,omitempty" db:"parent_id"`
+	Name        string      `json:"name" db:"name" validate:"required,lte=64"`
+	Slug        string      `json:"slug" db:"slug" validate:"omitempty,lte=80"`
+	Description *string     `json:"description,omitempty" db:"description" validate:"omitempty,lte=1024"`
+	Position    int         `json:"position" db:"position"`
+	CreatedAt   time.Time   `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at" db:"updated_at"`
+	Children    []*Category `json:"children,omitempty" db:"-"`
+}
@@ -8,32 +8,41 @@ import (
 
 // Comment model
 type Comment struct {
-	CommentID uuid.UUID `json:"comment_id" db:"comment_id" validate:"omitempty,uuid"`
-	AuthorID  uuid.UUID `json:"author_id" db:"author_id" validate:"required"`
-	NewsID    uuid.UUID `json:"news_id" db:"news_id" validate:"required"`
-	Message   string    `json:"message" db:"message" validate:"required,gte=5"`
-	Likes     int64     `json:"likes" db:"likes" validate:"omitempty"`
-	CreatedAt time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+	CommentID   uuid.UUID  `json:"comment_id" db:"comment_id" validate:"omitempty,uuid"`
+	AuthorID    uuid.UUID  `json:"author_id" db:"author_id" validate:"required"`
+	NewsID      uuid.UUID  `json:"news_id" db:"news_id" validate:"required"`
+	ParentID    *uuid.UUID `json:"parent_id,omitempty" db:"parent_id"`
+	Message     string     `json:"message" db:"message" validate:"required,gte=5"`
+	MessageHTML string     `json:"message_html,omitempty" db:"message_html"`
+	Likes       int64      `json:"likes" db:"likes" validate:"omitempty"`
+	Version     int        `json:"version" db:"version"`
+	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at" db:"updated_at"`
 }
 
 // Comment base response
 type CommentBase struct {
-	CommentID uuid.UUID `json:"comment_id" db:"comment_id" validate:"omitempty,uuid"`
-	AuthorID  uuid.UUID `json:"author_id" db:"author_id" validate:"required"`
-	Author    string    `json:"author" db:"author" validate:"required"`
-	AvatarURL *string   `json:"avatar_url" db:"avatar_url"`
-	Message   string    `json:"message" db:"message" validate:"required,gte=5"`
-	Likes     int64     `json:"likes" db:"likes" validate:"omitempty"`
-	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+	CommentID   uuid.UUID  `json:"comment_id" db:"comment_id" validate:"omitempty,uuid"`
+	AuthorID    uuid.UUID  `json:"author_id" db:"author_id" validate:"required"`
+	ParentID    *uuid.UUID `json:"parent_id,omitempty" db:"parent_id"`
+	Author      string     `json:"author" db:"author" validate:"required"`
+	AvatarURL   *string    `json:"avatar_url" db:"avatar_url"`
+	Message     string     `json:"message" db:"message" validate:"required,gte=5"`
+	MessageHTML string     `json:"message_html,omitempty" db:"message_html"`
+	Likes       int64      `json:"likes" db:"likes" validate:"omitempty"`
+	Version     int        `json:"version" db:"version"`
+	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at" db:"updated_at"`
 }
 
 // Comment base list
 type CommentsList struct {
-	TotalCount int            `json:"total_count"`
-	TotalPages int            `json:"total_pages"`
+	TotalCount int            `json:"total_count,omitempty"`
+	TotalPages int            `json:"total_pages,omitempty"`
 	Page       int            `json:"page"`
 	Size       int            `json:"size"`
 	HasMore    bool           `json:"has_more"`
+	NextCursor string         `json:"next_cursor,omitempty"`
+	PrevCursor string         `json:"prev_cursor,omitempty"`
 	Comments   []*CommentBase `json:"comments"`
 }
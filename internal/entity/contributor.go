@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Contributor roles
+const (
+	ContributorAuthor       = "author"
+	ContributorEditor       = "editor"
+	ContributorPhotographer = "photographer"
+)
+
+// Contributor statuses, invitees become co-authors once they accept
+const (
+	ContributorInvited  = "invited"
+	ContributorAccepted = "accepted"
+)
+
+// Contributor credited on news
+type NewsContributor struct {
+	NewsID     uuid.UUID  `json:"news_id" db:"news_id"`
+	NewsTitle  string     `json:"news_title,omitempty" db:"news_title"`
+	UserID     uuid.UUID  `json:"user_id" db:"user_id" validate:"required"`
+	Name       string     `json:"name,omitempty" db:"name"`
+	Role       string     `json:"role" db:"role" validate:"required,oneof=author editor photographer"`
+	Status     string     `json:"status,omitempty" db:"status"`
+	InvitedBy  *uuid.UUID `json:"invited_by,omitempty" db:"invited_by"`
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+	AcceptedAt *time.Time `json:"accepted_at,omitempty" db:"accepted_at"`
+}
@@ -0,0 +1,26 @@
+package entity
+
+import "time"
+
+// Feed kinds
+const (
+	FeedAll      = "all"
+	FeedCategory = "category"
+	FeedTag      = "tag"
+	FeedAuthor   = "author"
+)
+
+// Feed request, key is category or tag slug or author id
+type FeedQuery struct {
+	Kind   string `json:"kind" validate:"required,oneof=all category tag author"`
+	Key    string `json:"key" validate:"lte=128"`
+	Format string `json:"format" validate:"required,oneof=rss atom json"`
+}
+
+// Rendered feed document
+type FeedDocument struct {
+	Body         []byte    `json:"body"`
+	ContentType  string    `json:"content_type"`
+	ETag         string    `json:"etag"`
+	LastModified time.Time `json:"last_modified"`
+}
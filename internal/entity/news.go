@@ -6,36 +6,150 @@ import (
 	"github.com/google/uuid"
 )
 
+// Default text search configuration of news
+const DefaultNewsLanguage = "english"
+
+// Default locale of news, the last one of every locale fallback chain
+const DefaultNewsLocale = "en"
+
+// News publication statuses
+const (
+	NewsStatusDraft     = "draft"
+	NewsStatusScheduled = "scheduled"
+	NewsStatusPublished = "published"
+	NewsStatusArchived  = "archived"
+)
+
+// News review statuses, set by review actions only
+const (
+	NewsStatusPendingReview    = "pending_review"
+	NewsStatusChangesRequested = "changes_requested"
+	NewsStatusRejected         = "rejected"
+)
+
+// Relations news lists can embed with ?include=
+const (
+	NewsIncludeAuthor       = "author"
+	NewsIncludeCommentCount = "comment_count"
+)
+
+// All relations of news
+var NewsIncludes = []string{NewsIncludeAuthor, NewsIncludeCommentCount}
+
 // News base model
 type News struct {
-	NewsID    uuid.UUID `json:"news_id" db:"news_id" validate:"omitempty,uuid"`
-	AuthorID  uuid.UUID `json:"author_id" db:"author_id" validate:"required"`
-	Title     string    `json:"title" db:"title" validate:"required,gte=10"`
-	Content   string    `json:"content" db:"content" validate:"required,gte=20"`
-	ImageURL  *string   `json:"image_url,omitempty" db:"image_url" validate:"omitempty,lte=512,url"`
-	Category  *string   `json:"category,omitempty" db:"category" validate:"omitempty,lte=10"`
-	CreatedAt time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+	NewsID       uuid.UUID   `json:"news_id" db:"news_id" validate:"omitempty,uuid"`
+	AuthorID     uuid.UUID   `json:"author_id" db:"author_id" validate:"required"`
+	Title        string      `json:"title" db:"title" validate:"required,gte=10"`
+	Slug         string      `json:"slug" db:"slug"`
+	Content      string      `json:"content" db:"content" validate:"required,gte=20"`
+	ContentHTML  string      `json:"content_html,omitempty" db:"content_html"`
+	ImageURL     *string     `json:"image_url,omitempty" db:"image_url" validate:"omitempty,lte=512,url"`
+	Category     *string     `json:"category,omitempty" db:"category" validate:"omitempty,lte=64"`
+	CategoryID   *uuid.UUID  `json:"category_id,omitempty" db:"category_id"`
+	Language     string      `json:"language,omitempty" db:"language" validate:"omitempty,news_language"`
+	Locale       string      `json:"locale,omitempty" db:"locale" validate:"omitempty,locale"`
+	Locales      Locales     `json:"locales,omitempty" db:"locales"`
+	Status       string      `json:"status,omitempty" db:"status" validate:"omitempty,oneof=draft scheduled published archived"`
+	PublishAt    *time.Time  `json:"publish_at,omitempty" db:"publish_at"`
+	Tags         []string    `json:"tags,omitempty" db:"-" validate:"omitempty,max=10,dive,required,lte=32"`
+	Bookmarked   bool        `json:"bookmarked" db:"bookmarked"`
+	Author       *NewsAuthor `json:"author,omitempty" db:"-"`
+	CommentCount *int        `json:"comment_count,omitempty" db:"-"`
+	Version      int         `json:"version,omitempty" db:"version"`
+	CreatedAt    time.Time   `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time   `json:"updated_at" db:"updated_at"`
+}
+
+// Author embedded in news
+type NewsAuthor struct {
+	UserID    uuid.UUID `json:"user_id" db:"user_id"`
+	FirstName string    `json:"first_name" db:"first_name"`
+	LastName  string    `json:"last_name" db:"last_name"`
+	Avatar    *string   `json:"avatar,omitempty" db:"avatar"`
+}
+
+// Comments count of news
+type NewsCommentCount struct {
+	NewsID uuid.UUID `db:"news_id"`
+	Count  int       `db:"count"`
 }
 
 // News list response
 type NewsList struct {
-	TotalCount int     `json:"total_count"`
-	TotalPages int     `json:"total_pages"`
+	TotalCount int     `json:"total_count,omitempty"`
+	TotalPages int     `json:"total_pages,omitempty"`
 	Page       int     `json:"page"`
 	Size       int     `json:"size"`
 	HasMore    bool    `json:"has_more"`
+	NextCursor string  `json:"next_cursor,omitempty"`
+	PrevCursor string  `json:"prev_cursor,omitempty"`
 	News       []*News `json:"news"`
 }
 
 // News base
 type NewsBase struct {
-	NewsID    uuid.UUID `json:"news_id" db:"news_id" validate:"omitempty,uuid"`
-	AuthorID  uuid.UUID `json:"author_id" db:"author_id" validate:"omitempty,uuid"`
-	Title     string    `json:"title" db:"title" validate:"required,gte=10"`
-	Content   string    `json:"content" db:"content" validate:"required,gte=20"`
-	ImageURL  *string   `json:"image_url,omitempty" db:"image_url" validate:"omitempty,lte=512,url"`
-	Category  *string   `json:"category,omitempty" db:"category" validate:"omitempty,lte=10"`
-	Author    string    `json:"author" db:"author"`
-	UpdatedAt time.Time `json:"updated_at,omitempty" db:"updated_at"`
-}
\ No newline at end of file
+	NewsID      uuid.UUID  `json:"news_id" db:"news_id" validate:"omitempty,uuid"`
+	AuthorID    uuid.UUID  `json:"author_id" db:"author_id" validate:"omitempty,uuid"`
+	Title       string     `json:"title" db:"title" validate:"required,gte=10"`
+	Slug        string     `json:"slug" db:"slug"`
+	Content     string     `json:"content" db:"content" validate:"required,gte=20"`
+	ContentHTML string     `json:"content_html,omitempty" db:"content_html"`
+	ImageURL    *string    `json:"image_url,omitempty" db:"image_url" validate:"omitempty,lte=512,url"`
+	Category    *string    `json:"category,omitempty" db:"category" validate:"omitempty,lte=64"`
+	CategoryID  *uuid.UUID `json:"category_id,omitempty" db:"category_id"`
+	Language    string     `json:"language,omitempty" db:"language"`
+	Locale      string     `json:"locale,omitempty" db:"locale"`
+	Locales     Locales    `json:"locales,omitempty" db:"-"`
+	Status      string     `json:"status,omitempty" db:"status"`
+	PublishAt   *time.Time `json:"publish_at,omitempty" db:"publish_at"`
+	Tags        []*Tag     `json:"tags,omitempty" db:"-"`
+	Author      string     `json:"author" db:"author"`
+	Views       int64      `json:"views" db:"views"`
+	Bookmarked  bool       `json:"bookmarked" db:"-"`
+	// co-authors who accepted invitation
+	Contributors []*NewsContributor `json:"contributors,omitempty" db:"-"`
+	// translations are kept with cached news and dropped once a locale is selected
+	Translations []*NewsTranslation `json:"translations,omitempty" db:"-"`
+	Version      int                `json:"version" db:"version"`
+	UpdatedAt    time.Time          `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// Author or co-author of news
+func (n *NewsBase) IsAuthor(userID uuid.UUID) bool {
+	if userID == n.AuthorID {
+		return true
+	}
+	for _, contributor := range n.Contributors {
+		if contributor.UserID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// News full-text search query
+type NewsSearchQuery struct {
+	Query    string `json:"q" validate:"required,lte=256"`
+	Language string `json:"lang" validate:"required,news_language"`
+}
+
+// News search result with rank and highlighted fragments
+type NewsSearch struct {
+	News
+	Rank             float64 `json:"rank" db:"rank"`
+	TitleHighlight   string  `json:"title_highlight" db:"title_highlight"`
+	ContentHighlight string  `json:"content_highlight" db:"content_highlight"`
+}
+
+// News search list response
+type NewsSearchList struct {
+	TotalCount int           `json:"total_count,omitempty"`
+	TotalPages int           `json:"total_pages,omitempty"`
+	Page       int           `json:"page"`
+	Size       int           `json:"size"`
+	HasMore    bool          `json:"has_more"`
+	NextCursor string        `json:"next_cursor,omitempty"`
+	PrevCursor string        `json:"prev_cursor,omitempty"`
+	News       []*NewsSearch `json:"news"`
+}
@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Notification kinds
+const (
+	NotificationReviewApproved         = "review_approved"
+	NotificationReviewChangesRequested = "review_changes_requested"
+	NotificationReviewRejected         = "review_rejected"
+	NotificationContributorInvited     = "contributor_invited"
+)
+
+// Notification of user
+type Notification struct {
+	NotificationID uuid.UUID  `json:"notification_id" db:"notification_id"`
+	UserID         uuid.UUID  `json:"user_id" db:"user_id"`
+	Kind           string     `json:"kind" db:"kind"`
+	NewsID         *uuid.UUID `json:"news_id,omitempty" db:"news_id"`
+	Message        string     `json:"message,omitempty" db:"message"`
+	ReadAt         *time.Time `json:"read_at,omitempty" db:"read_at"`
+	CreatedAt      time.Time  `json:"created_at" db:"created_at"`
+}
+
+// Notifications list response, newest first
+type NotificationsList struct {
+	TotalCount    int             `json:"total_count"`
+	TotalPages    int             `json:"total_pages"`
+	Page          int             `json:"page"`
+	Size          int             `json:"size"`
+	HasMore       bool            `json:"has_more"`
+	Unread        int             `json:"unread"`
+	Notifications []*Notification `json:"notifications"`
+}
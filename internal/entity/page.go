@@ -0,0 +1,7 @@
+package entity
+
+// Rendered html page, or location of the page when it moved
+type PageDocument struct {
+	Body     []byte `json:"body"`
+	Redirect string `json:"redirect,omitempty"`
+}
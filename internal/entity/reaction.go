@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Reaction targets
+const (
+	ReactionTargetNews    = "news"
+	ReactionTargetComment = "comment"
+)
+
+// Reaction kind counted as comment like
+const ReactionLike = "like"
+
+// Reaction of user on news or comment
+type Reaction struct {
+	TargetType string    `json:"target_type" db:"target_type" validate:"required,oneof=news comment"`
+	TargetID   uuid.UUID `json:"target_id" db:"target_id" validate:"required"`
+	UserID     uuid.UUID `json:"user_id" db:"user_id" validate:"required"`
+	Kind       string    `json:"kind" db:"kind" validate:"required,lte=32"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+}
+
+// Number of reactions of kind
+type ReactionCount struct {
+	Kind  string `json:"kind" db:"kind"`
+	Count int64  `json:"count" db:"count"`
+}
+
+// Aggregated reactions of target with reactions of current user
+type Reactions struct {
+	Counts map[string]int64 `json:"counts"`
+	Mine   []string         `json:"mine"`
+}
@@ -0,0 +1,24 @@
+package entity
+
+import "github.com/google/uuid"
+
+// Related news request
+type RelatedQuery struct {
+	NewsID uuid.UUID `json:"news_id" validate:"required"`
+	Size   int       `json:"size" validate:"gte=0,lte=50"`
+}
+
+// Published news text compared for related news, tags are space separated slugs
+type RelatedDocument struct {
+	NewsID     uuid.UUID  `db:"news_id"`
+	Title      string     `db:"title"`
+	Content    string     `db:"content"`
+	CategoryID *uuid.UUID `db:"category_id"`
+	Tags       string     `db:"tags"`
+}
+
+// Related news response, most related first
+type RelatedList struct {
+	NewsID uuid.UUID `json:"news_id"`
+	News   []*News   `json:"news"`
+}
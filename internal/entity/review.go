@@ -0,0 +1,26 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// News review actions
+const (
+	ReviewSubmitted        = "submitted"
+	ReviewApproved         = "approved"
+	ReviewChangesRequested = "changes_requested"
+	ReviewRejected         = "rejected"
+)
+
+// Review action on news, submission by author or decision of editor
+type NewsReview struct {
+	ReviewID   uuid.UUID  `json:"review_id" db:"review_id"`
+	NewsID     uuid.UUID  `json:"news_id" db:"news_id"`
+	ReviewerID *uuid.UUID `json:"reviewer_id,omitempty" db:"reviewer_id"`
+	Reviewer   string     `json:"reviewer,omitempty" db:"reviewer"`
+	Action     string     `json:"action" db:"action" validate:"required,oneof=submitted approved changes_requested rejected"`
+	Comment    string     `json:"comment,omitempty" db:"comment" validate:"lte=2000"`
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+}
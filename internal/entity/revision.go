@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Diff chunk operations
+const (
+	DiffEqual  = "equal"
+	DiffInsert = "insert"
+	DiffDelete = "delete"
+)
+
+// News revision model, keeps title and content after every edit
+type NewsRevision struct {
+	RevisionID uuid.UUID `json:"revision_id" db:"revision_id"`
+	NewsID     uuid.UUID `json:"news_id" db:"news_id"`
+	Revision   int       `json:"revision" db:"revision"`
+	Title      string    `json:"title" db:"title"`
+	Content    string    `json:"content,omitempty" db:"content"`
+	EditorID   uuid.UUID `json:"editor_id" db:"editor_id"`
+	Editor     string    `json:"editor,omitempty" db:"editor"`
+	RollbackOf *int      `json:"rollback_of,omitempty" db:"rollback_of"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+}
+
+// News revisions list response
+type NewsRevisionsList struct {
+	TotalCount int             `json:"total_count"`
+	TotalPages int             `json:"total_pages"`
+	Page       int             `json:"page"`
+	Size       int             `json:"size"`
+	HasMore    bool            `json:"has_more"`
+	Revisions  []*NewsRevision `json:"revisions"`
+}
+
+// Diff chunk
+type DiffChunk struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+// Word-level diff between two news revisions
+type NewsRevisionDiff struct {
+	NewsID  uuid.UUID    `json:"news_id"`
+	From    int          `json:"from"`
+	To      int          `json:"to"`
+	Title   []*DiffChunk `json:"title"`
+	Content []*DiffChunk `json:"content"`
+}
@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Published news url of sitemap
+type SitemapNews struct {
+	NewsID    uuid.UUID `json:"news_id" db:"news_id"`
+	Title     string    `json:"title" db:"title"`
+	Slug      string    `json:"slug" db:"slug"`
+	Language  string    `json:"language" db:"language"`
+	PublishAt time.Time `json:"publish_at" db:"publish_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Child sitemap page, numbered from zero
+type SitemapPage struct {
+	Page    int       `json:"page" db:"page"`
+	LastMod time.Time `json:"lastmod" db:"lastmod"`
+}
+
+// Rendered sitemap document
+type SitemapDocument struct {
+	Body         []byte    `json:"body"`
+	LastModified time.Time `json:"last_modified"`
+}
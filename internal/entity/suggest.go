@@ -0,0 +1,23 @@
+package entity
+
+import "github.com/google/uuid"
+
+// Suggestion index kinds
+const (
+	SuggestNews    = "news"
+	SuggestAuthors = "authors"
+)
+
+// Suggestion model
+type Suggestion struct {
+	ID    uuid.UUID `json:"id" db:"id"`
+	Text  string    `json:"text" db:"text"`
+	Score float64   `json:"score" db:"score"`
+}
+
+// Suggestions response
+type SuggestList struct {
+	Prefix  string        `json:"prefix"`
+	News    []*Suggestion `json:"news"`
+	Authors []*Suggestion `json:"authors"`
+}
@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Max number of tags per news
+const MaxNewsTags = 10
+
+// Tag model
+type Tag struct {
+	TagID     uuid.UUID `json:"tag_id" db:"tag_id"`
+	Name      string    `json:"name" db:"name" validate:"required,lte=32"`
+	Slug      string    `json:"slug" db:"slug"`
+	NewsCount int       `json:"news_count" db:"news_count"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+// Tags list response
+type TagsList struct {
+	TotalCount int    `json:"total_count"`
+	TotalPages int    `json:"total_pages"`
+	Page       int    `json:"page"`
+	Size       int    `json:"size"`
+	HasMore    bool   `json:"has_more"`
+	Tags       []*Tag `json:"tags"`
+}
+
+// Tag merge request, news of the tag are moved to the target tag
+type TagMerge struct {
+	Target string `json:"target" validate:"required"`
+}
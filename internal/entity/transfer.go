@@ -0,0 +1,81 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// Formats of news import and export
+const (
+	TransferNDJSON = "ndjson"
+	TransferCSV    = "csv"
+)
+
+// News record of import and export. Imported news keep id, slug and
+// dates of the record when given, new ones are generated otherwise.
+type NewsRecord struct {
+	NewsID    uuid.UUID        `json:"news_id" db:"news_id"`
+	AuthorID  uuid.UUID        `json:"author_id" db:"author_id" validate:"required"`
+	Title     string           `json:"title" db:"title" validate:"required,gte=10,lte=250"`
+	Slug      string           `json:"slug,omitempty" db:"slug" validate:"omitempty,lte=250"`
+	Content   string           `json:"content" db:"content" validate:"required,gte=20"`
+	ImageURL  *string          `json:"image_url,omitempty" db:"image_url" validate:"omitempty,lte=512,url"`
+	Category  *string          `json:"category,omitempty" db:"category" validate:"omitempty,lte=64"`
+	Language  string           `json:"language,omitempty" db:"language" validate:"omitempty,news_language"`
+	Locale    string           `json:"locale,omitempty" db:"locale" validate:"omitempty,locale"`
+	Status    string           `json:"status,omitempty" db:"status" validate:"omitempty,oneof=draft scheduled published archived"`
+	PublishAt *time.Time       `json:"publish_at,omitempty" db:"publish_at"`
+	Tags      StringList       `json:"tags,omitempty" db:"tags" validate:"omitempty,max=10,dive,required,lte=32"`
+	CreatedAt *time.Time       `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt *time.Time       `json:"updated_at,omitempty" db:"updated_at"`
+	Comments  []*CommentRecord `json:"comments,omitempty" db:"-"`
+}
+
+// Comment record of export
+type CommentRecord struct {
+	CommentID uuid.UUID  `json:"comment_id" db:"comment_id"`
+	AuthorID  uuid.UUID  `json:"author_id" db:"author_id"`
+	ParentID  *uuid.UUID `json:"parent_id,omitempty" db:"parent_id"`
+	Message   string     `json:"message" db:"message"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// Import report, dry run reports news which would be imported
+type ImportReport struct {
+	DryRun   bool           `json:"dry_run"`
+	Total    int            `json:"total"`
+	Imported int            `json:"imported"`
+	Failed   int            `json:"failed"`
+	Errors   []*ImportError `json:"errors"`
+}
+
+// Error of imported record, row is the line of NDJSON or the record of CSV
+// counted without header
+type ImportError struct {
+	Row   int    `json:"row"`
+	Error string `json:"error"`
+}
+
+// List of strings scanned from json array
+type StringList []string
+
+// Scan json array
+func (l *StringList) Scan(src interface{}) error {
+	var b []byte
+	switch v := src.(type) {
+	case nil:
+		*l = nil
+		return nil
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	default:
+		return errors.Errorf("StringList.Scan: unsupported type %T", src)
+	}
+	return json.Unmarshal(b, (*[]string)(l))
+}
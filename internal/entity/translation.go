@@ -0,0 +1,46 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// Translation of news title and content
+type NewsTranslation struct {
+	NewsID      uuid.UUID `json:"news_id" db:"news_id"`
+	Locale      string    `json:"locale" db:"locale" validate:"required,locale"`
+	Title       string    `json:"title" db:"title" validate:"required,gte=10,lte=250"`
+	Content     string    `json:"content" db:"content" validate:"required,gte=20"`
+	ContentHTML string    `json:"content_html,omitempty" db:"content_html"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Locales news is available in, scanned from comma separated list
+type Locales []string
+
+// Scan comma separated locales
+func (l *Locales) Scan(src interface{}) error {
+	var value string
+	switch v := src.(type) {
+	case nil:
+		*l = nil
+		return nil
+	case string:
+		value = v
+	case []byte:
+		value = string(v)
+	default:
+		return errors.Errorf("Locales.Scan: unsupported type %T", src)
+	}
+
+	if value == "" {
+		*l = Locales{}
+		return nil
+	}
+	*l = strings.Split(value, ",")
+	return nil
+}
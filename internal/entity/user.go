@@ -7,13 +7,21 @@ import (
 	"time"
 )
 
+// User roles
+const (
+	RoleAdmin  = "admin"
+	RoleEditor = "editor"
+)
+
 // Users List
 type UsersList struct {
-	TotalCount int     `json:"total_count"`
-	TotalPages int     `json:"total_pages"`
+	TotalCount int     `json:"total_count,omitempty"`
+	TotalPages int     `json:"total_pages,omitempty"`
 	Page       int     `json:"page"`
 	Size       int     `json:"size"`
 	HasMore    bool    `json:"has_more"`
+	NextCursor string  `json:"next_cursor,omitempty"`
+	PrevCursor string  `json:"prev_cursor,omitempty"`
 	Users      []*User `json:"users"`
 }
 
@@ -32,10 +40,21 @@ type User struct {
 	Country     *string   `json:"country" db:"country" redis:"country" validate:"omitempty,lte=24"`
 	Postcode    *int      `json:"postcode" db:"postcode" redis:"postcode" validate:"omitempty,lte=10"`
 	Balance     float64   `json:"balance" db:"balance" redis:"balance"`
+	Version     int       `json:"version" db:"version" redis:"version"`
 	CreatedAt   time.Time `json:"created_at" db:"created_at" redis:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at" db:"updated_at" redis:"updated_at"`
 }
 
+// Fuzzy users search query with filters
+type UserSearchQuery struct {
+	Name        string     `json:"name" validate:"required,lte=64"`
+	Role        string     `json:"role" validate:"omitempty,lte=10"`
+	Country     string     `json:"country" validate:"omitempty,lte=30"`
+	City        string     `json:"city" validate:"omitempty,lte=30"`
+	CreatedFrom *time.Time `json:"created_from"`
+	CreatedTo   *time.Time `json:"created_to"`
+}
+
 // Find user query
 type UserWithToken struct {
 	User  *User  `json:"user"`
@@ -61,6 +80,19 @@ func (u *User) ComparePassword(password string) error {
 	return nil
 }
 
+// Check if user has one of roles
+func (u *User) HasRole(roles ...string) bool {
+	if u.Role == nil {
+		return false
+	}
+	for _, role := range roles {
+		if *u.Role == role {
+			return true
+		}
+	}
+	return false
+}
+
 // Sanitize user password
 func (u *User) SanitizePassword() {
 	u.Password = ""
@@ -0,0 +1,35 @@
+package entity
+
+import "github.com/google/uuid"
+
+// Trending windows
+const (
+	TrendingHour = "1h"
+	TrendingDay  = "24h"
+	TrendingWeek = "7d"
+)
+
+// Trending news request
+type TrendingQuery struct {
+	Window string `json:"window" validate:"required,oneof=1h 24h 7d"`
+	Size   int    `json:"size" validate:"gte=0,lte=100"`
+}
+
+// Decayed views score of news
+type NewsScore struct {
+	NewsID uuid.UUID `json:"news_id"`
+	Score  float64   `json:"score"`
+}
+
+// Trending news with total views and score within window
+type TrendingNews struct {
+	News
+	Views int64   `json:"views" db:"views"`
+	Score float64 `json:"score" db:"-"`
+}
+
+// Trending news response
+type TrendingList struct {
+	Window string          `json:"window"`
+	News   []*TrendingNews `json:"news"`
+}
@@ -0,0 +1,45 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+)
+
+// Kinds of imported entities
+const (
+	ImportKindUser     = "user"
+	ImportKindCategory = "category"
+	ImportKindNews     = "news"
+	ImportKindComment  = "comment"
+)
+
+// Imported entity by its id in the source
+type ImportSource struct {
+	Source   string    `json:"source" db:"source"`
+	Kind     string    `json:"kind" db:"kind"`
+	SourceID string    `json:"source_id" db:"source_id"`
+	TargetID uuid.UUID `json:"target_id" db:"target_id"`
+}
+
+// Counts of imported entities, skipped ones were imported before
+type ImportCount struct {
+	Created int `json:"created"`
+	Skipped int `json:"skipped"`
+	Failed  int `json:"failed"`
+}
+
+// WordPress import report
+type WordPressReport struct {
+	Source     string            `json:"source"`
+	Users      ImportCount       `json:"users"`
+	Categories ImportCount       `json:"categories"`
+	News       ImportCount       `json:"news"`
+	Comments   ImportCount       `json:"comments"`
+	Errors     []*WordPressError `json:"errors"`
+}
+
+// Error of imported WordPress entity
+type WordPressError struct {
+	Kind     string `json:"kind"`
+	SourceID string `json:"source_id"`
+	Error    string `json:"error"`
+}
@@ -71,6 +71,36 @@ func (mw *MiddlewareManager) AuthSessionMiddleware(next echo.HandlerFunc) echo.H
 	}
 }
 
+// Optional auth sessions middleware, puts user into context when session is valid
+// and lets anonymous requests through
+func (mw *MiddlewareManager) OptionalAuthSessionMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		cookie, err := c.Cookie(mw.config.Server.CookieName)
+		if err != nil {
+			return next(c)
+		}
+
+		session, err := mw.sessionService.GetSessionByID(c.Request().Context(), cookie.Value)
+		if err != nil {
+			return next(c)
+		}
+
+		user, err := mw.authService.GetUserByID(c.Request().Context(), session.UserID)
+		if err != nil {
+			return next(c)
+		}
+
+		c.Set("sid", cookie.Value)
+		c.Set("uid", session.UserID)
+		c.Set("user", user)
+
+		ctx := context.WithValue(c.Request().Context(), utils.UserCtxKey{}, user)
+		c.SetRequest(c.Request().WithContext(ctx))
+
+		return next(c)
+	}
+}
+
 // Check auth middleware
 func (mw *MiddlewareManager) CheckAuth(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
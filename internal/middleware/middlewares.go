@@ -21,9 +21,9 @@ type SessionService interface {
 type AuthService interface {
 	Register(ctx context.Context, user *entity.User) (*entity.UserWithToken, error)
 	Update(ctx context.Context, user *entity.User) (*entity.User, error)
-	Delete(ctx context.Context, userID uuid.UUID) error
+	Delete(ctx context.Context, userID uuid.UUID, version int) error
 	GetUserByID(ctx context.Context, userID uuid.UUID) (*entity.User, error)
-	FindUsersByName(ctx context.Context, name string, pq *utils.PaginationQuery) (*entity.UsersList, error)
+	FindUsersByName(ctx context.Context, search *entity.UserSearchQuery, pq *utils.PaginationQuery) (*entity.UsersList, error)
 	GetUsers(ctx context.Context, pq *utils.PaginationQuery) (*entity.UsersList, error)
 	Login(ctx context.Context, user *entity.User) (*entity.UserWithToken, error)
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/pkg/errors"
 	"net/http"
+	"strings"
 
 	"github.com/Edbeer/restapi/config"
 	"github.com/Edbeer/restapi/internal/entity"
@@ -24,9 +25,9 @@ const (
 type AuthPsql interface {
 	Register(ctx context.Context, user *entity.User) (*entity.User, error)
 	Update(ctx context.Context, user *entity.User) (*entity.User, error)
-	Delete(ctx context.Context, userID uuid.UUID) error
+	Delete(ctx context.Context, userID uuid.UUID, version int) error
 	GetUserByID(ctx context.Context, userID uuid.UUID) (*entity.User, error)
-	FindUsersByName(ctx context.Context, name string, pq *utils.PaginationQuery) (*entity.UsersList, error)
+	FindUsersByName(ctx context.Context, search *entity.UserSearchQuery, pq *utils.PaginationQuery) (*entity.UsersList, error)
 	GetUsers(ctx context.Context, pq *utils.PaginationQuery) (*entity.UsersList, error)
 	FindUserByEmail(ctx context.Context, user *entity.User) (*entity.User, error)
 }
@@ -44,14 +45,16 @@ type AuthService struct {
 	config       *config.Config
 	storagePsql  AuthPsql
 	storageRedis AuthRedis
+	suggestRedis SuggestRedis
 }
 
 // Auth service constructor
-func NewAuthService(config *config.Config, storagePsql AuthPsql, storageRedis AuthRedis, logger logger.Logger) *AuthService {
+func NewAuthService(config *config.Config, storagePsql AuthPsql, storageRedis AuthRedis, suggestRedis SuggestRedis, logger logger.Logger) *AuthService {
 	return &AuthService{
 		config:       config,
 		storagePsql:  storagePsql,
 		storageRedis: storageRedis,
+		suggestRedis: suggestRedis,
 		logger:       logger,
 	}
 }
@@ -77,6 +80,8 @@ func (a *AuthService) Register(ctx context.Context, user *entity.User) (*entity.
 	}
 	createdUser.SanitizePassword()
 
+	a.indexUser(ctx, createdUser)
+
 	token, err := utils.GenerateJWTToken(createdUser, a.config)
 	if err != nil {
 		return nil, httpe.NewInternalServerError(errors.Wrap(err, "AuthService.Register.GenerateJWTToken"))
@@ -88,7 +93,7 @@ func (a *AuthService) Register(ctx context.Context, user *entity.User) (*entity.
 	}, nil
 }
 
-// Update user
+// Update user of version, 0 updates any version
 func (a *AuthService) Update(ctx context.Context, user *entity.User) (*entity.User, error) {
 	if err := utils.ValidateStruct(ctx, user); err != nil {
 		return nil, err
@@ -108,19 +113,24 @@ func (a *AuthService) Update(ctx context.Context, user *entity.User) (*entity.Us
 		a.logger.Errorf("AuthService.Update.DeleteUserCtx: %v", err)
 	}
 
+	a.indexUser(ctx, updatedUser)
+
 	updatedUser.SanitizePassword()
 
 	return updatedUser, nil
 }
 
-// Delete user
-func (a *AuthService) Delete(ctx context.Context, userID uuid.UUID) error {
-	if err := a.storagePsql.Delete(ctx, userID); err != nil {
+// Delete user of version, 0 deletes any version
+func (a *AuthService) Delete(ctx context.Context, userID uuid.UUID, version int) error {
+	if err := a.storagePsql.Delete(ctx, userID, version); err != nil {
 		return err
 	}
 	if err := a.storageRedis.DeleteUserCtx(ctx, a.generateUserKey(userID.String())); err != nil {
 		a.logger.Errorf("AuthService.Delete.DeleteUserCtx: %v", err)
 	}
+	if err := a.suggestRedis.DeleteSuggestionCtx(ctx, entity.SuggestAuthors, userID); err != nil {
+		a.logger.Errorf("AuthService.Delete.DeleteSuggestionCtx: %v", err)
+	}
 	return nil
 }
 
@@ -146,10 +156,18 @@ func (a *AuthService) GetUserByID(ctx context.Context, userID uuid.UUID) (*entit
 	return user, nil
 }
 
-// Find users by name
-func (a *AuthService) FindUsersByName(ctx context.Context, name string,
+// Fuzzy search users by name or email with filters
+func (a *AuthService) FindUsersByName(ctx context.Context, search *entity.UserSearchQuery,
 	pq *utils.PaginationQuery) (*entity.UsersList, error) {
-	users, err := a.storagePsql.FindUsersByName(ctx, name, pq)
+	if err := utils.ValidateStruct(ctx, search); err != nil {
+		return nil, httpe.NewBadRequestError(errors.WithMessage(err, "AuthService.FindUsersByName.ValidateStruct"))
+	}
+
+	if search.CreatedFrom != nil && search.CreatedTo != nil && search.CreatedTo.Before(*search.CreatedFrom) {
+		return nil, httpe.NewBadRequestError("created_to must not be before created_from")
+	}
+
+	users, err := a.storagePsql.FindUsersByName(ctx, search, pq)
 	if err != nil {
 		return nil, err
 	}
@@ -191,6 +209,16 @@ func (a *AuthService) Login(ctx context.Context, user *entity.User) (*entity.Use
 	}, nil
 }
 
+// Add user name to the author suggestions index
+func (a *AuthService) indexUser(ctx context.Context, user *entity.User) {
+	if err := a.suggestRedis.AddSuggestionCtx(ctx, entity.SuggestAuthors, &entity.Suggestion{
+		ID:   user.ID,
+		Text: strings.TrimSpace(user.FirstName + " " + user.LastName),
+	}); err != nil {
+		a.logger.Errorf("AuthService.indexUser.AddSuggestionCtx: %v", err)
+	}
+}
+
 func (a *AuthService) generateUserKey(userID string) string {
 	return fmt.Sprintf("%s: %s", baseAuthPrefix, userID)
 }
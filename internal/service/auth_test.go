@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/Edbeer/restapi/config"
 	"github.com/Edbeer/restapi/internal/entity"
@@ -37,7 +38,8 @@ func TestService_Register(t *testing.T) {
 
 	apiLogger := logger.NewApiLogger(config)
 	mockAuthStorage := mockstorage.NewMockAuthPsql(ctrl)
-	authService := NewAuthService(config, mockAuthStorage, nil, apiLogger)
+	mockSuggestRedis := mockredis.NewMockSuggestRedis(ctrl)
+	authService := NewAuthService(config, mockAuthStorage, nil, mockSuggestRedis, apiLogger)
 
 	user := &entity.User{
 		Password: "12345678",
@@ -48,6 +50,7 @@ func TestService_Register(t *testing.T) {
 
 	mockAuthStorage.EXPECT().FindUserByEmail(ctx, gomock.Eq(user)).Return(nil, sql.ErrNoRows)
 	mockAuthStorage.EXPECT().Register(ctx, gomock.Eq(user)).Return(user, nil)
+	mockSuggestRedis.EXPECT().AddSuggestionCtx(ctx, entity.SuggestAuthors, gomock.Any()).Return(nil)
 
 	createdUser, err := authService.Register(ctx, user)
 	require.NoError(t, err)
@@ -76,7 +79,8 @@ func TestService_Update(t *testing.T) {
 	apiLogger := logger.NewApiLogger(config)
 	mockAuthStorage := mockstorage.NewMockAuthPsql(ctrl)
 	mockAuthRedis := mockredis.NewMockAuthRedis(ctrl)
-	authService := NewAuthService(config, mockAuthStorage, mockAuthRedis, apiLogger)
+	mockSuggestRedis := mockredis.NewMockSuggestRedis(ctrl)
+	authService := NewAuthService(config, mockAuthStorage, mockAuthRedis, mockSuggestRedis, apiLogger)
 
 	user := &entity.User{
 		Password: "12345678",
@@ -88,6 +92,7 @@ func TestService_Update(t *testing.T) {
 
 	mockAuthStorage.EXPECT().Update(ctx, gomock.Eq(user)).Return(user, nil)
 	mockAuthRedis.EXPECT().DeleteUserCtx(ctx, key).Return(nil)
+	mockSuggestRedis.EXPECT().AddSuggestionCtx(ctx, entity.SuggestAuthors, gomock.Any()).Return(nil)
 
 	updatedUser, err := authService.Update(ctx, user)
 	require.NoError(t, err)
@@ -116,7 +121,8 @@ func TestService_Delete(t *testing.T) {
 	apiLogger := logger.NewApiLogger(config)
 	mockAuthStorage := mockstorage.NewMockAuthPsql(ctrl)
 	mockAuthRedis := mockredis.NewMockAuthRedis(ctrl)
-	authService := NewAuthService(config, mockAuthStorage, mockAuthRedis, apiLogger)
+	mockSuggestRedis := mockredis.NewMockSuggestRedis(ctrl)
+	authService := NewAuthService(config, mockAuthStorage, mockAuthRedis, mockSuggestRedis, apiLogger)
 
 	user := &entity.User{
 		Password: "12345678",
@@ -126,10 +132,11 @@ func TestService_Delete(t *testing.T) {
 
 	ctx := context.Background()
 
-	mockAuthStorage.EXPECT().Delete(ctx, gomock.Eq(user.ID)).Return(nil)
+	mockAuthStorage.EXPECT().Delete(ctx, gomock.Eq(user.ID), 0).Return(nil)
 	mockAuthRedis.EXPECT().DeleteUserCtx(ctx, key).Return(nil)
+	mockSuggestRedis.EXPECT().DeleteSuggestionCtx(ctx, entity.SuggestAuthors, user.ID).Return(nil)
 
-	err := authService.Delete(ctx, user.ID)
+	err := authService.Delete(ctx, user.ID, 0)
 	require.NoError(t, err)
 	require.Nil(t, err)
 }
@@ -155,7 +162,7 @@ func TestService_GetUserByID(t *testing.T) {
 	apiLogger := logger.NewApiLogger(config)
 	mockAuthStorage := mockstorage.NewMockAuthPsql(ctrl)
 	mockAuthRedis := mockredis.NewMockAuthRedis(ctrl)
-	authService := NewAuthService(config, mockAuthStorage, mockAuthRedis, apiLogger)
+	authService := NewAuthService(config, mockAuthStorage, mockAuthRedis, nil, apiLogger)
 
 	user := &entity.User{
 		Password: "12345678",
@@ -196,25 +203,35 @@ func TestService_FindUserByName(t *testing.T) {
 	apiLogger := logger.NewApiLogger(config)
 	mockAuthStorage := mockstorage.NewMockAuthPsql(ctrl)
 	mockAuthRedis := mockredis.NewMockAuthRedis(ctrl)
-	authService := NewAuthService(config, mockAuthStorage, mockAuthRedis, apiLogger)
+	authService := NewAuthService(config, mockAuthStorage, mockAuthRedis, nil, apiLogger)
 
-	userName := "name"
+	search := &entity.UserSearchQuery{
+		Name: "name",
+	}
 	query := &utils.PaginationQuery{
 		Size: 10,
 		Page: 1,
-		OrderBy: "",
 	}
 
 	ctx := context.Background()
 
 	usersList := &entity.UsersList{}
 
-	mockAuthStorage.EXPECT().FindUsersByName(ctx, gomock.Eq(userName), query).Return(usersList, nil)
+	mockAuthStorage.EXPECT().FindUsersByName(ctx, gomock.Eq(search), query).Return(usersList, nil)
 
-	users, err := authService.FindUsersByName(ctx, userName, query)
+	users, err := authService.FindUsersByName(ctx, search, query)
 	require.NoError(t, err)
 	require.Nil(t, err)
 	require.NotNil(t, users)
+
+	from := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, -1)
+	_, err = authService.FindUsersByName(ctx, &entity.UserSearchQuery{
+		Name:        "name",
+		CreatedFrom: &from,
+		CreatedTo:   &to,
+	}, query)
+	require.Error(t, err)
 }
 
 func TestService_GetUsers(t *testing.T) {
@@ -238,12 +255,11 @@ func TestService_GetUsers(t *testing.T) {
 	apiLogger := logger.NewApiLogger(config)
 	mockAuthStorage := mockstorage.NewMockAuthPsql(ctrl)
 	mockAuthRedis := mockredis.NewMockAuthRedis(ctrl)
-	authService := NewAuthService(config, mockAuthStorage, mockAuthRedis, apiLogger)
+	authService := NewAuthService(config, mockAuthStorage, mockAuthRedis, nil, apiLogger)
 
 	query := &utils.PaginationQuery{
 		Size: 10,
 		Page: 1,
-		OrderBy: "",
 	}
 
 	ctx := context.Background()
@@ -279,7 +295,7 @@ func TestService_Login(t *testing.T) {
 	apiLogger := logger.NewApiLogger(config)
 	mockAuthStorage := mockstorage.NewMockAuthPsql(ctrl)
 	mockAuthRedis := mockredis.NewMockAuthRedis(ctrl)
-	authService := NewAuthService(config, mockAuthStorage, mockAuthRedis, apiLogger)
+	authService := NewAuthService(config, mockAuthStorage, mockAuthRedis, nil, apiLogger)
 
 	user := &entity.User{
 		Password: "12345678",
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// Bookmarks StoragePsql interface
+type BookmarksPsql interface {
+	AddBookmark(ctx context.Context, userID uuid.UUID, newsID uuid.UUID) error
+	RemoveBookmark(ctx context.Context, userID uuid.UUID, newsID uuid.UUID) error
+	GetBookmarks(ctx context.Context, userID uuid.UUID, pq *utils.PaginationQuery) (*entity.NewsList, error)
+	CreateList(ctx context.Context, list *entity.ReadingList) (*entity.ReadingList, error)
+	UpdateList(ctx context.Context, list *entity.ReadingListUpdate) (*entity.ReadingList, error)
+	DeleteList(ctx context.Context, listID uuid.UUID) error
+	GetList(ctx context.Context, listID uuid.UUID) (*entity.ReadingList, error)
+	GetLists(ctx context.Context, userID uuid.UUID) ([]*entity.ReadingList, error)
+	GetListNews(ctx context.Context, listID uuid.UUID, viewerID uuid.UUID, pq *utils.PaginationQuery) (*entity.NewsList, error)
+	AddListItem(ctx context.Context, listID uuid.UUID, newsID uuid.UUID) error
+	RemoveListItem(ctx context.Context, listID uuid.UUID, newsID uuid.UUID) error
+	ReorderList(ctx context.Context, listID uuid.UUID, newsIDs []uuid.UUID) error
+}
+
+// Bookmarks and reading lists service
+type BookmarksService struct {
+	logger      logger.Logger
+	config      *config.Config
+	storagePsql BookmarksPsql
+}
+
+// Bookmarks service constructor
+func NewBookmarksService(config *config.Config, storagePsql BookmarksPsql, logger logger.Logger) *BookmarksService {
+	return &BookmarksService{
+		config:      config,
+		storagePsql: storagePsql,
+		logger:      logger,
+	}
+}
+
+// Bookmark published news for current user
+func (b *BookmarksService) AddBookmark(ctx context.Context, newsID uuid.UUID) error {
+	user, err := utils.GetUserFromCtx(ctx)
+	if err != nil {
+		return httpe.NewUnauthorizedError(errors.WithMessage(err, "BookmarksService.AddBookmark.GetUserFromCtx"))
+	}
+	return b.storagePsql.AddBookmark(ctx, user.ID, newsID)
+}
+
+// Remove bookmark of current user
+func (b *BookmarksService) RemoveBookmark(ctx context.Context, newsID uuid.UUID) error {
+	user, err := utils.GetUserFromCtx(ctx)
+	if err != nil {
+		return httpe.NewUnauthorizedError(errors.WithMessage(err, "BookmarksService.RemoveBookmark.GetUserFromCtx"))
+	}
+	return b.storagePsql.RemoveBookmark(ctx, user.ID, newsID)
+}
+
+// Get bookmarked news of current user, newest bookmarks first
+func (b *BookmarksService) GetBookmarks(ctx context.Context, pq *utils.PaginationQuery) (*entity.NewsList, error) {
+	user, err := utils.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, httpe.NewUnauthorizedError(errors.WithMessage(err, "BookmarksService.GetBookmarks.GetUserFromCtx"))
+	}
+	return b.storagePsql.GetBookmarks(ctx, user.ID, pq)
+}
+
+// Create reading list of current user
+func (b *BookmarksService) CreateList(ctx context.Context, list *entity.ReadingList) (*entity.ReadingList, error) {
+	user, err := utils.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, httpe.NewUnauthorizedError(errors.WithMessage(err, "BookmarksService.CreateList.GetUserFromCtx"))
+	}
+
+	list.UserID = user.ID
+	if err := utils.ValidateStruct(ctx, list); err != nil {
+		return nil, httpe.NewBadRequestError(errors.WithMessage(err, "BookmarksService.CreateList.ValidateStruct"))
+	}
+	return b.storagePsql.CreateList(ctx, list)
+}
+
+// Rename reading list or change its sharing
+func (b *BookmarksService) UpdateList(ctx context.Context, list *entity.ReadingListUpdate) (*entity.ReadingList, error) {
+	if _, err := b.ownList(ctx, list.ListID, "UpdateList"); err != nil {
+		return nil, err
+	}
+
+	if err := utils.ValidateStruct(ctx, list); err != nil {
+		return nil, httpe.NewBadRequestError(errors.WithMessage(err, "BookmarksService.UpdateList.ValidateStruct"))
+	}
+	return b.storagePsql.UpdateList(ctx, list)
+}
+
+// Delete reading list
+func (b *BookmarksService) DeleteList(ctx context.Context, listID uuid.UUID) error {
+	if _, err := b.ownList(ctx, listID, "DeleteList"); err != nil {
+		return err
+	}
+	return b.storagePsql.DeleteList(ctx, listID)
+}
+
+// Get reading lists of current user
+func (b *BookmarksService) GetLists(ctx context.Context) ([]*entity.ReadingList, error) {
+	user, err := utils.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, httpe.NewUnauthorizedError(errors.WithMessage(err, "BookmarksService.GetLists.GetUserFromCtx"))
+	}
+	return b.storagePsql.GetLists(ctx, user.ID)
+}
+
+// Get reading list with its news, private lists are visible to their owner only
+func (b *BookmarksService) GetList(ctx context.Context, listID uuid.UUID, pq *utils.PaginationQuery) (*entity.ReadingListNews, error) {
+	list, err := b.storagePsql.GetList(ctx, listID)
+	if err != nil {
+		return nil, err
+	}
+
+	viewerID := getViewerID(ctx)
+	if !list.IsPublic && list.UserID != viewerID {
+		return nil, httpe.NewNotFoundError(errors.New("BookmarksService.GetList.IsPublic"))
+	}
+
+	newsList, err := b.storagePsql.GetListNews(ctx, listID, viewerID, pq)
+	if err != nil {
+		return nil, err
+	}
+	return &entity.ReadingListNews{List: list, News: newsList}, nil
+}
+
+// Add published news to the end of reading list
+func (b *BookmarksService) AddListItem(ctx context.Context, listID uuid.UUID, newsID uuid.UUID) error {
+	if _, err := b.ownList(ctx, listID, "AddListItem"); err != nil {
+		return err
+	}
+	return b.storagePsql.AddListItem(ctx, listID, newsID)
+}
+
+// Remove news from reading list
+func (b *BookmarksService) RemoveListItem(ctx context.Context, listID uuid.UUID, newsID uuid.UUID) error {
+	if _, err := b.ownList(ctx, listID, "RemoveListItem"); err != nil {
+		return err
+	}
+	return b.storagePsql.RemoveListItem(ctx, listID, newsID)
+}
+
+// Reorder reading list, news missing from order keep their relative order after the listed ones
+func (b *BookmarksService) ReorderList(ctx context.Context, listID uuid.UUID, order *entity.ReadingListOrder) error {
+	if err := utils.ValidateStruct(ctx, order); err != nil {
+		return httpe.NewBadRequestError(errors.WithMessage(err, "BookmarksService.ReorderList.ValidateStruct"))
+	}
+
+	seen := make(map[uuid.UUID]struct{}, len(order.NewsIDs))
+	for _, newsID := range order.NewsIDs {
+		if _, ok := seen[newsID]; ok {
+			return httpe.NewBadRequestError(errors.Errorf("BookmarksService.ReorderList: duplicate news %s", newsID))
+		}
+		seen[newsID] = struct{}{}
+	}
+
+	if _, err := b.ownList(ctx, listID, "ReorderList"); err != nil {
+		return err
+	}
+	return b.storagePsql.ReorderList(ctx, listID, order.NewsIDs)
+}
+
+func (b *BookmarksService) ownList(ctx context.Context, listID uuid.UUID, op string) (*entity.ReadingList, error) {
+	if _, err := utils.GetUserFromCtx(ctx); err != nil {
+		return nil, httpe.NewUnauthorizedError(errors.WithMessage(err, "BookmarksService."+op+".GetUserFromCtx"))
+	}
+
+	list, err := b.storagePsql.GetList(ctx, listID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := utils.ValidateIsOwner(ctx, list.UserID.String(), b.logger); err != nil {
+		return nil, httpe.NewRestError(http.StatusForbidden, "Forbidden", errors.Wrap(err, "BookmarksService."+op+".ValidateIsOwner"))
+	}
+	return list, nil
+}
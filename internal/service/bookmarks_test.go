@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	mockstorage "github.com/Edbeer/restapi/internal/storage/psql/mock"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Bookmarks(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockBookmarksStorage := mockstorage.NewMockBookmarksPsql(ctrl)
+	bookmarksService := NewBookmarksService(nil, mockBookmarksStorage, apiLogger)
+
+	userID, newsID := uuid.New(), uuid.New()
+	ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, &entity.User{ID: userID})
+
+	t.Run("AddBookmark", func(t *testing.T) {
+		mockBookmarksStorage.EXPECT().AddBookmark(ctx, userID, newsID).Return(nil)
+
+		require.NoError(t, bookmarksService.AddBookmark(ctx, newsID))
+	})
+
+	t.Run("GetBookmarks", func(t *testing.T) {
+		pq := &utils.PaginationQuery{Size: 10}
+		newsList := &entity.NewsList{TotalCount: 1, News: []*entity.News{{NewsID: newsID, Bookmarked: true}}}
+		mockBookmarksStorage.EXPECT().GetBookmarks(ctx, userID, pq).Return(newsList, nil)
+
+		result, err := bookmarksService.GetBookmarks(ctx, pq)
+		require.NoError(t, err)
+		require.Equal(t, newsList, result)
+	})
+
+	t.Run("Anonymous", func(t *testing.T) {
+		err := bookmarksService.AddBookmark(context.Background(), newsID)
+		require.Error(t, err)
+		require.Equal(t, http.StatusUnauthorized, httpe.ParseErrors(err).Status())
+	})
+}
+
+func TestService_ReadingLists(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockBookmarksStorage := mockstorage.NewMockBookmarksPsql(ctrl)
+	bookmarksService := NewBookmarksService(nil, mockBookmarksStorage, apiLogger)
+
+	userID, listID := uuid.New(), uuid.New()
+	ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, &entity.User{ID: userID})
+	list := &entity.ReadingList{ListID: listID, UserID: userID, Name: "later"}
+
+	t.Run("CreateList", func(t *testing.T) {
+		mockBookmarksStorage.EXPECT().CreateList(ctx, &entity.ReadingList{UserID: userID, Name: "later"}).Return(list, nil)
+
+		createdList, err := bookmarksService.CreateList(ctx, &entity.ReadingList{Name: "later"})
+		require.NoError(t, err)
+		require.Equal(t, list, createdList)
+	})
+
+	t.Run("Empty name", func(t *testing.T) {
+		_, err := bookmarksService.CreateList(ctx, &entity.ReadingList{})
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, httpe.ParseErrors(err).Status())
+	})
+
+	t.Run("UpdateList keeps name", func(t *testing.T) {
+		isPublic := true
+		update := &entity.ReadingListUpdate{ListID: listID, IsPublic: &isPublic}
+		mockBookmarksStorage.EXPECT().GetList(ctx, listID).Return(list, nil)
+		mockBookmarksStorage.EXPECT().UpdateList(ctx, update).
+			Return(&entity.ReadingList{ListID: listID, UserID: userID, Name: "later", IsPublic: true}, nil)
+
+		updatedList, err := bookmarksService.UpdateList(ctx, update)
+		require.NoError(t, err)
+		require.Equal(t, "later", updatedList.Name)
+		require.True(t, updatedList.IsPublic)
+	})
+
+	t.Run("UpdateList rename only", func(t *testing.T) {
+		update := &entity.ReadingListUpdate{ListID: listID, Name: "someday"}
+		mockBookmarksStorage.EXPECT().GetList(ctx, listID).Return(&entity.ReadingList{ListID: listID, UserID: userID, Name: "later", IsPublic: true}, nil)
+		mockBookmarksStorage.EXPECT().UpdateList(ctx, update).
+			Return(&entity.ReadingList{ListID: listID, UserID: userID, Name: "someday", IsPublic: true}, nil)
+
+		updatedList, err := bookmarksService.UpdateList(ctx, update)
+		require.NoError(t, err)
+		require.Equal(t, "someday", updatedList.Name)
+		require.True(t, updatedList.IsPublic)
+	})
+
+	t.Run("ReorderList", func(t *testing.T) {
+		order := &entity.ReadingListOrder{NewsIDs: []uuid.UUID{uuid.New(), uuid.New()}}
+		mockBookmarksStorage.EXPECT().GetList(ctx, listID).Return(list, nil)
+		mockBookmarksStorage.EXPECT().ReorderList(ctx, listID, order.NewsIDs).Return(nil)
+
+		require.NoError(t, bookmarksService.ReorderList(ctx, listID, order))
+	})
+
+	t.Run("Duplicate news in order", func(t *testing.T) {
+		newsID := uuid.New()
+		err := bookmarksService.ReorderList(ctx, listID, &entity.ReadingListOrder{NewsIDs: []uuid.UUID{newsID, newsID}})
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, httpe.ParseErrors(err).Status())
+	})
+
+	t.Run("Public list", func(t *testing.T) {
+		pq := &utils.PaginationQuery{Size: 10}
+		publicList := &entity.ReadingList{ListID: listID, UserID: userID, Name: "later", IsPublic: true}
+		newsList := &entity.NewsList{}
+		mockBookmarksStorage.EXPECT().GetList(context.Background(), listID).Return(publicList, nil)
+		mockBookmarksStorage.EXPECT().GetListNews(context.Background(), listID, uuid.Nil, pq).Return(newsList, nil)
+
+		result, err := bookmarksService.GetList(context.Background(), listID, pq)
+		require.NoError(t, err)
+		require.Equal(t, publicList, result.List)
+		require.Equal(t, newsList, result.News)
+	})
+
+	t.Run("Private list", func(t *testing.T) {
+		mockBookmarksStorage.EXPECT().GetList(context.Background(), listID).Return(list, nil)
+
+		_, err := bookmarksService.GetList(context.Background(), listID, &utils.PaginationQuery{Size: 10})
+		require.Error(t, err)
+		require.Equal(t, http.StatusNotFound, httpe.ParseErrors(err).Status())
+	})
+}
@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// Categories StoragePsql interface
+type CategoriesPsql interface {
+	Create(ctx context.Context, category *entity.Category) (*entity.Category, error)
+	Update(ctx context.Context, category *entity.Category) (*entity.Category, error)
+	Delete(ctx context.Context, categoryID uuid.UUID) error
+	GetByID(ctx context.Context, categoryID uuid.UUID) (*entity.Category, error)
+	GetBySlug(ctx context.Context, slug string) (*entity.Category, error)
+	GetAll(ctx context.Context) ([]*entity.Category, error)
+	IsDescendant(ctx context.Context, ancestorID uuid.UUID, categoryID uuid.UUID) (bool, error)
+	GetNews(ctx context.Context, categoryID uuid.UUID, descendants bool, pq *utils.PaginationQuery) (*entity.NewsList, error)
+}
+
+// Categories service
+type CategoriesService struct {
+	logger      logger.Logger
+	config      *config.Config
+	storagePsql CategoriesPsql
+}
+
+// Categories service constructor
+func NewCategoriesService(config *config.Config, storagePsql CategoriesPsql, logger logger.Logger) *CategoriesService {
+	return &CategoriesService{
+		config:      config,
+		storagePsql: storagePsql,
+		logger:      logger,
+	}
+}
+
+// Create category
+func (c *CategoriesService) Create(ctx context.Context, category *entity.Category) (*entity.Category, error) {
+	if err := c.prepareCategory(ctx, category, ""); err != nil {
+		return nil, err
+	}
+	return c.storagePsql.Create(ctx, category)
+}
+
+// Update category found by slug
+func (c *CategoriesService) Update(ctx context.Context, slug string, category *entity.Category) (*entity.Category, error) {
+	current, err := c.storagePsql.GetBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.prepareCategory(ctx, category, current.Slug); err != nil {
+		return nil, err
+	}
+	category.CategoryID = current.CategoryID
+
+	if category.ParentID != nil {
+		// parent can't be the category itself or one of its descendants
+		cycle, err := c.storagePsql.IsDescendant(ctx, current.CategoryID, *category.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		if cycle {
+			return nil, httpe.NewBadRequestError(errors.New("category can't be moved under itself or its descendant"))
+		}
+	}
+
+	return c.storagePsql.Update(ctx, category)
+}
+
+// Delete category, categories with children can't be deleted
+func (c *CategoriesService) Delete(ctx context.Context, slug string) error {
+	category, err := c.storagePsql.GetBySlug(ctx, slug)
+	if err != nil {
+		return err
+	}
+
+	categories, err := c.storagePsql.GetAll(ctx)
+	if err != nil {
+		return err
+	}
+	for _, child := range categories {
+		if child.ParentID != nil && *child.ParentID == category.CategoryID {
+			return httpe.NewBadRequestError(errors.Errorf("category %s has subcategories", slug))
+		}
+	}
+
+	return c.storagePsql.Delete(ctx, category.CategoryID)
+}
+
+// Get category by slug
+func (c *CategoriesService) GetBySlug(ctx context.Context, slug string) (*entity.Category, error) {
+	return c.storagePsql.GetBySlug(ctx, slug)
+}
+
+// Get categories tree
+func (c *CategoriesService) GetTree(ctx context.Context) ([]*entity.Category, error) {
+	categories, err := c.storagePsql.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return buildCategoryTree(categories), nil
+}
+
+// Get published news of category, optionally with news of subcategories
+func (c *CategoriesService) GetNews(ctx context.Context, slug string, descendants bool, pq *utils.PaginationQuery) (*entity.NewsList, error) {
+	category, err := c.storagePsql.GetBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	return c.storagePsql.GetNews(ctx, category.CategoryID, descendants, pq)
+}
+
+// Validate category, make its slug and check parent exists
+func (c *CategoriesService) prepareCategory(ctx context.Context, category *entity.Category, currentSlug string) error {
+	if err := utils.ValidateStruct(ctx, category); err != nil {
+		return httpe.NewBadRequestError(errors.WithMessage(err, "CategoriesService.prepareCategory.ValidateStruct"))
+	}
+
+	if category.Slug == "" {
+		category.Slug = utils.Slugify(category.Name)
+	} else {
+		category.Slug = utils.Slugify(category.Slug)
+	}
+	if category.Slug == "" {
+		return httpe.NewBadRequestError(errors.Errorf("invalid category name: %q", category.Name))
+	}
+
+	if category.Slug != currentSlug {
+		_, err := c.storagePsql.GetBySlug(ctx, category.Slug)
+		if err == nil {
+			return httpe.NewBadRequestError(errors.Errorf("category %s already exists", category.Slug))
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+	}
+
+	if category.ParentID != nil {
+		if _, err := c.storagePsql.GetByID(ctx, *category.ParentID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Build categories tree from flat list ordered by position
+func buildCategoryTree(categories []*entity.Category) []*entity.Category {
+	byID := make(map[uuid.UUID]*entity.Category, len(categories))
+	for _, category := range categories {
+		byID[category.CategoryID] = category
+	}
+
+	roots := make([]*entity.Category, 0)
+	for _, category := range categories {
+		if category.ParentID != nil {
+			if parent, ok := byID[*category.ParentID]; ok {
+				parent.Children = append(parent.Children, category)
+				continue
+			}
+		}
+		roots = append(roots, category)
+	}
+	return roots
+}
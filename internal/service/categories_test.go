@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	mockstorage "github.com/Edbeer/restapi/internal/storage/psql/mock"
+	"github.com/Edbeer/restapi/pkg/logger"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_CreateCategory(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockCategoriesStorage := mockstorage.NewMockCategoriesPsql(ctrl)
+	categoriesService := NewCategoriesService(nil, mockCategoriesStorage, apiLogger)
+
+	ctx := context.Background()
+	parentID := uuid.New()
+	category := &entity.Category{
+		ParentID: &parentID,
+		Name:     "Формула 1",
+	}
+
+	mockCategoriesStorage.EXPECT().GetBySlug(ctx, "formula-1").Return(nil, errors.Wrap(sql.ErrNoRows, "CategoriesStoragePsql.GetBySlug.GetContext"))
+	mockCategoriesStorage.EXPECT().GetByID(ctx, parentID).Return(&entity.Category{CategoryID: parentID}, nil)
+	mockCategoriesStorage.EXPECT().Create(ctx, category).Return(category, nil)
+
+	created, err := categoriesService.Create(ctx, category)
+	require.NoError(t, err)
+	require.Equal(t, "formula-1", created.Slug)
+}
+
+func TestService_UpdateCategory(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockCategoriesStorage := mockstorage.NewMockCategoriesPsql(ctrl)
+	categoriesService := NewCategoriesService(nil, mockCategoriesStorage, apiLogger)
+
+	ctx := context.Background()
+	current := &entity.Category{CategoryID: uuid.New(), Name: "Sport", Slug: "sport"}
+	childID := uuid.New()
+
+	t.Run("Cycle", func(t *testing.T) {
+		mockCategoriesStorage.EXPECT().GetBySlug(ctx, "sport").Return(current, nil)
+		mockCategoriesStorage.EXPECT().GetByID(ctx, childID).Return(&entity.Category{CategoryID: childID}, nil)
+		mockCategoriesStorage.EXPECT().IsDescendant(ctx, current.CategoryID, childID).Return(true, nil)
+
+		_, err := categoriesService.Update(ctx, "sport", &entity.Category{ParentID: &childID, Name: "Sport"})
+		require.Error(t, err)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		category := &entity.Category{Name: "Sport", Position: 3}
+
+		mockCategoriesStorage.EXPECT().GetBySlug(ctx, "sport").Return(current, nil)
+		mockCategoriesStorage.EXPECT().Update(ctx, category).Return(category, nil)
+
+		updated, err := categoriesService.Update(ctx, "sport", category)
+		require.NoError(t, err)
+		require.Equal(t, current.CategoryID, updated.CategoryID)
+		require.Equal(t, 3, updated.Position)
+	})
+}
+
+func TestService_DeleteCategory(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockCategoriesStorage := mockstorage.NewMockCategoriesPsql(ctrl)
+	categoriesService := NewCategoriesService(nil, mockCategoriesStorage, apiLogger)
+
+	ctx := context.Background()
+	category := &entity.Category{CategoryID: uuid.New(), Slug: "sport"}
+
+	mockCategoriesStorage.EXPECT().GetBySlug(ctx, "sport").Return(category, nil)
+	mockCategoriesStorage.EXPECT().GetAll(ctx).Return([]*entity.Category{
+		category,
+		{CategoryID: uuid.New(), ParentID: &category.CategoryID, Slug: "football"},
+	}, nil)
+
+	err := categoriesService.Delete(ctx, "sport")
+	require.Error(t, err)
+}
+
+func TestService_buildCategoryTree(t *testing.T) {
+	t.Parallel()
+
+	sport := &entity.Category{CategoryID: uuid.New(), Name: "Sport"}
+	football := &entity.Category{CategoryID: uuid.New(), ParentID: &sport.CategoryID, Name: "Football"}
+	euro := &entity.Category{CategoryID: uuid.New(), ParentID: &football.CategoryID, Name: "Euro"}
+	politics := &entity.Category{CategoryID: uuid.New(), Name: "Politics"}
+
+	tree := buildCategoryTree([]*entity.Category{sport, football, euro, politics})
+	require.Equal(t, []*entity.Category{sport, politics}, tree)
+	require.Equal(t, []*entity.Category{football}, sport.Children)
+	require.Equal(t, []*entity.Category{euro}, football.Children)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/Edbeer/restapi/internal/entity"
 	"github.com/Edbeer/restapi/pkg/httpe"
 	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/markdown"
 	"github.com/Edbeer/restapi/pkg/utils"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
@@ -19,7 +20,7 @@ type CommentsPsql interface {
 	Update(ctx context.Context, comments *entity.Comment) (*entity.Comment, error)
 	GetByID(ctx context.Context, commentID uuid.UUID) (*entity.CommentBase, error)
 	GetAllByNewsID(ctx context.Context, newsID uuid.UUID, pq *utils.PaginationQuery) (*entity.CommentsList, error)
-	Delete(ctx context.Context, commentID uuid.UUID) error
+	Delete(ctx context.Context, commentID uuid.UUID, version int) error
 }
 
 // Comments service
@@ -36,14 +37,20 @@ func NewCommentsService(config *config.Config, commentsStorage CommentsPsql, log
 
 // Create comments
 func (c *CommentsService) Create(ctx context.Context, comments *entity.Comment) (*entity.Comment, error) {
-	comments, err := c.commentsStorage.Create(ctx, comments)
+	messageHTML, err := markdown.ToHTML(comments.Message)
+	if err != nil {
+		return nil, httpe.NewBadRequestError(errors.WithMessage(err, "CommentsService.Create.ToHTML"))
+	}
+	comments.MessageHTML = messageHTML
+
+	comments, err = c.commentsStorage.Create(ctx, comments)
 	if err != nil {
 		return nil, err
 	}
 	return comments, nil
 }
 
-// Update comments
+// Update comments of version, 0 updates any version
 func (c *CommentsService) Update(ctx context.Context, comment *entity.Comment) (*entity.Comment, error) {
 	commByID, err := c.commentsStorage.GetByID(ctx, comment.CommentID)
 	if err != nil {
@@ -54,6 +61,10 @@ func (c *CommentsService) Update(ctx context.Context, comment *entity.Comment) (
 		return nil, httpe.NewRestError(http.StatusForbidden, "Forbidden", errors.Wrap(err, "CommentService.Update.ValidateIsOwner"))
 	}
 
+	if comment.MessageHTML, err = markdown.ToHTML(comment.Message); err != nil {
+		return nil, httpe.NewBadRequestError(errors.WithMessage(err, "CommentsService.Update.ToHTML"))
+	}
+
 	comments, err := c.commentsStorage.Update(ctx, comment)
 	if err != nil {
 		return nil, err
@@ -61,8 +72,8 @@ func (c *CommentsService) Update(ctx context.Context, comment *entity.Comment) (
 	return comments, nil
 }
 
-// Delete comments
-func (c *CommentsService) Delete(ctx context.Context, commentID uuid.UUID) error {
+// Delete comments of version, 0 deletes any version
+func (c *CommentsService) Delete(ctx context.Context, commentID uuid.UUID, version int) error {
 	commentByID, err := c.commentsStorage.GetByID(ctx, commentID)
 	if err != nil {
 		return err
@@ -72,7 +83,7 @@ func (c *CommentsService) Delete(ctx context.Context, commentID uuid.UUID) error
 		return httpe.NewRestError(http.StatusForbidden, "Forbidden", errors.Wrap(err, "CommentService.Delete.ValidateIsOwner"))
 	}
 
-	if err := c.commentsStorage.Delete(ctx, commentID); err != nil {
+	if err := c.commentsStorage.Delete(ctx, commentID, version); err != nil {
 		return err
 	}
 	return nil
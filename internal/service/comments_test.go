@@ -123,9 +123,9 @@ func TestService_DeleteComment(t *testing.T) {
 	ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, user)
 
 	mockCommStorage.EXPECT().GetByID(ctx, gomock.Eq(comment.CommentID)).Return(commentBase, nil)
-	mockCommStorage.EXPECT().Delete(ctx, gomock.Eq(comment.CommentID)).Return(nil)
+	mockCommStorage.EXPECT().Delete(ctx, gomock.Eq(comment.CommentID), 0).Return(nil)
 
-	err := commentsService.Delete(ctx, comment.CommentID)
+	err := commentsService.Delete(ctx, comment.CommentID, 0)
 	require.NoError(t, err)
 	require.Nil(t, err)
 }
@@ -152,9 +152,8 @@ func TestService_GetAllByNewsID(t *testing.T) {
 	ctx := context.Background()
 
 	query := &utils.PaginationQuery{
-		Size:    10,
-		Page:    1,
-		OrderBy: "",
+		Size: 10,
+		Page: 1,
 	}
 
 	mockCommStorage.EXPECT().GetAllByNewsID(ctx, gomock.Eq(comment.NewsID), query).Return(commentsList, nil)
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// Contributors StoragePsql interface
+type ContributorsPsql interface {
+	GetNews(ctx context.Context, newsID uuid.UUID) (*entity.News, error)
+	Invite(ctx context.Context, contributor *entity.NewsContributor) (*entity.NewsContributor, error)
+	Accept(ctx context.Context, newsID uuid.UUID, userID uuid.UUID) (*entity.NewsContributor, error)
+	Remove(ctx context.Context, newsID uuid.UUID, userID uuid.UUID) error
+	GetContributors(ctx context.Context, newsID uuid.UUID) ([]*entity.NewsContributor, error)
+	GetInvitations(ctx context.Context, userID uuid.UUID) ([]*entity.NewsContributor, error)
+}
+
+// Co-authors StoragePsql interface, for news loaded without contributors
+type CoAuthorsPsql interface {
+	IsCoAuthor(ctx context.Context, newsID uuid.UUID, userID uuid.UUID) (bool, error)
+}
+
+// News contributors service. The author invites contributors, who
+// edit news along with the author once they accept.
+type ContributorsService struct {
+	logger        logger.Logger
+	config        *config.Config
+	storagePsql   ContributorsPsql
+	storageRedis  NewsRedis
+	notifications NewsNotifications
+}
+
+// News contributors service constructor
+func NewContributorsService(config *config.Config, storagePsql ContributorsPsql, redis NewsRedis, notifications NewsNotifications, logger logger.Logger) *ContributorsService {
+	return &ContributorsService{
+		config:        config,
+		storagePsql:   storagePsql,
+		storageRedis:  redis,
+		notifications: notifications,
+		logger:        logger,
+	}
+}
+
+// Get contributors of news. Pending invitations are visible to the
+// author and co-authors only, as are contributors of unpublished news.
+func (c *ContributorsService) GetContributors(ctx context.Context, newsID uuid.UUID) ([]*entity.NewsContributor, error) {
+	news, err := c.storagePsql.GetNews(ctx, newsID)
+	if err != nil {
+		return nil, err
+	}
+	contributors, err := c.storagePsql.GetContributors(ctx, newsID)
+	if err != nil {
+		return nil, err
+	}
+
+	viewerID := getViewerID(ctx)
+	accepted := make([]*entity.NewsContributor, 0, len(contributors))
+	isAuthor := viewerID == news.AuthorID
+	for _, contributor := range contributors {
+		if contributor.Status != entity.ContributorAccepted {
+			continue
+		}
+		accepted = append(accepted, contributor)
+		isAuthor = isAuthor || contributor.UserID == viewerID
+	}
+
+	if isAuthor {
+		return contributors, nil
+	}
+	if news.Status != entity.NewsStatusPublished {
+		return nil, httpe.NewNotFoundError(errors.New("ContributorsService.GetContributors.Status"))
+	}
+	return accepted, nil
+}
+
+// Invite user to contribute to news, the invitee is notified
+func (c *ContributorsService) Invite(ctx context.Context, contributor *entity.NewsContributor) (*entity.NewsContributor, error) {
+	user, err := utils.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, httpe.NewUnauthorizedError(errors.WithMessage(err, "ContributorsService.Invite.GetUserFromCtx"))
+	}
+
+	if err := utils.ValidateStruct(ctx, contributor); err != nil {
+		return nil, httpe.NewBadRequestError(errors.WithMessage(err, "ContributorsService.Invite.ValidateStruct"))
+	}
+
+	news, err := c.storagePsql.GetNews(ctx, contributor.NewsID)
+	if err != nil {
+		return nil, err
+	}
+	if err := utils.ValidateIsOwner(ctx, news.AuthorID.String(), c.logger); err != nil {
+		return nil, httpe.NewRestError(http.StatusForbidden, "Forbidden", errors.Wrap(err, "ContributorsService.Invite.ValidateIsOwner"))
+	}
+	if contributor.UserID == news.AuthorID {
+		return nil, httpe.NewBadRequestError(errors.New("ContributorsService.Invite: author can't be invited"))
+	}
+
+	contributor.InvitedBy = &user.ID
+	invited, err := c.storagePsql.Invite(ctx, contributor)
+	if err != nil {
+		return nil, err
+	}
+	if invited == nil {
+		return nil, httpe.NewBadRequestError(errors.New("ContributorsService.Invite: user is already invited"))
+	}
+
+	if err := c.notifications.Notify(ctx, &entity.Notification{
+		UserID:  invited.UserID,
+		Kind:    entity.NotificationContributorInvited,
+		NewsID:  &invited.NewsID,
+		Message: news.Title,
+	}); err != nil {
+		c.logger.Errorf("ContributorsService.Invite.Notify: %v", err)
+	}
+	return invited, nil
+}
+
+// Accept invitation of current user to news
+func (c *ContributorsService) Accept(ctx context.Context, newsID uuid.UUID) (*entity.NewsContributor, error) {
+	user, err := utils.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, httpe.NewUnauthorizedError(errors.WithMessage(err, "ContributorsService.Accept.GetUserFromCtx"))
+	}
+
+	contributor, err := c.storagePsql.Accept(ctx, newsID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	c.invalidateNews(ctx, newsID)
+	return contributor, nil
+}
+
+// Remove contributor, contributors decline invitations or leave themselves
+func (c *ContributorsService) Remove(ctx context.Context, newsID uuid.UUID, userID uuid.UUID) error {
+	user, err := utils.GetUserFromCtx(ctx)
+	if err != nil {
+		return httpe.NewUnauthorizedError(errors.WithMessage(err, "ContributorsService.Remove.GetUserFromCtx"))
+	}
+
+	if user.ID != userID {
+		news, err := c.storagePsql.GetNews(ctx, newsID)
+		if err != nil {
+			return err
+		}
+		if err := utils.ValidateIsOwner(ctx, news.AuthorID.String(), c.logger); err != nil {
+			return httpe.NewRestError(http.StatusForbidden, "Forbidden", errors.Wrap(err, "ContributorsService.Remove.ValidateIsOwner"))
+		}
+	}
+
+	if err := c.storagePsql.Remove(ctx, newsID, userID); err != nil {
+		return err
+	}
+	c.invalidateNews(ctx, newsID)
+	return nil
+}
+
+// Get pending invitations of current user
+func (c *ContributorsService) GetInvitations(ctx context.Context) ([]*entity.NewsContributor, error) {
+	user, err := utils.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, httpe.NewUnauthorizedError(errors.WithMessage(err, "ContributorsService.GetInvitations.GetUserFromCtx"))
+	}
+	return c.storagePsql.GetInvitations(ctx, user.ID)
+}
+
+// Cached news hold their co-authors
+func (c *ContributorsService) invalidateNews(ctx context.Context, newsID uuid.UUID) {
+	if err := c.storageRedis.DeleteNewsCtx(ctx, newsCacheKey(newsID.String())); err != nil {
+		c.logger.Errorf("ContributorsService.invalidateNews.DeleteNewsCtx: %v", err)
+	}
+}
+
+// Author and co-authors edit news
+func validateIsAuthor(ctx context.Context, news *entity.NewsBase, logger logger.Logger) error {
+	if news.IsAuthor(getViewerID(ctx)) {
+		return nil
+	}
+	return utils.ValidateIsOwner(ctx, news.AuthorID.String(), logger)
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	mockservice "github.com/Edbeer/restapi/internal/service/mock"
+	mockstorage "github.com/Edbeer/restapi/internal/storage/psql/mock"
+	mockredis "github.com/Edbeer/restapi/internal/storage/redis/mock"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_InviteContributor(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockContributorsStorage := mockstorage.NewMockContributorsPsql(ctrl)
+	mockNotifications := mockservice.NewMockNotifications(ctrl)
+	contributorsService := NewContributorsService(nil, mockContributorsStorage, nil, mockNotifications, apiLogger)
+
+	authorID, inviteeID, newsID := uuid.New(), uuid.New(), uuid.New()
+	ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, &entity.User{ID: authorID})
+	news := &entity.News{NewsID: newsID, AuthorID: authorID, Title: "Rain in Berlin", Status: entity.NewsStatusDraft}
+
+	t.Run("Invite", func(t *testing.T) {
+		contributor := &entity.NewsContributor{NewsID: newsID, UserID: inviteeID, Role: entity.ContributorAuthor}
+		invited := &entity.NewsContributor{NewsID: newsID, UserID: inviteeID, Role: entity.ContributorAuthor, Status: entity.ContributorInvited}
+
+		mockContributorsStorage.EXPECT().GetNews(ctx, newsID).Return(news, nil)
+		mockContributorsStorage.EXPECT().Invite(ctx, contributor).Return(invited, nil)
+		mockNotifications.EXPECT().Notify(ctx, &entity.Notification{
+			UserID:  inviteeID,
+			Kind:    entity.NotificationContributorInvited,
+			NewsID:  &invited.NewsID,
+			Message: news.Title,
+		}).Return(nil)
+
+		result, err := contributorsService.Invite(ctx, contributor)
+		require.NoError(t, err)
+		require.Equal(t, entity.ContributorInvited, result.Status)
+		require.Equal(t, authorID, *contributor.InvitedBy)
+	})
+
+	t.Run("Author", func(t *testing.T) {
+		mockContributorsStorage.EXPECT().GetNews(ctx, newsID).Return(news, nil)
+
+		_, err := contributorsService.Invite(ctx, &entity.NewsContributor{NewsID: newsID, UserID: authorID, Role: entity.ContributorAuthor})
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, httpe.ParseErrors(err).Status())
+	})
+
+	t.Run("Invalid role", func(t *testing.T) {
+		_, err := contributorsService.Invite(ctx, &entity.NewsContributor{NewsID: newsID, UserID: inviteeID, Role: "reader"})
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, httpe.ParseErrors(err).Status())
+	})
+}
+
+func TestService_GetContributors(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockContributorsStorage := mockstorage.NewMockContributorsPsql(ctrl)
+	contributorsService := NewContributorsService(nil, mockContributorsStorage, nil, nil, apiLogger)
+
+	authorID, coAuthorID, newsID := uuid.New(), uuid.New(), uuid.New()
+	news := &entity.News{NewsID: newsID, AuthorID: authorID, Status: entity.NewsStatusPublished}
+	contributors := []*entity.NewsContributor{
+		{NewsID: newsID, UserID: coAuthorID, Role: entity.ContributorAuthor, Status: entity.ContributorAccepted},
+		{NewsID: newsID, UserID: uuid.New(), Role: entity.ContributorPhotographer, Status: entity.ContributorInvited},
+	}
+
+	t.Run("Co-author", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, &entity.User{ID: coAuthorID})
+		mockContributorsStorage.EXPECT().GetNews(ctx, newsID).Return(news, nil)
+		mockContributorsStorage.EXPECT().GetContributors(ctx, newsID).Return(contributors, nil)
+
+		result, err := contributorsService.GetContributors(ctx, newsID)
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+	})
+
+	t.Run("Reader", func(t *testing.T) {
+		ctx := context.Background()
+		mockContributorsStorage.EXPECT().GetNews(ctx, newsID).Return(news, nil)
+		mockContributorsStorage.EXPECT().GetContributors(ctx, newsID).Return(contributors, nil)
+
+		result, err := contributorsService.GetContributors(ctx, newsID)
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		require.Equal(t, coAuthorID, result[0].UserID)
+	})
+}
+
+func TestService_UpdateNewsCoAuthor(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsStorage := mockstorage.NewMockNewsPsql(ctrl)
+	mockNewsRedis := mockredis.NewMockNewsRedis(ctrl)
+	newsService := NewNewsService(nil, mockNewsStorage, nil, nil, mockNewsRedis, nil, nil, nil, nil, nil, nil, nil, apiLogger)
+
+	authorID, coAuthorID, newsID := uuid.New(), uuid.New(), uuid.New()
+	ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, &entity.User{ID: coAuthorID})
+
+	newsBase := &entity.NewsBase{
+		NewsID:   newsID,
+		AuthorID: authorID,
+		Status:   entity.NewsStatusDraft,
+		Contributors: []*entity.NewsContributor{
+			{NewsID: newsID, UserID: coAuthorID, Role: entity.ContributorAuthor, Status: entity.ContributorAccepted},
+		},
+	}
+	news := &entity.News{NewsID: newsID, Content: "ContentContentContentContentContent"}
+	updated := &entity.News{NewsID: newsID, AuthorID: authorID, Status: entity.NewsStatusDraft}
+
+	mockNewsStorage.EXPECT().GetNewsByID(ctx, newsID).Return(newsBase, nil)
+	mockNewsStorage.EXPECT().Update(ctx, news, &entity.NewsRevision{EditorID: coAuthorID}).Return(updated, nil)
+	mockNewsRedis.EXPECT().DeleteNewsCtx(ctx, gomock.Any()).Return(nil)
+
+	result, err := newsService.Update(ctx, news)
+	require.NoError(t, err)
+	require.Equal(t, authorID, result.AuthorID)
+}
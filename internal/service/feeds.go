@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/feed"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/markdown"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// Feeds news source interface
+type FeedsNews interface {
+	GetNews(ctx context.Context, pq *utils.PaginationQuery) (*entity.NewsList, error)
+	GetNewsByAuthor(ctx context.Context, authorID uuid.UUID, pq *utils.PaginationQuery) (*entity.NewsList, error)
+}
+
+// Feeds categories source interface
+type FeedsCategories interface {
+	GetBySlug(ctx context.Context, slug string) (*entity.Category, error)
+	GetNews(ctx context.Context, slug string, descendants bool, pq *utils.PaginationQuery) (*entity.NewsList, error)
+}
+
+// Feeds tags source interface
+type FeedsTags interface {
+	GetNewsByTag(ctx context.Context, slug string, pq *utils.PaginationQuery) (*entity.NewsList, error)
+}
+
+// Feeds authors source interface
+type FeedsUsers interface {
+	GetUserByID(ctx context.Context, userID uuid.UUID) (*entity.User, error)
+}
+
+// Feeds StorageRedis interface
+type FeedsRedis interface {
+	GetFeedCtx(ctx context.Context, version int64, key string) (*entity.FeedDocument, error)
+	SetFeedCtx(ctx context.Context, version int64, key string, seconds int, feed *entity.FeedDocument) error
+	GetFeedsVersionCtx(ctx context.Context) (int64, error)
+	InvalidateFeedsCtx(ctx context.Context) error
+}
+
+// Feeds service
+type FeedsService struct {
+	logger       logger.Logger
+	config       *config.Config
+	news         FeedsNews
+	categories   FeedsCategories
+	tags         FeedsTags
+	users        FeedsUsers
+	storageRedis FeedsRedis
+}
+
+// Feeds service constructor
+func NewFeedsService(config *config.Config, news FeedsNews, categories FeedsCategories, tags FeedsTags, users FeedsUsers, redis FeedsRedis, logger logger.Logger) *FeedsService {
+	return &FeedsService{
+		config:       config,
+		news:         news,
+		categories:   categories,
+		tags:         tags,
+		users:        users,
+		storageRedis: redis,
+		logger:       logger,
+	}
+}
+
+// Get rendered feed, cached until news change or ttl expires
+func (f *FeedsService) GetFeed(ctx context.Context, query *entity.FeedQuery) (*entity.FeedDocument, error) {
+	if err := utils.ValidateStruct(ctx, query); err != nil {
+		return nil, httpe.NewBadRequestError(errors.WithMessage(err, "FeedsService.GetFeed.ValidateStruct"))
+	}
+
+	key := fmt.Sprintf("%s:%s:%s", query.Kind, query.Key, query.Format)
+	version, err := f.storageRedis.GetFeedsVersionCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cachedFeed, err := f.storageRedis.GetFeedCtx(ctx, version, key)
+	if err != nil {
+		return nil, err
+	}
+	if cachedFeed != nil {
+		return cachedFeed, nil
+	}
+
+	newsFeed, err := f.buildFeed(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	body, contentType, err := newsFeed.Render(query.Format)
+	if err != nil {
+		return nil, httpe.NewInternalServerError(errors.WithMessage(err, "FeedsService.GetFeed.Render"))
+	}
+	sum := sha256.Sum256(body)
+	document := &entity.FeedDocument{
+		Body:         body,
+		ContentType:  contentType,
+		ETag:         `"` + hex.EncodeToString(sum[:16]) + `"`,
+		LastModified: newsFeed.Updated,
+	}
+
+	if err := f.storageRedis.SetFeedCtx(ctx, version, key, f.config.Feeds.CacheTTL, document); err != nil {
+		f.logger.Errorf("FeedsService.GetFeed.SetFeedCtx: %v", err)
+	}
+	return document, nil
+}
+
+// Load news of feed and describe the feed itself
+func (f *FeedsService) buildFeed(ctx context.Context, query *entity.FeedQuery) (*feed.Feed, error) {
+	var (
+		newsList *entity.NewsList
+		category *entity.Category
+		user     *entity.User
+		authorID uuid.UUID
+		err      error
+	)
+	baseURL := f.config.Feeds.BaseURL
+	pq := &utils.PaginationQuery{Size: f.config.Feeds.Size}
+	newsFeed := &feed.Feed{
+		Title:       f.config.Feeds.Title,
+		Description: f.config.Feeds.Description,
+		Link:        baseURL + "/news",
+	}
+
+	switch query.Kind {
+	case entity.FeedAll:
+		newsFeed.FeedURL = fmt.Sprintf("%s/feeds/news.%s", baseURL, query.Format)
+		newsList, err = f.news.GetNews(ctx, pq)
+	case entity.FeedCategory:
+		category, err = f.categories.GetBySlug(ctx, query.Key)
+		if err != nil {
+			return nil, err
+		}
+		newsFeed.Title = fmt.Sprintf("%s: %s", newsFeed.Title, category.Name)
+		newsFeed.Link = fmt.Sprintf("%s/categories/%s", baseURL, category.Slug)
+		newsFeed.FeedURL = fmt.Sprintf("%s/feeds/categories/%s/news.%s", baseURL, category.Slug, query.Format)
+		newsList, err = f.categories.GetNews(ctx, category.Slug, true, pq)
+	case entity.FeedTag:
+		newsFeed.Title = fmt.Sprintf("%s: #%s", newsFeed.Title, query.Key)
+		newsFeed.Link = fmt.Sprintf("%s/tags/%s", baseURL, query.Key)
+		newsFeed.FeedURL = fmt.Sprintf("%s/feeds/tags/%s/news.%s", baseURL, query.Key, query.Format)
+		newsList, err = f.tags.GetNewsByTag(ctx, query.Key, pq)
+	case entity.FeedAuthor:
+		if authorID, err = uuid.Parse(query.Key); err != nil {
+			return nil, httpe.NewBadRequestError(errors.WithMessage(err, "FeedsService.buildFeed.Parse"))
+		}
+		user, err = f.users.GetUserByID(ctx, authorID)
+		if err != nil {
+			return nil, err
+		}
+		newsFeed.Title = fmt.Sprintf("%s: %s %s", newsFeed.Title, user.FirstName, user.LastName)
+		newsFeed.Link = fmt.Sprintf("%s/authors/%s", baseURL, authorID)
+		newsFeed.FeedURL = fmt.Sprintf("%s/feeds/authors/%s/news.%s", baseURL, authorID, query.Format)
+		newsList, err = f.news.GetNewsByAuthor(ctx, authorID, pq)
+	}
+	if err != nil {
+		return nil, err
+	}
+	newsFeed.ID = newsFeed.FeedURL
+
+	for _, news := range newsList.News {
+		if news.Status != "" && news.Status != entity.NewsStatusPublished {
+			continue
+		}
+		contentHTML, _ := markdown.Format(markdown.FormatHTML, news.Content, news.ContentHTML)
+		item := &feed.Item{
+			ID:          "urn:uuid:" + news.NewsID.String(),
+			Title:       news.Title,
+			Link:        fmt.Sprintf("%s/news/%s", baseURL, news.Slug),
+			ContentHTML: contentHTML,
+			Categories:  news.Tags,
+			Published:   news.CreatedAt,
+			Updated:     news.UpdatedAt,
+		}
+		if news.PublishAt != nil {
+			item.Published = *news.PublishAt
+		}
+		if item.Updated.Before(item.Published) {
+			item.Updated = item.Published
+		}
+		if news.Category != nil {
+			item.Categories = append([]string{*news.Category}, item.Categories...)
+		}
+		if item.Updated.After(newsFeed.Updated) {
+			newsFeed.Updated = item.Updated
+		}
+		newsFeed.Items = append(newsFeed.Items, item)
+	}
+	sort.SliceStable(newsFeed.Items, func(i, j int) bool {
+		return newsFeed.Items[i].Published.After(newsFeed.Items[j].Published)
+	})
+	if newsFeed.Updated.IsZero() {
+		newsFeed.Updated = time.Unix(0, 0).UTC()
+	}
+	return newsFeed, nil
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	mockservice "github.com/Edbeer/restapi/internal/service/mock"
+	mockredis "github.com/Edbeer/restapi/internal/storage/redis/mock"
+	"github.com/Edbeer/restapi/pkg/feed"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_GetFeed(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		Feeds: config.FeedsConfig{
+			Title:    "News",
+			BaseURL:  "http://localhost:5000",
+			Size:     10,
+			CacheTTL: 600,
+		},
+	}
+	apiLogger := logger.NewApiLogger(nil)
+	mockNews := mockservice.NewMockNews(ctrl)
+	mockTags := mockservice.NewMockTags(ctrl)
+	mockFeedsRedis := mockredis.NewMockFeedsRedis(ctrl)
+	feedsService := NewFeedsService(cfg, mockNews, nil, mockTags, nil, mockFeedsRedis, apiLogger)
+
+	ctx := context.Background()
+	older := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+	newsList := &entity.NewsList{
+		News: []*entity.News{
+			{
+				NewsID:    uuid.New(),
+				Title:     "Older title",
+				Slug:      "older-title",
+				Content:   "older content",
+				Status:    entity.NewsStatusPublished,
+				PublishAt: &older,
+				CreatedAt: older,
+				UpdatedAt: older,
+			},
+			{
+				NewsID:    uuid.New(),
+				Title:     "Newer title",
+				Slug:      "newer-title",
+				Content:   "newer content",
+				Status:    entity.NewsStatusPublished,
+				PublishAt: &newer,
+				CreatedAt: newer,
+				UpdatedAt: newer,
+			},
+		},
+	}
+
+	t.Run("Build", func(t *testing.T) {
+		query := &entity.FeedQuery{Kind: entity.FeedTag, Key: "golang", Format: feed.FormatJSON}
+		mockFeedsRedis.EXPECT().GetFeedsVersionCtx(ctx).Return(int64(3), nil)
+		mockFeedsRedis.EXPECT().GetFeedCtx(ctx, int64(3), "tag:golang:json").Return(nil, nil)
+		mockTags.EXPECT().GetNewsByTag(ctx, "golang", &utils.PaginationQuery{Size: 10}).Return(newsList, nil)
+		mockFeedsRedis.EXPECT().SetFeedCtx(ctx, int64(3), "tag:golang:json", 600, gomock.Any()).Return(nil)
+
+		document, err := feedsService.GetFeed(ctx, query)
+		require.NoError(t, err)
+		require.Equal(t, feed.ContentTypeJSON, document.ContentType)
+		require.Equal(t, newer, document.LastModified)
+		require.NotEmpty(t, document.ETag)
+		require.Contains(t, string(document.Body), "http://localhost:5000/news/newer-title")
+		require.Contains(t, string(document.Body), `"content_html":"\u003cp\u003enewer content\u003c/p\u003e\n"`)
+		require.Less(t, bytes.Index(document.Body, []byte("newer-title")), bytes.Index(document.Body, []byte("older-title")))
+	})
+
+	t.Run("Cached", func(t *testing.T) {
+		cached := &entity.FeedDocument{Body: []byte("<rss/>"), ContentType: feed.ContentTypeRSS, ETag: `"etag"`}
+		mockFeedsRedis.EXPECT().GetFeedsVersionCtx(ctx).Return(int64(3), nil)
+		mockFeedsRedis.EXPECT().GetFeedCtx(ctx, int64(3), "all::rss").Return(cached, nil)
+
+		document, err := feedsService.GetFeed(ctx, &entity.FeedQuery{Kind: entity.FeedAll, Format: feed.FormatRSS})
+		require.NoError(t, err)
+		require.Equal(t, cached, document)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := feedsService.GetFeed(ctx, &entity.FeedQuery{Kind: entity.FeedAll, Format: "xml"})
+		require.Error(t, err)
+
+		_, err = feedsService.GetFeed(ctx, &entity.FeedQuery{Kind: "user", Format: feed.FormatRSS})
+		require.Error(t, err)
+	})
+}
@@ -6,7 +6,9 @@ package mock
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/Edbeer/restapi/internal/entity"
 	utils "github.com/Edbeer/restapi/pkg/utils"
@@ -38,32 +40,32 @@ func (m *MockAuth) EXPECT() *MockAuthMockRecorder {
 }
 
 // Delete mocks base method.
-func (m *MockAuth) Delete(ctx context.Context, userID uuid.UUID) error {
+func (m *MockAuth) Delete(ctx context.Context, userID uuid.UUID, version int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, userID)
+	ret := m.ctrl.Call(m, "Delete", ctx, userID, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockAuthMockRecorder) Delete(ctx, userID interface{}) *gomock.Call {
+func (mr *MockAuthMockRecorder) Delete(ctx, userID, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAuth)(nil).Delete), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAuth)(nil).Delete), ctx, userID, version)
 }
 
 // FindUsersByName mocks base method.
-func (m *MockAuth) FindUsersByName(ctx context.Context, name string, pq *utils.PaginationQuery) (*entity.UsersList, error) {
+func (m *MockAuth) FindUsersByName(ctx context.Context, search *entity.UserSearchQuery, pq *utils.PaginationQuery) (*entity.UsersList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindUsersByName", ctx, name, pq)
+	ret := m.ctrl.Call(m, "FindUsersByName", ctx, search, pq)
 	ret0, _ := ret[0].(*entity.UsersList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindUsersByName indicates an expected call of FindUsersByName.
-func (mr *MockAuthMockRecorder) FindUsersByName(ctx, name, pq interface{}) *gomock.Call {
+func (mr *MockAuthMockRecorder) FindUsersByName(ctx, search, pq interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUsersByName", reflect.TypeOf((*MockAuth)(nil).FindUsersByName), ctx, name, pq)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUsersByName", reflect.TypeOf((*MockAuth)(nil).FindUsersByName), ctx, search, pq)
 }
 
 // GetUserByID mocks base method.
@@ -180,17 +182,32 @@ func (mr *MockNewsMockRecorder) Create(ctx, news interface{}) *gomock.Call {
 }
 
 // Delete mocks base method.
-func (m *MockNews) Delete(ctx context.Context, newsID uuid.UUID) error {
+func (m *MockNews) Delete(ctx context.Context, newsID uuid.UUID, version int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, newsID)
+	ret := m.ctrl.Call(m, "Delete", ctx, newsID, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockNewsMockRecorder) Delete(ctx, newsID interface{}) *gomock.Call {
+func (mr *MockNewsMockRecorder) Delete(ctx, newsID, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockNews)(nil).Delete), ctx, newsID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockNews)(nil).Delete), ctx, newsID, version)
+}
+
+// DiffRevisions mocks base method.
+func (m *MockNews) DiffRevisions(ctx context.Context, newsID uuid.UUID, from, to int) (*entity.NewsRevisionDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffRevisions", ctx, newsID, from, to)
+	ret0, _ := ret[0].(*entity.NewsRevisionDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffRevisions indicates an expected call of DiffRevisions.
+func (mr *MockNewsMockRecorder) DiffRevisions(ctx, newsID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffRevisions", reflect.TypeOf((*MockNews)(nil).DiffRevisions), ctx, newsID, from, to)
 }
 
 // GetNews mocks base method.
@@ -208,6 +225,21 @@ func (mr *MockNewsMockRecorder) GetNews(ctx, pq interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNews", reflect.TypeOf((*MockNews)(nil).GetNews), ctx, pq)
 }
 
+// GetNewsByAuthor mocks base method.
+func (m *MockNews) GetNewsByAuthor(ctx context.Context, authorID uuid.UUID, pq *utils.PaginationQuery) (*entity.NewsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNewsByAuthor", ctx, authorID, pq)
+	ret0, _ := ret[0].(*entity.NewsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNewsByAuthor indicates an expected call of GetNewsByAuthor.
+func (mr *MockNewsMockRecorder) GetNewsByAuthor(ctx, authorID, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNewsByAuthor", reflect.TypeOf((*MockNews)(nil).GetNewsByAuthor), ctx, authorID, pq)
+}
+
 // GetNewsByID mocks base method.
 func (m *MockNews) GetNewsByID(ctx context.Context, newsID uuid.UUID) (*entity.NewsBase, error) {
 	m.ctrl.T.Helper()
@@ -223,19 +255,168 @@ func (mr *MockNewsMockRecorder) GetNewsByID(ctx, newsID interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNewsByID", reflect.TypeOf((*MockNews)(nil).GetNewsByID), ctx, newsID)
 }
 
-// SearchNews mocks base method.
-func (m *MockNews) SearchNews(ctx context.Context, title string, pq *utils.PaginationQuery) (*entity.NewsList, error) {
+// GetNewsBySlug mocks base method.
+func (m *MockNews) GetNewsBySlug(ctx context.Context, slug string) (*entity.NewsBase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNewsBySlug", ctx, slug)
+	ret0, _ := ret[0].(*entity.NewsBase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNewsBySlug indicates an expected call of GetNewsBySlug.
+func (mr *MockNewsMockRecorder) GetNewsBySlug(ctx, slug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNewsBySlug", reflect.TypeOf((*MockNews)(nil).GetNewsBySlug), ctx, slug)
+}
+
+// GetReviewQueue mocks base method.
+func (m *MockNews) GetReviewQueue(ctx context.Context, pq *utils.PaginationQuery) (*entity.NewsList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchNews", ctx, title, pq)
+	ret := m.ctrl.Call(m, "GetReviewQueue", ctx, pq)
 	ret0, _ := ret[0].(*entity.NewsList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// GetReviewQueue indicates an expected call of GetReviewQueue.
+func (mr *MockNewsMockRecorder) GetReviewQueue(ctx, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewQueue", reflect.TypeOf((*MockNews)(nil).GetReviewQueue), ctx, pq)
+}
+
+// GetReviews mocks base method.
+func (m *MockNews) GetReviews(ctx context.Context, newsID uuid.UUID) ([]*entity.NewsReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviews", ctx, newsID)
+	ret0, _ := ret[0].([]*entity.NewsReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviews indicates an expected call of GetReviews.
+func (mr *MockNewsMockRecorder) GetReviews(ctx, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviews", reflect.TypeOf((*MockNews)(nil).GetReviews), ctx, newsID)
+}
+
+// GetRevision mocks base method.
+func (m *MockNews) GetRevision(ctx context.Context, newsID uuid.UUID, revision int) (*entity.NewsRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevision", ctx, newsID, revision)
+	ret0, _ := ret[0].(*entity.NewsRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevision indicates an expected call of GetRevision.
+func (mr *MockNewsMockRecorder) GetRevision(ctx, newsID, revision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevision", reflect.TypeOf((*MockNews)(nil).GetRevision), ctx, newsID, revision)
+}
+
+// GetRevisions mocks base method.
+func (m *MockNews) GetRevisions(ctx context.Context, newsID uuid.UUID, pq *utils.PaginationQuery) (*entity.NewsRevisionsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisions", ctx, newsID, pq)
+	ret0, _ := ret[0].(*entity.NewsRevisionsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisions indicates an expected call of GetRevisions.
+func (mr *MockNewsMockRecorder) GetRevisions(ctx, newsID, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisions", reflect.TypeOf((*MockNews)(nil).GetRevisions), ctx, newsID, pq)
+}
+
+// IncludeRelations mocks base method.
+func (m *MockNews) IncludeRelations(ctx context.Context, newsList []*entity.News, include []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncludeRelations", ctx, newsList, include)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncludeRelations indicates an expected call of IncludeRelations.
+func (mr *MockNewsMockRecorder) IncludeRelations(ctx, newsList, include interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncludeRelations", reflect.TypeOf((*MockNews)(nil).IncludeRelations), ctx, newsList, include)
+}
+
+// PublishScheduled mocks base method.
+func (m *MockNews) PublishScheduled(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishScheduled", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishScheduled indicates an expected call of PublishScheduled.
+func (mr *MockNewsMockRecorder) PublishScheduled(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishScheduled", reflect.TypeOf((*MockNews)(nil).PublishScheduled), ctx)
+}
+
+// ReviewNews mocks base method.
+func (m *MockNews) ReviewNews(ctx context.Context, review *entity.NewsReview) (*entity.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewNews", ctx, review)
+	ret0, _ := ret[0].(*entity.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewNews indicates an expected call of ReviewNews.
+func (mr *MockNewsMockRecorder) ReviewNews(ctx, review interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewNews", reflect.TypeOf((*MockNews)(nil).ReviewNews), ctx, review)
+}
+
+// RollbackRevision mocks base method.
+func (m *MockNews) RollbackRevision(ctx context.Context, newsID uuid.UUID, revision int) (*entity.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackRevision", ctx, newsID, revision)
+	ret0, _ := ret[0].(*entity.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackRevision indicates an expected call of RollbackRevision.
+func (mr *MockNewsMockRecorder) RollbackRevision(ctx, newsID, revision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackRevision", reflect.TypeOf((*MockNews)(nil).RollbackRevision), ctx, newsID, revision)
+}
+
+// SearchNews mocks base method.
+func (m *MockNews) SearchNews(ctx context.Context, search *entity.NewsSearchQuery, pq *utils.PaginationQuery) (*entity.NewsSearchList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchNews", ctx, search, pq)
+	ret0, _ := ret[0].(*entity.NewsSearchList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // SearchNews indicates an expected call of SearchNews.
-func (mr *MockNewsMockRecorder) SearchNews(ctx, title, pq interface{}) *gomock.Call {
+func (mr *MockNewsMockRecorder) SearchNews(ctx, search, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchNews", reflect.TypeOf((*MockNews)(nil).SearchNews), ctx, search, pq)
+}
+
+// SubmitForReview mocks base method.
+func (m *MockNews) SubmitForReview(ctx context.Context, newsID uuid.UUID, comment string) (*entity.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitForReview", ctx, newsID, comment)
+	ret0, _ := ret[0].(*entity.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitForReview indicates an expected call of SubmitForReview.
+func (mr *MockNewsMockRecorder) SubmitForReview(ctx, newsID, comment interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchNews", reflect.TypeOf((*MockNews)(nil).SearchNews), ctx, title, pq)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitForReview", reflect.TypeOf((*MockNews)(nil).SubmitForReview), ctx, newsID, comment)
 }
 
 // Update mocks base method.
@@ -292,17 +473,17 @@ func (mr *MockCommentsMockRecorder) Create(ctx, comments interface{}) *gomock.Ca
 }
 
 // Delete mocks base method.
-func (m *MockComments) Delete(ctx context.Context, commentID uuid.UUID) error {
+func (m *MockComments) Delete(ctx context.Context, commentID uuid.UUID, version int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, commentID)
+	ret := m.ctrl.Call(m, "Delete", ctx, commentID, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockCommentsMockRecorder) Delete(ctx, commentID interface{}) *gomock.Call {
+func (mr *MockCommentsMockRecorder) Delete(ctx, commentID, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockComments)(nil).Delete), ctx, commentID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockComments)(nil).Delete), ctx, commentID, version)
 }
 
 // GetAllByNewsID mocks base method.
@@ -416,3 +597,1173 @@ func (mr *MockSessionMockRecorder) GetSessionByID(ctx, sessionID interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionByID", reflect.TypeOf((*MockSession)(nil).GetSessionByID), ctx, sessionID)
 }
+
+// MockSuggest is a mock of Suggest interface.
+type MockSuggest struct {
+	ctrl     *gomock.Controller
+	recorder *MockSuggestMockRecorder
+}
+
+// MockSuggestMockRecorder is the mock recorder for MockSuggest.
+type MockSuggestMockRecorder struct {
+	mock *MockSuggest
+}
+
+// NewMockSuggest creates a new mock instance.
+func NewMockSuggest(ctrl *gomock.Controller) *MockSuggest {
+	mock := &MockSuggest{ctrl: ctrl}
+	mock.recorder = &MockSuggestMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSuggest) EXPECT() *MockSuggestMockRecorder {
+	return m.recorder
+}
+
+// Rebuild mocks base method.
+func (m *MockSuggest) Rebuild(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rebuild", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rebuild indicates an expected call of Rebuild.
+func (mr *MockSuggestMockRecorder) Rebuild(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rebuild", reflect.TypeOf((*MockSuggest)(nil).Rebuild), ctx)
+}
+
+// Suggest mocks base method.
+func (m *MockSuggest) Suggest(ctx context.Context, prefix, kind string, limit int) (*entity.SuggestList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggest", ctx, prefix, kind, limit)
+	ret0, _ := ret[0].(*entity.SuggestList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MockSuggestMockRecorder) Suggest(ctx, prefix, kind, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MockSuggest)(nil).Suggest), ctx, prefix, kind, limit)
+}
+
+// MockTags is a mock of Tags interface.
+type MockTags struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagsMockRecorder
+}
+
+// MockTagsMockRecorder is the mock recorder for MockTags.
+type MockTagsMockRecorder struct {
+	mock *MockTags
+}
+
+// NewMockTags creates a new mock instance.
+func NewMockTags(ctrl *gomock.Controller) *MockTags {
+	mock := &MockTags{ctrl: ctrl}
+	mock.recorder = &MockTagsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTags) EXPECT() *MockTagsMockRecorder {
+	return m.recorder
+}
+
+// GetNewsByTag mocks base method.
+func (m *MockTags) GetNewsByTag(ctx context.Context, slug string, pq *utils.PaginationQuery) (*entity.NewsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNewsByTag", ctx, slug, pq)
+	ret0, _ := ret[0].(*entity.NewsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNewsByTag indicates an expected call of GetNewsByTag.
+func (mr *MockTagsMockRecorder) GetNewsByTag(ctx, slug, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNewsByTag", reflect.TypeOf((*MockTags)(nil).GetNewsByTag), ctx, slug, pq)
+}
+
+// GetTags mocks base method.
+func (m *MockTags) GetTags(ctx context.Context, pq *utils.PaginationQuery) (*entity.TagsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTags", ctx, pq)
+	ret0, _ := ret[0].(*entity.TagsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTags indicates an expected call of GetTags.
+func (mr *MockTagsMockRecorder) GetTags(ctx, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockTags)(nil).GetTags), ctx, pq)
+}
+
+// MergeTags mocks base method.
+func (m *MockTags) MergeTags(ctx context.Context, slug string, merge *entity.TagMerge) (*entity.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeTags", ctx, slug, merge)
+	ret0, _ := ret[0].(*entity.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeTags indicates an expected call of MergeTags.
+func (mr *MockTagsMockRecorder) MergeTags(ctx, slug, merge interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeTags", reflect.TypeOf((*MockTags)(nil).MergeTags), ctx, slug, merge)
+}
+
+// RenameTag mocks base method.
+func (m *MockTags) RenameTag(ctx context.Context, slug, name string) (*entity.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameTag", ctx, slug, name)
+	ret0, _ := ret[0].(*entity.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameTag indicates an expected call of RenameTag.
+func (mr *MockTagsMockRecorder) RenameTag(ctx, slug, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameTag", reflect.TypeOf((*MockTags)(nil).RenameTag), ctx, slug, name)
+}
+
+// MockCategories is a mock of Categories interface.
+type MockCategories struct {
+	ctrl     *gomock.Controller
+	recorder *MockCategoriesMockRecorder
+}
+
+// MockCategoriesMockRecorder is the mock recorder for MockCategories.
+type MockCategoriesMockRecorder struct {
+	mock *MockCategories
+}
+
+// NewMockCategories creates a new mock instance.
+func NewMockCategories(ctrl *gomock.Controller) *MockCategories {
+	mock := &MockCategories{ctrl: ctrl}
+	mock.recorder = &MockCategoriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCategories) EXPECT() *MockCategoriesMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCategories) Create(ctx context.Context, category *entity.Category) (*entity.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, category)
+	ret0, _ := ret[0].(*entity.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCategoriesMockRecorder) Create(ctx, category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCategories)(nil).Create), ctx, category)
+}
+
+// Delete mocks base method.
+func (m *MockCategories) Delete(ctx context.Context, slug string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, slug)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCategoriesMockRecorder) Delete(ctx, slug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCategories)(nil).Delete), ctx, slug)
+}
+
+// GetBySlug mocks base method.
+func (m *MockCategories) GetBySlug(ctx context.Context, slug string) (*entity.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBySlug", ctx, slug)
+	ret0, _ := ret[0].(*entity.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBySlug indicates an expected call of GetBySlug.
+func (mr *MockCategoriesMockRecorder) GetBySlug(ctx, slug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySlug", reflect.TypeOf((*MockCategories)(nil).GetBySlug), ctx, slug)
+}
+
+// GetNews mocks base method.
+func (m *MockCategories) GetNews(ctx context.Context, slug string, descendants bool, pq *utils.PaginationQuery) (*entity.NewsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNews", ctx, slug, descendants, pq)
+	ret0, _ := ret[0].(*entity.NewsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNews indicates an expected call of GetNews.
+func (mr *MockCategoriesMockRecorder) GetNews(ctx, slug, descendants, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNews", reflect.TypeOf((*MockCategories)(nil).GetNews), ctx, slug, descendants, pq)
+}
+
+// GetTree mocks base method.
+func (m *MockCategories) GetTree(ctx context.Context) ([]*entity.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTree", ctx)
+	ret0, _ := ret[0].([]*entity.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTree indicates an expected call of GetTree.
+func (mr *MockCategoriesMockRecorder) GetTree(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTree", reflect.TypeOf((*MockCategories)(nil).GetTree), ctx)
+}
+
+// Update mocks base method.
+func (m *MockCategories) Update(ctx context.Context, slug string, category *entity.Category) (*entity.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, slug, category)
+	ret0, _ := ret[0].(*entity.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCategoriesMockRecorder) Update(ctx, slug, category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCategories)(nil).Update), ctx, slug, category)
+}
+
+// MockSitemaps is a mock of Sitemaps interface.
+type MockSitemaps struct {
+	ctrl     *gomock.Controller
+	recorder *MockSitemapsMockRecorder
+}
+
+// MockSitemapsMockRecorder is the mock recorder for MockSitemaps.
+type MockSitemapsMockRecorder struct {
+	mock *MockSitemaps
+}
+
+// NewMockSitemaps creates a new mock instance.
+func NewMockSitemaps(ctrl *gomock.Controller) *MockSitemaps {
+	mock := &MockSitemaps{ctrl: ctrl}
+	mock.recorder = &MockSitemapsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSitemaps) EXPECT() *MockSitemapsMockRecorder {
+	return m.recorder
+}
+
+// GetIndex mocks base method.
+func (m *MockSitemaps) GetIndex(ctx context.Context) (*entity.SitemapDocument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIndex", ctx)
+	ret0, _ := ret[0].(*entity.SitemapDocument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIndex indicates an expected call of GetIndex.
+func (mr *MockSitemapsMockRecorder) GetIndex(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIndex", reflect.TypeOf((*MockSitemaps)(nil).GetIndex), ctx)
+}
+
+// GetLatest mocks base method.
+func (m *MockSitemaps) GetLatest(ctx context.Context) (*entity.SitemapDocument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatest", ctx)
+	ret0, _ := ret[0].(*entity.SitemapDocument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatest indicates an expected call of GetLatest.
+func (mr *MockSitemapsMockRecorder) GetLatest(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatest", reflect.TypeOf((*MockSitemaps)(nil).GetLatest), ctx)
+}
+
+// GetPage mocks base method.
+func (m *MockSitemaps) GetPage(ctx context.Context, page int) (*entity.SitemapDocument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPage", ctx, page)
+	ret0, _ := ret[0].(*entity.SitemapDocument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPage indicates an expected call of GetPage.
+func (mr *MockSitemapsMockRecorder) GetPage(ctx, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPage", reflect.TypeOf((*MockSitemaps)(nil).GetPage), ctx, page)
+}
+
+// InvalidateNews mocks base method.
+func (m *MockSitemaps) InvalidateNews(ctx context.Context, newsID uuid.UUID, publishAt *time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvalidateNews", ctx, newsID, publishAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InvalidateNews indicates an expected call of InvalidateNews.
+func (mr *MockSitemapsMockRecorder) InvalidateNews(ctx, newsID, publishAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateNews", reflect.TypeOf((*MockSitemaps)(nil).InvalidateNews), ctx, newsID, publishAt)
+}
+
+// MockPages is a mock of Pages interface.
+type MockPages struct {
+	ctrl     *gomock.Controller
+	recorder *MockPagesMockRecorder
+}
+
+// MockPagesMockRecorder is the mock recorder for MockPages.
+type MockPagesMockRecorder struct {
+	mock *MockPages
+}
+
+// NewMockPages creates a new mock instance.
+func NewMockPages(ctrl *gomock.Controller) *MockPages {
+	mock := &MockPages{ctrl: ctrl}
+	mock.recorder = &MockPagesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPages) EXPECT() *MockPagesMockRecorder {
+	return m.recorder
+}
+
+// RenderArticle mocks base method.
+func (m *MockPages) RenderArticle(ctx context.Context, slug string) (*entity.PageDocument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderArticle", ctx, slug)
+	ret0, _ := ret[0].(*entity.PageDocument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderArticle indicates an expected call of RenderArticle.
+func (mr *MockPagesMockRecorder) RenderArticle(ctx, slug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderArticle", reflect.TypeOf((*MockPages)(nil).RenderArticle), ctx, slug)
+}
+
+// RenderCategory mocks base method.
+func (m *MockPages) RenderCategory(ctx context.Context, slug string, page int) (*entity.PageDocument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderCategory", ctx, slug, page)
+	ret0, _ := ret[0].(*entity.PageDocument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderCategory indicates an expected call of RenderCategory.
+func (mr *MockPagesMockRecorder) RenderCategory(ctx, slug, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderCategory", reflect.TypeOf((*MockPages)(nil).RenderCategory), ctx, slug, page)
+}
+
+// RenderList mocks base method.
+func (m *MockPages) RenderList(ctx context.Context, page int) (*entity.PageDocument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderList", ctx, page)
+	ret0, _ := ret[0].(*entity.PageDocument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderList indicates an expected call of RenderList.
+func (mr *MockPagesMockRecorder) RenderList(ctx, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderList", reflect.TypeOf((*MockPages)(nil).RenderList), ctx, page)
+}
+
+// MockFeeds is a mock of Feeds interface.
+type MockFeeds struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeedsMockRecorder
+}
+
+// MockFeedsMockRecorder is the mock recorder for MockFeeds.
+type MockFeedsMockRecorder struct {
+	mock *MockFeeds
+}
+
+// NewMockFeeds creates a new mock instance.
+func NewMockFeeds(ctrl *gomock.Controller) *MockFeeds {
+	mock := &MockFeeds{ctrl: ctrl}
+	mock.recorder = &MockFeedsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeeds) EXPECT() *MockFeedsMockRecorder {
+	return m.recorder
+}
+
+// GetFeed mocks base method.
+func (m *MockFeeds) GetFeed(ctx context.Context, query *entity.FeedQuery) (*entity.FeedDocument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeed", ctx, query)
+	ret0, _ := ret[0].(*entity.FeedDocument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeed indicates an expected call of GetFeed.
+func (mr *MockFeedsMockRecorder) GetFeed(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeed", reflect.TypeOf((*MockFeeds)(nil).GetFeed), ctx, query)
+}
+
+// MockViews is a mock of Views interface.
+type MockViews struct {
+	ctrl     *gomock.Controller
+	recorder *MockViewsMockRecorder
+}
+
+// MockViewsMockRecorder is the mock recorder for MockViews.
+type MockViewsMockRecorder struct {
+	mock *MockViews
+}
+
+// NewMockViews creates a new mock instance.
+func NewMockViews(ctrl *gomock.Controller) *MockViews {
+	mock := &MockViews{ctrl: ctrl}
+	mock.recorder = &MockViewsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockViews) EXPECT() *MockViewsMockRecorder {
+	return m.recorder
+}
+
+// FlushViews mocks base method.
+func (m *MockViews) FlushViews(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FlushViews", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FlushViews indicates an expected call of FlushViews.
+func (mr *MockViewsMockRecorder) FlushViews(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlushViews", reflect.TypeOf((*MockViews)(nil).FlushViews), ctx)
+}
+
+// GetTrending mocks base method.
+func (m *MockViews) GetTrending(ctx context.Context, query *entity.TrendingQuery) (*entity.TrendingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrending", ctx, query)
+	ret0, _ := ret[0].(*entity.TrendingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrending indicates an expected call of GetTrending.
+func (mr *MockViewsMockRecorder) GetTrending(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrending", reflect.TypeOf((*MockViews)(nil).GetTrending), ctx, query)
+}
+
+// MockReactions is a mock of Reactions interface.
+type MockReactions struct {
+	ctrl     *gomock.Controller
+	recorder *MockReactionsMockRecorder
+}
+
+// MockReactionsMockRecorder is the mock recorder for MockReactions.
+type MockReactionsMockRecorder struct {
+	mock *MockReactions
+}
+
+// NewMockReactions creates a new mock instance.
+func NewMockReactions(ctrl *gomock.Controller) *MockReactions {
+	mock := &MockReactions{ctrl: ctrl}
+	mock.recorder = &MockReactionsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReactions) EXPECT() *MockReactionsMockRecorder {
+	return m.recorder
+}
+
+// GetReactions mocks base method.
+func (m *MockReactions) GetReactions(ctx context.Context, targetType string, targetID uuid.UUID) (*entity.Reactions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReactions", ctx, targetType, targetID)
+	ret0, _ := ret[0].(*entity.Reactions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReactions indicates an expected call of GetReactions.
+func (mr *MockReactionsMockRecorder) GetReactions(ctx, targetType, targetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReactions", reflect.TypeOf((*MockReactions)(nil).GetReactions), ctx, targetType, targetID)
+}
+
+// React mocks base method.
+func (m *MockReactions) React(ctx context.Context, targetType string, targetID uuid.UUID, kind string) (*entity.Reactions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "React", ctx, targetType, targetID, kind)
+	ret0, _ := ret[0].(*entity.Reactions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// React indicates an expected call of React.
+func (mr *MockReactionsMockRecorder) React(ctx, targetType, targetID, kind interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "React", reflect.TypeOf((*MockReactions)(nil).React), ctx, targetType, targetID, kind)
+}
+
+// Reconcile mocks base method.
+func (m *MockReactions) Reconcile(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reconcile", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reconcile indicates an expected call of Reconcile.
+func (mr *MockReactionsMockRecorder) Reconcile(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockReactions)(nil).Reconcile), ctx)
+}
+
+// Unreact mocks base method.
+func (m *MockReactions) Unreact(ctx context.Context, targetType string, targetID uuid.UUID, kind string) (*entity.Reactions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unreact", ctx, targetType, targetID, kind)
+	ret0, _ := ret[0].(*entity.Reactions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unreact indicates an expected call of Unreact.
+func (mr *MockReactionsMockRecorder) Unreact(ctx, targetType, targetID, kind interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unreact", reflect.TypeOf((*MockReactions)(nil).Unreact), ctx, targetType, targetID, kind)
+}
+
+// MockBookmarks is a mock of Bookmarks interface.
+type MockBookmarks struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookmarksMockRecorder
+}
+
+// MockBookmarksMockRecorder is the mock recorder for MockBookmarks.
+type MockBookmarksMockRecorder struct {
+	mock *MockBookmarks
+}
+
+// NewMockBookmarks creates a new mock instance.
+func NewMockBookmarks(ctrl *gomock.Controller) *MockBookmarks {
+	mock := &MockBookmarks{ctrl: ctrl}
+	mock.recorder = &MockBookmarksMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookmarks) EXPECT() *MockBookmarksMockRecorder {
+	return m.recorder
+}
+
+// AddBookmark mocks base method.
+func (m *MockBookmarks) AddBookmark(ctx context.Context, newsID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBookmark", ctx, newsID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddBookmark indicates an expected call of AddBookmark.
+func (mr *MockBookmarksMockRecorder) AddBookmark(ctx, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBookmark", reflect.TypeOf((*MockBookmarks)(nil).AddBookmark), ctx, newsID)
+}
+
+// AddListItem mocks base method.
+func (m *MockBookmarks) AddListItem(ctx context.Context, listID, newsID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddListItem", ctx, listID, newsID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddListItem indicates an expected call of AddListItem.
+func (mr *MockBookmarksMockRecorder) AddListItem(ctx, listID, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddListItem", reflect.TypeOf((*MockBookmarks)(nil).AddListItem), ctx, listID, newsID)
+}
+
+// CreateList mocks base method.
+func (m *MockBookmarks) CreateList(ctx context.Context, list *entity.ReadingList) (*entity.ReadingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateList", ctx, list)
+	ret0, _ := ret[0].(*entity.ReadingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateList indicates an expected call of CreateList.
+func (mr *MockBookmarksMockRecorder) CreateList(ctx, list interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateList", reflect.TypeOf((*MockBookmarks)(nil).CreateList), ctx, list)
+}
+
+// DeleteList mocks base method.
+func (m *MockBookmarks) DeleteList(ctx context.Context, listID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteList", ctx, listID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteList indicates an expected call of DeleteList.
+func (mr *MockBookmarksMockRecorder) DeleteList(ctx, listID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteList", reflect.TypeOf((*MockBookmarks)(nil).DeleteList), ctx, listID)
+}
+
+// GetBookmarks mocks base method.
+func (m *MockBookmarks) GetBookmarks(ctx context.Context, pq *utils.PaginationQuery) (*entity.NewsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookmarks", ctx, pq)
+	ret0, _ := ret[0].(*entity.NewsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookmarks indicates an expected call of GetBookmarks.
+func (mr *MockBookmarksMockRecorder) GetBookmarks(ctx, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookmarks", reflect.TypeOf((*MockBookmarks)(nil).GetBookmarks), ctx, pq)
+}
+
+// GetList mocks base method.
+func (m *MockBookmarks) GetList(ctx context.Context, listID uuid.UUID, pq *utils.PaginationQuery) (*entity.ReadingListNews, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetList", ctx, listID, pq)
+	ret0, _ := ret[0].(*entity.ReadingListNews)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetList indicates an expected call of GetList.
+func (mr *MockBookmarksMockRecorder) GetList(ctx, listID, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetList", reflect.TypeOf((*MockBookmarks)(nil).GetList), ctx, listID, pq)
+}
+
+// GetLists mocks base method.
+func (m *MockBookmarks) GetLists(ctx context.Context) ([]*entity.ReadingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLists", ctx)
+	ret0, _ := ret[0].([]*entity.ReadingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLists indicates an expected call of GetLists.
+func (mr *MockBookmarksMockRecorder) GetLists(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLists", reflect.TypeOf((*MockBookmarks)(nil).GetLists), ctx)
+}
+
+// RemoveBookmark mocks base method.
+func (m *MockBookmarks) RemoveBookmark(ctx context.Context, newsID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveBookmark", ctx, newsID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveBookmark indicates an expected call of RemoveBookmark.
+func (mr *MockBookmarksMockRecorder) RemoveBookmark(ctx, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBookmark", reflect.TypeOf((*MockBookmarks)(nil).RemoveBookmark), ctx, newsID)
+}
+
+// RemoveListItem mocks base method.
+func (m *MockBookmarks) RemoveListItem(ctx context.Context, listID, newsID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveListItem", ctx, listID, newsID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveListItem indicates an expected call of RemoveListItem.
+func (mr *MockBookmarksMockRecorder) RemoveListItem(ctx, listID, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveListItem", reflect.TypeOf((*MockBookmarks)(nil).RemoveListItem), ctx, listID, newsID)
+}
+
+// ReorderList mocks base method.
+func (m *MockBookmarks) ReorderList(ctx context.Context, listID uuid.UUID, order *entity.ReadingListOrder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderList", ctx, listID, order)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderList indicates an expected call of ReorderList.
+func (mr *MockBookmarksMockRecorder) ReorderList(ctx, listID, order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderList", reflect.TypeOf((*MockBookmarks)(nil).ReorderList), ctx, listID, order)
+}
+
+// UpdateList mocks base method.
+func (m *MockBookmarks) UpdateList(ctx context.Context, list *entity.ReadingListUpdate) (*entity.ReadingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateList", ctx, list)
+	ret0, _ := ret[0].(*entity.ReadingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateList indicates an expected call of UpdateList.
+func (mr *MockBookmarksMockRecorder) UpdateList(ctx, list interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateList", reflect.TypeOf((*MockBookmarks)(nil).UpdateList), ctx, list)
+}
+
+// MockRelated is a mock of Related interface.
+type MockRelated struct {
+	ctrl     *gomock.Controller
+	recorder *MockRelatedMockRecorder
+}
+
+// MockRelatedMockRecorder is the mock recorder for MockRelated.
+type MockRelatedMockRecorder struct {
+	mock *MockRelated
+}
+
+// NewMockRelated creates a new mock instance.
+func NewMockRelated(ctrl *gomock.Controller) *MockRelated {
+	mock := &MockRelated{ctrl: ctrl}
+	mock.recorder = &MockRelatedMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRelated) EXPECT() *MockRelatedMockRecorder {
+	return m.recorder
+}
+
+// GetRelated mocks base method.
+func (m *MockRelated) GetRelated(ctx context.Context, query *entity.RelatedQuery) (*entity.RelatedList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelated", ctx, query)
+	ret0, _ := ret[0].(*entity.RelatedList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRelated indicates an expected call of GetRelated.
+func (mr *MockRelatedMockRecorder) GetRelated(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelated", reflect.TypeOf((*MockRelated)(nil).GetRelated), ctx, query)
+}
+
+// MarkStale mocks base method.
+func (m *MockRelated) MarkStale(ctx context.Context, newsID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkStale", ctx, newsID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkStale indicates an expected call of MarkStale.
+func (mr *MockRelatedMockRecorder) MarkStale(ctx, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkStale", reflect.TypeOf((*MockRelated)(nil).MarkStale), ctx, newsID)
+}
+
+// Refresh mocks base method.
+func (m *MockRelated) Refresh(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockRelatedMockRecorder) Refresh(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockRelated)(nil).Refresh), ctx)
+}
+
+// MockNotifications is a mock of Notifications interface.
+type MockNotifications struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationsMockRecorder
+}
+
+// MockNotificationsMockRecorder is the mock recorder for MockNotifications.
+type MockNotificationsMockRecorder struct {
+	mock *MockNotifications
+}
+
+// NewMockNotifications creates a new mock instance.
+func NewMockNotifications(ctrl *gomock.Controller) *MockNotifications {
+	mock := &MockNotifications{ctrl: ctrl}
+	mock.recorder = &MockNotificationsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotifications) EXPECT() *MockNotificationsMockRecorder {
+	return m.recorder
+}
+
+// GetNotifications mocks base method.
+func (m *MockNotifications) GetNotifications(ctx context.Context, unreadOnly bool, pq *utils.PaginationQuery) (*entity.NotificationsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotifications", ctx, unreadOnly, pq)
+	ret0, _ := ret[0].(*entity.NotificationsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotifications indicates an expected call of GetNotifications.
+func (mr *MockNotificationsMockRecorder) GetNotifications(ctx, unreadOnly, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifications", reflect.TypeOf((*MockNotifications)(nil).GetNotifications), ctx, unreadOnly, pq)
+}
+
+// MarkAllRead mocks base method.
+func (m *MockNotifications) MarkAllRead(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllRead", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAllRead indicates an expected call of MarkAllRead.
+func (mr *MockNotificationsMockRecorder) MarkAllRead(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllRead", reflect.TypeOf((*MockNotifications)(nil).MarkAllRead), ctx)
+}
+
+// MarkRead mocks base method.
+func (m *MockNotifications) MarkRead(ctx context.Context, notificationID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", ctx, notificationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockNotificationsMockRecorder) MarkRead(ctx, notificationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockNotifications)(nil).MarkRead), ctx, notificationID)
+}
+
+// Notify mocks base method.
+func (m *MockNotifications) Notify(ctx context.Context, notification *entity.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", ctx, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockNotificationsMockRecorder) Notify(ctx, notification interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockNotifications)(nil).Notify), ctx, notification)
+}
+
+// MockTranslations is a mock of Translations interface.
+type MockTranslations struct {
+	ctrl     *gomock.Controller
+	recorder *MockTranslationsMockRecorder
+}
+
+// MockTranslationsMockRecorder is the mock recorder for MockTranslations.
+type MockTranslationsMockRecorder struct {
+	mock *MockTranslations
+}
+
+// NewMockTranslations creates a new mock instance.
+func NewMockTranslations(ctrl *gomock.Controller) *MockTranslations {
+	mock := &MockTranslations{ctrl: ctrl}
+	mock.recorder = &MockTranslationsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTranslations) EXPECT() *MockTranslationsMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTranslations) Create(ctx context.Context, translation *entity.NewsTranslation) (*entity.NewsTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, translation)
+	ret0, _ := ret[0].(*entity.NewsTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTranslationsMockRecorder) Create(ctx, translation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTranslations)(nil).Create), ctx, translation)
+}
+
+// Delete mocks base method.
+func (m *MockTranslations) Delete(ctx context.Context, newsID uuid.UUID, locale string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, newsID, locale)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTranslationsMockRecorder) Delete(ctx, newsID, locale interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTranslations)(nil).Delete), ctx, newsID, locale)
+}
+
+// GetTranslations mocks base method.
+func (m *MockTranslations) GetTranslations(ctx context.Context, newsID uuid.UUID) ([]*entity.NewsTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTranslations", ctx, newsID)
+	ret0, _ := ret[0].([]*entity.NewsTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTranslations indicates an expected call of GetTranslations.
+func (mr *MockTranslationsMockRecorder) GetTranslations(ctx, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTranslations", reflect.TypeOf((*MockTranslations)(nil).GetTranslations), ctx, newsID)
+}
+
+// Update mocks base method.
+func (m *MockTranslations) Update(ctx context.Context, translation *entity.NewsTranslation) (*entity.NewsTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, translation)
+	ret0, _ := ret[0].(*entity.NewsTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTranslationsMockRecorder) Update(ctx, translation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTranslations)(nil).Update), ctx, translation)
+}
+
+// MockContributors is a mock of Contributors interface.
+type MockContributors struct {
+	ctrl     *gomock.Controller
+	recorder *MockContributorsMockRecorder
+}
+
+// MockContributorsMockRecorder is the mock recorder for MockContributors.
+type MockContributorsMockRecorder struct {
+	mock *MockContributors
+}
+
+// NewMockContributors creates a new mock instance.
+func NewMockContributors(ctrl *gomock.Controller) *MockContributors {
+	mock := &MockContributors{ctrl: ctrl}
+	mock.recorder = &MockContributorsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContributors) EXPECT() *MockContributorsMockRecorder {
+	return m.recorder
+}
+
+// Accept mocks base method.
+func (m *MockContributors) Accept(ctx context.Context, newsID uuid.UUID) (*entity.NewsContributor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Accept", ctx, newsID)
+	ret0, _ := ret[0].(*entity.NewsContributor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Accept indicates an expected call of Accept.
+func (mr *MockContributorsMockRecorder) Accept(ctx, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MockContributors)(nil).Accept), ctx, newsID)
+}
+
+// GetContributors mocks base method.
+func (m *MockContributors) GetContributors(ctx context.Context, newsID uuid.UUID) ([]*entity.NewsContributor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContributors", ctx, newsID)
+	ret0, _ := ret[0].([]*entity.NewsContributor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContributors indicates an expected call of GetContributors.
+func (mr *MockContributorsMockRecorder) GetContributors(ctx, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContributors", reflect.TypeOf((*MockContributors)(nil).GetContributors), ctx, newsID)
+}
+
+// GetInvitations mocks base method.
+func (m *MockContributors) GetInvitations(ctx context.Context) ([]*entity.NewsContributor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvitations", ctx)
+	ret0, _ := ret[0].([]*entity.NewsContributor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvitations indicates an expected call of GetInvitations.
+func (mr *MockContributorsMockRecorder) GetInvitations(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvitations", reflect.TypeOf((*MockContributors)(nil).GetInvitations), ctx)
+}
+
+// Invite mocks base method.
+func (m *MockContributors) Invite(ctx context.Context, contributor *entity.NewsContributor) (*entity.NewsContributor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Invite", ctx, contributor)
+	ret0, _ := ret[0].(*entity.NewsContributor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Invite indicates an expected call of Invite.
+func (mr *MockContributorsMockRecorder) Invite(ctx, contributor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invite", reflect.TypeOf((*MockContributors)(nil).Invite), ctx, contributor)
+}
+
+// Remove mocks base method.
+func (m *MockContributors) Remove(ctx context.Context, newsID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, newsID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockContributorsMockRecorder) Remove(ctx, newsID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockContributors)(nil).Remove), ctx, newsID, userID)
+}
+
+// MockTransfer is a mock of Transfer interface.
+type MockTransfer struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferMockRecorder
+}
+
+// MockTransferMockRecorder is the mock recorder for MockTransfer.
+type MockTransferMockRecorder struct {
+	mock *MockTransfer
+}
+
+// NewMockTransfer creates a new mock instance.
+func NewMockTransfer(ctrl *gomock.Controller) *MockTransfer {
+	mock := &MockTransfer{ctrl: ctrl}
+	mock.recorder = &MockTransferMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransfer) EXPECT() *MockTransferMockRecorder {
+	return m.recorder
+}
+
+// Export mocks base method.
+func (m *MockTransfer) Export(ctx context.Context, format string, withComments bool, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, format, withComments, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockTransferMockRecorder) Export(ctx, format, withComments, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockTransfer)(nil).Export), ctx, format, withComments, w)
+}
+
+// Import mocks base method.
+func (m *MockTransfer) Import(ctx context.Context, format string, r io.Reader, dryRun bool) (*entity.ImportReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, format, r, dryRun)
+	ret0, _ := ret[0].(*entity.ImportReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockTransferMockRecorder) Import(ctx, format, r, dryRun interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockTransfer)(nil).Import), ctx, format, r, dryRun)
+}
+
+// MockWordPress is a mock of WordPress interface.
+type MockWordPress struct {
+	ctrl     *gomock.Controller
+	recorder *MockWordPressMockRecorder
+}
+
+// MockWordPressMockRecorder is the mock recorder for MockWordPress.
+type MockWordPressMockRecorder struct {
+	mock *MockWordPress
+}
+
+// NewMockWordPress creates a new mock instance.
+func NewMockWordPress(ctrl *gomock.Controller) *MockWordPress {
+	mock := &MockWordPress{ctrl: ctrl}
+	mock.recorder = &MockWordPressMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWordPress) EXPECT() *MockWordPressMockRecorder {
+	return m.recorder
+}
+
+// Import mocks base method.
+func (m *MockWordPress) Import(ctx context.Context, r io.Reader) (*entity.WordPressReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, r)
+	ret0, _ := ret[0].(*entity.WordPressReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockWordPressMockRecorder) Import(ctx, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockWordPress)(nil).Import), ctx, r)
+}
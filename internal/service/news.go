@@ -2,13 +2,16 @@ package service
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/Edbeer/restapi/config"
 	"github.com/Edbeer/restapi/internal/entity"
 	"github.com/Edbeer/restapi/pkg/httpe"
 	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/markdown"
 	"github.com/Edbeer/restapi/pkg/utils"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
@@ -22,11 +25,17 @@ var (
 // News StoragePsql interface
 type NewsPsql interface {
 	Create(ctx context.Context, news *entity.News) (*entity.News, error)
-	Update(ctx context.Context, news *entity.News) (*entity.News, error)
-	GetNews(ctx context.Context, pq *utils.PaginationQuery) (*entity.NewsList, error)
+	Update(ctx context.Context, news *entity.News, rev *entity.NewsRevision) (*entity.News, error)
+	GetNews(ctx context.Context, viewerID uuid.UUID, locales []string, pq *utils.PaginationQuery) (*entity.NewsList, error)
 	GetNewsByID(ctx context.Context, newsID uuid.UUID) (*entity.NewsBase, error)
-	SearchNews(ctx context.Context, title string, pq *utils.PaginationQuery) (*entity.NewsList, error)
-	Delete(ctx context.Context, newsID uuid.UUID) error
+	GetNewsIDBySlug(ctx context.Context, slug string) (uuid.UUID, error)
+	IsBookmarked(ctx context.Context, userID uuid.UUID, newsID uuid.UUID) (bool, error)
+	GetNewsByAuthor(ctx context.Context, authorID uuid.UUID, pq *utils.PaginationQuery) (*entity.NewsList, error)
+	SearchNews(ctx context.Context, search *entity.NewsSearchQuery, viewerID uuid.UUID, pq *utils.PaginationQuery) (*entity.NewsSearchList, error)
+	GetAuthors(ctx context.Context, userIDs []uuid.UUID) ([]*entity.NewsAuthor, error)
+	GetCommentCounts(ctx context.Context, newsIDs []uuid.UUID) ([]*entity.NewsCommentCount, error)
+	PublishScheduled(ctx context.Context) ([]*entity.News, error)
+	Delete(ctx context.Context, newsID uuid.UUID, version int) error
 }
 
 // News StorageRedis interface
@@ -36,21 +45,54 @@ type NewsRedis interface {
 	DeleteNewsCtx(ctx context.Context, key string) error
 }
 
+// News views StorageRedis interface
+type NewsViewsRedis interface {
+	RecordViewCtx(ctx context.Context, newsID uuid.UUID, visitor string, bucket int64, seconds int) (int64, error)
+}
+
+// News sitemaps interface
+type NewsSitemaps interface {
+	InvalidateNews(ctx context.Context, newsID uuid.UUID, publishAt *time.Time) error
+}
+
+// News related interface
+type NewsRelated interface {
+	MarkStale(ctx context.Context, newsID uuid.UUID) error
+}
+
 //  News service
 type NewsService struct {
-	logger       logger.Logger
-	config       *config.Config
-	storagePsql  NewsPsql
-	storageRedis NewsRedis
+	logger        logger.Logger
+	config        *config.Config
+	storagePsql   NewsPsql
+	revisionsPsql RevisionsPsql
+	categoryPsql  CategoriesPsql
+	storageRedis  NewsRedis
+	suggestRedis  SuggestRedis
+	feedsRedis    FeedsRedis
+	viewsRedis    NewsViewsRedis
+	sitemaps      NewsSitemaps
+	related       NewsRelated
+	reviewsPsql   ReviewsPsql
+	notifications NewsNotifications
 }
 
 // News service constructor
-func NewNewsService(config *config.Config, storagePsql NewsPsql, redis NewsRedis, logger logger.Logger) *NewsService {
+func NewNewsService(config *config.Config, storagePsql NewsPsql, revisionsPsql RevisionsPsql, categoryPsql CategoriesPsql, redis NewsRedis, suggestRedis SuggestRedis, feedsRedis FeedsRedis, viewsRedis NewsViewsRedis, sitemaps NewsSitemaps, related NewsRelated, reviewsPsql ReviewsPsql, notifications NewsNotifications, logger logger.Logger) *NewsService {
 	return &NewsService{
-		config:       config,
-		storagePsql:  storagePsql,
-		storageRedis: redis,
-		logger:       logger,
+		config:        config,
+		storagePsql:   storagePsql,
+		revisionsPsql: revisionsPsql,
+		categoryPsql:  categoryPsql,
+		storageRedis:  redis,
+		suggestRedis:  suggestRedis,
+		feedsRedis:    feedsRedis,
+		viewsRedis:    viewsRedis,
+		sitemaps:      sitemaps,
+		related:       related,
+		reviewsPsql:   reviewsPsql,
+		notifications: notifications,
+		logger:        logger,
 	}
 }
 
@@ -61,41 +103,96 @@ func (n *NewsService) Create(ctx context.Context, news *entity.News) (*entity.Ne
 		return nil, httpe.NewUnauthorizedError(errors.WithMessage(err, "NewsService.Create.GetUserFromCtx"))
 	}
 	news.AuthorID = user.ID
+	news.Locale = newsLocale(news)
 
 	if err = utils.ValidateStruct(ctx, news); err != nil {
 		return nil, httpe.NewBadRequestError(errors.WithMessage(err, "NewsService.Create.ValidateStruct"))
 	}
 
+	if news.Status == "" && !isEditor(ctx) {
+		news.Status = entity.NewsStatusDraft
+	}
+	if err = checkPublishing(ctx, news.Status, ""); err != nil {
+		return nil, httpe.NewRestError(http.StatusForbidden, "Forbidden", errors.Wrap(err, "NewsService.Create.checkPublishing"))
+	}
+
+	if err = prepareNewsStatus(news, ""); err != nil {
+		return nil, httpe.NewBadRequestError(errors.WithMessage(err, "NewsService.Create.prepareNewsStatus"))
+	}
+
+	if news.Tags, err = normalizeTags(news.Tags); err != nil {
+		return nil, httpe.NewBadRequestError(errors.WithMessage(err, "NewsService.Create.normalizeTags"))
+	}
+
+	if err = n.resolveCategory(ctx, news); err != nil {
+		return nil, httpe.NewBadRequestError(errors.WithMessage(err, "NewsService.Create.resolveCategory"))
+	}
+
+	if news.ContentHTML, err = markdown.ToHTML(news.Content); err != nil {
+		return nil, httpe.NewBadRequestError(errors.WithMessage(err, "NewsService.Create.ToHTML"))
+	}
+
 	news, err = n.storagePsql.Create(ctx, news)
 	if err != nil {
 		return nil, err
 	}
+
+	n.indexNews(ctx, news, false)
 	return news, nil
 }
 
-// Update news items
+// Update news items of version, 0 updates any version
 func (n *NewsService) Update(ctx context.Context, news *entity.News) (*entity.News, error) {
 	newsByID, err := n.storagePsql.GetNewsByID(ctx, news.NewsID)
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.ValidateIsOwner(ctx, newsByID.AuthorID.String(), n.logger); err != nil {
-		return nil, httpe.NewRestError(http.StatusForbidden, "Forbidden", errors.Wrap(err, "NewsService.Update.ValidateIsOwner"))
+	if err = validateIsAuthor(ctx, newsByID, n.logger); err != nil {
+		return nil, httpe.NewRestError(http.StatusForbidden, "Forbidden", errors.Wrap(err, "NewsService.Update.validateIsAuthor"))
+	}
+
+	if err = checkPublishing(ctx, news.Status, newsByID.Status); err != nil {
+		return nil, httpe.NewRestError(http.StatusForbidden, "Forbidden", errors.Wrap(err, "NewsService.Update.checkPublishing"))
+	}
+
+	if err = prepareNewsStatus(news, newsByID.Status); err != nil {
+		return nil, httpe.NewBadRequestError(errors.WithMessage(err, "NewsService.Update.prepareNewsStatus"))
+	}
+	news.Status = reviewStatus(ctx, news.Status, newsByID.Status)
+
+	if news.Locale != "" {
+		if news.Locale, err = originalLocale(news.Locale, newsByID); err != nil {
+			return nil, httpe.NewBadRequestError(errors.WithMessage(err, "NewsService.Update.originalLocale"))
+		}
+	}
+
+	if news.Tags, err = normalizeTags(news.Tags); err != nil {
+		return nil, httpe.NewBadRequestError(errors.WithMessage(err, "NewsService.Update.normalizeTags"))
+	}
+
+	if err = n.resolveCategory(ctx, news); err != nil {
+		return nil, httpe.NewBadRequestError(errors.WithMessage(err, "NewsService.Update.resolveCategory"))
+	}
+
+	// empty content keeps the current one with its html
+	news.ContentHTML = ""
+	if news.Content != "" {
+		if news.ContentHTML, err = markdown.ToHTML(news.Content); err != nil {
+			return nil, httpe.NewBadRequestError(errors.WithMessage(err, "NewsService.Update.ToHTML"))
+		}
 	}
 
-	updatedNews, err := n.storagePsql.Update(ctx, news)
+	updatedNews, err := n.storagePsql.Update(ctx, news, &entity.NewsRevision{EditorID: getViewerID(ctx)})
 	if err != nil {
 		return nil, err
 	}
-	if err := n.storageRedis.DeleteNewsCtx(ctx, n.generateNewsKey(news.NewsID.String())); err != nil {
-		n.logger.Errorf("NewsService.Update.DeleteNewsCtx: %v", err)
-	}
+	n.afterUpdate(ctx, updatedNews, newsByID.Status == entity.NewsStatusPublished)
 	return updatedNews, err
 }
 
-// Delete news by id
-func (n *NewsService) Delete(ctx context.Context, newsID uuid.UUID) error {
+// Delete news by id of version, 0 deletes any version
+func (n *NewsService) Delete(ctx context.Context, newsID uuid.UUID, version int) error {
 	newsByID, err := n.storagePsql.GetNewsByID(ctx, newsID)
 	if err != nil {
 		return err
@@ -105,25 +202,40 @@ func (n *NewsService) Delete(ctx context.Context, newsID uuid.UUID) error {
 		return httpe.NewRestError(http.StatusForbidden, "Forbidden", errors.Wrap(err, "NewsService.Delete.ValidateIsOwner"))
 	}
 
-	if err := n.storagePsql.Delete(ctx, newsID); err != nil {
+	if err := n.storagePsql.Delete(ctx, newsID, version); err != nil {
 		return err
 	}
 
 	if err := n.storageRedis.DeleteNewsCtx(ctx, n.generateNewsKey(newsID.String())); err != nil {
 		n.logger.Errorf("NewsService.Delete.DeleteNewsCtx: %v", err)
 	}
+	if err := n.suggestRedis.DeleteSuggestionCtx(ctx, entity.SuggestNews, newsID); err != nil {
+		n.logger.Errorf("NewsService.Delete.DeleteSuggestionCtx: %v", err)
+	}
+	if newsByID.Status == entity.NewsStatusPublished {
+		if err := n.suggestRedis.IncrSuggestionCtx(ctx, entity.SuggestAuthors, newsByID.AuthorID, -1); err != nil {
+			n.logger.Errorf("NewsService.Delete.IncrSuggestionCtx: %v", err)
+		}
+		n.invalidateFeeds(ctx)
+		n.invalidateSitemaps(ctx, newsID, newsByID.PublishAt)
+	}
 	return nil
 }
 
 // Get news
 func (n *NewsService) GetNews(ctx context.Context, pq *utils.PaginationQuery) (*entity.NewsList, error) {
-	newsList, err := n.storagePsql.GetNews(ctx, pq)
+	newsList, err := n.storagePsql.GetNews(ctx, getViewerID(ctx), utils.GetLocalesFromCtx(ctx), pq)
 	if err != nil {
 		return nil, err
 	}
 	return newsList, err
 }
 
+// Get published news of author
+func (n *NewsService) GetNewsByAuthor(ctx context.Context, authorID uuid.UUID, pq *utils.PaginationQuery) (*entity.NewsList, error) {
+	return n.storagePsql.GetNewsByAuthor(ctx, authorID, pq)
+}
+
 // Get single news by id
 func (n *NewsService) GetNewsByID(ctx context.Context, newsID uuid.UUID) (*entity.NewsBase, error) {
 	cachedNews, err := n.storageRedis.GetNewsByIDCtx(ctx, n.generateNewsKey(newsID.String()))
@@ -131,7 +243,7 @@ func (n *NewsService) GetNewsByID(ctx context.Context, newsID uuid.UUID) (*entit
 		return nil, err
 	}
 	if cachedNews != nil {
-		return cachedNews, nil
+		return n.visibleNews(ctx, cachedNews)
 	}
 
 	news, err := n.storagePsql.GetNewsByID(ctx, newsID)
@@ -143,18 +255,249 @@ func (n *NewsService) GetNewsByID(ctx context.Context, newsID uuid.UUID) (*entit
 		n.logger.Errorf("NewsService.GetNewsByID.SetNewsCtx: %v", err)
 	}
 
-	return news, nil
+	return n.visibleNews(ctx, news)
 }
 
-// Find news by title
-func (n *NewsService) SearchNews(ctx context.Context, title string, pq *utils.PaginationQuery) (*entity.NewsList, error) {
-	news, err := n.storagePsql.SearchNews(ctx, title, pq)
+// Get single news by current or old slug
+func (n *NewsService) GetNewsBySlug(ctx context.Context, slug string) (*entity.NewsBase, error) {
+	newsID, err := n.storagePsql.GetNewsIDBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	return n.GetNewsByID(ctx, newsID)
+}
+
+// Full-text search of news
+func (n *NewsService) SearchNews(ctx context.Context, search *entity.NewsSearchQuery, pq *utils.PaginationQuery) (*entity.NewsSearchList, error) {
+	if search.Language == "" {
+		search.Language = entity.DefaultNewsLanguage
+	}
+
+	if err := utils.ValidateStruct(ctx, search); err != nil {
+		return nil, httpe.NewBadRequestError(errors.WithMessage(err, "NewsService.SearchNews.ValidateStruct"))
+	}
+
+	news, err := n.storagePsql.SearchNews(ctx, search, getViewerID(ctx), pq)
 	if err != nil {
 		return nil, err
 	}
 	return news, nil
 }
 
+// Publish scheduled news which are due and drop them from cache
+func (n *NewsService) PublishScheduled(ctx context.Context) (int, error) {
+	newsList, err := n.storagePsql.PublishScheduled(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, news := range newsList {
+		if err := n.storageRedis.DeleteNewsCtx(ctx, n.generateNewsKey(news.NewsID.String())); err != nil {
+			n.logger.Errorf("NewsService.PublishScheduled.DeleteNewsCtx: %v", err)
+		}
+		n.indexNews(ctx, news, false)
+	}
+	return len(newsList), nil
+}
+
+// Hide unpublished news from everyone but the author, count reads of published ones
+// and show news in the locale preferred by reader
+func (n *NewsService) visibleNews(ctx context.Context, news *entity.NewsBase) (*entity.NewsBase, error) {
+	if !isNewsVisible(ctx, news) {
+		return nil, httpe.NewNotFoundError(errors.New("NewsService.GetNewsByID.visibleNews"))
+	}
+	localizeNews(news, utils.GetLocalesFromCtx(ctx))
+
+	if news.Status == "" || news.Status == entity.NewsStatusPublished {
+		n.incrNewsPopularity(ctx, news.NewsID)
+		n.recordView(ctx, news)
+	}
+	n.markBookmarked(ctx, news)
+	return news, nil
+}
+
+// Drop updated news from cache and refresh suggestions
+func (n *NewsService) afterUpdate(ctx context.Context, news *entity.News, wasPublished bool) {
+	if err := n.storageRedis.DeleteNewsCtx(ctx, n.generateNewsKey(news.NewsID.String())); err != nil {
+		n.logger.Errorf("NewsService.afterUpdate.DeleteNewsCtx: %v", err)
+	}
+	n.indexNews(ctx, news, wasPublished)
+}
+
+// Keep suggestions, feeds and sitemaps in sync with publication status of news
+func (n *NewsService) indexNews(ctx context.Context, news *entity.News, wasPublished bool) {
+	isPublished := news.Status == entity.NewsStatusPublished
+	if isPublished || wasPublished {
+		n.invalidateFeeds(ctx)
+		n.invalidateSitemaps(ctx, news.NewsID, news.PublishAt)
+	}
+	if isPublished {
+		if err := n.suggestRedis.AddSuggestionCtx(ctx, entity.SuggestNews, &entity.Suggestion{
+			ID:   news.NewsID,
+			Text: news.Title,
+		}); err != nil {
+			n.logger.Errorf("NewsService.indexNews.AddSuggestionCtx: %v", err)
+		}
+		if err := n.related.MarkStale(ctx, news.NewsID); err != nil {
+			n.logger.Errorf("NewsService.indexNews.MarkStale: %v", err)
+		}
+	} else if wasPublished {
+		if err := n.suggestRedis.DeleteSuggestionCtx(ctx, entity.SuggestNews, news.NewsID); err != nil {
+			n.logger.Errorf("NewsService.indexNews.DeleteSuggestionCtx: %v", err)
+		}
+	}
+
+	if isPublished == wasPublished {
+		return
+	}
+	incr := 1.0
+	if wasPublished {
+		incr = -1
+	}
+	if err := n.suggestRedis.IncrSuggestionCtx(ctx, entity.SuggestAuthors, news.AuthorID, incr); err != nil {
+		n.logger.Errorf("NewsService.indexNews.IncrSuggestionCtx: %v", err)
+	}
+}
+
+// Every read makes the news title rank higher in suggestions
+func (n *NewsService) incrNewsPopularity(ctx context.Context, newsID uuid.UUID) {
+	if err := n.suggestRedis.IncrSuggestionCtx(ctx, entity.SuggestNews, newsID, 1); err != nil {
+		n.logger.Errorf("NewsService.GetNewsByID.IncrSuggestionCtx: %v", err)
+	}
+}
+
+// Count unique view of visitor and add views not flushed to postgres yet,
+// news read without visitor are not counted
+func (n *NewsService) recordView(ctx context.Context, news *entity.NewsBase) {
+	visitor := getVisitorID(ctx)
+	if visitor == "" {
+		return
+	}
+
+	pending, err := n.viewsRedis.RecordViewCtx(ctx, news.NewsID, visitor, viewsBucketOf(time.Now()), int(viewsBucket/time.Second))
+	if err != nil {
+		n.logger.Errorf("NewsService.recordView.RecordViewCtx: %v", err)
+		return
+	}
+	news.Views += pending
+}
+
+// Mark news bookmarked by signed in viewer, cached news are never marked
+func (n *NewsService) markBookmarked(ctx context.Context, news *entity.NewsBase) {
+	viewerID := getViewerID(ctx)
+	if viewerID == uuid.Nil {
+		return
+	}
+
+	bookmarked, err := n.storagePsql.IsBookmarked(ctx, viewerID, news.NewsID)
+	if err != nil {
+		n.logger.Errorf("NewsService.markBookmarked.IsBookmarked: %v", err)
+		return
+	}
+	news.Bookmarked = bookmarked
+}
+
+// Drop cached feeds after published news changed
+func (n *NewsService) invalidateFeeds(ctx context.Context) {
+	if err := n.feedsRedis.InvalidateFeedsCtx(ctx); err != nil {
+		n.logger.Errorf("NewsService.invalidateFeeds.InvalidateFeedsCtx: %v", err)
+	}
+}
+
+// Drop cached sitemaps listing changed published news
+func (n *NewsService) invalidateSitemaps(ctx context.Context, newsID uuid.UUID, publishAt *time.Time) {
+	if err := n.sitemaps.InvalidateNews(ctx, newsID, publishAt); err != nil {
+		n.logger.Errorf("NewsService.invalidateSitemaps.InvalidateNews: %v", err)
+	}
+}
+
 func (n *NewsService) generateNewsKey(newsID string) string {
+	return newsCacheKey(newsID)
+}
+
+func newsCacheKey(newsID string) string {
 	return fmt.Sprintf("%s: %s", baseNewsPrefix, newsID)
 }
+
+// Resolve category of news by id or by legacy category name.
+// News without category keeps the current one on update.
+func (n *NewsService) resolveCategory(ctx context.Context, news *entity.News) error {
+	var (
+		category *entity.Category
+		err      error
+	)
+	switch {
+	case news.CategoryID != nil:
+		category, err = n.categoryPsql.GetByID(ctx, *news.CategoryID)
+	case news.Category != nil && *news.Category != "":
+		category, err = n.categoryPsql.GetBySlug(ctx, utils.Slugify(*news.Category))
+	default:
+		return nil
+	}
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("unknown category")
+		}
+		return err
+	}
+
+	news.CategoryID = &category.CategoryID
+	news.Category = &category.Name
+	return nil
+}
+
+// Check publication status and set publish time of news published right away.
+// Empty status keeps the current one on update, on create it is left only by
+// editors, whose news get published, contributors start with a draft.
+func prepareNewsStatus(news *entity.News, current string) error {
+	if news.Status == "" {
+		if current != "" {
+			return nil
+		}
+		news.Status = entity.NewsStatusPublished
+	}
+
+	switch news.Status {
+	case entity.NewsStatusScheduled:
+		if news.PublishAt == nil || !news.PublishAt.After(time.Now()) {
+			return errors.New("publish_at must be in the future for scheduled news")
+		}
+	case entity.NewsStatusPublished:
+		if news.PublishAt == nil && current != entity.NewsStatusPublished {
+			now := time.Now()
+			news.PublishAt = &now
+		}
+	case entity.NewsStatusDraft, entity.NewsStatusArchived:
+	default:
+		return errors.Errorf("invalid news status: %s", news.Status)
+	}
+	return nil
+}
+
+// Published news are visible to everyone, the rest only to the authors
+func isNewsVisible(ctx context.Context, news *entity.NewsBase) bool {
+	if news.Status == "" || news.Status == entity.NewsStatusPublished {
+		return true
+	}
+	return news.IsAuthor(getViewerID(ctx))
+}
+
+// Get id of the current user or uuid.Nil for anonymous readers
+func getViewerID(ctx context.Context) uuid.UUID {
+	user, err := utils.GetUserFromCtx(ctx)
+	if err != nil {
+		return uuid.Nil
+	}
+	return user.ID
+}
+
+// Signed in user or anonymous visitor set by handler, empty if unknown
+func getVisitorID(ctx context.Context) string {
+	if viewerID := getViewerID(ctx); viewerID != uuid.Nil {
+		return "user:" + viewerID.String()
+	}
+	if visitor, ok := ctx.Value(utils.VisitorCtxKey{}).(string); ok && visitor != "" {
+		return "anon:" + visitor
+	}
+	return ""
+}
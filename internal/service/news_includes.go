@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/google/uuid"
+)
+
+// Embed related data in news of list, each relation is loaded by one query
+// for the whole list. Include is validated against entity.NewsIncludes by GetIncludeFromCtx
+func (n *NewsService) IncludeRelations(ctx context.Context, newsList []*entity.News, include []string) error {
+	if len(newsList) == 0 {
+		return nil
+	}
+
+	for _, relation := range include {
+		switch relation {
+		case entity.NewsIncludeAuthor:
+			if err := n.includeAuthors(ctx, newsList); err != nil {
+				return err
+			}
+		case entity.NewsIncludeCommentCount:
+			if err := n.includeCommentCounts(ctx, newsList); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (n *NewsService) includeAuthors(ctx context.Context, newsList []*entity.News) error {
+	seen := make(map[uuid.UUID]bool)
+	var userIDs []uuid.UUID
+	for _, news := range newsList {
+		if !seen[news.AuthorID] {
+			seen[news.AuthorID] = true
+			userIDs = append(userIDs, news.AuthorID)
+		}
+	}
+
+	authors, err := n.storagePsql.GetAuthors(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+	byID := make(map[uuid.UUID]*entity.NewsAuthor, len(authors))
+	for _, author := range authors {
+		byID[author.UserID] = author
+	}
+	for _, news := range newsList {
+		news.Author = byID[news.AuthorID]
+	}
+	return nil
+}
+
+func (n *NewsService) includeCommentCounts(ctx context.Context, newsList []*entity.News) error {
+	newsIDs := make([]uuid.UUID, 0, len(newsList))
+	for _, news := range newsList {
+		newsIDs = append(newsIDs, news.NewsID)
+	}
+
+	counts, err := n.storagePsql.GetCommentCounts(ctx, newsIDs)
+	if err != nil {
+		return err
+	}
+	byID := make(map[uuid.UUID]int, len(counts))
+	for _, count := range counts {
+		byID[count.NewsID] = count.Count
+	}
+	for _, news := range newsList {
+		// news without comments have no count row
+		count := byID[news.NewsID]
+		news.CommentCount = &count
+	}
+	return nil
+}
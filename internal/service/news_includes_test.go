@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	mockstorage "github.com/Edbeer/restapi/internal/storage/psql/mock"
+	"github.com/Edbeer/restapi/pkg/logger"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_IncludeRelations(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsStorage := mockstorage.NewMockNewsPsql(ctrl)
+	newsService := NewNewsService(nil, mockNewsStorage, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, apiLogger)
+
+	ctx := context.Background()
+	authorID := uuid.New()
+	newsList := []*entity.News{
+		{NewsID: uuid.New(), AuthorID: authorID},
+		{NewsID: uuid.New(), AuthorID: authorID},
+	}
+
+	t.Run("Author and comment count", func(t *testing.T) {
+		// one query per relation for the whole list
+		mockNewsStorage.EXPECT().GetAuthors(ctx, []uuid.UUID{authorID}).Return([]*entity.NewsAuthor{
+			{UserID: authorID, FirstName: "Pavel", LastName: "Volkov"},
+		}, nil)
+		mockNewsStorage.EXPECT().GetCommentCounts(ctx, []uuid.UUID{newsList[0].NewsID, newsList[1].NewsID}).Return([]*entity.NewsCommentCount{
+			{NewsID: newsList[0].NewsID, Count: 3},
+		}, nil)
+
+		err := newsService.IncludeRelations(ctx, newsList, []string{entity.NewsIncludeAuthor, entity.NewsIncludeCommentCount})
+		require.NoError(t, err)
+		for _, news := range newsList {
+			require.NotNil(t, news.Author)
+			require.Equal(t, "Pavel", news.Author.FirstName)
+		}
+		require.Equal(t, 3, *newsList[0].CommentCount)
+		require.Equal(t, 0, *newsList[1].CommentCount)
+	})
+
+	t.Run("Empty list", func(t *testing.T) {
+		err := newsService.IncludeRelations(ctx, nil, []string{entity.NewsIncludeAuthor})
+		require.NoError(t, err)
+	})
+}
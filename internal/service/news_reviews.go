@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// News reviews StoragePsql interface
+type ReviewsPsql interface {
+	Transition(ctx context.Context, review *entity.NewsReview, from []string, to string, publishAt *time.Time) (*entity.News, error)
+	GetReviews(ctx context.Context, newsID uuid.UUID) ([]*entity.NewsReview, error)
+	GetQueue(ctx context.Context, pq *utils.PaginationQuery) (*entity.NewsList, error)
+}
+
+// News notifications interface
+type NewsNotifications interface {
+	Notify(ctx context.Context, notification *entity.Notification) error
+}
+
+// Statuses news can leave by review action and the status it gets,
+// approved news get published or scheduled instead
+var reviewTransitions = map[string]struct {
+	from []string
+	to   string
+}{
+	entity.ReviewSubmitted:        {from: []string{entity.NewsStatusDraft, entity.NewsStatusChangesRequested}, to: entity.NewsStatusPendingReview},
+	entity.ReviewApproved:         {from: []string{entity.NewsStatusPendingReview}, to: entity.NewsStatusPublished},
+	entity.ReviewChangesRequested: {from: []string{entity.NewsStatusPendingReview}, to: entity.NewsStatusChangesRequested},
+	entity.ReviewRejected:         {from: []string{entity.NewsStatusPendingReview}, to: entity.NewsStatusRejected},
+}
+
+// Author notifications on editor decisions
+var reviewNotifications = map[string]string{
+	entity.ReviewApproved:         entity.NotificationReviewApproved,
+	entity.ReviewChangesRequested: entity.NotificationReviewChangesRequested,
+	entity.ReviewRejected:         entity.NotificationReviewRejected,
+}
+
+// Submit draft or news with requested changes for review by editors
+func (n *NewsService) SubmitForReview(ctx context.Context, newsID uuid.UUID, comment string) (*entity.News, error) {
+	user, err := utils.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, httpe.NewUnauthorizedError(errors.WithMessage(err, "NewsService.SubmitForReview.GetUserFromCtx"))
+	}
+
+	newsByID, err := n.storagePsql.GetNewsByID(ctx, newsID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = validateIsAuthor(ctx, newsByID, n.logger); err != nil {
+		return nil, httpe.NewRestError(http.StatusForbidden, "Forbidden", errors.Wrap(err, "NewsService.SubmitForReview.validateIsAuthor"))
+	}
+
+	return n.transition(ctx, newsByID, &entity.NewsReview{
+		NewsID:     newsID,
+		ReviewerID: &user.ID,
+		Action:     entity.ReviewSubmitted,
+		Comment:    comment,
+	})
+}
+
+// Approve news pending review, request changes or reject it. Decisions
+// other than approval need a comment for the author.
+func (n *NewsService) ReviewNews(ctx context.Context, review *entity.NewsReview) (*entity.News, error) {
+	user, err := n.getEditor(ctx, "ReviewNews")
+	if err != nil {
+		return nil, err
+	}
+	review.ReviewerID = &user.ID
+
+	if err := utils.ValidateStruct(ctx, review); err != nil {
+		return nil, httpe.NewBadRequestError(errors.WithMessage(err, "NewsService.ReviewNews.ValidateStruct"))
+	}
+	if review.Action == entity.ReviewSubmitted {
+		return nil, httpe.NewBadRequestError(errors.New("NewsService.ReviewNews: news are submitted by author"))
+	}
+	if review.Action != entity.ReviewApproved && review.Comment == "" {
+		return nil, httpe.NewBadRequestError(errors.New("NewsService.ReviewNews: comment is required"))
+	}
+
+	newsByID, err := n.storagePsql.GetNewsByID(ctx, review.NewsID)
+	if err != nil {
+		return nil, err
+	}
+
+	news, err := n.transition(ctx, newsByID, review)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := n.notifications.Notify(ctx, &entity.Notification{
+		UserID:  newsByID.AuthorID,
+		Kind:    reviewNotifications[review.Action],
+		NewsID:  &review.NewsID,
+		Message: review.Comment,
+	}); err != nil {
+		n.logger.Errorf("NewsService.ReviewNews.Notify: %v", err)
+	}
+	return news, nil
+}
+
+// Get news waiting for review, longest waiting first
+func (n *NewsService) GetReviewQueue(ctx context.Context, pq *utils.PaginationQuery) (*entity.NewsList, error) {
+	if _, err := n.getEditor(ctx, "GetReviewQueue"); err != nil {
+		return nil, err
+	}
+	return n.reviewsPsql.GetQueue(ctx, pq)
+}
+
+// Get review history of news, visible to the authors and editors
+func (n *NewsService) GetReviews(ctx context.Context, newsID uuid.UUID) ([]*entity.NewsReview, error) {
+	newsByID, err := n.storagePsql.GetNewsByID(ctx, newsID)
+	if err != nil {
+		return nil, err
+	}
+	if !newsByID.IsAuthor(getViewerID(ctx)) && !isEditor(ctx) {
+		return nil, httpe.NewNotFoundError(errors.New("NewsService.GetReviews"))
+	}
+	return n.reviewsPsql.GetReviews(ctx, newsID)
+}
+
+// Move news to the status of review action
+func (n *NewsService) transition(ctx context.Context, newsByID *entity.NewsBase, review *entity.NewsReview) (*entity.News, error) {
+	transition := reviewTransitions[review.Action]
+	if !containsString(transition.from, newsByID.Status) {
+		return nil, httpe.NewBadRequestError(errors.Errorf("NewsService.transition: %s news can't be %s", newsByID.Status, review.Action))
+	}
+
+	to, publishAt := transition.to, (*time.Time)(nil)
+	if review.Action == entity.ReviewApproved {
+		now := time.Now()
+		publishAt = &now
+		if newsByID.PublishAt != nil && newsByID.PublishAt.After(now) {
+			to, publishAt = entity.NewsStatusScheduled, newsByID.PublishAt
+		}
+	}
+
+	news, err := n.reviewsPsql.Transition(ctx, review, transition.from, to, publishAt)
+	if err != nil {
+		return nil, err
+	}
+	if news == nil {
+		return nil, httpe.NewBadRequestError(errors.New("NewsService.transition: news status has changed"))
+	}
+
+	if err := n.storageRedis.DeleteNewsCtx(ctx, n.generateNewsKey(news.NewsID.String())); err != nil {
+		n.logger.Errorf("NewsService.transition.DeleteNewsCtx: %v", err)
+	}
+	n.indexNews(ctx, news, false)
+	return news, nil
+}
+
+func (n *NewsService) getEditor(ctx context.Context, op string) (*entity.User, error) {
+	user, err := utils.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, httpe.NewUnauthorizedError(errors.WithMessage(err, "NewsService."+op+".GetUserFromCtx"))
+	}
+	if !user.HasRole(entity.RoleAdmin, entity.RoleEditor) {
+		return nil, httpe.NewRestError(http.StatusForbidden, "Forbidden", errors.Wrap(httpe.PermissionDenied, "NewsService."+op))
+	}
+	return user, nil
+}
+
+// Editors and admins publish news right away, contributors through review
+func isEditor(ctx context.Context) bool {
+	user, err := utils.GetUserFromCtx(ctx)
+	return err == nil && user.HasRole(entity.RoleAdmin, entity.RoleEditor)
+}
+
+// Only editors can publish or schedule unpublished news, contributors submit
+// it for review. Contributor edits of live news are routed by reviewStatus.
+func checkPublishing(ctx context.Context, status string, current string) error {
+	if status != entity.NewsStatusPublished && status != entity.NewsStatusScheduled {
+		return nil
+	}
+	if status == current || isEditor(ctx) {
+		return nil
+	}
+	return errors.Errorf("%s news must be submitted for review", status)
+}
+
+// Status of news edited by contributor. Edits of published or scheduled news
+// are written right away and take the news offline to pending_review until an
+// editor approves it again, unless they withdraw it to draft or archive.
+func reviewStatus(ctx context.Context, status string, current string) string {
+	if isEditor(ctx) || !isLive(current) {
+		return status
+	}
+	if status == "" || isLive(status) {
+		return entity.NewsStatusPendingReview
+	}
+	return status
+}
+
+// Published news or news that will be without another review
+func isLive(status string) bool {
+	return status == entity.NewsStatusPublished || status == entity.NewsStatusScheduled
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	mockservice "github.com/Edbeer/restapi/internal/service/mock"
+	mockstorage "github.com/Edbeer/restapi/internal/storage/psql/mock"
+	mockredis "github.com/Edbeer/restapi/internal/storage/redis/mock"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_SubmitForReview(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsStorage := mockstorage.NewMockNewsPsql(ctrl)
+	mockReviewsStorage := mockstorage.NewMockReviewsPsql(ctrl)
+	mockNewsRedis := mockredis.NewMockNewsRedis(ctrl)
+	newsService := NewNewsService(nil, mockNewsStorage, nil, nil, mockNewsRedis, nil, nil, nil, nil, nil, mockReviewsStorage, nil, apiLogger)
+
+	authorID, newsID := uuid.New(), uuid.New()
+	ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, &entity.User{ID: authorID})
+
+	t.Run("Submit", func(t *testing.T) {
+		newsByID := &entity.NewsBase{NewsID: newsID, AuthorID: authorID, Status: entity.NewsStatusDraft}
+		review := &entity.NewsReview{NewsID: newsID, ReviewerID: &authorID, Action: entity.ReviewSubmitted, Comment: "ready"}
+		submitted := &entity.News{NewsID: newsID, AuthorID: authorID, Status: entity.NewsStatusPendingReview}
+
+		mockNewsStorage.EXPECT().GetNewsByID(ctx, newsID).Return(newsByID, nil)
+		mockReviewsStorage.EXPECT().Transition(ctx, review, reviewTransitions[entity.ReviewSubmitted].from, entity.NewsStatusPendingReview, nil).Return(submitted, nil)
+		mockNewsRedis.EXPECT().DeleteNewsCtx(ctx, gomock.Any()).Return(nil)
+
+		news, err := newsService.SubmitForReview(ctx, newsID, "ready")
+		require.NoError(t, err)
+		require.Equal(t, entity.NewsStatusPendingReview, news.Status)
+	})
+
+	t.Run("Already pending", func(t *testing.T) {
+		newsByID := &entity.NewsBase{NewsID: newsID, AuthorID: authorID, Status: entity.NewsStatusPendingReview}
+		mockNewsStorage.EXPECT().GetNewsByID(ctx, newsID).Return(newsByID, nil)
+
+		_, err := newsService.SubmitForReview(ctx, newsID, "")
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, httpe.ParseErrors(err).Status())
+	})
+}
+
+func TestService_ReviewNews(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsStorage := mockstorage.NewMockNewsPsql(ctrl)
+	mockReviewsStorage := mockstorage.NewMockReviewsPsql(ctrl)
+	mockNewsRedis := mockredis.NewMockNewsRedis(ctrl)
+	mockNotifications := mockservice.NewMockNotifications(ctrl)
+	newsService := NewNewsService(nil, mockNewsStorage, nil, nil, mockNewsRedis, nil, nil, nil, nil, nil, mockReviewsStorage, mockNotifications, apiLogger)
+
+	authorID, editorID, newsID := uuid.New(), uuid.New(), uuid.New()
+	role := entity.RoleEditor
+	ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, &entity.User{ID: editorID, Role: &role})
+
+	t.Run("Approve scheduled", func(t *testing.T) {
+		publishAt := time.Now().Add(time.Hour)
+		newsByID := &entity.NewsBase{NewsID: newsID, AuthorID: authorID, Status: entity.NewsStatusPendingReview, PublishAt: &publishAt}
+		review := &entity.NewsReview{NewsID: newsID, Action: entity.ReviewApproved}
+		scheduled := &entity.News{NewsID: newsID, AuthorID: authorID, Status: entity.NewsStatusScheduled, PublishAt: &publishAt}
+
+		mockNewsStorage.EXPECT().GetNewsByID(ctx, newsID).Return(newsByID, nil)
+		mockReviewsStorage.EXPECT().Transition(ctx, review, []string{entity.NewsStatusPendingReview}, entity.NewsStatusScheduled, &publishAt).Return(scheduled, nil)
+		mockNewsRedis.EXPECT().DeleteNewsCtx(ctx, gomock.Any()).Return(nil)
+		mockNotifications.EXPECT().Notify(ctx, &entity.Notification{
+			UserID: authorID,
+			Kind:   entity.NotificationReviewApproved,
+			NewsID: &review.NewsID,
+		}).Return(nil)
+
+		news, err := newsService.ReviewNews(ctx, review)
+		require.NoError(t, err)
+		require.Equal(t, entity.NewsStatusScheduled, news.Status)
+		require.Equal(t, editorID, *review.ReviewerID)
+	})
+
+	t.Run("Changes requested without comment", func(t *testing.T) {
+		_, err := newsService.ReviewNews(ctx, &entity.NewsReview{NewsID: newsID, Action: entity.ReviewChangesRequested})
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, httpe.ParseErrors(err).Status())
+	})
+
+	t.Run("Not editor", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, &entity.User{ID: authorID})
+
+		_, err := newsService.ReviewNews(ctx, &entity.NewsReview{NewsID: newsID, Action: entity.ReviewApproved})
+		require.Error(t, err)
+		require.Equal(t, http.StatusForbidden, httpe.ParseErrors(err).Status())
+	})
+}
+
+func TestService_CreateNewsContributor(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsStorage := mockstorage.NewMockNewsPsql(ctrl)
+	newsService := NewNewsService(nil, mockNewsStorage, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, apiLogger)
+
+	ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, &entity.User{ID: uuid.New()})
+
+	_, err := newsService.Create(ctx, &entity.News{
+		Title:   "TitleTitleTitleTitleTitleTitleTitle",
+		Content: "ContentContentContentContentContent",
+		Status:  entity.NewsStatusPublished,
+	})
+	require.Error(t, err)
+	require.Equal(t, http.StatusForbidden, httpe.ParseErrors(err).Status())
+}
+
+func TestService_UpdatePublishedNewsContributor(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsStorage := mockstorage.NewMockNewsPsql(ctrl)
+	mockNewsRedis := mockredis.NewMockNewsRedis(ctrl)
+	mockSuggestRedis := mockredis.NewMockSuggestRedis(ctrl)
+	mockFeedsRedis := mockredis.NewMockFeedsRedis(ctrl)
+	mockSitemaps := mockservice.NewMockSitemaps(ctrl)
+	newsService := NewNewsService(nil, mockNewsStorage, nil, nil, mockNewsRedis, mockSuggestRedis, mockFeedsRedis, nil, mockSitemaps, nil, nil, nil, apiLogger)
+
+	authorID, newsID := uuid.New(), uuid.New()
+	ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, &entity.User{ID: authorID})
+	newsByID := &entity.NewsBase{NewsID: newsID, AuthorID: authorID, Status: entity.NewsStatusPublished}
+
+	for _, status := range []string{"", entity.NewsStatusPublished} {
+		news := &entity.News{NewsID: newsID, Content: "ContentContentContentContentContent", Status: status}
+		pending := &entity.News{NewsID: newsID, AuthorID: authorID, Status: entity.NewsStatusPendingReview}
+
+		mockNewsStorage.EXPECT().GetNewsByID(ctx, newsID).Return(newsByID, nil)
+		mockNewsStorage.EXPECT().Update(ctx, gomock.Any(), &entity.NewsRevision{EditorID: authorID}).DoAndReturn(
+			func(_ context.Context, news *entity.News, _ *entity.NewsRevision) (*entity.News, error) {
+				require.Equal(t, entity.NewsStatusPendingReview, news.Status)
+				return pending, nil
+			})
+		mockNewsRedis.EXPECT().DeleteNewsCtx(ctx, gomock.Any()).Return(nil)
+		mockFeedsRedis.EXPECT().InvalidateFeedsCtx(ctx).Return(nil)
+		mockSitemaps.EXPECT().InvalidateNews(ctx, newsID, gomock.Any()).Return(nil)
+		mockSuggestRedis.EXPECT().DeleteSuggestionCtx(ctx, entity.SuggestNews, newsID).Return(nil)
+		mockSuggestRedis.EXPECT().IncrSuggestionCtx(ctx, entity.SuggestAuthors, authorID, -1.0).Return(nil)
+
+		updated, err := newsService.Update(ctx, news)
+		require.NoError(t, err)
+		require.Equal(t, entity.NewsStatusPendingReview, updated.Status)
+	}
+
+	t.Run("Withdraw", func(t *testing.T) {
+		require.Equal(t, entity.NewsStatusDraft, reviewStatus(ctx, entity.NewsStatusDraft, entity.NewsStatusPublished))
+	})
+
+	t.Run("Editor", func(t *testing.T) {
+		role := entity.RoleEditor
+		editorCtx := context.WithValue(context.Background(), utils.UserCtxKey{}, &entity.User{ID: uuid.New(), Role: &role})
+		require.Equal(t, "", reviewStatus(editorCtx, "", entity.NewsStatusPublished))
+	})
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/markdown"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// News revisions StoragePsql interface
+type RevisionsPsql interface {
+	GetRevisions(ctx context.Context, newsID uuid.UUID, pq *utils.PaginationQuery) (*entity.NewsRevisionsList, error)
+	GetRevision(ctx context.Context, newsID uuid.UUID, revision int) (*entity.NewsRevision, error)
+}
+
+// Get revisions of news, newest first
+func (n *NewsService) GetRevisions(ctx context.Context, newsID uuid.UUID, pq *utils.PaginationQuery) (*entity.NewsRevisionsList, error) {
+	if err := n.checkNewsVisible(ctx, newsID); err != nil {
+		return nil, err
+	}
+	return n.revisionsPsql.GetRevisions(ctx, newsID, pq)
+}
+
+// Get single revision of news
+func (n *NewsService) GetRevision(ctx context.Context, newsID uuid.UUID, revision int) (*entity.NewsRevision, error) {
+	if err := n.checkNewsVisible(ctx, newsID); err != nil {
+		return nil, err
+	}
+	return n.revisionsPsql.GetRevision(ctx, newsID, revision)
+}
+
+// Word-level diff of title and content between two revisions
+func (n *NewsService) DiffRevisions(ctx context.Context, newsID uuid.UUID, from, to int) (*entity.NewsRevisionDiff, error) {
+	if err := n.checkNewsVisible(ctx, newsID); err != nil {
+		return nil, err
+	}
+
+	fromRev, err := n.revisionsPsql.GetRevision(ctx, newsID, from)
+	if err != nil {
+		return nil, err
+	}
+	toRev, err := n.revisionsPsql.GetRevision(ctx, newsID, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.NewsRevisionDiff{
+		NewsID:  newsID,
+		From:    from,
+		To:      to,
+		Title:   utils.DiffWords(fromRev.Title, toRev.Title),
+		Content: utils.DiffWords(fromRev.Content, toRev.Content),
+	}, nil
+}
+
+// Roll news back to title and content of a previous revision, recorded as a new revision.
+// Rollbacks of published news by contributors go back to review like their edits.
+func (n *NewsService) RollbackRevision(ctx context.Context, newsID uuid.UUID, revision int) (*entity.News, error) {
+	newsByID, err := n.storagePsql.GetNewsByID(ctx, newsID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = validateIsAuthor(ctx, newsByID, n.logger); err != nil {
+		return nil, httpe.NewRestError(http.StatusForbidden, "Forbidden", errors.Wrap(err, "NewsService.RollbackRevision.validateIsAuthor"))
+	}
+
+	rev, err := n.revisionsPsql.GetRevision(ctx, newsID, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	contentHTML, err := markdown.ToHTML(rev.Content)
+	if err != nil {
+		return nil, httpe.NewBadRequestError(errors.WithMessage(err, "NewsService.RollbackRevision.ToHTML"))
+	}
+
+	updatedNews, err := n.storagePsql.Update(ctx, &entity.News{
+		NewsID:      newsID,
+		Title:       rev.Title,
+		Content:     rev.Content,
+		ContentHTML: contentHTML,
+		Status:      reviewStatus(ctx, "", newsByID.Status),
+	}, &entity.NewsRevision{
+		EditorID:   getViewerID(ctx),
+		RollbackOf: &rev.Revision,
+	})
+	if err != nil {
+		return nil, err
+	}
+	n.afterUpdate(ctx, updatedNews, newsByID.Status == entity.NewsStatusPublished)
+	return updatedNews, nil
+}
+
+// Revisions of unpublished news are visible only to the author
+func (n *NewsService) checkNewsVisible(ctx context.Context, newsID uuid.UUID) error {
+	news, err := n.storagePsql.GetNewsByID(ctx, newsID)
+	if err != nil {
+		return err
+	}
+	if !isNewsVisible(ctx, news) {
+		return httpe.NewNotFoundError(errors.New("NewsService.checkNewsVisible"))
+	}
+	return nil
+}
@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	mockservice "github.com/Edbeer/restapi/internal/service/mock"
+	mockstorage "github.com/Edbeer/restapi/internal/storage/psql/mock"
+	mockredis "github.com/Edbeer/restapi/internal/storage/redis/mock"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_GetRevisions(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsStorage := mockstorage.NewMockNewsPsql(ctrl)
+	mockRevisionsStorage := mockstorage.NewMockRevisionsPsql(ctrl)
+	newsService := NewNewsService(nil, mockNewsStorage, mockRevisionsStorage, nil, nil, nil, nil, nil, nil, nil, nil, nil, apiLogger)
+
+	newsID := uuid.New()
+	ctx := context.Background()
+	pq := &utils.PaginationQuery{Size: 10}
+
+	t.Run("Published", func(t *testing.T) {
+		mockNewsStorage.EXPECT().GetNewsByID(ctx, newsID).Return(&entity.NewsBase{
+			NewsID: newsID,
+			Status: entity.NewsStatusPublished,
+		}, nil)
+		mockRevisionsStorage.EXPECT().GetRevisions(ctx, newsID, pq).Return(&entity.NewsRevisionsList{}, nil)
+
+		revisions, err := newsService.GetRevisions(ctx, newsID, pq)
+		require.NoError(t, err)
+		require.NotNil(t, revisions)
+	})
+
+	t.Run("Draft of another author", func(t *testing.T) {
+		mockNewsStorage.EXPECT().GetNewsByID(ctx, newsID).Return(&entity.NewsBase{
+			NewsID:   newsID,
+			AuthorID: uuid.New(),
+			Status:   entity.NewsStatusDraft,
+		}, nil)
+
+		_, err := newsService.GetRevisions(ctx, newsID, pq)
+		require.Error(t, err)
+	})
+}
+
+func TestService_DiffRevisions(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsStorage := mockstorage.NewMockNewsPsql(ctrl)
+	mockRevisionsStorage := mockstorage.NewMockRevisionsPsql(ctrl)
+	newsService := NewNewsService(nil, mockNewsStorage, mockRevisionsStorage, nil, nil, nil, nil, nil, nil, nil, nil, nil, apiLogger)
+
+	newsID := uuid.New()
+	ctx := context.Background()
+
+	mockNewsStorage.EXPECT().GetNewsByID(ctx, newsID).Return(&entity.NewsBase{
+		NewsID: newsID,
+		Status: entity.NewsStatusPublished,
+	}, nil)
+	mockRevisionsStorage.EXPECT().GetRevision(ctx, newsID, 1).Return(&entity.NewsRevision{
+		Title:   "Old title",
+		Content: "Some old content",
+	}, nil)
+	mockRevisionsStorage.EXPECT().GetRevision(ctx, newsID, 2).Return(&entity.NewsRevision{
+		Title:   "Old title",
+		Content: "Some new content",
+	}, nil)
+
+	diff, err := newsService.DiffRevisions(ctx, newsID, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, []*entity.DiffChunk{{Op: entity.DiffEqual, Text: "Old title"}}, diff.Title)
+	require.Equal(t, []*entity.DiffChunk{
+		{Op: entity.DiffEqual, Text: "Some "},
+		{Op: entity.DiffDelete, Text: "old"},
+		{Op: entity.DiffInsert, Text: "new"},
+		{Op: entity.DiffEqual, Text: " content"},
+	}, diff.Content)
+}
+
+func TestService_RollbackRevision(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsStorage := mockstorage.NewMockNewsPsql(ctrl)
+	mockRevisionsStorage := mockstorage.NewMockRevisionsPsql(ctrl)
+	mockNewsRedis := mockredis.NewMockNewsRedis(ctrl)
+	mockSuggestRedis := mockredis.NewMockSuggestRedis(ctrl)
+	mockFeedsRedis := mockredis.NewMockFeedsRedis(ctrl)
+	mockSitemaps := mockservice.NewMockSitemaps(ctrl)
+	mockRelated := mockservice.NewMockRelated(ctrl)
+	newsService := NewNewsService(nil, mockNewsStorage, mockRevisionsStorage, nil, mockNewsRedis, mockSuggestRedis, mockFeedsRedis, nil, mockSitemaps, mockRelated, nil, nil, apiLogger)
+
+	newsID := uuid.New()
+	userID := uuid.New()
+	role := entity.RoleEditor
+	ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, &entity.User{ID: userID, Role: &role})
+	cacheKey := fmt.Sprintf("%s: %s", baseNewsPrefix, newsID)
+
+	rev := &entity.NewsRevision{
+		NewsID:   newsID,
+		Revision: 1,
+		Title:    "TitleTitleTitleTitleTitleTitleTitle",
+		Content:  "ContentContentContentContentContent",
+	}
+	restored := &entity.News{
+		NewsID:   newsID,
+		AuthorID: userID,
+		Title:    rev.Title,
+		Content:  rev.Content,
+		Status:   entity.NewsStatusPublished,
+	}
+
+	mockNewsStorage.EXPECT().GetNewsByID(ctx, newsID).Return(&entity.NewsBase{
+		NewsID:   newsID,
+		AuthorID: userID,
+		Status:   entity.NewsStatusPublished,
+	}, nil)
+	mockRevisionsStorage.EXPECT().GetRevision(ctx, newsID, 1).Return(rev, nil)
+	mockNewsStorage.EXPECT().Update(ctx, &entity.News{
+		NewsID:      newsID,
+		Title:       rev.Title,
+		Content:     rev.Content,
+		ContentHTML: "<p>" + rev.Content + "</p>\n",
+	}, &entity.NewsRevision{
+		EditorID:   userID,
+		RollbackOf: &rev.Revision,
+	}).Return(restored, nil)
+	mockNewsRedis.EXPECT().DeleteNewsCtx(ctx, cacheKey).Return(nil)
+	mockSuggestRedis.EXPECT().AddSuggestionCtx(ctx, entity.SuggestNews, gomock.Any()).Return(nil)
+	mockFeedsRedis.EXPECT().InvalidateFeedsCtx(ctx).Return(nil)
+	mockSitemaps.EXPECT().InvalidateNews(ctx, gomock.Any(), gomock.Any()).Return(nil)
+	mockRelated.EXPECT().MarkStale(ctx, gomock.Any()).Return(nil)
+
+	news, err := newsService.RollbackRevision(ctx, newsID, 1)
+	require.NoError(t, err)
+	require.Equal(t, restored, news)
+}
+
+func TestService_RollbackRevisionContributor(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsStorage := mockstorage.NewMockNewsPsql(ctrl)
+	mockRevisionsStorage := mockstorage.NewMockRevisionsPsql(ctrl)
+	mockNewsRedis := mockredis.NewMockNewsRedis(ctrl)
+	mockSuggestRedis := mockredis.NewMockSuggestRedis(ctrl)
+	mockFeedsRedis := mockredis.NewMockFeedsRedis(ctrl)
+	mockSitemaps := mockservice.NewMockSitemaps(ctrl)
+	newsService := NewNewsService(nil, mockNewsStorage, mockRevisionsStorage, nil, mockNewsRedis, mockSuggestRedis, mockFeedsRedis, nil, mockSitemaps, nil, nil, nil, apiLogger)
+
+	newsID := uuid.New()
+	userID := uuid.New()
+	ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, &entity.User{ID: userID})
+
+	rev := &entity.NewsRevision{
+		NewsID:   newsID,
+		Revision: 1,
+		Title:    "TitleTitleTitleTitleTitleTitleTitle",
+		Content:  "ContentContentContentContentContent",
+	}
+	pending := &entity.News{
+		NewsID:   newsID,
+		AuthorID: userID,
+		Title:    rev.Title,
+		Content:  rev.Content,
+		Status:   entity.NewsStatusPendingReview,
+	}
+
+	mockNewsStorage.EXPECT().GetNewsByID(ctx, newsID).Return(&entity.NewsBase{
+		NewsID:   newsID,
+		AuthorID: userID,
+		Status:   entity.NewsStatusPublished,
+	}, nil)
+	mockRevisionsStorage.EXPECT().GetRevision(ctx, newsID, 1).Return(rev, nil)
+	mockNewsStorage.EXPECT().Update(ctx, &entity.News{
+		NewsID:      newsID,
+		Title:       rev.Title,
+		Content:     rev.Content,
+		ContentHTML: "<p>" + rev.Content + "</p>\n",
+		Status:      entity.NewsStatusPendingReview,
+	}, &entity.NewsRevision{
+		EditorID:   userID,
+		RollbackOf: &rev.Revision,
+	}).Return(pending, nil)
+	mockNewsRedis.EXPECT().DeleteNewsCtx(ctx, gomock.Any()).Return(nil)
+	mockFeedsRedis.EXPECT().InvalidateFeedsCtx(ctx).Return(nil)
+	mockSitemaps.EXPECT().InvalidateNews(ctx, newsID, gomock.Any()).Return(nil)
+	mockSuggestRedis.EXPECT().DeleteSuggestionCtx(ctx, entity.SuggestNews, newsID).Return(nil)
+	mockSuggestRedis.EXPECT().IncrSuggestionCtx(ctx, entity.SuggestAuthors, userID, -1.0).Return(nil)
+
+	news, err := newsService.RollbackRevision(ctx, newsID, 1)
+	require.NoError(t, err)
+	require.Equal(t, entity.NewsStatusPendingReview, news.Status)
+}
@@ -2,16 +2,19 @@ package service
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"testing"
 
 	"github.com/Edbeer/restapi/internal/entity"
+	mockservice "github.com/Edbeer/restapi/internal/service/mock"
 	mockstorage "github.com/Edbeer/restapi/internal/storage/psql/mock"
 	mockredis "github.com/Edbeer/restapi/internal/storage/redis/mock"
 	"github.com/Edbeer/restapi/pkg/logger"
 	"github.com/Edbeer/restapi/pkg/utils"
 	gomock "github.com/golang/mock/gomock"
 	"github.com/google/uuid"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 )
 
@@ -23,7 +26,11 @@ func TestService_CreateNews(t *testing.T) {
 
 	apiLogger := logger.NewApiLogger(nil)
 	mockNewsStorage := mockstorage.NewMockNewsPsql(ctrl)
-	newsService := NewNewsService(nil, mockNewsStorage, nil, apiLogger)
+	mockSuggestRedis := mockredis.NewMockSuggestRedis(ctrl)
+	mockFeedsRedis := mockredis.NewMockFeedsRedis(ctrl)
+	mockSitemaps := mockservice.NewMockSitemaps(ctrl)
+	mockRelated := mockservice.NewMockRelated(ctrl)
+	newsService := NewNewsService(nil, mockNewsStorage, nil, nil, nil, mockSuggestRedis, mockFeedsRedis, nil, mockSitemaps, mockRelated, nil, nil, apiLogger)
 
 	userID := uuid.New()
 
@@ -33,18 +40,37 @@ func TestService_CreateNews(t *testing.T) {
 		Content:  "ContentContentContentContentContent",
 	}
 
+	role := entity.RoleEditor
 	user := &entity.User{
-		ID: userID,
+		ID:   userID,
+		Role: &role,
 	}
 
 	ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, user)
 
 	mockNewsStorage.EXPECT().Create(ctx, news).Return(news, nil)
+	mockSuggestRedis.EXPECT().AddSuggestionCtx(ctx, entity.SuggestNews, &entity.Suggestion{
+		ID:   news.NewsID,
+		Text: news.Title,
+	}).Return(nil)
+	mockSuggestRedis.EXPECT().IncrSuggestionCtx(ctx, entity.SuggestAuthors, userID, float64(1)).Return(nil)
+	mockFeedsRedis.EXPECT().InvalidateFeedsCtx(ctx).Return(nil)
+	mockSitemaps.EXPECT().InvalidateNews(ctx, gomock.Any(), gomock.Any()).Return(nil)
+	mockRelated.EXPECT().MarkStale(ctx, gomock.Any()).Return(nil)
 
 	createdNews, err := newsService.Create(ctx, news)
 	require.NoError(t, err)
 	require.Nil(t, err)
 	require.NotNil(t, createdNews)
+	require.Equal(t, entity.NewsStatusPublished, createdNews.Status)
+	require.NotNil(t, createdNews.PublishAt)
+
+	_, err = newsService.Create(ctx, &entity.News{
+		Title:   "TitleTitleTitleTitleTitleTitleTitle",
+		Content: "ContentContentContentContentContent",
+		Status:  entity.NewsStatusScheduled,
+	})
+	require.Error(t, err)
 }
 
 func TestService_UpdateNews(t *testing.T) {
@@ -56,7 +82,11 @@ func TestService_UpdateNews(t *testing.T) {
 	apiLogger := logger.NewApiLogger(nil)
 	mockNewsStorage := mockstorage.NewMockNewsPsql(ctrl)
 	mockNewsRedis := mockredis.NewMockNewsRedis(ctrl)
-	newsService := NewNewsService(nil, mockNewsStorage, mockNewsRedis, apiLogger)
+	mockSuggestRedis := mockredis.NewMockSuggestRedis(ctrl)
+	mockFeedsRedis := mockredis.NewMockFeedsRedis(ctrl)
+	mockSitemaps := mockservice.NewMockSitemaps(ctrl)
+	mockRelated := mockservice.NewMockRelated(ctrl)
+	newsService := NewNewsService(nil, mockNewsStorage, nil, nil, mockNewsRedis, mockSuggestRedis, mockFeedsRedis, nil, mockSitemaps, mockRelated, nil, nil, apiLogger)
 
 	userID := uuid.New()
 	newsID := uuid.New()
@@ -72,7 +102,10 @@ func TestService_UpdateNews(t *testing.T) {
 		AuthorID: userID,
 		Title:    "TitleTitleTitleTitleTitleTitleTitleTitle",
 		Content:  "ContentContentContentContentContent",
+		Status:   entity.NewsStatusPublished,
 	}
+	updated := *news
+	updated.Status = entity.NewsStatusPublished
 
 	user := &entity.User{
 		ID: userID,
@@ -83,8 +116,12 @@ func TestService_UpdateNews(t *testing.T) {
 	ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, user)
 
 	mockNewsStorage.EXPECT().GetNewsByID(ctx, gomock.Eq(news.NewsID)).Return(newsBase, nil)
-	mockNewsStorage.EXPECT().Update(ctx, gomock.Eq(news)).Return(news, nil)
+	mockNewsStorage.EXPECT().Update(ctx, gomock.Eq(news), &entity.NewsRevision{EditorID: userID}).Return(&updated, nil)
 	mockNewsRedis.EXPECT().DeleteNewsCtx(ctx, gomock.Eq(cacheKey)).Return(nil)
+	mockSuggestRedis.EXPECT().AddSuggestionCtx(ctx, entity.SuggestNews, gomock.Any()).Return(nil)
+	mockFeedsRedis.EXPECT().InvalidateFeedsCtx(ctx).Return(nil)
+	mockSitemaps.EXPECT().InvalidateNews(ctx, gomock.Any(), gomock.Any()).Return(nil)
+	mockRelated.EXPECT().MarkStale(ctx, gomock.Any()).Return(nil)
 
 	updatedNews, err := newsService.Update(ctx, news)
 	require.NoError(t, err)
@@ -101,7 +138,8 @@ func TestService_GetNewsByID(t *testing.T) {
 	apiLogger := logger.NewApiLogger(nil)
 	mockNewsStorage := mockstorage.NewMockNewsPsql(ctrl)
 	mockNewsRedis := mockredis.NewMockNewsRedis(ctrl)
-	newsService := NewNewsService(nil, mockNewsStorage, mockNewsRedis, apiLogger)
+	mockSuggestRedis := mockredis.NewMockSuggestRedis(ctrl)
+	newsService := NewNewsService(nil, mockNewsStorage, nil, nil, mockNewsRedis, mockSuggestRedis, nil, nil, nil, nil, nil, nil, apiLogger)
 
 	newsID := uuid.New()
 	newsBase := &entity.NewsBase{
@@ -114,11 +152,88 @@ func TestService_GetNewsByID(t *testing.T) {
 	mockNewsRedis.EXPECT().GetNewsByIDCtx(ctx, gomock.Eq(cacheKey)).Return(nil, nil)
 	mockNewsStorage.EXPECT().GetNewsByID(ctx, gomock.Eq(newsID)).Return(newsBase, nil)
 	mockNewsRedis.EXPECT().SetNewsCtx(ctx, cacheKey, cacheNewsDuration, newsBase).Return(nil)
+	mockSuggestRedis.EXPECT().IncrSuggestionCtx(ctx, entity.SuggestNews, newsID, float64(1)).Return(nil)
 
 	newsById, err := newsService.GetNewsByID(ctx, newsBase.NewsID)
 	require.NoError(t, err)
 	require.Nil(t, err)
 	require.NotNil(t, newsById)
+
+	t.Run("Draft", func(t *testing.T) {
+		authorID := uuid.New()
+		draft := &entity.NewsBase{
+			NewsID:   uuid.New(),
+			AuthorID: authorID,
+			Status:   entity.NewsStatusDraft,
+		}
+		draftKey := fmt.Sprintf("%s: %s", baseNewsPrefix, draft.NewsID)
+
+		mockNewsRedis.EXPECT().GetNewsByIDCtx(gomock.Any(), gomock.Eq(draftKey)).Return(draft, nil).Times(2)
+
+		_, err := newsService.GetNewsByID(ctx, draft.NewsID)
+		require.Error(t, err)
+
+		authorCtx := context.WithValue(ctx, utils.UserCtxKey{}, &entity.User{ID: authorID})
+		mockNewsStorage.EXPECT().IsBookmarked(authorCtx, authorID, draft.NewsID).Return(true, nil)
+
+		news, err := newsService.GetNewsByID(authorCtx, draft.NewsID)
+		require.NoError(t, err)
+		require.Equal(t, draft, news)
+		require.True(t, news.Bookmarked)
+	})
+
+	t.Run("Views", func(t *testing.T) {
+		mockViewsRedis := mockredis.NewMockViewsRedis(ctrl)
+		newsService := NewNewsService(nil, mockNewsStorage, nil, nil, mockNewsRedis, mockSuggestRedis, nil, mockViewsRedis, nil, nil, nil, nil, apiLogger)
+
+		published := &entity.NewsBase{
+			NewsID: uuid.New(),
+			Status: entity.NewsStatusPublished,
+			Views:  40,
+		}
+		publishedKey := fmt.Sprintf("%s: %s", baseNewsPrefix, published.NewsID)
+		visitorCtx := context.WithValue(ctx, utils.VisitorCtxKey{}, "visitor")
+
+		mockNewsRedis.EXPECT().GetNewsByIDCtx(visitorCtx, publishedKey).Return(published, nil)
+		mockSuggestRedis.EXPECT().IncrSuggestionCtx(visitorCtx, entity.SuggestNews, published.NewsID, float64(1)).Return(nil)
+		mockViewsRedis.EXPECT().RecordViewCtx(visitorCtx, published.NewsID, "anon:visitor", gomock.Any(), 3600).Return(int64(2), nil)
+
+		news, err := newsService.GetNewsByID(visitorCtx, published.NewsID)
+		require.NoError(t, err)
+		require.Equal(t, int64(42), news.Views)
+	})
+}
+
+func TestService_GetNewsBySlug(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsStorage := mockstorage.NewMockNewsPsql(ctrl)
+	mockNewsRedis := mockredis.NewMockNewsRedis(ctrl)
+	newsService := NewNewsService(nil, mockNewsStorage, nil, nil, mockNewsRedis, nil, nil, nil, nil, nil, nil, nil, apiLogger)
+
+	ctx := context.Background()
+	draft := &entity.NewsBase{
+		NewsID:   uuid.New(),
+		AuthorID: uuid.New(),
+		Slug:     "draft-title",
+		Status:   entity.NewsStatusDraft,
+	}
+	cacheKey := fmt.Sprintf("%s: %s", baseNewsPrefix, draft.NewsID)
+
+	mockNewsStorage.EXPECT().GetNewsIDBySlug(ctx, "old-draft-title").Return(draft.NewsID, nil)
+	mockNewsRedis.EXPECT().GetNewsByIDCtx(ctx, cacheKey).Return(draft, nil)
+
+	_, err := newsService.GetNewsBySlug(ctx, "old-draft-title")
+	require.Error(t, err)
+
+	mockNewsStorage.EXPECT().GetNewsIDBySlug(ctx, "unknown").Return(uuid.Nil, errors.Wrap(sql.ErrNoRows, "NewsStoragePsql.GetNewsIDBySlug.GetContext"))
+
+	_, err = newsService.GetNewsBySlug(ctx, "unknown")
+	require.Error(t, err)
 }
 
 func TestService_DeleteNews(t *testing.T) {
@@ -130,13 +245,18 @@ func TestService_DeleteNews(t *testing.T) {
 	apiLogger := logger.NewApiLogger(nil)
 	mockNewsStorage := mockstorage.NewMockNewsPsql(ctrl)
 	mockNewsRedis := mockredis.NewMockNewsRedis(ctrl)
-	newsService := NewNewsService(nil, mockNewsStorage, mockNewsRedis, apiLogger)
+	mockSuggestRedis := mockredis.NewMockSuggestRedis(ctrl)
+	mockFeedsRedis := mockredis.NewMockFeedsRedis(ctrl)
+	mockSitemaps := mockservice.NewMockSitemaps(ctrl)
+	mockRelated := mockservice.NewMockRelated(ctrl)
+	newsService := NewNewsService(nil, mockNewsStorage, nil, nil, mockNewsRedis, mockSuggestRedis, mockFeedsRedis, nil, mockSitemaps, mockRelated, nil, nil, apiLogger)
 
 	newsID := uuid.New()
 	userID := uuid.New()
 	newsBase := &entity.NewsBase{
 		NewsID:   newsID,
 		AuthorID: userID,
+		Status:   entity.NewsStatusPublished,
 	}
 	cacheKey := fmt.Sprintf("%s: %s", baseNewsPrefix, newsID)
 
@@ -147,10 +267,14 @@ func TestService_DeleteNews(t *testing.T) {
 	ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, user)
 
 	mockNewsStorage.EXPECT().GetNewsByID(ctx, gomock.Eq(newsBase.NewsID)).Return(newsBase, nil)
-	mockNewsStorage.EXPECT().Delete(ctx, gomock.Eq(newsID)).Return(nil)
+	mockNewsStorage.EXPECT().Delete(ctx, gomock.Eq(newsID), 3).Return(nil)
 	mockNewsRedis.EXPECT().DeleteNewsCtx(ctx, gomock.Eq(cacheKey)).Return(nil)
+	mockSuggestRedis.EXPECT().DeleteSuggestionCtx(ctx, entity.SuggestNews, newsID).Return(nil)
+	mockSuggestRedis.EXPECT().IncrSuggestionCtx(ctx, entity.SuggestAuthors, userID, float64(-1)).Return(nil)
+	mockFeedsRedis.EXPECT().InvalidateFeedsCtx(ctx).Return(nil)
+	mockSitemaps.EXPECT().InvalidateNews(ctx, gomock.Any(), gomock.Any()).Return(nil)
 
-	err := newsService.Delete(ctx, newsBase.NewsID)
+	err := newsService.Delete(ctx, newsBase.NewsID, 3)
 	require.NoError(t, err)
 	require.Nil(t, err)
 }
@@ -164,19 +288,18 @@ func TestService_GetNews(t *testing.T) {
 	apiLogger := logger.NewApiLogger(nil)
 	mockNewsStorage := mockstorage.NewMockNewsPsql(ctrl)
 	mockNewsRedis := mockredis.NewMockNewsRedis(ctrl)
-	newsService := NewNewsService(nil, mockNewsStorage, mockNewsRedis, apiLogger)
+	newsService := NewNewsService(nil, mockNewsStorage, nil, nil, mockNewsRedis, nil, nil, nil, nil, nil, nil, nil, apiLogger)
 
 	ctx := context.Background()
 
 	query := &utils.PaginationQuery{
-		Size:    10,
-		Page:    1,
-		OrderBy: "",
+		Size: 10,
+		Page: 1,
 	}
 
 	newsList := &entity.NewsList{}
 
-	mockNewsStorage.EXPECT().GetNews(ctx, query).Return(newsList, nil)
+	mockNewsStorage.EXPECT().GetNews(ctx, uuid.Nil, gomock.Nil(), query).Return(newsList, nil)
 
 	news, err := newsService.GetNews(ctx, query)
 	require.NoError(t, err)
@@ -193,23 +316,108 @@ func TestService_SearchNews(t *testing.T) {
 	apiLogger := logger.NewApiLogger(nil)
 	mockNewsStorage := mockstorage.NewMockNewsPsql(ctrl)
 	mockNewsRedis := mockredis.NewMockNewsRedis(ctrl)
-	newsService := NewNewsService(nil, mockNewsStorage, mockNewsRedis, apiLogger)
+	newsService := NewNewsService(nil, mockNewsStorage, nil, nil, mockNewsRedis, nil, nil, nil, nil, nil, nil, nil, apiLogger)
 
 	ctx := context.Background()
 
 	query := &utils.PaginationQuery{
-		Size:    10,
-		Page:    1,
-		OrderBy: "",
+		Size: 10,
+		Page: 1,
 	}
 
-	newsList := &entity.NewsList{}
-	title := "title"
+	newsList := &entity.NewsSearchList{}
+	search := &entity.NewsSearchQuery{
+		Query: "title",
+	}
 
-	mockNewsStorage.EXPECT().SearchNews(ctx, title, query).Return(newsList, nil)
+	mockNewsStorage.EXPECT().SearchNews(ctx, search, uuid.Nil, query).Return(newsList, nil)
 
-	news, err := newsService.SearchNews(ctx, title, query)
+	news, err := newsService.SearchNews(ctx, search, query)
 	require.NoError(t, err)
 	require.Nil(t, err)
 	require.NotNil(t, news)
-}
\ No newline at end of file
+	require.Equal(t, entity.DefaultNewsLanguage, search.Language)
+
+	_, err = newsService.SearchNews(ctx, &entity.NewsSearchQuery{
+		Query:    "title",
+		Language: "klingon",
+	}, query)
+	require.Error(t, err)
+}
+
+func TestService_PublishScheduled(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsStorage := mockstorage.NewMockNewsPsql(ctrl)
+	mockNewsRedis := mockredis.NewMockNewsRedis(ctrl)
+	mockSuggestRedis := mockredis.NewMockSuggestRedis(ctrl)
+	mockFeedsRedis := mockredis.NewMockFeedsRedis(ctrl)
+	mockSitemaps := mockservice.NewMockSitemaps(ctrl)
+	mockRelated := mockservice.NewMockRelated(ctrl)
+	newsService := NewNewsService(nil, mockNewsStorage, nil, nil, mockNewsRedis, mockSuggestRedis, mockFeedsRedis, nil, mockSitemaps, mockRelated, nil, nil, apiLogger)
+
+	news := &entity.News{
+		NewsID:   uuid.New(),
+		AuthorID: uuid.New(),
+		Title:    "TitleTitleTitleTitleTitleTitleTitle",
+		Status:   entity.NewsStatusPublished,
+	}
+	cacheKey := fmt.Sprintf("%s: %s", baseNewsPrefix, news.NewsID)
+
+	ctx := context.Background()
+
+	mockNewsStorage.EXPECT().PublishScheduled(ctx).Return([]*entity.News{news}, nil)
+	mockNewsRedis.EXPECT().DeleteNewsCtx(ctx, gomock.Eq(cacheKey)).Return(nil)
+	mockSuggestRedis.EXPECT().AddSuggestionCtx(ctx, entity.SuggestNews, &entity.Suggestion{
+		ID:   news.NewsID,
+		Text: news.Title,
+	}).Return(nil)
+	mockSuggestRedis.EXPECT().IncrSuggestionCtx(ctx, entity.SuggestAuthors, news.AuthorID, float64(1)).Return(nil)
+	mockFeedsRedis.EXPECT().InvalidateFeedsCtx(ctx).Return(nil)
+	mockSitemaps.EXPECT().InvalidateNews(ctx, gomock.Any(), gomock.Any()).Return(nil)
+	mockRelated.EXPECT().MarkStale(ctx, gomock.Any()).Return(nil)
+
+	published, err := newsService.PublishScheduled(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, published)
+}
+
+func TestService_resolveCategory(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockCategoriesStorage := mockstorage.NewMockCategoriesPsql(ctrl)
+	newsService := NewNewsService(nil, nil, nil, mockCategoriesStorage, nil, nil, nil, nil, nil, nil, nil, nil, apiLogger)
+
+	ctx := context.Background()
+	category := &entity.Category{CategoryID: uuid.New(), Name: "Tech", Slug: "tech"}
+
+	t.Run("By name", func(t *testing.T) {
+		name := " TECH "
+		news := &entity.News{Category: &name}
+
+		mockCategoriesStorage.EXPECT().GetBySlug(ctx, "tech").Return(category, nil)
+
+		err := newsService.resolveCategory(ctx, news)
+		require.NoError(t, err)
+		require.Equal(t, category.CategoryID, *news.CategoryID)
+		require.Equal(t, "Tech", *news.Category)
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		categoryID := uuid.New()
+		news := &entity.News{CategoryID: &categoryID}
+
+		mockCategoriesStorage.EXPECT().GetByID(ctx, categoryID).Return(nil, errors.Wrap(sql.ErrNoRows, "CategoriesStoragePsql.GetByID.GetContext"))
+
+		err := newsService.resolveCategory(ctx, news)
+		require.Error(t, err)
+	})
+}
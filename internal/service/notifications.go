@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// Notifications StoragePsql interface
+type NotificationsPsql interface {
+	Create(ctx context.Context, notification *entity.Notification) (*entity.Notification, error)
+	GetNotifications(ctx context.Context, userID uuid.UUID, unreadOnly bool, pq *utils.PaginationQuery) (*entity.NotificationsList, error)
+	MarkRead(ctx context.Context, userID uuid.UUID, notificationID uuid.UUID) error
+	MarkAllRead(ctx context.Context, userID uuid.UUID) (int64, error)
+}
+
+// Notifications service
+type NotificationsService struct {
+	logger      logger.Logger
+	config      *config.Config
+	storagePsql NotificationsPsql
+}
+
+// Notifications service constructor
+func NewNotificationsService(config *config.Config, storagePsql NotificationsPsql, logger logger.Logger) *NotificationsService {
+	return &NotificationsService{
+		config:      config,
+		storagePsql: storagePsql,
+		logger:      logger,
+	}
+}
+
+// Notify user
+func (s *NotificationsService) Notify(ctx context.Context, notification *entity.Notification) error {
+	_, err := s.storagePsql.Create(ctx, notification)
+	return err
+}
+
+// Get notifications of current user, newest first
+func (s *NotificationsService) GetNotifications(ctx context.Context, unreadOnly bool, pq *utils.PaginationQuery) (*entity.NotificationsList, error) {
+	user, err := utils.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, httpe.NewUnauthorizedError(errors.WithMessage(err, "NotificationsService.GetNotifications.GetUserFromCtx"))
+	}
+	return s.storagePsql.GetNotifications(ctx, user.ID, unreadOnly, pq)
+}
+
+// Mark notification of current user read
+func (s *NotificationsService) MarkRead(ctx context.Context, notificationID uuid.UUID) error {
+	user, err := utils.GetUserFromCtx(ctx)
+	if err != nil {
+		return httpe.NewUnauthorizedError(errors.WithMessage(err, "NotificationsService.MarkRead.GetUserFromCtx"))
+	}
+	return s.storagePsql.MarkRead(ctx, user.ID, notificationID)
+}
+
+// Mark all notifications of current user read, returns number of marked ones
+func (s *NotificationsService) MarkAllRead(ctx context.Context) (int64, error) {
+	user, err := utils.GetUserFromCtx(ctx)
+	if err != nil {
+		return 0, httpe.NewUnauthorizedError(errors.WithMessage(err, "NotificationsService.MarkAllRead.GetUserFromCtx"))
+	}
+	return s.storagePsql.MarkAllRead(ctx, user.ID)
+}
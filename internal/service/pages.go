@@ -0,0 +1,329 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"time"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/markdown"
+	"github.com/Edbeer/restapi/pkg/render"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	pageDescriptionLength = 200
+	pageCommentsSize      = 50
+)
+
+// Pages news source interface
+type PagesNews interface {
+	GetNews(ctx context.Context, pq *utils.PaginationQuery) (*entity.NewsList, error)
+	GetNewsBySlug(ctx context.Context, slug string) (*entity.NewsBase, error)
+}
+
+// Pages comments source interface
+type PagesComments interface {
+	GetAllByNewsID(ctx context.Context, newsID uuid.UUID, pq *utils.PaginationQuery) (*entity.CommentsList, error)
+}
+
+// Pages categories source interface
+type PagesCategories interface {
+	GetBySlug(ctx context.Context, slug string) (*entity.Category, error)
+	GetNews(ctx context.Context, slug string, descendants bool, pq *utils.PaginationQuery) (*entity.NewsList, error)
+}
+
+// Pages StorageRedis interface
+type PagesRedis interface {
+	GetPageCtx(ctx context.Context, key string) ([]byte, error)
+	SetPageCtx(ctx context.Context, key string, seconds int, page []byte) error
+}
+
+// Pages service renders public html pages
+type PagesService struct {
+	logger       logger.Logger
+	config       *config.Config
+	news         PagesNews
+	comments     PagesComments
+	categories   PagesCategories
+	storageRedis PagesRedis
+	renderer     *render.Renderer
+}
+
+// Pages service constructor
+func NewPagesService(config *config.Config, news PagesNews, comments PagesComments, categories PagesCategories, redis PagesRedis, logger logger.Logger) *PagesService {
+	return &PagesService{
+		config:       config,
+		news:         news,
+		comments:     comments,
+		categories:   categories,
+		storageRedis: redis,
+		renderer:     render.NewRenderer(config.Pages.TemplatesDir, config.Pages.Reload),
+		logger:       logger,
+	}
+}
+
+// Open Graph, Twitter card and JSON-LD metadata of page
+type pageMeta struct {
+	SiteName      string
+	Title         string
+	Description   string
+	URL           string
+	Image         string
+	Type          string
+	TwitterCard   string
+	TwitterSite   string
+	PublishedTime *time.Time
+	ModifiedTime  *time.Time
+	Author        string
+	Section       string
+	Tags          []string
+	JSONLD        template.JS
+}
+
+type pageNews struct {
+	*entity.News
+	URL     string
+	Excerpt string
+}
+
+type pageComment struct {
+	*entity.CommentBase
+	MessageHTML template.HTML
+}
+
+type articlePage struct {
+	Meta        pageMeta
+	News        *entity.NewsBase
+	ContentHTML template.HTML
+	Comments    []*pageComment
+}
+
+type listPage struct {
+	Meta     pageMeta
+	Heading  string
+	Category *entity.Category
+	News     []*pageNews
+	Page     int
+	HasMore  bool
+}
+
+type newsArticleLD struct {
+	Context          string     `json:"@context"`
+	Type             string     `json:"@type"`
+	Headline         string     `json:"headline"`
+	Description      string     `json:"description,omitempty"`
+	Image            []string   `json:"image,omitempty"`
+	DatePublished    *time.Time `json:"datePublished,omitempty"`
+	DateModified     time.Time  `json:"dateModified"`
+	Author           []ldThing  `json:"author,omitempty"`
+	Publisher        ldThing    `json:"publisher"`
+	MainEntityOfPage string     `json:"mainEntityOfPage"`
+	ArticleSection   string     `json:"articleSection,omitempty"`
+	Keywords         []string   `json:"keywords,omitempty"`
+}
+
+type ldThing struct {
+	Type string `json:"@type"`
+	Name string `json:"name"`
+}
+
+// Render article page of published news, old slugs redirect to the current one
+func (p *PagesService) RenderArticle(ctx context.Context, slug string) (*entity.PageDocument, error) {
+	key := "article:" + slug
+	if page := p.getCached(ctx, key); page != nil {
+		return page, nil
+	}
+
+	news, err := p.news.GetNewsBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	if news.Status != "" && news.Status != entity.NewsStatusPublished {
+		return nil, httpe.NewNotFoundError(errors.New("PagesService.RenderArticle.Status"))
+	}
+	if news.Slug != slug {
+		return &entity.PageDocument{Redirect: "/news/" + news.Slug}, nil
+	}
+
+	comments, err := p.comments.GetAllByNewsID(ctx, news.NewsID, &utils.PaginationQuery{Size: pageCommentsSize})
+	if err != nil {
+		return nil, err
+	}
+
+	contentHTML, _ := markdown.Format(markdown.FormatHTML, news.Content, news.ContentHTML)
+	data := &articlePage{
+		Meta:        p.articleMeta(news, contentHTML),
+		News:        news,
+		ContentHTML: template.HTML(contentHTML),
+	}
+	for _, comment := range comments.Comments {
+		messageHTML, _ := markdown.Format(markdown.FormatHTML, comment.Message, comment.MessageHTML)
+		data.Comments = append(data.Comments, &pageComment{
+			CommentBase: comment,
+			MessageHTML: template.HTML(messageHTML),
+		})
+	}
+
+	return p.render(ctx, key, "article", data)
+}
+
+// Render listing page of latest published news
+func (p *PagesService) RenderList(ctx context.Context, page int) (*entity.PageDocument, error) {
+	key := fmt.Sprintf("list:%d", page)
+	if cached := p.getCached(ctx, key); cached != nil {
+		return cached, nil
+	}
+
+	newsList, err := p.news.GetNews(ctx, p.pagination(page))
+	if err != nil {
+		return nil, err
+	}
+
+	data := p.listPage(newsList, page)
+	data.Heading = "Latest news"
+	data.Meta.Title = p.config.Pages.SiteName
+	data.Meta.URL = p.config.Pages.BaseURL + "/news"
+	return p.render(ctx, key, "list", data)
+}
+
+// Render page of category with news of its subcategories
+func (p *PagesService) RenderCategory(ctx context.Context, slug string, page int) (*entity.PageDocument, error) {
+	key := fmt.Sprintf("category:%s:%d", slug, page)
+	if cached := p.getCached(ctx, key); cached != nil {
+		return cached, nil
+	}
+
+	category, err := p.categories.GetBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	newsList, err := p.categories.GetNews(ctx, category.Slug, true, p.pagination(page))
+	if err != nil {
+		return nil, err
+	}
+
+	data := p.listPage(newsList, page)
+	data.Heading = category.Name
+	data.Category = category
+	data.Meta.Title = fmt.Sprintf("%s - %s", category.Name, p.config.Pages.SiteName)
+	data.Meta.URL = fmt.Sprintf("%s/categories/%s", p.config.Pages.BaseURL, category.Slug)
+	if category.Description != nil {
+		data.Meta.Description = *category.Description
+	}
+	return p.render(ctx, key, "category", data)
+}
+
+func (p *PagesService) articleMeta(news *entity.NewsBase, contentHTML string) pageMeta {
+	url := fmt.Sprintf("%s/news/%s", p.config.Pages.BaseURL, news.Slug)
+	meta := pageMeta{
+		SiteName:      p.config.Pages.SiteName,
+		Title:         news.Title,
+		Description:   markdown.Excerpt(contentHTML, pageDescriptionLength),
+		URL:           url,
+		Type:          "article",
+		TwitterCard:   "summary",
+		TwitterSite:   p.config.Pages.TwitterSite,
+		PublishedTime: news.PublishAt,
+		Author:        news.Author,
+	}
+	if !news.UpdatedAt.IsZero() {
+		meta.ModifiedTime = &news.UpdatedAt
+	}
+	if news.ImageURL != nil {
+		meta.Image = *news.ImageURL
+		meta.TwitterCard = "summary_large_image"
+	}
+	if news.Category != nil {
+		meta.Section = *news.Category
+	}
+	for _, tag := range news.Tags {
+		meta.Tags = append(meta.Tags, tag.Name)
+	}
+
+	ld := newsArticleLD{
+		Context:          "https://schema.org",
+		Type:             "NewsArticle",
+		Headline:         news.Title,
+		Description:      meta.Description,
+		DatePublished:    news.PublishAt,
+		DateModified:     news.UpdatedAt,
+		Publisher:        ldThing{Type: "Organization", Name: p.config.Pages.SiteName},
+		MainEntityOfPage: url,
+		ArticleSection:   meta.Section,
+		Keywords:         meta.Tags,
+	}
+	if meta.Image != "" {
+		ld.Image = []string{meta.Image}
+	}
+	if news.Author != "" {
+		ld.Author = []ldThing{{Type: "Person", Name: news.Author}}
+	}
+	// json escapes <, > and & so the script element can't be closed early
+	if b, err := json.Marshal(ld); err == nil {
+		meta.JSONLD = template.JS(b)
+	}
+	return meta
+}
+
+func (p *PagesService) listPage(newsList *entity.NewsList, page int) *listPage {
+	data := &listPage{
+		Meta: pageMeta{
+			SiteName:    p.config.Pages.SiteName,
+			Description: p.config.Pages.SiteName,
+			Type:        "website",
+			TwitterCard: "summary",
+			TwitterSite: p.config.Pages.TwitterSite,
+		},
+		Page:    page,
+		HasMore: newsList.HasMore,
+	}
+	for _, news := range newsList.News {
+		if news.Status != "" && news.Status != entity.NewsStatusPublished {
+			continue
+		}
+		contentHTML, _ := markdown.Format(markdown.FormatHTML, news.Content, news.ContentHTML)
+		data.News = append(data.News, &pageNews{
+			News:    news,
+			URL:     "/news/" + news.Slug,
+			Excerpt: markdown.Excerpt(contentHTML, pageDescriptionLength),
+		})
+	}
+	return data
+}
+
+// Pages are numbered from one
+func (p *PagesService) pagination(page int) *utils.PaginationQuery {
+	return &utils.PaginationQuery{Size: p.config.Pages.PageSize, Page: page - 1}
+}
+
+func (p *PagesService) getCached(ctx context.Context, key string) *entity.PageDocument {
+	body, err := p.storageRedis.GetPageCtx(ctx, key)
+	if err != nil {
+		p.logger.Errorf("PagesService.getCached.GetPageCtx: %v", err)
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	return &entity.PageDocument{Body: body}
+}
+
+func (p *PagesService) render(ctx context.Context, key string, name string, data interface{}) (*entity.PageDocument, error) {
+	body, err := p.renderer.Render(name, data)
+	if err != nil {
+		return nil, httpe.NewInternalServerError(errors.WithMessage(err, "PagesService.render.Render"))
+	}
+
+	if err := p.storageRedis.SetPageCtx(ctx, key, p.config.Pages.CacheTTL, body); err != nil {
+		p.logger.Errorf("PagesService.render.SetPageCtx: %v", err)
+	}
+	return &entity.PageDocument{Body: body}, nil
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	mockservice "github.com/Edbeer/restapi/internal/service/mock"
+	mockredis "github.com/Edbeer/restapi/internal/storage/redis/mock"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func testPagesConfig() *config.Config {
+	return &config.Config{
+		Pages: config.PagesConfig{
+			TemplatesDir: "../../templates",
+			SiteName:     "Daily",
+			BaseURL:      "https://example.com",
+			PageSize:     10,
+			CacheTTL:     60,
+		},
+	}
+}
+
+func TestService_RenderArticle(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNews := mockservice.NewMockNews(ctrl)
+	mockComments := mockservice.NewMockComments(ctrl)
+	mockPagesRedis := mockredis.NewMockPagesRedis(ctrl)
+	pagesService := NewPagesService(testPagesConfig(), mockNews, mockComments, nil, mockPagesRedis, apiLogger)
+
+	ctx := context.Background()
+	publishAt := time.Date(2026, 10, 19, 9, 30, 0, 0, time.UTC)
+	imageURL := "https://example.com/image.png"
+	category := "world"
+	news := &entity.NewsBase{
+		NewsID:      uuid.New(),
+		Title:       "Rain </script> in Spain",
+		Slug:        "rain-in-spain",
+		Content:     "Mainly on the plain",
+		ContentHTML: "<p>Mainly on the <em>plain</em></p>",
+		ImageURL:    &imageURL,
+		Category:    &category,
+		Status:      entity.NewsStatusPublished,
+		PublishAt:   &publishAt,
+		Tags:        []*entity.Tag{{Name: "weather"}},
+		Author:      "Jane Roe",
+		UpdatedAt:   publishAt,
+	}
+
+	t.Run("Render", func(t *testing.T) {
+		mockPagesRedis.EXPECT().GetPageCtx(ctx, "article:rain-in-spain").Return(nil, nil)
+		mockNews.EXPECT().GetNewsBySlug(ctx, "rain-in-spain").Return(news, nil)
+		mockComments.EXPECT().GetAllByNewsID(ctx, news.NewsID, &utils.PaginationQuery{Size: pageCommentsSize}).Return(&entity.CommentsList{
+			Comments: []*entity.CommentBase{{Author: "John Doe", Message: "**Nice**"}},
+		}, nil)
+		mockPagesRedis.EXPECT().SetPageCtx(ctx, "article:rain-in-spain", 60, gomock.Any()).Return(nil)
+
+		page, err := pagesService.RenderArticle(ctx, "rain-in-spain")
+		require.NoError(t, err)
+		body := string(page.Body)
+		require.Contains(t, body, `<meta property="og:type" content="article">`)
+		require.Contains(t, body, `<meta property="og:url" content="https://example.com/news/rain-in-spain">`)
+		require.Contains(t, body, `<meta property="og:image" content="https://example.com/image.png">`)
+		require.Contains(t, body, `<meta property="article:published_time" content="2026-10-19T09:30:00Z">`)
+		require.Contains(t, body, `<meta property="article:tag" content="weather">`)
+		require.Contains(t, body, `<meta name="twitter:card" content="summary_large_image">`)
+		require.Contains(t, body, `<meta name="description" content="Mainly on the plain">`)
+		require.Contains(t, body, `"@type":"NewsArticle"`)
+		require.Contains(t, body, `"headline":"Rain \u003c/script\u003e in Spain"`)
+		require.Contains(t, body, "<p>Mainly on the <em>plain</em></p>")
+		require.Contains(t, body, "<strong>Nice</strong>")
+		require.NotContains(t, body, "Rain </script>")
+	})
+
+	t.Run("OldSlug", func(t *testing.T) {
+		mockPagesRedis.EXPECT().GetPageCtx(ctx, "article:old-rain").Return(nil, nil)
+		mockNews.EXPECT().GetNewsBySlug(ctx, "old-rain").Return(news, nil)
+
+		page, err := pagesService.RenderArticle(ctx, "old-rain")
+		require.NoError(t, err)
+		require.Equal(t, "/news/rain-in-spain", page.Redirect)
+	})
+
+	t.Run("Cached", func(t *testing.T) {
+		mockPagesRedis.EXPECT().GetPageCtx(ctx, "article:rain-in-spain").Return([]byte("<html></html>"), nil)
+
+		page, err := pagesService.RenderArticle(ctx, "rain-in-spain")
+		require.NoError(t, err)
+		require.Equal(t, "<html></html>", string(page.Body))
+	})
+}
+
+func TestService_RenderCategory(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockCategories := mockservice.NewMockCategories(ctrl)
+	mockPagesRedis := mockredis.NewMockPagesRedis(ctrl)
+	pagesService := NewPagesService(testPagesConfig(), nil, nil, mockCategories, mockPagesRedis, apiLogger)
+
+	ctx := context.Background()
+	description := "News from around the world"
+	category := &entity.Category{Name: "World", Slug: "world", Description: &description}
+
+	mockPagesRedis.EXPECT().GetPageCtx(ctx, "category:world:2").Return(nil, nil)
+	mockCategories.EXPECT().GetBySlug(ctx, "world").Return(category, nil)
+	mockCategories.EXPECT().GetNews(ctx, "world", true, &utils.PaginationQuery{Size: 10, Page: 1}).Return(&entity.NewsList{
+		HasMore: true,
+		News: []*entity.News{
+			{Title: "Rain in Spain", Slug: "rain-in-spain", Content: "Mainly on the plain", Status: entity.NewsStatusPublished},
+		},
+	}, nil)
+	mockPagesRedis.EXPECT().SetPageCtx(ctx, "category:world:2", 60, gomock.Any()).Return(nil)
+
+	page, err := pagesService.RenderCategory(ctx, "world", 2)
+	require.NoError(t, err)
+	body := string(page.Body)
+	require.Contains(t, body, "<title>World - Daily</title>")
+	require.Contains(t, body, `<meta property="og:type" content="website">`)
+	require.Contains(t, body, `<a href="/news/rain-in-spain">Rain in Spain</a>`)
+	require.Contains(t, body, `<a rel="prev" href="?page=1">`)
+	require.Contains(t, body, `<a rel="next" href="?page=3">`)
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// Reactions StoragePsql interface
+type ReactionsPsql interface {
+	Add(ctx context.Context, reaction *entity.Reaction) (bool, error)
+	Remove(ctx context.Context, reaction *entity.Reaction) (bool, error)
+	GetReactions(ctx context.Context, targetType string, targetID uuid.UUID, userID uuid.UUID) (*entity.Reactions, error)
+	Reconcile(ctx context.Context) (int64, error)
+}
+
+// Reactions service
+type ReactionsService struct {
+	logger      logger.Logger
+	config      *config.Config
+	storagePsql ReactionsPsql
+}
+
+// Reactions service constructor
+func NewReactionsService(config *config.Config, storagePsql ReactionsPsql, logger logger.Logger) *ReactionsService {
+	return &ReactionsService{
+		config:      config,
+		storagePsql: storagePsql,
+		logger:      logger,
+	}
+}
+
+// React on news or comment, reacting again with the same kind changes nothing
+func (r *ReactionsService) React(ctx context.Context, targetType string, targetID uuid.UUID, kind string) (*entity.Reactions, error) {
+	reaction, err := r.userReaction(ctx, targetType, targetID, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := r.storagePsql.Add(ctx, reaction); err != nil {
+		return nil, err
+	}
+	return r.storagePsql.GetReactions(ctx, targetType, targetID, reaction.UserID)
+}
+
+// Remove reaction of current user
+func (r *ReactionsService) Unreact(ctx context.Context, targetType string, targetID uuid.UUID, kind string) (*entity.Reactions, error) {
+	reaction, err := r.userReaction(ctx, targetType, targetID, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := r.storagePsql.Remove(ctx, reaction); err != nil {
+		return nil, err
+	}
+	return r.storagePsql.GetReactions(ctx, targetType, targetID, reaction.UserID)
+}
+
+// Get reaction counts of news or comment with reactions of current user
+func (r *ReactionsService) GetReactions(ctx context.Context, targetType string, targetID uuid.UUID) (*entity.Reactions, error) {
+	return r.storagePsql.GetReactions(ctx, targetType, targetID, getViewerID(ctx))
+}
+
+// Recount reaction counters, returns number of fixed rows
+func (r *ReactionsService) Reconcile(ctx context.Context) (int64, error) {
+	return r.storagePsql.Reconcile(ctx)
+}
+
+func (r *ReactionsService) userReaction(ctx context.Context, targetType string, targetID uuid.UUID, kind string) (*entity.Reaction, error) {
+	user, err := utils.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, httpe.NewUnauthorizedError(errors.WithMessage(err, "ReactionsService.userReaction.GetUserFromCtx"))
+	}
+
+	if !r.validKind(kind) {
+		return nil, httpe.NewBadRequestError(errors.Errorf("ReactionsService.userReaction: unknown reaction %q", kind))
+	}
+
+	reaction := &entity.Reaction{
+		TargetType: targetType,
+		TargetID:   targetID,
+		UserID:     user.ID,
+		Kind:       kind,
+	}
+	if err := utils.ValidateStruct(ctx, reaction); err != nil {
+		return nil, httpe.NewBadRequestError(errors.WithMessage(err, "ReactionsService.userReaction.ValidateStruct"))
+	}
+	return reaction, nil
+}
+
+// Like is always allowed, other kinds are configured
+func (r *ReactionsService) validKind(kind string) bool {
+	if kind == entity.ReactionLike {
+		return true
+	}
+	for _, allowed := range r.config.Reactions.Kinds {
+		if kind == allowed {
+			return true
+		}
+	}
+	return false
+}
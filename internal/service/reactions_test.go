@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	mockstorage "github.com/Edbeer/restapi/internal/storage/psql/mock"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_React(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Reactions: config.ReactionsConfig{Kinds: []string{"wow"}}}
+	apiLogger := logger.NewApiLogger(nil)
+	mockReactionsStorage := mockstorage.NewMockReactionsPsql(ctrl)
+	reactionsService := NewReactionsService(cfg, mockReactionsStorage, apiLogger)
+
+	userID, newsID := uuid.New(), uuid.New()
+	ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, &entity.User{ID: userID})
+	reactions := &entity.Reactions{Counts: map[string]int64{"wow": 1}, Mine: []string{"wow"}}
+
+	t.Run("React", func(t *testing.T) {
+		mockReactionsStorage.EXPECT().Add(ctx, &entity.Reaction{
+			TargetType: entity.ReactionTargetNews,
+			TargetID:   newsID,
+			UserID:     userID,
+			Kind:       "wow",
+		}).Return(true, nil)
+		mockReactionsStorage.EXPECT().GetReactions(ctx, entity.ReactionTargetNews, newsID, userID).Return(reactions, nil)
+
+		result, err := reactionsService.React(ctx, entity.ReactionTargetNews, newsID, "wow")
+		require.NoError(t, err)
+		require.Equal(t, reactions, result)
+	})
+
+	t.Run("Unreact", func(t *testing.T) {
+		mockReactionsStorage.EXPECT().Remove(ctx, gomock.Any()).Return(false, nil)
+		mockReactionsStorage.EXPECT().GetReactions(ctx, entity.ReactionTargetNews, newsID, userID).Return(reactions, nil)
+
+		_, err := reactionsService.Unreact(ctx, entity.ReactionTargetNews, newsID, entity.ReactionLike)
+		require.NoError(t, err)
+	})
+
+	t.Run("Unknown kind", func(t *testing.T) {
+		_, err := reactionsService.React(ctx, entity.ReactionTargetNews, newsID, "angry")
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, httpe.ParseErrors(err).Status())
+	})
+
+	t.Run("Anonymous", func(t *testing.T) {
+		_, err := reactionsService.React(context.Background(), entity.ReactionTargetNews, newsID, entity.ReactionLike)
+		require.Error(t, err)
+		require.Equal(t, http.StatusUnauthorized, httpe.ParseErrors(err).Status())
+	})
+}
+
+func TestService_GetReactions(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockReactionsStorage := mockstorage.NewMockReactionsPsql(ctrl)
+	reactionsService := NewReactionsService(nil, mockReactionsStorage, apiLogger)
+
+	commentID := uuid.New()
+	ctx := context.Background()
+
+	mockReactionsStorage.EXPECT().GetReactions(ctx, entity.ReactionTargetComment, commentID, uuid.Nil).Return(&entity.Reactions{
+		Counts: map[string]int64{entity.ReactionLike: 2},
+		Mine:   []string{},
+	}, nil)
+
+	reactions, err := reactionsService.GetReactions(ctx, entity.ReactionTargetComment, commentID)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), reactions.Counts[entity.ReactionLike])
+	require.Empty(t, reactions.Mine)
+}
@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/tfidf"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// Neighbors less similar than this are noise
+const relatedMinScore = 0.05
+
+// Weights of news fields in similarity
+const (
+	relatedTitleWeight    = 3
+	relatedContentWeight  = 1
+	relatedTagWeight      = 2
+	relatedCategoryWeight = 2
+)
+
+// Related StoragePsql interface
+type RelatedPsql interface {
+	GetCorpus(ctx context.Context) ([]*entity.RelatedDocument, error)
+	GetNewsCategory(ctx context.Context, newsID uuid.UUID) (*uuid.UUID, error)
+	GetNews(ctx context.Context, newsIDs []uuid.UUID) ([]*entity.News, error)
+	GetCategoryNews(ctx context.Context, categoryID *uuid.UUID, newsID uuid.UUID, limit int) ([]*entity.News, error)
+}
+
+// Related StorageRedis interface
+type RelatedRedis interface {
+	GetRelatedCtx(ctx context.Context, newsID uuid.UUID) ([]uuid.UUID, error)
+	SetRelatedCtx(ctx context.Context, newsID uuid.UUID, seconds int, related []uuid.UUID) error
+	MarkStaleCtx(ctx context.Context, newsIDs ...uuid.UUID) error
+	TakeStaleCtx(ctx context.Context, limit int) ([]uuid.UUID, error)
+}
+
+// Related news service. Neighbors of news by TF-IDF similarity are
+// recomputed in background after news changed and kept in redis.
+type RelatedService struct {
+	logger       logger.Logger
+	config       *config.Config
+	storagePsql  RelatedPsql
+	storageRedis RelatedRedis
+}
+
+// Related news service constructor
+func NewRelatedService(config *config.Config, storagePsql RelatedPsql, redis RelatedRedis, logger logger.Logger) *RelatedService {
+	return &RelatedService{
+		config:       config,
+		storagePsql:  storagePsql,
+		storageRedis: redis,
+		logger:       logger,
+	}
+}
+
+// Get published news related to published news, most related first.
+// Latest news of the same category fill up missing neighbors.
+func (r *RelatedService) GetRelated(ctx context.Context, query *entity.RelatedQuery) (*entity.RelatedList, error) {
+	if query.Size == 0 {
+		query.Size = r.config.Related.Size
+	}
+	if err := utils.ValidateStruct(ctx, query); err != nil {
+		return nil, httpe.NewBadRequestError(errors.WithMessage(err, "RelatedService.GetRelated.ValidateStruct"))
+	}
+
+	categoryID, err := r.storagePsql.GetNewsCategory(ctx, query.NewsID)
+	if err != nil {
+		return nil, err
+	}
+
+	relatedIDs, err := r.storageRedis.GetRelatedCtx(ctx, query.NewsID)
+	if err != nil {
+		r.logger.Errorf("RelatedService.GetRelated.GetRelatedCtx: %v", err)
+	}
+	if relatedIDs == nil {
+		if err := r.storageRedis.MarkStaleCtx(ctx, query.NewsID); err != nil {
+			r.logger.Errorf("RelatedService.GetRelated.MarkStaleCtx: %v", err)
+		}
+	}
+	if len(relatedIDs) > query.Size {
+		relatedIDs = relatedIDs[:query.Size]
+	}
+
+	// neighbors may not be published anymore
+	newsList, err := r.storagePsql.GetNews(ctx, relatedIDs)
+	if err != nil {
+		return nil, err
+	}
+	newsByID := make(map[uuid.UUID]*entity.News, len(newsList))
+	for _, news := range newsList {
+		newsByID[news.NewsID] = news
+	}
+
+	related := &entity.RelatedList{NewsID: query.NewsID, News: make([]*entity.News, 0, query.Size)}
+	for _, newsID := range relatedIDs {
+		if news, ok := newsByID[newsID]; ok {
+			related.News = append(related.News, news)
+		}
+	}
+	if len(related.News) == query.Size {
+		return related, nil
+	}
+
+	recent, err := r.storagePsql.GetCategoryNews(ctx, categoryID, query.NewsID, query.Size)
+	if err != nil {
+		return nil, err
+	}
+	for _, news := range recent {
+		if len(related.News) == query.Size {
+			break
+		}
+		if _, ok := newsByID[news.NewsID]; !ok {
+			related.News = append(related.News, news)
+		}
+	}
+	return related, nil
+}
+
+// Queue news for recomputation of its neighbors
+func (r *RelatedService) MarkStale(ctx context.Context, newsID uuid.UUID) error {
+	return r.storageRedis.MarkStaleCtx(ctx, newsID)
+}
+
+// Recompute neighbors of queued news and of their new neighbors, which
+// may list them now. Returns number of news recomputed.
+func (r *RelatedService) Refresh(ctx context.Context) (int, error) {
+	stale, err := r.storageRedis.TakeStaleCtx(ctx, r.config.Related.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	if len(stale) == 0 {
+		return 0, nil
+	}
+
+	corpus, err := r.storagePsql.GetCorpus(ctx)
+	if err != nil {
+		if err := r.storageRedis.MarkStaleCtx(ctx, stale...); err != nil {
+			r.logger.Errorf("RelatedService.Refresh.MarkStaleCtx: %v", err)
+		}
+		return 0, err
+	}
+	index := relatedIndex(corpus)
+
+	refreshed := make(map[uuid.UUID]struct{}, len(stale))
+	var neighbors []uuid.UUID
+	for _, newsID := range stale {
+		related, err := r.refreshNews(ctx, index, newsID)
+		if err != nil {
+			return len(refreshed), err
+		}
+		refreshed[newsID] = struct{}{}
+		neighbors = append(neighbors, related...)
+	}
+	for _, newsID := range neighbors {
+		if _, ok := refreshed[newsID]; ok {
+			continue
+		}
+		if _, err := r.refreshNews(ctx, index, newsID); err != nil {
+			return len(refreshed), err
+		}
+		refreshed[newsID] = struct{}{}
+	}
+	return len(refreshed), nil
+}
+
+func (r *RelatedService) refreshNews(ctx context.Context, index *tfidf.Index, newsID uuid.UUID) ([]uuid.UUID, error) {
+	matches := index.Similar(newsID.String(), r.config.Related.Neighbors, relatedMinScore)
+	related := make([]uuid.UUID, 0, len(matches))
+	for _, match := range matches {
+		relatedID, err := uuid.Parse(match.ID)
+		if err != nil {
+			return nil, errors.Wrap(err, "RelatedService.refreshNews.Parse")
+		}
+		related = append(related, relatedID)
+	}
+
+	if err := r.storageRedis.SetRelatedCtx(ctx, newsID, r.config.Related.CacheTTL, related); err != nil {
+		return nil, err
+	}
+	return related, nil
+}
+
+func relatedIndex(corpus []*entity.RelatedDocument) *tfidf.Index {
+	index := tfidf.NewIndex()
+	for _, document := range corpus {
+		fields := []tfidf.Field{
+			{Text: document.Title, Weight: relatedTitleWeight},
+			{Text: document.Content, Weight: relatedContentWeight},
+		}
+		for _, tag := range strings.Fields(document.Tags) {
+			fields = append(fields, tfidf.Field{Text: "tag:" + tag, Weight: relatedTagWeight, Keyword: true})
+		}
+		if document.CategoryID != nil {
+			fields = append(fields, tfidf.Field{Text: "category:" + document.CategoryID.String(), Weight: relatedCategoryWeight, Keyword: true})
+		}
+		index.Add(document.NewsID.String(), fields...)
+	}
+	index.Build()
+	return index
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"testing"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	mockstorage "github.com/Edbeer/restapi/internal/storage/psql/mock"
+	mockredis "github.com/Edbeer/restapi/internal/storage/redis/mock"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_GetRelated(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Related: config.RelatedConfig{Size: 3}}
+	apiLogger := logger.NewApiLogger(nil)
+	mockRelatedStorage := mockstorage.NewMockRelatedPsql(ctrl)
+	mockRelatedRedis := mockredis.NewMockRelatedRedis(ctrl)
+	relatedService := NewRelatedService(cfg, mockRelatedStorage, mockRelatedRedis, apiLogger)
+
+	ctx := context.Background()
+	categoryID := uuid.New()
+
+	t.Run("Neighbors with category fallback", func(t *testing.T) {
+		newsID, first, unpublished, second, recent := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+		mockRelatedStorage.EXPECT().GetNewsCategory(ctx, newsID).Return(&categoryID, nil)
+		mockRelatedRedis.EXPECT().GetRelatedCtx(ctx, newsID).Return([]uuid.UUID{first, unpublished, second, uuid.New()}, nil)
+		mockRelatedStorage.EXPECT().GetNews(ctx, []uuid.UUID{first, unpublished, second}).
+			Return([]*entity.News{{NewsID: second}, {NewsID: first}}, nil)
+		mockRelatedStorage.EXPECT().GetCategoryNews(ctx, &categoryID, newsID, 3).
+			Return([]*entity.News{{NewsID: first}, {NewsID: recent}}, nil)
+
+		related, err := relatedService.GetRelated(ctx, &entity.RelatedQuery{NewsID: newsID})
+		require.NoError(t, err)
+		require.Len(t, related.News, 3)
+		require.Equal(t, first, related.News[0].NewsID)
+		require.Equal(t, second, related.News[1].NewsID)
+		require.Equal(t, recent, related.News[2].NewsID)
+	})
+
+	t.Run("Not computed", func(t *testing.T) {
+		newsID, recent := uuid.New(), uuid.New()
+		mockRelatedStorage.EXPECT().GetNewsCategory(ctx, newsID).Return(nil, nil)
+		mockRelatedRedis.EXPECT().GetRelatedCtx(ctx, newsID).Return(nil, nil)
+		mockRelatedRedis.EXPECT().MarkStaleCtx(ctx, newsID).Return(nil)
+		mockRelatedStorage.EXPECT().GetNews(ctx, gomock.Len(0)).Return([]*entity.News{}, nil)
+		mockRelatedStorage.EXPECT().GetCategoryNews(ctx, nil, newsID, 2).Return([]*entity.News{{NewsID: recent}}, nil)
+
+		related, err := relatedService.GetRelated(ctx, &entity.RelatedQuery{NewsID: newsID, Size: 2})
+		require.NoError(t, err)
+		require.Len(t, related.News, 1)
+		require.Equal(t, recent, related.News[0].NewsID)
+	})
+
+	t.Run("Missing news", func(t *testing.T) {
+		newsID := uuid.New()
+		mockRelatedStorage.EXPECT().GetNewsCategory(ctx, newsID).Return(nil, errors.Wrap(sql.ErrNoRows, "GetNewsCategory"))
+
+		_, err := relatedService.GetRelated(ctx, &entity.RelatedQuery{NewsID: newsID})
+		require.Error(t, err)
+		require.Equal(t, http.StatusNotFound, httpe.ParseErrors(err).Status())
+	})
+
+	t.Run("Invalid size", func(t *testing.T) {
+		_, err := relatedService.GetRelated(ctx, &entity.RelatedQuery{NewsID: uuid.New(), Size: 51})
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, httpe.ParseErrors(err).Status())
+	})
+}
+
+func TestService_RefreshRelated(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Related: config.RelatedConfig{Neighbors: 10, BatchSize: 100, CacheTTL: 60}}
+	apiLogger := logger.NewApiLogger(nil)
+	mockRelatedStorage := mockstorage.NewMockRelatedPsql(ctrl)
+	mockRelatedRedis := mockredis.NewMockRelatedRedis(ctrl)
+	relatedService := NewRelatedService(cfg, mockRelatedStorage, mockRelatedRedis, apiLogger)
+
+	ctx := context.Background()
+	rain, storm, chess := uuid.New(), uuid.New(), uuid.New()
+	categoryID := uuid.New()
+
+	mockRelatedRedis.EXPECT().TakeStaleCtx(ctx, 100).Return([]uuid.UUID{rain}, nil)
+	mockRelatedStorage.EXPECT().GetCorpus(ctx).Return([]*entity.RelatedDocument{
+		{NewsID: rain, Title: "Heavy rain floods Berlin", Content: "Streets of Berlin are flooded", CategoryID: &categoryID, Tags: "weather"},
+		{NewsID: storm, Title: "Storm and rain expected", Content: "Weather service warns Berlin", CategoryID: &categoryID, Tags: "weather"},
+		{NewsID: chess, Title: "Chess championship opens", Content: "Players arrive in Madrid"},
+	}, nil)
+	mockRelatedRedis.EXPECT().SetRelatedCtx(ctx, rain, 60, []uuid.UUID{storm}).Return(nil)
+	mockRelatedRedis.EXPECT().SetRelatedCtx(ctx, storm, 60, []uuid.UUID{rain}).Return(nil)
+
+	refreshed, err := relatedService.Refresh(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, refreshed)
+
+	t.Run("Nothing stale", func(t *testing.T) {
+		mockRelatedRedis.EXPECT().TakeStaleCtx(ctx, 100).Return([]uuid.UUID{}, nil)
+
+		refreshed, err := relatedService.Refresh(ctx)
+		require.NoError(t, err)
+		require.Zero(t, refreshed)
+	})
+
+	t.Run("Corpus failed", func(t *testing.T) {
+		mockRelatedRedis.EXPECT().TakeStaleCtx(ctx, 100).Return([]uuid.UUID{chess}, nil)
+		mockRelatedStorage.EXPECT().GetCorpus(ctx).Return(nil, errors.New("connection refused"))
+		mockRelatedRedis.EXPECT().MarkStaleCtx(ctx, chess).Return(nil)
+
+		_, err := relatedService.Refresh(ctx)
+		require.Error(t, err)
+	})
+}
@@ -3,6 +3,8 @@ package service
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/Edbeer/restapi/config"
 	"github.com/Edbeer/restapi/internal/entity"
@@ -17,9 +19,9 @@ import (
 type Auth interface {
 	Register(ctx context.Context, user *entity.User) (*entity.UserWithToken, error)
 	Update(ctx context.Context, user *entity.User) (*entity.User, error)
-	Delete(ctx context.Context, userID uuid.UUID) error
+	Delete(ctx context.Context, userID uuid.UUID, version int) error
 	GetUserByID(ctx context.Context, userID uuid.UUID) (*entity.User, error)
-	FindUsersByName(ctx context.Context, name string, pq *utils.PaginationQuery) (*entity.UsersList, error)
+	FindUsersByName(ctx context.Context, search *entity.UserSearchQuery, pq *utils.PaginationQuery) (*entity.UsersList, error)
 	GetUsers(ctx context.Context, pq *utils.PaginationQuery) (*entity.UsersList, error)
 	Login(ctx context.Context, user *entity.User) (*entity.UserWithToken, error)
 }
@@ -30,8 +32,20 @@ type News interface {
 	Update(ctx context.Context, news *entity.News) (*entity.News, error)
 	GetNews(ctx context.Context, pq *utils.PaginationQuery) (*entity.NewsList, error)
 	GetNewsByID(ctx context.Context, newsID uuid.UUID) (*entity.NewsBase, error)
-	SearchNews(ctx context.Context, title string, pq *utils.PaginationQuery) (*entity.NewsList, error)
-	Delete(ctx context.Context, newsID uuid.UUID) error
+	GetNewsBySlug(ctx context.Context, slug string) (*entity.NewsBase, error)
+	GetNewsByAuthor(ctx context.Context, authorID uuid.UUID, pq *utils.PaginationQuery) (*entity.NewsList, error)
+	SearchNews(ctx context.Context, search *entity.NewsSearchQuery, pq *utils.PaginationQuery) (*entity.NewsSearchList, error)
+	IncludeRelations(ctx context.Context, newsList []*entity.News, include []string) error
+	PublishScheduled(ctx context.Context) (int, error)
+	Delete(ctx context.Context, newsID uuid.UUID, version int) error
+	GetRevisions(ctx context.Context, newsID uuid.UUID, pq *utils.PaginationQuery) (*entity.NewsRevisionsList, error)
+	GetRevision(ctx context.Context, newsID uuid.UUID, revision int) (*entity.NewsRevision, error)
+	DiffRevisions(ctx context.Context, newsID uuid.UUID, from, to int) (*entity.NewsRevisionDiff, error)
+	RollbackRevision(ctx context.Context, newsID uuid.UUID, revision int) (*entity.News, error)
+	SubmitForReview(ctx context.Context, newsID uuid.UUID, comment string) (*entity.News, error)
+	ReviewNews(ctx context.Context, review *entity.NewsReview) (*entity.News, error)
+	GetReviewQueue(ctx context.Context, pq *utils.PaginationQuery) (*entity.NewsList, error)
+	GetReviews(ctx context.Context, newsID uuid.UUID) ([]*entity.NewsReview, error)
 }
 
 // Comments Service interface
@@ -40,7 +54,7 @@ type Comments interface {
 	Update(ctx context.Context, comments *entity.Comment) (*entity.Comment, error)
 	GetAllByNewsID(ctx context.Context, newsID uuid.UUID, pq *utils.PaginationQuery) (*entity.CommentsList, error)
 	GetByID(ctx context.Context, commentID uuid.UUID) (*entity.CommentBase, error)
-	Delete(ctx context.Context, commentID uuid.UUID) error
+	Delete(ctx context.Context, commentID uuid.UUID, version int) error
 }
 
 // Session service interface
@@ -50,11 +64,142 @@ type Session interface {
 	DeleteSessionByID(ctx context.Context, sessionID string) error
 }
 
+// Suggest service interface
+type Suggest interface {
+	Suggest(ctx context.Context, prefix string, kind string, limit int) (*entity.SuggestList, error)
+	Rebuild(ctx context.Context) error
+}
+
+// Tags service interface
+type Tags interface {
+	GetTags(ctx context.Context, pq *utils.PaginationQuery) (*entity.TagsList, error)
+	GetNewsByTag(ctx context.Context, slug string, pq *utils.PaginationQuery) (*entity.NewsList, error)
+	RenameTag(ctx context.Context, slug string, name string) (*entity.Tag, error)
+	MergeTags(ctx context.Context, slug string, merge *entity.TagMerge) (*entity.Tag, error)
+}
+
+// Categories service interface
+type Categories interface {
+	Create(ctx context.Context, category *entity.Category) (*entity.Category, error)
+	Update(ctx context.Context, slug string, category *entity.Category) (*entity.Category, error)
+	Delete(ctx context.Context, slug string) error
+	GetBySlug(ctx context.Context, slug string) (*entity.Category, error)
+	GetTree(ctx context.Context) ([]*entity.Category, error)
+	GetNews(ctx context.Context, slug string, descendants bool, pq *utils.PaginationQuery) (*entity.NewsList, error)
+}
+
+// Sitemaps service interface
+type Sitemaps interface {
+	GetIndex(ctx context.Context) (*entity.SitemapDocument, error)
+	GetPage(ctx context.Context, page int) (*entity.SitemapDocument, error)
+	GetLatest(ctx context.Context) (*entity.SitemapDocument, error)
+	InvalidateNews(ctx context.Context, newsID uuid.UUID, publishAt *time.Time) error
+}
+
+// Pages service interface
+type Pages interface {
+	RenderArticle(ctx context.Context, slug string) (*entity.PageDocument, error)
+	RenderList(ctx context.Context, page int) (*entity.PageDocument, error)
+	RenderCategory(ctx context.Context, slug string, page int) (*entity.PageDocument, error)
+}
+
+// Feeds service interface
+type Feeds interface {
+	GetFeed(ctx context.Context, query *entity.FeedQuery) (*entity.FeedDocument, error)
+}
+
+// Views service interface
+type Views interface {
+	GetTrending(ctx context.Context, query *entity.TrendingQuery) (*entity.TrendingList, error)
+	FlushViews(ctx context.Context) (int, error)
+}
+
+// Reactions service interface
+type Reactions interface {
+	React(ctx context.Context, targetType string, targetID uuid.UUID, kind string) (*entity.Reactions, error)
+	Unreact(ctx context.Context, targetType string, targetID uuid.UUID, kind string) (*entity.Reactions, error)
+	GetReactions(ctx context.Context, targetType string, targetID uuid.UUID) (*entity.Reactions, error)
+	Reconcile(ctx context.Context) (int64, error)
+}
+
+// Bookmarks service interface
+type Bookmarks interface {
+	AddBookmark(ctx context.Context, newsID uuid.UUID) error
+	RemoveBookmark(ctx context.Context, newsID uuid.UUID) error
+	GetBookmarks(ctx context.Context, pq *utils.PaginationQuery) (*entity.NewsList, error)
+	CreateList(ctx context.Context, list *entity.ReadingList) (*entity.ReadingList, error)
+	UpdateList(ctx context.Context, list *entity.ReadingListUpdate) (*entity.ReadingList, error)
+	DeleteList(ctx context.Context, listID uuid.UUID) error
+	GetLists(ctx context.Context) ([]*entity.ReadingList, error)
+	GetList(ctx context.Context, listID uuid.UUID, pq *utils.PaginationQuery) (*entity.ReadingListNews, error)
+	AddListItem(ctx context.Context, listID uuid.UUID, newsID uuid.UUID) error
+	RemoveListItem(ctx context.Context, listID uuid.UUID, newsID uuid.UUID) error
+	ReorderList(ctx context.Context, listID uuid.UUID, order *entity.ReadingListOrder) error
+}
+
+// Related service interface
+type Related interface {
+	GetRelated(ctx context.Context, query *entity.RelatedQuery) (*entity.RelatedList, error)
+	MarkStale(ctx context.Context, newsID uuid.UUID) error
+	Refresh(ctx context.Context) (int, error)
+}
+
+// Notifications service interface
+type Notifications interface {
+	Notify(ctx context.Context, notification *entity.Notification) error
+	GetNotifications(ctx context.Context, unreadOnly bool, pq *utils.PaginationQuery) (*entity.NotificationsList, error)
+	MarkRead(ctx context.Context, notificationID uuid.UUID) error
+	MarkAllRead(ctx context.Context) (int64, error)
+}
+
+// Translations service interface
+type Translations interface {
+	GetTranslations(ctx context.Context, newsID uuid.UUID) ([]*entity.NewsTranslation, error)
+	Create(ctx context.Context, translation *entity.NewsTranslation) (*entity.NewsTranslation, error)
+	Update(ctx context.Context, translation *entity.NewsTranslation) (*entity.NewsTranslation, error)
+	Delete(ctx context.Context, newsID uuid.UUID, locale string) error
+}
+
+// Contributors service interface
+type Contributors interface {
+	GetContributors(ctx context.Context, newsID uuid.UUID) ([]*entity.NewsContributor, error)
+	Invite(ctx context.Context, contributor *entity.NewsContributor) (*entity.NewsContributor, error)
+	Accept(ctx context.Context, newsID uuid.UUID) (*entity.NewsContributor, error)
+	Remove(ctx context.Context, newsID uuid.UUID, userID uuid.UUID) error
+	GetInvitations(ctx context.Context) ([]*entity.NewsContributor, error)
+}
+
+// Transfer service interface
+type Transfer interface {
+	Export(ctx context.Context, format string, withComments bool, w io.Writer) error
+	Import(ctx context.Context, format string, r io.Reader, dryRun bool) (*entity.ImportReport, error)
+}
+
+// WordPress service interface
+type WordPress interface {
+	Import(ctx context.Context, r io.Reader) (*entity.WordPressReport, error)
+}
+
 type Services struct {
-	Auth     *AuthService
-	News     *NewsService
-	Comments *CommentsService
-	Session  *SessionService
+	Auth          *AuthService
+	News          *NewsService
+	Comments      *CommentsService
+	Session       *SessionService
+	Suggest       *SuggestService
+	Tags          *TagsService
+	Categories    *CategoriesService
+	Feeds         *FeedsService
+	Sitemaps      *SitemapsService
+	Pages         *PagesService
+	Views         *ViewsService
+	Reactions     *ReactionsService
+	Bookmarks     *BookmarksService
+	Related       *RelatedService
+	Translations  *TranslationsService
+	Notifications *NotificationsService
+	Contributors  *ContributorsService
+	Transfer      *TransferService
+	WordPress     *WordPressService
 }
 
 type Deps struct {
@@ -65,14 +210,44 @@ type Deps struct {
 }
 
 func NewService(deps Deps) *Services {
-	authService := NewAuthService(deps.Config, deps.PsqlStorage.Auth, deps.RedisStorage.Auth, deps.Logger)
-	newsService := NewNewsService(deps.Config, deps.PsqlStorage.News, deps.RedisStorage.News, deps.Logger)
+	authService := NewAuthService(deps.Config, deps.PsqlStorage.Auth, deps.RedisStorage.Auth, deps.RedisStorage.Suggest, deps.Logger)
+	sitemapsService := NewSitemapsService(deps.Config, deps.PsqlStorage.Sitemaps, deps.RedisStorage.Sitemaps, deps.Logger)
+	relatedService := NewRelatedService(deps.Config, deps.PsqlStorage.Related, deps.RedisStorage.Related, deps.Logger)
+	notificationsService := NewNotificationsService(deps.Config, deps.PsqlStorage.Notifications, deps.Logger)
+	newsService := NewNewsService(deps.Config, deps.PsqlStorage.News, deps.PsqlStorage.Revisions, deps.PsqlStorage.Categories, deps.RedisStorage.News, deps.RedisStorage.Suggest, deps.RedisStorage.Feeds, deps.RedisStorage.Views, sitemapsService, relatedService, deps.PsqlStorage.Reviews, notificationsService, deps.Logger)
 	commentsService := NewCommentsService(deps.Config, deps.PsqlStorage.Comments, deps.Logger)
 	sessionService := NewSessionService(deps.Config, deps.RedisStorage.Session, deps.Logger)
+	suggestService := NewSuggestService(deps.Config, deps.PsqlStorage.Suggest, deps.RedisStorage.Suggest, deps.Logger)
+	tagsService := NewTagsService(deps.Config, deps.PsqlStorage.Tags, deps.Logger)
+	categoriesService := NewCategoriesService(deps.Config, deps.PsqlStorage.Categories, deps.Logger)
+	pagesService := NewPagesService(deps.Config, newsService, commentsService, categoriesService, deps.RedisStorage.Pages, deps.Logger)
+	viewsService := NewViewsService(deps.Config, deps.PsqlStorage.Views, deps.RedisStorage.Views, deps.RedisStorage.News, deps.Logger)
+	reactionsService := NewReactionsService(deps.Config, deps.PsqlStorage.Reactions, deps.Logger)
+	bookmarksService := NewBookmarksService(deps.Config, deps.PsqlStorage.Bookmarks, deps.Logger)
+	translationsService := NewTranslationsService(deps.Config, deps.PsqlStorage.Translations, deps.PsqlStorage.Contributors, deps.RedisStorage.News, deps.Logger)
+	contributorsService := NewContributorsService(deps.Config, deps.PsqlStorage.Contributors, deps.RedisStorage.News, notificationsService, deps.Logger)
+	transferService := NewTransferService(deps.Config, deps.PsqlStorage.Transfer, deps.RedisStorage.Feeds, sitemapsService, relatedService, deps.Logger)
+	wordPressService := NewWordPressService(deps.Config, deps.PsqlStorage.WordPress, deps.RedisStorage.Feeds, sitemapsService, relatedService, deps.Logger)
+	feedsService := NewFeedsService(deps.Config, newsService, categoriesService, tagsService, authService, deps.RedisStorage.Feeds, deps.Logger)
 	return &Services{
-		Auth:     authService,
-		News:     newsService,
-		Comments: commentsService,
-		Session:  sessionService,
+		Auth:          authService,
+		News:          newsService,
+		Comments:      commentsService,
+		Session:       sessionService,
+		Suggest:       suggestService,
+		Tags:          tagsService,
+		Categories:    categoriesService,
+		Feeds:         feedsService,
+		Sitemaps:      sitemapsService,
+		Pages:         pagesService,
+		Views:         viewsService,
+		Reactions:     reactionsService,
+		Bookmarks:     bookmarksService,
+		Related:       relatedService,
+		Translations:  translationsService,
+		Notifications: notificationsService,
+		Contributors:  contributorsService,
+		Transfer:      transferService,
+		WordPress:     wordPressService,
 	}
 }
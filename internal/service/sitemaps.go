@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/sitemap"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// ISO 639 codes of news text search configurations, used as news locales
+var languageLocales = map[string]string{
+	"arabic": "ar", "danish": "da", "dutch": "nl", "english": "en", "finnish": "fi",
+	"french": "fr", "german": "de", "greek": "el", "hungarian": "hu", "indonesian": "id",
+	"irish": "ga", "italian": "it", "lithuanian": "lt", "nepali": "ne", "norwegian": "no",
+	"portuguese": "pt", "romanian": "ro", "russian": "ru", "spanish": "es", "swedish": "sv",
+	"tamil": "ta", "turkish": "tr",
+}
+
+// Sitemaps StoragePsql interface
+type SitemapsPsql interface {
+	GetPages(ctx context.Context, size int) ([]*entity.SitemapPage, error)
+	GetNews(ctx context.Context, page int, size int) ([]*entity.SitemapNews, error)
+	GetNewsPosition(ctx context.Context, publishAt time.Time, newsID uuid.UUID) (int, error)
+	GetLatestNews(ctx context.Context, since time.Time, limit int) ([]*entity.SitemapNews, error)
+}
+
+// Sitemaps StorageRedis interface
+type SitemapsRedis interface {
+	GetIndexCtx(ctx context.Context) (*entity.SitemapDocument, error)
+	SetIndexCtx(ctx context.Context, seconds int, sitemap *entity.SitemapDocument) error
+	GetPageCtx(ctx context.Context, page int) (*entity.SitemapDocument, error)
+	SetPageCtx(ctx context.Context, page int, seconds int, sitemap *entity.SitemapDocument) error
+	GetLatestCtx(ctx context.Context) (*entity.SitemapDocument, error)
+	SetLatestCtx(ctx context.Context, seconds int, sitemap *entity.SitemapDocument) error
+	InvalidateFromPageCtx(ctx context.Context, page int) error
+}
+
+// Sitemaps service
+type SitemapsService struct {
+	logger       logger.Logger
+	config       *config.Config
+	storagePsql  SitemapsPsql
+	storageRedis SitemapsRedis
+}
+
+// Sitemaps service constructor
+func NewSitemapsService(config *config.Config, storagePsql SitemapsPsql, redis SitemapsRedis, logger logger.Logger) *SitemapsService {
+	return &SitemapsService{
+		config:       config,
+		storagePsql:  storagePsql,
+		storageRedis: redis,
+		logger:       logger,
+	}
+}
+
+// Get sitemap index of news pages and latest news sitemap
+func (s *SitemapsService) GetIndex(ctx context.Context) (*entity.SitemapDocument, error) {
+	cached, err := s.storageRedis.GetIndexCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if cached != nil {
+		return cached, nil
+	}
+
+	pages, err := s.storagePsql.GetPages(ctx, s.pageSize())
+	if err != nil {
+		return nil, err
+	}
+
+	document := &entity.SitemapDocument{}
+	sitemaps := make([]*sitemap.Sitemap, 0, len(pages)+1)
+	for _, page := range pages {
+		sitemaps = append(sitemaps, &sitemap.Sitemap{
+			Loc:     fmt.Sprintf("%s/sitemaps/news/%d.xml", s.config.Sitemaps.BaseURL, page.Page+1),
+			LastMod: page.LastMod,
+		})
+		if page.LastMod.After(document.LastModified) {
+			document.LastModified = page.LastMod
+		}
+	}
+	sitemaps = append(sitemaps, &sitemap.Sitemap{Loc: s.config.Sitemaps.BaseURL + "/sitemaps/latest.xml"})
+
+	if document.Body, err = sitemap.Index(sitemaps); err != nil {
+		return nil, httpe.NewInternalServerError(errors.WithMessage(err, "SitemapsService.GetIndex.Index"))
+	}
+
+	if err := s.storageRedis.SetIndexCtx(ctx, s.config.Sitemaps.CacheTTL, document); err != nil {
+		s.logger.Errorf("SitemapsService.GetIndex.SetIndexCtx: %v", err)
+	}
+	return document, nil
+}
+
+// Get sitemap page of published news, pages are numbered from one
+func (s *SitemapsService) GetPage(ctx context.Context, page int) (*entity.SitemapDocument, error) {
+	if page < 1 {
+		return nil, httpe.NewNotFoundError(errors.Errorf("sitemap page %d not found", page))
+	}
+	page--
+
+	cached, err := s.storageRedis.GetPageCtx(ctx, page)
+	if err != nil {
+		return nil, err
+	}
+	if cached != nil {
+		return cached, nil
+	}
+
+	newsList, err := s.storagePsql.GetNews(ctx, page, s.pageSize())
+	if err != nil {
+		return nil, err
+	}
+	if len(newsList) == 0 && page > 0 {
+		return nil, httpe.NewNotFoundError(errors.Errorf("sitemap page %d not found", page+1))
+	}
+
+	document := &entity.SitemapDocument{}
+	urls := make([]*sitemap.URL, 0, len(newsList))
+	for _, news := range newsList {
+		urls = append(urls, &sitemap.URL{
+			Loc:     s.newsURL(news),
+			LastMod: news.UpdatedAt,
+		})
+		if news.UpdatedAt.After(document.LastModified) {
+			document.LastModified = news.UpdatedAt
+		}
+	}
+
+	if document.Body, err = sitemap.URLSet(urls); err != nil {
+		return nil, httpe.NewInternalServerError(errors.WithMessage(err, "SitemapsService.GetPage.URLSet"))
+	}
+
+	if err := s.storageRedis.SetPageCtx(ctx, page, s.config.Sitemaps.CacheTTL, document); err != nil {
+		s.logger.Errorf("SitemapsService.GetPage.SetPageCtx: %v", err)
+	}
+	return document, nil
+}
+
+// Get news sitemap of news published within the latest window
+func (s *SitemapsService) GetLatest(ctx context.Context) (*entity.SitemapDocument, error) {
+	cached, err := s.storageRedis.GetLatestCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if cached != nil {
+		return cached, nil
+	}
+
+	since := time.Now().Add(-time.Duration(s.config.Sitemaps.LatestWindow) * time.Hour)
+	newsList, err := s.storagePsql.GetLatestNews(ctx, since, sitemap.MaxNewsURLs)
+	if err != nil {
+		return nil, err
+	}
+
+	document := &entity.SitemapDocument{}
+	urls := make([]*sitemap.URL, 0, len(newsList))
+	for _, news := range newsList {
+		language, ok := languageLocales[news.Language]
+		if !ok {
+			language = s.config.Sitemaps.Language
+		}
+		urls = append(urls, &sitemap.URL{
+			Loc: s.newsURL(news),
+			News: &sitemap.News{
+				PublicationName: s.config.Sitemaps.PublicationName,
+				Language:        language,
+				PublicationDate: news.PublishAt,
+				Title:           news.Title,
+			},
+		})
+		if news.UpdatedAt.After(document.LastModified) {
+			document.LastModified = news.UpdatedAt
+		}
+	}
+
+	if document.Body, err = sitemap.URLSet(urls); err != nil {
+		return nil, httpe.NewInternalServerError(errors.WithMessage(err, "SitemapsService.GetLatest.URLSet"))
+	}
+
+	// latest news drop out of the window with time, so cache them briefly
+	ttl := s.config.Sitemaps.CacheTTL
+	if ttl > 3600 {
+		ttl = 3600
+	}
+	if err := s.storageRedis.SetLatestCtx(ctx, ttl, document); err != nil {
+		s.logger.Errorf("SitemapsService.GetLatest.SetLatestCtx: %v", err)
+	}
+	return document, nil
+}
+
+// Drop cached sitemaps which may list the news, pages before it are kept.
+// News without publication time drops all pages.
+func (s *SitemapsService) InvalidateNews(ctx context.Context, newsID uuid.UUID, publishAt *time.Time) error {
+	page := 0
+	if publishAt != nil {
+		position, err := s.storagePsql.GetNewsPosition(ctx, *publishAt, newsID)
+		if err != nil {
+			return err
+		}
+		page = position / s.pageSize()
+	}
+	return s.storageRedis.InvalidateFromPageCtx(ctx, page)
+}
+
+func (s *SitemapsService) newsURL(news *entity.SitemapNews) string {
+	return fmt.Sprintf("%s/news/%s", s.config.Sitemaps.BaseURL, news.Slug)
+}
+
+func (s *SitemapsService) pageSize() int {
+	size := s.config.Sitemaps.PageSize
+	if size <= 0 || size > sitemap.MaxURLs {
+		return sitemap.MaxURLs
+	}
+	return size
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	mockstorage "github.com/Edbeer/restapi/internal/storage/psql/mock"
+	mockredis "github.com/Edbeer/restapi/internal/storage/redis/mock"
+	"github.com/Edbeer/restapi/pkg/logger"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_GetSitemapIndex(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Sitemaps: config.SitemapsConfig{BaseURL: "https://example.com", PageSize: 2, CacheTTL: 60}}
+	apiLogger := logger.NewApiLogger(nil)
+	mockSitemapsStorage := mockstorage.NewMockSitemapsPsql(ctrl)
+	mockSitemapsRedis := mockredis.NewMockSitemapsRedis(ctrl)
+	sitemapsService := NewSitemapsService(cfg, mockSitemapsStorage, mockSitemapsRedis, apiLogger)
+
+	ctx := context.Background()
+	lastmod := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	mockSitemapsRedis.EXPECT().GetIndexCtx(ctx).Return(nil, nil)
+	mockSitemapsStorage.EXPECT().GetPages(ctx, 2).Return([]*entity.SitemapPage{
+		{Page: 0, LastMod: lastmod.Add(-time.Hour)},
+		{Page: 1, LastMod: lastmod},
+	}, nil)
+	mockSitemapsRedis.EXPECT().SetIndexCtx(ctx, 60, gomock.Any()).Return(nil)
+
+	index, err := sitemapsService.GetIndex(ctx)
+	require.NoError(t, err)
+	require.Equal(t, lastmod, index.LastModified)
+	require.Contains(t, string(index.Body), "<loc>https://example.com/sitemaps/news/1.xml</loc>")
+	require.Contains(t, string(index.Body), "<loc>https://example.com/sitemaps/news/2.xml</loc><lastmod>2026-10-19T12:00:00Z</lastmod>")
+	require.Contains(t, string(index.Body), "<loc>https://example.com/sitemaps/latest.xml</loc>")
+}
+
+func TestService_GetSitemapPage(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Sitemaps: config.SitemapsConfig{BaseURL: "https://example.com", PageSize: 2, CacheTTL: 60}}
+	apiLogger := logger.NewApiLogger(nil)
+	mockSitemapsStorage := mockstorage.NewMockSitemapsPsql(ctrl)
+	mockSitemapsRedis := mockredis.NewMockSitemapsRedis(ctrl)
+	sitemapsService := NewSitemapsService(cfg, mockSitemapsStorage, mockSitemapsRedis, apiLogger)
+
+	ctx := context.Background()
+
+	t.Run("Page", func(t *testing.T) {
+		mockSitemapsRedis.EXPECT().GetPageCtx(ctx, 1).Return(nil, nil)
+		mockSitemapsStorage.EXPECT().GetNews(ctx, 1, 2).Return([]*entity.SitemapNews{
+			{NewsID: uuid.New(), Slug: "first", UpdatedAt: time.Now()},
+		}, nil)
+		mockSitemapsRedis.EXPECT().SetPageCtx(ctx, 1, 60, gomock.Any()).Return(nil)
+
+		page, err := sitemapsService.GetPage(ctx, 2)
+		require.NoError(t, err)
+		require.Contains(t, string(page.Body), "<loc>https://example.com/news/first</loc>")
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockSitemapsRedis.EXPECT().GetPageCtx(ctx, 4).Return(nil, nil)
+		mockSitemapsStorage.EXPECT().GetNews(ctx, 4, 2).Return([]*entity.SitemapNews{}, nil)
+
+		_, err := sitemapsService.GetPage(ctx, 5)
+		require.Error(t, err)
+
+		_, err = sitemapsService.GetPage(ctx, 0)
+		require.Error(t, err)
+	})
+}
+
+func TestService_InvalidateSitemapNews(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Sitemaps: config.SitemapsConfig{PageSize: 100}}
+	apiLogger := logger.NewApiLogger(nil)
+	mockSitemapsStorage := mockstorage.NewMockSitemapsPsql(ctrl)
+	mockSitemapsRedis := mockredis.NewMockSitemapsRedis(ctrl)
+	sitemapsService := NewSitemapsService(cfg, mockSitemapsStorage, mockSitemapsRedis, apiLogger)
+
+	ctx := context.Background()
+	newsID := uuid.New()
+	publishAt := time.Now()
+
+	mockSitemapsStorage.EXPECT().GetNewsPosition(ctx, publishAt, newsID).Return(250, nil)
+	mockSitemapsRedis.EXPECT().InvalidateFromPageCtx(ctx, 2).Return(nil)
+	require.NoError(t, sitemapsService.InvalidateNews(ctx, newsID, &publishAt))
+
+	mockSitemapsRedis.EXPECT().InvalidateFromPageCtx(ctx, 0).Return(nil)
+	require.NoError(t, sitemapsService.InvalidateNews(ctx, newsID, nil))
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultSuggestLimit = 10
+	maxSuggestLimit     = 25
+	suggestRebuildBatch = 500
+)
+
+// Suggest StoragePsql interface
+type SuggestPsql interface {
+	GetNewsSuggestions(ctx context.Context, after uuid.UUID, limit int) ([]*entity.Suggestion, error)
+	GetAuthorSuggestions(ctx context.Context, after uuid.UUID, limit int) ([]*entity.Suggestion, error)
+}
+
+// Suggest StorageRedis interface
+type SuggestRedis interface {
+	AddSuggestionCtx(ctx context.Context, kind string, suggestion *entity.Suggestion) error
+	DeleteSuggestionCtx(ctx context.Context, kind string, id uuid.UUID) error
+	IncrSuggestionCtx(ctx context.Context, kind string, id uuid.UUID, incr float64) error
+	GetSuggestionsCtx(ctx context.Context, kind string, prefix string, limit int) ([]*entity.Suggestion, error)
+	ClearSuggestionsCtx(ctx context.Context, kind string) error
+}
+
+// Suggest service
+type SuggestService struct {
+	logger       logger.Logger
+	config       *config.Config
+	storagePsql  SuggestPsql
+	storageRedis SuggestRedis
+}
+
+// Suggest service constructor
+func NewSuggestService(config *config.Config, storagePsql SuggestPsql, storageRedis SuggestRedis, logger logger.Logger) *SuggestService {
+	return &SuggestService{
+		config:       config,
+		storagePsql:  storagePsql,
+		storageRedis: storageRedis,
+		logger:       logger,
+	}
+}
+
+// Get top news title and author name completions for prefix
+func (s *SuggestService) Suggest(ctx context.Context, prefix string, kind string, limit int) (*entity.SuggestList, error) {
+	if limit <= 0 {
+		limit = defaultSuggestLimit
+	}
+	if limit > maxSuggestLimit {
+		limit = maxSuggestLimit
+	}
+
+	list := &entity.SuggestList{
+		Prefix:  prefix,
+		News:    make([]*entity.Suggestion, 0),
+		Authors: make([]*entity.Suggestion, 0),
+	}
+
+	var err error
+	switch kind {
+	case "":
+		if list.News, err = s.storageRedis.GetSuggestionsCtx(ctx, entity.SuggestNews, prefix, limit); err != nil {
+			return nil, err
+		}
+		if list.Authors, err = s.storageRedis.GetSuggestionsCtx(ctx, entity.SuggestAuthors, prefix, limit); err != nil {
+			return nil, err
+		}
+	case entity.SuggestNews:
+		if list.News, err = s.storageRedis.GetSuggestionsCtx(ctx, entity.SuggestNews, prefix, limit); err != nil {
+			return nil, err
+		}
+	case entity.SuggestAuthors:
+		if list.Authors, err = s.storageRedis.GetSuggestionsCtx(ctx, entity.SuggestAuthors, prefix, limit); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, httpe.NewBadRequestError("unknown suggestion type: " + kind)
+	}
+
+	return list, nil
+}
+
+// Rebuild suggestion index from postgres
+func (s *SuggestService) Rebuild(ctx context.Context) error {
+	if err := s.rebuildKind(ctx, entity.SuggestNews, s.storagePsql.GetNewsSuggestions); err != nil {
+		return errors.Wrap(err, "SuggestService.Rebuild.News")
+	}
+	if err := s.rebuildKind(ctx, entity.SuggestAuthors, s.storagePsql.GetAuthorSuggestions); err != nil {
+		return errors.Wrap(err, "SuggestService.Rebuild.Authors")
+	}
+	return nil
+}
+
+func (s *SuggestService) rebuildKind(ctx context.Context, kind string,
+	next func(ctx context.Context, after uuid.UUID, limit int) ([]*entity.Suggestion, error)) error {
+
+	if err := s.storageRedis.ClearSuggestionsCtx(ctx, kind); err != nil {
+		return err
+	}
+
+	after := uuid.Nil
+	for {
+		batch, err := next(ctx, after, suggestRebuildBatch)
+		if err != nil {
+			return err
+		}
+		for _, suggestion := range batch {
+			if err := s.storageRedis.AddSuggestionCtx(ctx, kind, suggestion); err != nil {
+				return err
+			}
+			after = suggestion.ID
+		}
+		if len(batch) < suggestRebuildBatch {
+			break
+		}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	mockstorage "github.com/Edbeer/restapi/internal/storage/psql/mock"
+	mockredis "github.com/Edbeer/restapi/internal/storage/redis/mock"
+	"github.com/Edbeer/restapi/pkg/logger"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Suggest(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockSuggestRedis := mockredis.NewMockSuggestRedis(ctrl)
+	suggestService := NewSuggestService(nil, nil, mockSuggestRedis, apiLogger)
+
+	ctx := context.Background()
+
+	news := []*entity.Suggestion{{ID: uuid.New(), Text: "Golang news"}}
+	authors := []*entity.Suggestion{{ID: uuid.New(), Text: "Gopher Golangov"}}
+
+	mockSuggestRedis.EXPECT().GetSuggestionsCtx(ctx, entity.SuggestNews, "go", defaultSuggestLimit).Return(news, nil)
+	mockSuggestRedis.EXPECT().GetSuggestionsCtx(ctx, entity.SuggestAuthors, "go", defaultSuggestLimit).Return(authors, nil)
+
+	suggestions, err := suggestService.Suggest(ctx, "go", "", 0)
+	require.NoError(t, err)
+	require.Equal(t, news, suggestions.News)
+	require.Equal(t, authors, suggestions.Authors)
+
+	mockSuggestRedis.EXPECT().GetSuggestionsCtx(ctx, entity.SuggestNews, "go", maxSuggestLimit).Return(news, nil)
+
+	suggestions, err = suggestService.Suggest(ctx, "go", entity.SuggestNews, 100)
+	require.NoError(t, err)
+	require.Len(t, suggestions.Authors, 0)
+
+	_, err = suggestService.Suggest(ctx, "go", "comments", 10)
+	require.Error(t, err)
+}
+
+func TestService_RebuildSuggestions(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockSuggestStorage := mockstorage.NewMockSuggestPsql(ctrl)
+	mockSuggestRedis := mockredis.NewMockSuggestRedis(ctrl)
+	suggestService := NewSuggestService(nil, mockSuggestStorage, mockSuggestRedis, apiLogger)
+
+	ctx := context.Background()
+
+	news := &entity.Suggestion{ID: uuid.New(), Text: "Golang news"}
+	author := &entity.Suggestion{ID: uuid.New(), Text: "Pavel Volkov", Score: 1}
+
+	mockSuggestRedis.EXPECT().ClearSuggestionsCtx(ctx, entity.SuggestNews).Return(nil)
+	mockSuggestStorage.EXPECT().GetNewsSuggestions(ctx, uuid.Nil, suggestRebuildBatch).Return([]*entity.Suggestion{news}, nil)
+	mockSuggestRedis.EXPECT().AddSuggestionCtx(ctx, entity.SuggestNews, news).Return(nil)
+	mockSuggestRedis.EXPECT().ClearSuggestionsCtx(ctx, entity.SuggestAuthors).Return(nil)
+	mockSuggestStorage.EXPECT().GetAuthorSuggestions(ctx, uuid.Nil, suggestRebuildBatch).Return([]*entity.Suggestion{author}, nil)
+	mockSuggestRedis.EXPECT().AddSuggestionCtx(ctx, entity.SuggestAuthors, author).Return(nil)
+
+	err := suggestService.Rebuild(ctx)
+	require.NoError(t, err)
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"unicode/utf8"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const maxTagLength = 32
+
+// Tags StoragePsql interface
+type TagsPsql interface {
+	GetTags(ctx context.Context, pq *utils.PaginationQuery) (*entity.TagsList, error)
+	GetTagBySlug(ctx context.Context, slug string) (*entity.Tag, error)
+	GetNewsByTag(ctx context.Context, tagID uuid.UUID, pq *utils.PaginationQuery) (*entity.NewsList, error)
+	RenameTag(ctx context.Context, tagID uuid.UUID, name string) (*entity.Tag, error)
+	MergeTags(ctx context.Context, sourceID uuid.UUID, targetID uuid.UUID) error
+}
+
+// Tags service
+type TagsService struct {
+	logger      logger.Logger
+	config      *config.Config
+	storagePsql TagsPsql
+}
+
+// Tags service constructor
+func NewTagsService(config *config.Config, storagePsql TagsPsql, logger logger.Logger) *TagsService {
+	return &TagsService{
+		config:      config,
+		storagePsql: storagePsql,
+		logger:      logger,
+	}
+}
+
+// Get tags with usage counts
+func (t *TagsService) GetTags(ctx context.Context, pq *utils.PaginationQuery) (*entity.TagsList, error) {
+	return t.storagePsql.GetTags(ctx, pq)
+}
+
+// Get published news of tag
+func (t *TagsService) GetNewsByTag(ctx context.Context, slug string, pq *utils.PaginationQuery) (*entity.NewsList, error) {
+	tag, err := t.storagePsql.GetTagBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	return t.storagePsql.GetNewsByTag(ctx, tag.TagID, pq)
+}
+
+// Rename tag, renaming onto an existing tag must be done by merge
+func (t *TagsService) RenameTag(ctx context.Context, slug string, name string) (*entity.Tag, error) {
+	tag, err := t.storagePsql.GetTagBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err = normalizeTag(name)
+	if err != nil {
+		return nil, httpe.NewBadRequestError(errors.WithMessage(err, "TagsService.RenameTag.normalizeTag"))
+	}
+
+	newSlug := utils.Slugify(name)
+	if newSlug != tag.Slug {
+		_, err := t.storagePsql.GetTagBySlug(ctx, newSlug)
+		if err == nil {
+			return nil, httpe.NewBadRequestError(errors.Errorf("tag %s already exists, merge tags instead", newSlug))
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return t.storagePsql.RenameTag(ctx, tag.TagID, name)
+}
+
+// Merge tag into target tag
+func (t *TagsService) MergeTags(ctx context.Context, slug string, merge *entity.TagMerge) (*entity.Tag, error) {
+	if err := utils.ValidateStruct(ctx, merge); err != nil {
+		return nil, httpe.NewBadRequestError(errors.WithMessage(err, "TagsService.MergeTags.ValidateStruct"))
+	}
+	if merge.Target == slug {
+		return nil, httpe.NewBadRequestError(errors.New("can't merge tag into itself"))
+	}
+
+	source, err := t.storagePsql.GetTagBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	target, err := t.storagePsql.GetTagBySlug(ctx, merge.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.storagePsql.MergeTags(ctx, source.TagID, target.TagID); err != nil {
+		return nil, err
+	}
+	return t.storagePsql.GetTagBySlug(ctx, target.Slug)
+}
+
+// Normalize tag name and check it makes a slug
+func normalizeTag(name string) (string, error) {
+	name = utils.NormalizeTag(name)
+	if utils.Slugify(name) == "" {
+		return "", errors.Errorf("invalid tag: %q", name)
+	}
+	if utf8.RuneCountInString(name) > maxTagLength {
+		return "", errors.Errorf("tag is longer than %d characters: %q", maxTagLength, name)
+	}
+	return name, nil
+}
+
+// Normalize and deduplicate tags of news, nil keeps tags on update
+func normalizeTags(tags []string) ([]string, error) {
+	if tags == nil {
+		return nil, nil
+	}
+
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		name, err := normalizeTag(tag)
+		if err != nil {
+			return nil, err
+		}
+		slug := utils.Slugify(name)
+		if seen[slug] {
+			continue
+		}
+		seen[slug] = true
+		normalized = append(normalized, name)
+	}
+
+	if len(normalized) > entity.MaxNewsTags {
+		return nil, errors.Errorf("news can't have more than %d tags", entity.MaxNewsTags)
+	}
+	return normalized, nil
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	mockstorage "github.com/Edbeer/restapi/internal/storage/psql/mock"
+	"github.com/Edbeer/restapi/pkg/logger"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_RenameTag(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockTagsStorage := mockstorage.NewMockTagsPsql(ctrl)
+	tagsService := NewTagsService(nil, mockTagsStorage, apiLogger)
+
+	ctx := context.Background()
+	tag := &entity.Tag{
+		TagID: uuid.New(),
+		Name:  "golang",
+		Slug:  "golang",
+	}
+
+	t.Run("Rename", func(t *testing.T) {
+		mockTagsStorage.EXPECT().GetTagBySlug(ctx, "golang").Return(tag, nil)
+		mockTagsStorage.EXPECT().GetTagBySlug(ctx, "go-lang").Return(nil, errors.Wrap(sql.ErrNoRows, "TagsStoragePsql.GetTagBySlug.GetContext"))
+		mockTagsStorage.EXPECT().RenameTag(ctx, tag.TagID, "go lang").Return(&entity.Tag{Name: "go lang", Slug: "go-lang"}, nil)
+
+		renamed, err := tagsService.RenameTag(ctx, "golang", "  Go   Lang ")
+		require.NoError(t, err)
+		require.Equal(t, "go-lang", renamed.Slug)
+	})
+
+	t.Run("Existing tag", func(t *testing.T) {
+		mockTagsStorage.EXPECT().GetTagBySlug(ctx, "golang").Return(tag, nil)
+		mockTagsStorage.EXPECT().GetTagBySlug(ctx, "go").Return(&entity.Tag{Slug: "go"}, nil)
+
+		_, err := tagsService.RenameTag(ctx, "golang", "Go")
+		require.Error(t, err)
+	})
+}
+
+func TestService_MergeTags(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockTagsStorage := mockstorage.NewMockTagsPsql(ctrl)
+	tagsService := NewTagsService(nil, mockTagsStorage, apiLogger)
+
+	ctx := context.Background()
+	source := &entity.Tag{TagID: uuid.New(), Slug: "go-lang"}
+	target := &entity.Tag{TagID: uuid.New(), Slug: "golang"}
+
+	mockTagsStorage.EXPECT().GetTagBySlug(ctx, source.Slug).Return(source, nil)
+	mockTagsStorage.EXPECT().GetTagBySlug(ctx, target.Slug).Return(target, nil).Times(2)
+	mockTagsStorage.EXPECT().MergeTags(ctx, source.TagID, target.TagID).Return(nil)
+
+	tag, err := tagsService.MergeTags(ctx, source.Slug, &entity.TagMerge{Target: target.Slug})
+	require.NoError(t, err)
+	require.Equal(t, target, tag)
+
+	_, err = tagsService.MergeTags(ctx, target.Slug, &entity.TagMerge{Target: target.Slug})
+	require.Error(t, err)
+}
+
+func TestService_normalizeTags(t *testing.T) {
+	t.Parallel()
+
+	tags, err := normalizeTags([]string{" Go ", "go", "Web  Development"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"go", "web development"}, tags)
+
+	tags, err = normalizeTags(nil)
+	require.NoError(t, err)
+	require.Nil(t, tags)
+
+	_, err = normalizeTags([]string{"!!!"})
+	require.Error(t, err)
+
+	_, err = normalizeTags([]string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11"})
+	require.Error(t, err)
+}
@@ -0,0 +1,489 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/markdown"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// Tags of news share one CSV column
+const csvTagsSeparator = "|"
+
+// CSV columns of news records, exported comments are a json array
+var csvNewsColumns = []string{
+	"news_id", "author_id", "title", "slug", "content", "image_url", "category", "language",
+	"locale", "status", "publish_at", "tags", "created_at", "updated_at",
+}
+
+// Transfer StoragePsql interface
+type TransferPsql interface {
+	ExportNews(ctx context.Context, withComments bool, fn func(record *entity.NewsRecord) error) error
+	ImportNews(ctx context.Context, newsList []*entity.News, dryRun bool) ([]error, error)
+}
+
+// News import and export service. Imported news are indexed for feeds,
+// sitemaps and related news, suggestions are rebuilt separately.
+type TransferService struct {
+	logger      logger.Logger
+	config      *config.Config
+	storagePsql TransferPsql
+	feedsRedis  FeedsRedis
+	sitemaps    NewsSitemaps
+	related     NewsRelated
+}
+
+// News import and export service constructor
+func NewTransferService(config *config.Config, storagePsql TransferPsql, feedsRedis FeedsRedis, sitemaps NewsSitemaps, related NewsRelated, logger logger.Logger) *TransferService {
+	return &TransferService{
+		config:      config,
+		storagePsql: storagePsql,
+		feedsRedis:  feedsRedis,
+		sitemaps:    sitemaps,
+		related:     related,
+		logger:      logger,
+	}
+}
+
+// Record of import with its row
+type importRow struct {
+	row    int
+	record *entity.NewsRecord
+	err    error
+}
+
+// Reader of imported records, rows which can't be parsed are returned with
+// error, io.EOF ends the upload
+type importReader interface {
+	Read() (*importRow, error)
+}
+
+// Write all news to w as NDJSON or CSV, oldest first. Records are written
+// as they are read and flushed every batch.
+func (t *TransferService) Export(ctx context.Context, format string, withComments bool, w io.Writer) error {
+	var write func(record *entity.NewsRecord) error
+	var flush func() error
+	switch format {
+	case entity.TransferNDJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetEscapeHTML(false)
+		write = func(record *entity.NewsRecord) error {
+			return encoder.Encode(record)
+		}
+		flush = func() error { return nil }
+	case entity.TransferCSV:
+		writer := csv.NewWriter(w)
+		header := csvNewsColumns
+		if withComments {
+			header = append(header[:len(header):len(header)], "comments")
+		}
+		// header is written with the first record, so failed exports can still respond with error
+		headerWritten := false
+		write = func(record *entity.NewsRecord) error {
+			if !headerWritten {
+				headerWritten = true
+				if err := writer.Write(header); err != nil {
+					return err
+				}
+			}
+			row, err := csvNewsRecord(record, withComments)
+			if err != nil {
+				return err
+			}
+			return writer.Write(row)
+		}
+		flush = func() error {
+			if !headerWritten {
+				headerWritten = true
+				if err := writer.Write(header); err != nil {
+					return err
+				}
+			}
+			writer.Flush()
+			return writer.Error()
+		}
+	default:
+		return httpe.NewBadRequestError(errors.Errorf("TransferService.Export: unknown format %q", format))
+	}
+
+	var count int
+	if err := t.storagePsql.ExportNews(ctx, withComments, func(record *entity.NewsRecord) error {
+		if err := write(record); err != nil {
+			return errors.Wrap(err, "TransferService.Export.write")
+		}
+		count++
+		if count%t.config.Transfer.BatchSize == 0 {
+			return flushExport(w, flush)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	return flushExport(w, flush)
+}
+
+// Import news from NDJSON or CSV upload. Every record is validated and
+// valid ones are inserted in batches, each batch in its own transaction.
+// Dry run checks records against the database without keeping them.
+func (t *TransferService) Import(ctx context.Context, format string, r io.Reader, dryRun bool) (*entity.ImportReport, error) {
+	var reader importReader
+	switch format {
+	case entity.TransferNDJSON:
+		reader = newNDJSONReader(r)
+	case entity.TransferCSV:
+		csvReader, err := newCSVReader(r)
+		if err != nil {
+			return nil, httpe.NewBadRequestError(errors.WithMessage(err, "TransferService.Import.newCSVReader"))
+		}
+		reader = csvReader
+	default:
+		return nil, httpe.NewBadRequestError(errors.Errorf("TransferService.Import: unknown format %q", format))
+	}
+
+	report := &entity.ImportReport{DryRun: dryRun, Errors: []*entity.ImportError{}}
+	batch := make([]*entity.News, 0, t.config.Transfer.BatchSize)
+	batchRows := make([]int, 0, t.config.Transfer.BatchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := t.importBatch(ctx, report, batch, batchRows); err != nil {
+			return err
+		}
+		batch, batchRows = batch[:0], batchRows[:0]
+		return nil
+	}
+
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, httpe.NewBadRequestError(errors.WithMessage(err, "TransferService.Import.Read"))
+		}
+
+		report.Total++
+		if row.err != nil {
+			report.Errors = append(report.Errors, &entity.ImportError{Row: row.row, Error: row.err.Error()})
+			continue
+		}
+		news, err := prepareImportedNews(ctx, row.record)
+		if err != nil {
+			report.Errors = append(report.Errors, &entity.ImportError{Row: row.row, Error: err.Error()})
+			continue
+		}
+
+		batch, batchRows = append(batch, news), append(batchRows, row.row)
+		if len(batch) == t.config.Transfer.BatchSize {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	report.Failed = len(report.Errors)
+	if report.Imported > 0 && !dryRun {
+		if err := t.feedsRedis.InvalidateFeedsCtx(ctx); err != nil {
+			t.logger.Errorf("TransferService.Import.InvalidateFeedsCtx: %v", err)
+		}
+	}
+	return report, nil
+}
+
+func (t *TransferService) importBatch(ctx context.Context, report *entity.ImportReport, batch []*entity.News, rows []int) error {
+	errs, err := t.storagePsql.ImportNews(ctx, batch, report.DryRun)
+	if err != nil {
+		return err
+	}
+
+	for i, news := range batch {
+		if errs[i] != nil {
+			report.Errors = append(report.Errors, &entity.ImportError{Row: rows[i], Error: errs[i].Error()})
+			continue
+		}
+		report.Imported++
+		if report.DryRun || news.Status != entity.NewsStatusPublished {
+			continue
+		}
+		if err := t.sitemaps.InvalidateNews(ctx, news.NewsID, news.PublishAt); err != nil {
+			t.logger.Errorf("TransferService.importBatch.InvalidateNews: %v", err)
+		}
+		if err := t.related.MarkStale(ctx, news.NewsID); err != nil {
+			t.logger.Errorf("TransferService.importBatch.MarkStale: %v", err)
+		}
+	}
+	return nil
+}
+
+// Validate imported record and prepare news like created ones
+func prepareImportedNews(ctx context.Context, record *entity.NewsRecord) (*entity.News, error) {
+	if err := utils.ValidateStruct(ctx, record); err != nil {
+		return nil, err
+	}
+
+	news := &entity.News{
+		NewsID:    record.NewsID,
+		AuthorID:  record.AuthorID,
+		Title:     record.Title,
+		Slug:      record.Slug,
+		Content:   record.Content,
+		ImageURL:  record.ImageURL,
+		Category:  record.Category,
+		Language:  record.Language,
+		Locale:    record.Locale,
+		Status:    record.Status,
+		PublishAt: record.PublishAt,
+		Tags:      record.Tags,
+	}
+	if record.CreatedAt != nil {
+		news.CreatedAt = *record.CreatedAt
+	}
+	if record.UpdatedAt != nil {
+		news.UpdatedAt = *record.UpdatedAt
+	}
+	news.Locale = newsLocale(news)
+
+	// news published before import keep their date
+	if (news.Status == "" || news.Status == entity.NewsStatusPublished) && news.PublishAt == nil && record.CreatedAt != nil {
+		news.PublishAt = record.CreatedAt
+	}
+	if err := prepareNewsStatus(news, ""); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if news.Tags, err = normalizeTags(news.Tags); err != nil {
+		return nil, err
+	}
+	if news.ContentHTML, err = markdown.ToHTML(news.Content); err != nil {
+		return nil, err
+	}
+	return news, nil
+}
+
+// Flush buffered records and the response
+func flushExport(w io.Writer, flush func() error) error {
+	if err := flush(); err != nil {
+		return errors.Wrap(err, "TransferService.Export.flush")
+	}
+	if flusher, ok := w.(interface{ Flush() }); ok {
+		flusher.Flush()
+	}
+	return nil
+}
+
+type ndjsonReader struct {
+	reader *bufio.Reader
+	line   int
+}
+
+func newNDJSONReader(r io.Reader) *ndjsonReader {
+	return &ndjsonReader{reader: bufio.NewReader(r)}
+}
+
+// Read next non-empty line
+func (n *ndjsonReader) Read() (*importRow, error) {
+	for {
+		line, err := n.reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		if len(line) == 0 && err == io.EOF {
+			return nil, io.EOF
+		}
+		n.line++
+
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			if err == io.EOF {
+				return nil, io.EOF
+			}
+			continue
+		}
+
+		record := &entity.NewsRecord{}
+		decoder := json.NewDecoder(bytes.NewReader(line))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(record); err != nil {
+			return &importRow{row: n.line, err: err}, nil
+		}
+		return &importRow{row: n.line, record: record}, nil
+	}
+}
+
+type csvReader struct {
+	reader  *csv.Reader
+	columns []string
+	row     int
+}
+
+// CSV reader checks columns of header, title, content and author are required
+func newCSVReader(r io.Reader) (*csvReader, error) {
+	reader := csv.NewReader(r)
+	header, err := reader.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, errors.New("empty upload")
+		}
+		return nil, err
+	}
+
+	known := make(map[string]bool, len(csvNewsColumns)+1)
+	for _, column := range csvNewsColumns {
+		known[column] = true
+	}
+	known["comments"] = true
+
+	seen := make(map[string]bool, len(header))
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if !known[column] {
+			return nil, errors.Errorf("unknown column %q", column)
+		}
+		header[i] = column
+		seen[column] = true
+	}
+	for _, column := range []string{"author_id", "title", "content"} {
+		if !seen[column] {
+			return nil, errors.Errorf("missing column %q", column)
+		}
+	}
+	return &csvReader{reader: reader, columns: header}, nil
+}
+
+// Read next record, comments are not imported
+func (c *csvReader) Read() (*importRow, error) {
+	fields, err := c.reader.Read()
+	if err == io.EOF {
+		return nil, io.EOF
+	}
+	c.row++
+	if err != nil {
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return &importRow{row: c.row, err: err}, nil
+		}
+		return nil, err
+	}
+
+	record := &entity.NewsRecord{}
+	for i, value := range fields {
+		if err := setCSVField(record, c.columns[i], value); err != nil {
+			return &importRow{row: c.row, err: errors.WithMessage(err, c.columns[i])}, nil
+		}
+	}
+	return &importRow{row: c.row, record: record}, nil
+}
+
+func setCSVField(record *entity.NewsRecord, column string, value string) error {
+	if value == "" {
+		return nil
+	}
+
+	var err error
+	switch column {
+	case "news_id":
+		record.NewsID, err = uuid.Parse(value)
+	case "author_id":
+		record.AuthorID, err = uuid.Parse(value)
+	case "title":
+		record.Title = value
+	case "slug":
+		record.Slug = value
+	case "content":
+		record.Content = value
+	case "image_url":
+		record.ImageURL = &value
+	case "category":
+		record.Category = &value
+	case "language":
+		record.Language = value
+	case "locale":
+		record.Locale = value
+	case "status":
+		record.Status = value
+	case "publish_at":
+		record.PublishAt, err = parseCSVTime(value)
+	case "tags":
+		for _, tag := range strings.Split(value, csvTagsSeparator) {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				record.Tags = append(record.Tags, tag)
+			}
+		}
+	case "created_at":
+		record.CreatedAt, err = parseCSVTime(value)
+	case "updated_at":
+		record.UpdatedAt, err = parseCSVTime(value)
+	}
+	return err
+}
+
+func parseCSVTime(value string) (*time.Time, error) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func csvNewsRecord(record *entity.NewsRecord, withComments bool) ([]string, error) {
+	row := []string{
+		record.NewsID.String(),
+		record.AuthorID.String(),
+		record.Title,
+		record.Slug,
+		record.Content,
+		csvString(record.ImageURL),
+		csvString(record.Category),
+		record.Language,
+		record.Locale,
+		record.Status,
+		csvTime(record.PublishAt),
+		strings.Join(record.Tags, csvTagsSeparator),
+		csvTime(record.CreatedAt),
+		csvTime(record.UpdatedAt),
+	}
+	if withComments {
+		comments := record.Comments
+		if comments == nil {
+			comments = []*entity.CommentRecord{}
+		}
+		b, err := json.Marshal(comments)
+		if err != nil {
+			return nil, err
+		}
+		row = append(row, string(b))
+	}
+	return row, nil
+}
+
+func csvString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func csvTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339Nano)
+}
@@ -0,0 +1,171 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	mockservice "github.com/Edbeer/restapi/internal/service/mock"
+	mockstorage "github.com/Edbeer/restapi/internal/storage/psql/mock"
+	mockredis "github.com/Edbeer/restapi/internal/storage/redis/mock"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_ImportNews(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	config := &config.Config{
+		Transfer: config.TransferConfig{
+			BatchSize: 1,
+		},
+	}
+	apiLogger := logger.NewApiLogger(nil)
+	mockTransferStorage := mockstorage.NewMockTransferPsql(ctrl)
+	mockFeedsRedis := mockredis.NewMockFeedsRedis(ctrl)
+	mockSitemaps := mockservice.NewMockSitemaps(ctrl)
+	mockRelated := mockservice.NewMockRelated(ctrl)
+	transferService := NewTransferService(config, mockTransferStorage, mockFeedsRedis, mockSitemaps, mockRelated, apiLogger)
+
+	ctx := context.Background()
+	authorID := uuid.New()
+
+	t.Run("NDJSON", func(t *testing.T) {
+		upload := strings.Join([]string{
+			`{"author_id":"` + authorID.String() + `","title":"Rain in Berlin","content":"It rains in Berlin all week","tags":["Weather"],"created_at":"2024-01-02T10:00:00Z"}`,
+			`{"author_id":"` + authorID.String() + `","title":"Short","content":"It rains in Berlin all week"}`,
+			``,
+			`{"author_id":"` + authorID.String() + `","title":"Sun in Madrid","content":"It is sunny in Madrid all week","status":"draft","unknown":1}`,
+			`{"author_id":"` + authorID.String() + `","title":"Sun in Madrid","content":"It is sunny in Madrid all week","status":"draft"}`,
+		}, "\n")
+
+		newsID := uuid.New()
+		mockTransferStorage.EXPECT().ImportNews(ctx, gomock.Any(), false).DoAndReturn(
+			func(ctx context.Context, newsList []*entity.News, dryRun bool) ([]error, error) {
+				require.Len(t, newsList, 1)
+				require.Equal(t, entity.NewsStatusPublished, newsList[0].Status)
+				require.Equal(t, []string{"weather"}, newsList[0].Tags)
+				require.Equal(t, time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC), *newsList[0].PublishAt)
+				newsList[0].NewsID = newsID
+				return []error{nil}, nil
+			},
+		)
+		mockSitemaps.EXPECT().InvalidateNews(ctx, newsID, gomock.Any()).Return(nil)
+		mockRelated.EXPECT().MarkStale(ctx, newsID).Return(nil)
+		mockTransferStorage.EXPECT().ImportNews(ctx, gomock.Any(), false).Return([]error{errors.New("duplicate key")}, nil)
+		mockFeedsRedis.EXPECT().InvalidateFeedsCtx(ctx).Return(nil)
+
+		report, err := transferService.Import(ctx, entity.TransferNDJSON, strings.NewReader(upload), false)
+		require.NoError(t, err)
+		require.Equal(t, 4, report.Total)
+		require.Equal(t, 1, report.Imported)
+		require.Equal(t, 3, report.Failed)
+		require.Equal(t, 2, report.Errors[0].Row)
+		require.Equal(t, 4, report.Errors[1].Row)
+		require.Equal(t, 5, report.Errors[2].Row)
+	})
+
+	t.Run("CSV dry run", func(t *testing.T) {
+		upload := "title,content,author_id,tags,status\n" +
+			"Rain in Berlin,It rains in Berlin all week," + authorID.String() + ",weather|berlin,published\n" +
+			"Rain in Berlin,It rains in Berlin all week,author,,\n"
+
+		mockTransferStorage.EXPECT().ImportNews(ctx, gomock.Any(), true).DoAndReturn(
+			func(ctx context.Context, newsList []*entity.News, dryRun bool) ([]error, error) {
+				require.Equal(t, []string{"weather", "berlin"}, newsList[0].Tags)
+				return []error{nil}, nil
+			},
+		)
+
+		report, err := transferService.Import(ctx, entity.TransferCSV, strings.NewReader(upload), true)
+		require.NoError(t, err)
+		require.True(t, report.DryRun)
+		require.Equal(t, 2, report.Total)
+		require.Equal(t, 1, report.Imported)
+		require.Equal(t, 2, report.Errors[0].Row)
+	})
+
+	t.Run("CSV unknown column", func(t *testing.T) {
+		_, err := transferService.Import(ctx, entity.TransferCSV, strings.NewReader("title,content,author_id,views\n"), false)
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, httpe.ParseErrors(err).Status())
+	})
+
+	t.Run("Unknown format", func(t *testing.T) {
+		_, err := transferService.Import(ctx, "xml", strings.NewReader(""), false)
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, httpe.ParseErrors(err).Status())
+	})
+}
+
+func TestService_ExportNews(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	config := &config.Config{
+		Transfer: config.TransferConfig{
+			BatchSize: 500,
+		},
+	}
+	apiLogger := logger.NewApiLogger(nil)
+	mockTransferStorage := mockstorage.NewMockTransferPsql(ctrl)
+	transferService := NewTransferService(config, mockTransferStorage, nil, nil, nil, apiLogger)
+
+	ctx := context.Background()
+	record := &entity.NewsRecord{
+		NewsID:   uuid.New(),
+		AuthorID: uuid.New(),
+		Title:    "Rain in Berlin",
+		Slug:     "rain-in-berlin",
+		Content:  "It rains in Berlin <all> week",
+		Status:   entity.NewsStatusPublished,
+		Tags:     entity.StringList{"berlin", "weather"},
+		Comments: []*entity.CommentRecord{{CommentID: uuid.New(), AuthorID: uuid.New(), Message: "wet"}},
+	}
+	export := func(ctx context.Context, withComments bool, fn func(record *entity.NewsRecord) error) error {
+		return fn(record)
+	}
+
+	t.Run("NDJSON", func(t *testing.T) {
+		mockTransferStorage.EXPECT().ExportNews(ctx, true, gomock.Any()).DoAndReturn(export)
+
+		var buf bytes.Buffer
+		err := transferService.Export(ctx, entity.TransferNDJSON, true, &buf)
+		require.NoError(t, err)
+		require.Contains(t, buf.String(), `"content":"It rains in Berlin <all> week"`)
+		require.Contains(t, buf.String(), `"message":"wet"`)
+		require.Equal(t, 1, strings.Count(buf.String(), "\n"))
+	})
+
+	t.Run("CSV", func(t *testing.T) {
+		mockTransferStorage.EXPECT().ExportNews(ctx, false, gomock.Any()).DoAndReturn(export)
+
+		var buf bytes.Buffer
+		err := transferService.Export(ctx, entity.TransferCSV, false, &buf)
+		require.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		require.Len(t, lines, 2)
+		require.Equal(t, strings.Join(csvNewsColumns, ","), lines[0])
+		require.Contains(t, lines[1], "berlin|weather")
+	})
+
+	t.Run("Unknown format", func(t *testing.T) {
+		err := transferService.Export(ctx, "xml", false, &bytes.Buffer{})
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, httpe.ParseErrors(err).Status())
+	})
+}
@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"sort"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/markdown"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// Translations StoragePsql interface
+type TranslationsPsql interface {
+	GetNews(ctx context.Context, newsID uuid.UUID) (*entity.News, error)
+	GetTranslations(ctx context.Context, newsID uuid.UUID) ([]*entity.NewsTranslation, error)
+	Create(ctx context.Context, translation *entity.NewsTranslation) (*entity.NewsTranslation, error)
+	Update(ctx context.Context, translation *entity.NewsTranslation) (*entity.NewsTranslation, error)
+	Delete(ctx context.Context, newsID uuid.UUID, locale string) error
+}
+
+// News translations service, translations are managed by the authors of news
+// until it is published and by editors afterwards
+type TranslationsService struct {
+	logger       logger.Logger
+	config       *config.Config
+	storagePsql  TranslationsPsql
+	coAuthors    CoAuthorsPsql
+	storageRedis NewsRedis
+}
+
+// News translations service constructor
+func NewTranslationsService(config *config.Config, storagePsql TranslationsPsql, coAuthors CoAuthorsPsql, redis NewsRedis, logger logger.Logger) *TranslationsService {
+	return &TranslationsService{
+		config:       config,
+		storagePsql:  storagePsql,
+		coAuthors:    coAuthors,
+		storageRedis: redis,
+		logger:       logger,
+	}
+}
+
+// Get translations of news, translations of unpublished news are visible to the authors only
+func (t *TranslationsService) GetTranslations(ctx context.Context, newsID uuid.UUID) ([]*entity.NewsTranslation, error) {
+	news, err := t.storagePsql.GetNews(ctx, newsID)
+	if err != nil {
+		return nil, err
+	}
+	if news.Status != entity.NewsStatusPublished {
+		isAuthor, err := t.isAuthor(ctx, news)
+		if err != nil {
+			return nil, err
+		}
+		if !isAuthor {
+			return nil, httpe.NewNotFoundError(errors.New("TranslationsService.GetTranslations.Status"))
+		}
+	}
+	return t.storagePsql.GetTranslations(ctx, newsID)
+}
+
+// Translate news to another locale
+func (t *TranslationsService) Create(ctx context.Context, translation *entity.NewsTranslation) (*entity.NewsTranslation, error) {
+	if err := t.prepare(ctx, translation, "Create"); err != nil {
+		return nil, err
+	}
+
+	created, err := t.storagePsql.Create(ctx, translation)
+	if err != nil {
+		return nil, err
+	}
+	if created == nil {
+		return nil, httpe.NewBadRequestError(errors.Errorf("TranslationsService.Create: news is already translated to %s", translation.Locale))
+	}
+	t.invalidateNews(ctx, translation.NewsID)
+	return created, nil
+}
+
+// Replace title and content of translation
+func (t *TranslationsService) Update(ctx context.Context, translation *entity.NewsTranslation) (*entity.NewsTranslation, error) {
+	if err := t.prepare(ctx, translation, "Update"); err != nil {
+		return nil, err
+	}
+
+	updated, err := t.storagePsql.Update(ctx, translation)
+	if err != nil {
+		return nil, err
+	}
+	t.invalidateNews(ctx, translation.NewsID)
+	return updated, nil
+}
+
+// Delete translation
+func (t *TranslationsService) Delete(ctx context.Context, newsID uuid.UUID, locale string) error {
+	if _, err := t.ownNews(ctx, newsID, "Delete"); err != nil {
+		return err
+	}
+
+	if err := t.storagePsql.Delete(ctx, newsID, utils.NormalizeLocale(locale)); err != nil {
+		return err
+	}
+	t.invalidateNews(ctx, newsID)
+	return nil
+}
+
+// Check permission and translation, original locale can't be translated to
+func (t *TranslationsService) prepare(ctx context.Context, translation *entity.NewsTranslation, op string) error {
+	news, err := t.ownNews(ctx, translation.NewsID, op)
+	if err != nil {
+		return err
+	}
+
+	if locale := utils.NormalizeLocale(translation.Locale); locale != "" {
+		translation.Locale = locale
+	}
+	if err := utils.ValidateStruct(ctx, translation); err != nil {
+		return httpe.NewBadRequestError(errors.WithMessage(err, "TranslationsService."+op+".ValidateStruct"))
+	}
+	if translation.Locale == news.Locale {
+		return httpe.NewBadRequestError(errors.Errorf("TranslationsService.%s: %s is the original locale of news", op, news.Locale))
+	}
+
+	if translation.ContentHTML, err = markdown.ToHTML(translation.Content); err != nil {
+		return httpe.NewBadRequestError(errors.WithMessage(err, "TranslationsService."+op+".ToHTML"))
+	}
+	return nil
+}
+
+func (t *TranslationsService) ownNews(ctx context.Context, newsID uuid.UUID, op string) (*entity.News, error) {
+	if _, err := utils.GetUserFromCtx(ctx); err != nil {
+		return nil, httpe.NewUnauthorizedError(errors.WithMessage(err, "TranslationsService."+op+".GetUserFromCtx"))
+	}
+
+	news, err := t.storagePsql.GetNews(ctx, newsID)
+	if err != nil {
+		return nil, err
+	}
+
+	isAuthor, err := t.isAuthor(ctx, news)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthor {
+		if err := utils.ValidateIsOwner(ctx, news.AuthorID.String(), t.logger); err != nil {
+			return nil, httpe.NewRestError(http.StatusForbidden, "Forbidden", errors.Wrap(err, "TranslationsService."+op+".ValidateIsOwner"))
+		}
+	}
+	// translations go live without review, so contributors translate news before it is published
+	if isLive(news.Status) && !isEditor(ctx) {
+		return nil, httpe.NewRestError(http.StatusForbidden, "Forbidden", errors.Errorf("TranslationsService.%s: translations of %s news are changed by editors", op, news.Status))
+	}
+	return news, nil
+}
+
+// Author or co-author of news
+func (t *TranslationsService) isAuthor(ctx context.Context, news *entity.News) (bool, error) {
+	viewerID := getViewerID(ctx)
+	if viewerID == news.AuthorID {
+		return true, nil
+	}
+	if viewerID == uuid.Nil {
+		return false, nil
+	}
+	return t.coAuthors.IsCoAuthor(ctx, news.NewsID, viewerID)
+}
+
+// Cached news hold their translations
+func (t *TranslationsService) invalidateNews(ctx context.Context, newsID uuid.UUID) {
+	if err := t.storageRedis.DeleteNewsCtx(ctx, newsCacheKey(newsID.String())); err != nil {
+		t.logger.Errorf("TranslationsService.invalidateNews.DeleteNewsCtx: %v", err)
+	}
+}
+
+// Locale of new news, defaults to the locale of its text search language
+func newsLocale(news *entity.News) string {
+	if news.Locale == "" {
+		return languageLocales[news.Language]
+	}
+	if locale := utils.NormalizeLocale(news.Locale); locale != "" {
+		return locale
+	}
+	return news.Locale
+}
+
+// Check new original locale of news which must not be translated to
+func originalLocale(locale string, news *entity.NewsBase) (string, error) {
+	normalized := utils.NormalizeLocale(locale)
+	if normalized == "" {
+		return "", errors.Errorf("invalid locale: %s", locale)
+	}
+	for _, translation := range news.Translations {
+		if translation.Locale == normalized {
+			return "", errors.Errorf("news is translated to %s", normalized)
+		}
+	}
+	return normalized, nil
+}
+
+// Show the translation which comes first in the locale fallback chain,
+// the original wins ties and locales out of the chain
+func localizeNews(news *entity.NewsBase, chain []string) {
+	if news.Locale == "" {
+		news.Locale = entity.DefaultNewsLocale
+	}
+
+	locales := entity.Locales{news.Locale}
+	var selected *entity.NewsTranslation
+	rank := localeRank(chain, news.Locale)
+	for _, translation := range news.Translations {
+		locales = append(locales, translation.Locale)
+		if r := localeRank(chain, translation.Locale); r < rank {
+			selected, rank = translation, r
+		}
+	}
+	sort.Strings(locales)
+
+	if selected != nil {
+		news.Locale = selected.Locale
+		news.Title = selected.Title
+		news.Content = selected.Content
+		news.ContentHTML = selected.ContentHTML
+	}
+	news.Locales = locales
+	news.Translations = nil
+}
+
+func localeRank(chain []string, locale string) int {
+	for i, l := range chain {
+		if l == locale {
+			return i
+		}
+	}
+	return len(chain)
+}
@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	mockstorage "github.com/Edbeer/restapi/internal/storage/psql/mock"
+	mockredis "github.com/Edbeer/restapi/internal/storage/redis/mock"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_CreateTranslation(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockTranslationsStorage := mockstorage.NewMockTranslationsPsql(ctrl)
+	mockNewsRedis := mockredis.NewMockNewsRedis(ctrl)
+	translationsService := NewTranslationsService(nil, mockTranslationsStorage, nil, mockNewsRedis, apiLogger)
+
+	authorID, newsID := uuid.New(), uuid.New()
+	ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, &entity.User{ID: authorID})
+	news := &entity.News{NewsID: newsID, AuthorID: authorID, Locale: "en", Status: entity.NewsStatusDraft}
+
+	t.Run("Create", func(t *testing.T) {
+		translation := &entity.NewsTranslation{
+			NewsID:  newsID,
+			Locale:  "pt_br",
+			Title:   "Chuva em Berlim hoje",
+			Content: "Está chovendo em **Berlim**",
+		}
+		mockTranslationsStorage.EXPECT().GetNews(ctx, newsID).Return(news, nil)
+		mockTranslationsStorage.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, translation *entity.NewsTranslation) (*entity.NewsTranslation, error) {
+				return translation, nil
+			})
+		mockNewsRedis.EXPECT().DeleteNewsCtx(ctx, newsCacheKey(newsID.String())).Return(nil)
+
+		created, err := translationsService.Create(ctx, translation)
+		require.NoError(t, err)
+		require.Equal(t, "pt-BR", created.Locale)
+		require.Contains(t, created.ContentHTML, "<strong>Berlim</strong>")
+	})
+
+	t.Run("Original locale", func(t *testing.T) {
+		mockTranslationsStorage.EXPECT().GetNews(ctx, newsID).Return(news, nil)
+
+		_, err := translationsService.Create(ctx, &entity.NewsTranslation{
+			NewsID:  newsID,
+			Locale:  "EN",
+			Title:   "Rain in Berlin today",
+			Content: "It is raining in Berlin",
+		})
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, httpe.ParseErrors(err).Status())
+	})
+
+	t.Run("Already translated", func(t *testing.T) {
+		mockTranslationsStorage.EXPECT().GetNews(ctx, newsID).Return(news, nil)
+		mockTranslationsStorage.EXPECT().Create(ctx, gomock.Any()).Return(nil, nil)
+
+		_, err := translationsService.Create(ctx, &entity.NewsTranslation{
+			NewsID:  newsID,
+			Locale:  "de",
+			Title:   "Regen in Berlin heute",
+			Content: "Es regnet heute in Berlin",
+		})
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, httpe.ParseErrors(err).Status())
+	})
+
+	t.Run("Published by contributor", func(t *testing.T) {
+		mockTranslationsStorage.EXPECT().GetNews(ctx, newsID).Return(&entity.News{NewsID: newsID, AuthorID: authorID, Locale: "en", Status: entity.NewsStatusPublished}, nil)
+
+		_, err := translationsService.Create(ctx, &entity.NewsTranslation{
+			NewsID:  newsID,
+			Locale:  "de",
+			Title:   "Regen in Berlin heute",
+			Content: "Es regnet heute in Berlin",
+		})
+		require.Error(t, err)
+		require.Equal(t, http.StatusForbidden, httpe.ParseErrors(err).Status())
+	})
+
+	t.Run("Published by editor", func(t *testing.T) {
+		role := entity.RoleEditor
+		editorCtx := context.WithValue(context.Background(), utils.UserCtxKey{}, &entity.User{ID: authorID, Role: &role})
+		mockTranslationsStorage.EXPECT().GetNews(editorCtx, newsID).Return(&entity.News{NewsID: newsID, AuthorID: authorID, Locale: "en", Status: entity.NewsStatusPublished}, nil)
+		mockTranslationsStorage.EXPECT().Create(editorCtx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, translation *entity.NewsTranslation) (*entity.NewsTranslation, error) {
+				return translation, nil
+			})
+		mockNewsRedis.EXPECT().DeleteNewsCtx(editorCtx, newsCacheKey(newsID.String())).Return(nil)
+
+		_, err := translationsService.Create(editorCtx, &entity.NewsTranslation{
+			NewsID:  newsID,
+			Locale:  "de",
+			Title:   "Regen in Berlin heute",
+			Content: "Es regnet heute in Berlin",
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		_, err := translationsService.Create(context.Background(), &entity.NewsTranslation{NewsID: newsID})
+		require.Error(t, err)
+		require.Equal(t, http.StatusUnauthorized, httpe.ParseErrors(err).Status())
+	})
+}
+
+func TestService_GetTranslations(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockTranslationsStorage := mockstorage.NewMockTranslationsPsql(ctrl)
+	translationsService := NewTranslationsService(nil, mockTranslationsStorage, nil, nil, apiLogger)
+
+	newsID := uuid.New()
+	ctx := context.Background()
+
+	t.Run("Published", func(t *testing.T) {
+		translations := []*entity.NewsTranslation{{NewsID: newsID, Locale: "de"}}
+		mockTranslationsStorage.EXPECT().GetNews(ctx, newsID).Return(&entity.News{NewsID: newsID, Status: entity.NewsStatusPublished}, nil)
+		mockTranslationsStorage.EXPECT().GetTranslations(ctx, newsID).Return(translations, nil)
+
+		result, err := translationsService.GetTranslations(ctx, newsID)
+		require.NoError(t, err)
+		require.Equal(t, translations, result)
+	})
+
+	t.Run("Draft", func(t *testing.T) {
+		mockTranslationsStorage.EXPECT().GetNews(ctx, newsID).Return(&entity.News{NewsID: newsID, AuthorID: uuid.New(), Status: entity.NewsStatusDraft}, nil)
+
+		_, err := translationsService.GetTranslations(ctx, newsID)
+		require.Error(t, err)
+		require.Equal(t, http.StatusNotFound, httpe.ParseErrors(err).Status())
+	})
+}
+
+func TestService_DeleteTranslation(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockTranslationsStorage := mockstorage.NewMockTranslationsPsql(ctrl)
+	mockNewsRedis := mockredis.NewMockNewsRedis(ctrl)
+	translationsService := NewTranslationsService(nil, mockTranslationsStorage, nil, mockNewsRedis, apiLogger)
+
+	authorID, newsID := uuid.New(), uuid.New()
+	ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, &entity.User{ID: authorID})
+
+	mockTranslationsStorage.EXPECT().GetNews(ctx, newsID).Return(&entity.News{NewsID: newsID, AuthorID: authorID, Locale: "en"}, nil)
+	mockTranslationsStorage.EXPECT().Delete(ctx, newsID, "pt-BR").Return(nil)
+	mockNewsRedis.EXPECT().DeleteNewsCtx(ctx, newsCacheKey(newsID.String())).Return(nil)
+
+	require.NoError(t, translationsService.Delete(ctx, newsID, "pt-br"))
+}
+
+func TestLocalizeNews(t *testing.T) {
+	t.Parallel()
+
+	translations := func() []*entity.NewsTranslation {
+		return []*entity.NewsTranslation{
+			{Locale: "fr", Title: "Pluie", Content: "Il pleut"},
+			{Locale: "de", Title: "Regen", Content: "Es regnet"},
+		}
+	}
+
+	t.Run("Preferred translation", func(t *testing.T) {
+		news := &entity.NewsBase{Locale: "en", Title: "Rain", Content: "It rains", Translations: translations()}
+		localizeNews(news, utils.LocaleChain([]string{"de-AT", "fr"}))
+		require.Equal(t, "de", news.Locale)
+		require.Equal(t, "Regen", news.Title)
+		require.Equal(t, entity.Locales{"de", "en", "fr"}, news.Locales)
+		require.Nil(t, news.Translations)
+	})
+
+	t.Run("Original before translation", func(t *testing.T) {
+		news := &entity.NewsBase{Locale: "en", Title: "Rain", Translations: translations()}
+		localizeNews(news, utils.LocaleChain([]string{"it"}))
+		require.Equal(t, "en", news.Locale)
+		require.Equal(t, "Rain", news.Title)
+	})
+
+	t.Run("Default locale", func(t *testing.T) {
+		news := &entity.NewsBase{Locale: "fr", Title: "Pluie", Translations: []*entity.NewsTranslation{{Locale: "en", Title: "Rain"}}}
+		localizeNews(news, utils.LocaleChain([]string{"it"}))
+		require.Equal(t, "en", news.Locale)
+		require.Equal(t, "Rain", news.Title)
+	})
+
+	t.Run("No preference", func(t *testing.T) {
+		news := &entity.NewsBase{Title: "Rain", Translations: translations()}
+		localizeNews(news, nil)
+		require.Equal(t, entity.DefaultNewsLocale, news.Locale)
+		require.Equal(t, "Rain", news.Title)
+		require.Equal(t, entity.Locales{"de", "en", "fr"}, news.Locales)
+	})
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// Unique views are counted per hour
+const viewsBucket = time.Hour
+
+// Trending windows, views lose half of their weight every quarter of window
+var trendingWindows = map[string]time.Duration{
+	entity.TrendingHour: time.Hour,
+	entity.TrendingDay:  24 * time.Hour,
+	entity.TrendingWeek: 7 * 24 * time.Hour,
+}
+
+// Views StoragePsql interface
+type ViewsPsql interface {
+	AddViews(ctx context.Context, views map[uuid.UUID]int64) error
+	GetTrendingNews(ctx context.Context, newsIDs []uuid.UUID) ([]*entity.TrendingNews, error)
+}
+
+// Views StorageRedis interface
+type ViewsRedis interface {
+	TakeViewsCtx(ctx context.Context, seconds int) (map[uuid.UUID]int64, error)
+	AckViewsCtx(ctx context.Context) error
+	RankViewsCtx(ctx context.Context, key string, weights map[int64]float64, seconds int, limit int) ([]*entity.NewsScore, error)
+}
+
+// Views service, views are counted in redis by news service
+// and flushed to postgres in background
+type ViewsService struct {
+	logger       logger.Logger
+	config       *config.Config
+	storagePsql  ViewsPsql
+	storageRedis ViewsRedis
+	newsRedis    NewsRedis
+}
+
+// Views service constructor
+func NewViewsService(config *config.Config, storagePsql ViewsPsql, redis ViewsRedis, newsRedis NewsRedis, logger logger.Logger) *ViewsService {
+	return &ViewsService{
+		config:       config,
+		storagePsql:  storagePsql,
+		storageRedis: redis,
+		newsRedis:    newsRedis,
+		logger:       logger,
+	}
+}
+
+// Get published news ranked by unique views within window with time decay
+func (v *ViewsService) GetTrending(ctx context.Context, query *entity.TrendingQuery) (*entity.TrendingList, error) {
+	if query.Size == 0 {
+		query.Size = v.config.Views.TrendingSize
+	}
+	if err := utils.ValidateStruct(ctx, query); err != nil {
+		return nil, httpe.NewBadRequestError(errors.WithMessage(err, "ViewsService.GetTrending.ValidateStruct"))
+	}
+
+	// ranking may hold news which are not published anymore
+	weights := trendingWeights(time.Now(), trendingWindows[query.Window])
+	scores, err := v.storageRedis.RankViewsCtx(ctx, query.Window, weights, v.config.Views.CacheTTL, 2*query.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	newsIDs := make([]uuid.UUID, 0, len(scores))
+	for _, score := range scores {
+		newsIDs = append(newsIDs, score.NewsID)
+	}
+	newsList, err := v.storagePsql.GetTrendingNews(ctx, newsIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	newsByID := make(map[uuid.UUID]*entity.TrendingNews, len(newsList))
+	for _, news := range newsList {
+		newsByID[news.NewsID] = news
+	}
+
+	trending := &entity.TrendingList{Window: query.Window, News: make([]*entity.TrendingNews, 0, query.Size)}
+	for _, score := range scores {
+		news, ok := newsByID[score.NewsID]
+		if !ok {
+			continue
+		}
+		news.Score = score.Score
+		trending.News = append(trending.News, news)
+		if len(trending.News) == query.Size {
+			break
+		}
+	}
+	return trending, nil
+}
+
+// Add views counted in redis to postgres totals, returns number of news
+// flushed. Views stay in redis when postgres fails and are flushed again.
+func (v *ViewsService) FlushViews(ctx context.Context) (int, error) {
+	views, err := v.storageRedis.TakeViewsCtx(ctx, v.config.Scheduler.ViewsFlushInterval)
+	if err != nil {
+		return 0, err
+	}
+	if views == nil {
+		return 0, nil
+	}
+
+	if err := v.storagePsql.AddViews(ctx, views); err != nil {
+		return 0, err
+	}
+	if err := v.storageRedis.AckViewsCtx(ctx); err != nil {
+		return 0, err
+	}
+
+	// cached news hold views of postgres
+	for newsID := range views {
+		if err := v.newsRedis.DeleteNewsCtx(ctx, newsCacheKey(newsID.String())); err != nil {
+			v.logger.Errorf("ViewsService.FlushViews.DeleteNewsCtx: %v", err)
+		}
+	}
+	return len(views), nil
+}
+
+func viewsBucketOf(t time.Time) int64 {
+	return t.Unix() / int64(viewsBucket/time.Second)
+}
+
+// Weights of buckets within window before now. Views of bucket decay by
+// age of its part within window, partly covered buckets count by the
+// covered part of their elapsed time.
+func trendingWeights(now time.Time, window time.Duration) map[int64]float64 {
+	from := now.Add(-window)
+	halfLife := window / 4
+	weights := make(map[int64]float64)
+	for bucket := viewsBucketOf(from); bucket <= viewsBucketOf(now); bucket++ {
+		start := time.Unix(bucket*int64(viewsBucket/time.Second), 0)
+		end := start.Add(viewsBucket)
+		if end.After(now) {
+			end = now
+		}
+		covered := start
+		if from.After(covered) {
+			covered = from
+		}
+		if !end.After(covered) {
+			continue
+		}
+
+		fraction := float64(end.Sub(covered)) / float64(end.Sub(start))
+		age := now.Sub(covered.Add(end.Sub(covered) / 2))
+		weights[bucket] = fraction * math.Pow(0.5, float64(age)/float64(halfLife))
+	}
+	return weights
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	mockstorage "github.com/Edbeer/restapi/internal/storage/psql/mock"
+	mockredis "github.com/Edbeer/restapi/internal/storage/redis/mock"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_GetTrending(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Views: config.ViewsConfig{TrendingSize: 2, CacheTTL: 60}}
+	apiLogger := logger.NewApiLogger(nil)
+	mockViewsStorage := mockstorage.NewMockViewsPsql(ctrl)
+	mockViewsRedis := mockredis.NewMockViewsRedis(ctrl)
+	viewsService := NewViewsService(cfg, mockViewsStorage, mockViewsRedis, nil, apiLogger)
+
+	ctx := context.Background()
+
+	t.Run("Ranked", func(t *testing.T) {
+		firstID, deletedID, secondID, thirdID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+		mockViewsRedis.EXPECT().RankViewsCtx(ctx, "24h", gomock.Any(), 60, 4).Return([]*entity.NewsScore{
+			{NewsID: firstID, Score: 9},
+			{NewsID: deletedID, Score: 7},
+			{NewsID: secondID, Score: 5},
+			{NewsID: thirdID, Score: 1},
+		}, nil)
+		mockViewsStorage.EXPECT().GetTrendingNews(ctx, []uuid.UUID{firstID, deletedID, secondID, thirdID}).Return([]*entity.TrendingNews{
+			{News: entity.News{NewsID: thirdID}, Views: 100},
+			{News: entity.News{NewsID: secondID}, Views: 10},
+			{News: entity.News{NewsID: firstID}, Views: 20},
+		}, nil)
+
+		trending, err := viewsService.GetTrending(ctx, &entity.TrendingQuery{Window: entity.TrendingDay})
+		require.NoError(t, err)
+		require.Equal(t, "24h", trending.Window)
+		require.Len(t, trending.News, 2)
+		require.Equal(t, firstID, trending.News[0].NewsID)
+		require.Equal(t, 9.0, trending.News[0].Score)
+		require.Equal(t, secondID, trending.News[1].NewsID)
+	})
+
+	t.Run("Unknown window", func(t *testing.T) {
+		_, err := viewsService.GetTrending(ctx, &entity.TrendingQuery{Window: "2h"})
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, httpe.ParseErrors(err).Status())
+	})
+}
+
+func TestService_FlushViews(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Scheduler: config.SchedulerConfig{ViewsFlushInterval: 60}}
+	apiLogger := logger.NewApiLogger(nil)
+	mockViewsStorage := mockstorage.NewMockViewsPsql(ctrl)
+	mockViewsRedis := mockredis.NewMockViewsRedis(ctrl)
+	mockNewsRedis := mockredis.NewMockNewsRedis(ctrl)
+	viewsService := NewViewsService(cfg, mockViewsStorage, mockViewsRedis, mockNewsRedis, apiLogger)
+
+	ctx := context.Background()
+	newsID := uuid.New()
+	views := map[uuid.UUID]int64{newsID: 3}
+
+	t.Run("Flush", func(t *testing.T) {
+		mockViewsRedis.EXPECT().TakeViewsCtx(ctx, 60).Return(views, nil)
+		mockViewsStorage.EXPECT().AddViews(ctx, views).Return(nil)
+		mockViewsRedis.EXPECT().AckViewsCtx(ctx).Return(nil)
+		mockNewsRedis.EXPECT().DeleteNewsCtx(ctx, fmt.Sprintf("%s: %s", baseNewsPrefix, newsID)).Return(nil)
+
+		flushed, err := viewsService.FlushViews(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, flushed)
+	})
+
+	t.Run("Locked", func(t *testing.T) {
+		mockViewsRedis.EXPECT().TakeViewsCtx(ctx, 60).Return(nil, nil)
+
+		flushed, err := viewsService.FlushViews(ctx)
+		require.NoError(t, err)
+		require.Zero(t, flushed)
+	})
+
+	t.Run("Postgres error", func(t *testing.T) {
+		mockViewsRedis.EXPECT().TakeViewsCtx(ctx, 60).Return(views, nil)
+		mockViewsStorage.EXPECT().AddViews(ctx, views).Return(errors.New("connection refused"))
+
+		_, err := viewsService.FlushViews(ctx)
+		require.Error(t, err)
+	})
+}
+
+func TestService_TrendingWeights(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Hour", func(t *testing.T) {
+		now := time.Unix(100*3600+1800, 0)
+		weights := trendingWeights(now, time.Hour)
+		require.Len(t, weights, 2)
+		// current half hour and the last half of previous bucket
+		require.InDelta(t, 0.5, weights[100], 1e-9)
+		require.InDelta(t, 0.5*0.125, weights[99], 1e-9)
+	})
+
+	t.Run("Week", func(t *testing.T) {
+		now := time.Unix(1000*3600, 0)
+		weights := trendingWeights(now, 7*24*time.Hour)
+		require.Len(t, weights, 168)
+		require.Greater(t, weights[999], weights[900])
+		require.Greater(t, weights[900], weights[832])
+	})
+}
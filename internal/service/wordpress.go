@@ -0,0 +1,685 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"html"
+	"io"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/markdown"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/Edbeer/restapi/pkg/wxr"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	// Last name of placeholder users which have no last name in WordPress
+	wordPressLastName = "(WordPress)"
+	// Domain of placeholder emails of commenters without email
+	wordPressEmailDomain = "wordpress.invalid"
+
+	maxUserNameLength     = 32
+	maxCategoryNameLength = 64
+	maxCategorySlugLength = 80
+	maxNewsTitleLength    = 250
+	maxCommentLength      = 1024
+)
+
+var hrefPattern = regexp.MustCompile(`(?i)(href\s*=\s*["'])([^"']+)(["'])`)
+
+// WordPress StoragePsql interface
+type WordPressPsql interface {
+	GetImported(ctx context.Context, source string, kind string) (map[string]uuid.UUID, error)
+	GetImportedSlugs(ctx context.Context, source string) (map[string]string, error)
+	ImportUser(ctx context.Context, source string, sourceID string, user *entity.User) (bool, error)
+	ImportCategory(ctx context.Context, source string, sourceID string, category *entity.Category) (bool, error)
+	ImportNews(ctx context.Context, source string, sourceID string, news *entity.News) error
+	UpdateImportedContent(ctx context.Context, newsID uuid.UUID, content string, contentHTML string) error
+	ImportComment(ctx context.Context, source string, sourceID string, comment *entity.Comment) error
+}
+
+// WordPress import service. Authors and commenters become placeholder
+// users which can't sign in, entities imported before are skipped.
+type WordPressService struct {
+	logger      logger.Logger
+	config      *config.Config
+	storagePsql WordPressPsql
+	feedsRedis  FeedsRedis
+	sitemaps    NewsSitemaps
+	related     NewsRelated
+}
+
+// WordPress import service constructor
+func NewWordPressService(config *config.Config, storagePsql WordPressPsql, feedsRedis FeedsRedis, sitemaps NewsSitemaps, related NewsRelated, logger logger.Logger) *WordPressService {
+	return &WordPressService{
+		config:      config,
+		storagePsql: storagePsql,
+		feedsRedis:  feedsRedis,
+		sitemaps:    sitemaps,
+		related:     related,
+		logger:      logger,
+	}
+}
+
+// State of one WordPress import, entities are mapped by their source ids
+type wordPressImport struct {
+	*WordPressService
+	export     *wxr.Export
+	source     string
+	report     *entity.WordPressReport
+	users      map[string]uuid.UUID
+	categories map[string]*entity.Category
+	news       map[string]uuid.UUID
+	comments   map[string]uuid.UUID
+	links      *wordPressLinks
+	created    []*wordPressPost
+}
+
+// Post created by the import with its original content
+type wordPressPost struct {
+	item *wxr.Item
+	news *entity.News
+}
+
+// Import WordPress eXtended RSS export: posts with their categories, tags,
+// authors and approved comments. Links between posts are rewritten to news.
+func (w *WordPressService) Import(ctx context.Context, r io.Reader) (*entity.WordPressReport, error) {
+	export, err := wxr.Parse(r)
+	if err != nil {
+		return nil, httpe.NewBadRequestError(errors.WithMessage(err, "WordPressService.Import.Parse"))
+	}
+	source := wordPressSource(export.BlogURL())
+	if source == "" {
+		return nil, httpe.NewBadRequestError(errors.New("WordPressService.Import: export has no blog url"))
+	}
+
+	imp := &wordPressImport{
+		WordPressService: w,
+		export:           export,
+		source:           source,
+		report:           &entity.WordPressReport{Source: source, Errors: []*entity.WordPressError{}},
+		categories:       make(map[string]*entity.Category),
+		links:            newWordPressLinks(export.BlogURL()),
+	}
+	if err := imp.load(ctx); err != nil {
+		return nil, err
+	}
+
+	for _, author := range export.Authors {
+		imp.importAuthor(ctx, author)
+	}
+	for _, category := range export.Categories {
+		imp.importCategory(ctx, category.Nicename, category.Name)
+	}
+	imp.importPosts(ctx)
+	imp.rewriteLinks(ctx)
+	imp.importComments(ctx)
+
+	imp.invalidate(ctx)
+	return imp.report, nil
+}
+
+// Load entities imported before
+func (i *wordPressImport) load(ctx context.Context) error {
+	var err error
+	if i.users, err = i.storagePsql.GetImported(ctx, i.source, entity.ImportKindUser); err != nil {
+		return err
+	}
+	if i.news, err = i.storagePsql.GetImported(ctx, i.source, entity.ImportKindNews); err != nil {
+		return err
+	}
+	if i.comments, err = i.storagePsql.GetImported(ctx, i.source, entity.ImportKindComment); err != nil {
+		return err
+	}
+	categories, err := i.storagePsql.GetImported(ctx, i.source, entity.ImportKindCategory)
+	if err != nil {
+		return err
+	}
+	for nicename, categoryID := range categories {
+		i.categories[nicename] = &entity.Category{CategoryID: categoryID}
+	}
+
+	slugs, err := i.storagePsql.GetImportedSlugs(ctx, i.source)
+	if err != nil {
+		return err
+	}
+	for postID, slug := range slugs {
+		i.links.ids[postID] = slug
+	}
+	return nil
+}
+
+func (i *wordPressImport) fail(kind string, sourceID string, err error) {
+	i.report.Errors = append(i.report.Errors, &entity.WordPressError{Kind: kind, SourceID: sourceID, Error: err.Error()})
+	switch kind {
+	case entity.ImportKindUser:
+		i.report.Users.Failed++
+	case entity.ImportKindCategory:
+		i.report.Categories.Failed++
+	case entity.ImportKindNews:
+		i.report.News.Failed++
+	case entity.ImportKindComment:
+		i.report.Comments.Failed++
+	}
+}
+
+func (i *wordPressImport) importAuthor(ctx context.Context, author *wxr.Author) (uuid.UUID, bool) {
+	sourceID := "login:" + author.Login
+	if userID, ok := i.users[sourceID]; ok {
+		i.report.Users.Skipped++
+		return userID, true
+	}
+
+	email := strings.TrimSpace(author.Email)
+	if email == "" {
+		email = utils.Slugify(author.Login) + "@" + wordPressEmailDomain
+	}
+	firstName, lastName := author.FirstName, author.LastName
+	if strings.TrimSpace(firstName+lastName) == "" {
+		firstName = author.DisplayName
+	}
+	return i.importUser(ctx, sourceID, placeholderUser(firstName, lastName, author.Login, email))
+}
+
+// Commenter is the blog user or a placeholder by email or name
+func (i *wordPressImport) importCommenter(ctx context.Context, comment *wxr.Comment) (uuid.UUID, bool) {
+	if comment.UserID != "" && comment.UserID != "0" {
+		for _, author := range i.export.Authors {
+			if author.ID == comment.UserID {
+				if userID, ok := i.users["login:"+author.Login]; ok {
+					return userID, true
+				}
+			}
+		}
+	}
+
+	name := strings.TrimSpace(comment.Author)
+	if name == "" {
+		name = "Anonymous"
+	}
+	email := strings.ToLower(strings.TrimSpace(comment.AuthorEmail))
+	sourceID := "email:" + email
+	if email == "" {
+		sourceID = "name:" + name
+		email = utils.Slugify(name) + "@" + wordPressEmailDomain
+	}
+	if userID, ok := i.users[sourceID]; ok {
+		return userID, true
+	}
+	return i.importUser(ctx, sourceID, placeholderUser(name, "", "", email))
+}
+
+func (i *wordPressImport) importUser(ctx context.Context, sourceID string, user *entity.User) (uuid.UUID, bool) {
+	created, err := i.storagePsql.ImportUser(ctx, i.source, sourceID, user)
+	if err != nil {
+		i.fail(entity.ImportKindUser, sourceID, err)
+		return uuid.Nil, false
+	}
+	if created {
+		i.report.Users.Created++
+	} else {
+		i.report.Users.Skipped++
+	}
+	i.users[sourceID] = user.ID
+	return user.ID, true
+}
+
+// Import category with its parents, categories missing in the export are
+// named by posts
+func (i *wordPressImport) importCategory(ctx context.Context, nicename string, name string) *entity.Category {
+	var exported *wxr.Category
+	for _, c := range i.export.Categories {
+		if c.Nicename == nicename {
+			exported = c
+			name = c.Name
+			break
+		}
+	}
+
+	if category, ok := i.categories[nicename]; ok {
+		if category == nil {
+			return nil
+		}
+		if category.Name == "" {
+			i.report.Categories.Skipped++
+			category.Name = truncateRunes(strings.TrimSpace(html.UnescapeString(name)), maxCategoryNameLength)
+		}
+		return category
+	}
+	// parents of broken exports may loop
+	i.categories[nicename] = nil
+
+	category := &entity.Category{Name: truncateRunes(strings.TrimSpace(html.UnescapeString(name)), maxCategoryNameLength)}
+	if exported != nil {
+		if exported.Parent != "" {
+			if parent := i.importCategory(ctx, exported.Parent, exported.Parent); parent != nil {
+				category.ParentID = &parent.CategoryID
+			}
+		}
+		if exported.Description != "" {
+			description := truncateRunes(exported.Description, 1024)
+			category.Description = &description
+		}
+	}
+	category.Slug = wordPressCategorySlug(nicename)
+	if category.Name == "" {
+		category.Name = category.Slug
+	}
+
+	created, err := i.storagePsql.ImportCategory(ctx, i.source, nicename, category)
+	if err != nil {
+		i.fail(entity.ImportKindCategory, nicename, err)
+		return nil
+	}
+	if created {
+		i.report.Categories.Created++
+	} else {
+		i.report.Categories.Skipped++
+	}
+	i.categories[nicename] = category
+	return category
+}
+
+// Create news of posts, links to posts created later are rewritten afterwards
+func (i *wordPressImport) importPosts(ctx context.Context) {
+	attachments := make(map[string]string)
+	for _, item := range i.export.Items {
+		if item.PostType == wxr.PostTypeAttachment && item.AttachmentURL != "" {
+			attachments[item.PostID] = item.AttachmentURL
+		}
+	}
+	for _, item := range i.export.Items {
+		if item.PostType == wxr.PostTypePost {
+			i.links.addPost(item)
+		}
+	}
+	for _, category := range i.export.Categories {
+		i.links.categories[category.Nicename] = wordPressCategorySlug(category.Nicename)
+	}
+
+	for _, item := range i.export.Items {
+		if item.PostType != wxr.PostTypePost {
+			continue
+		}
+		status, ok := wordPressStatus(item)
+		if !ok {
+			continue
+		}
+		if _, ok := i.news[item.PostID]; ok {
+			i.report.News.Skipped++
+			continue
+		}
+
+		news, err := i.prepareNews(ctx, item, status, attachments[item.MetaValue("_thumbnail_id")])
+		if err != nil {
+			i.fail(entity.ImportKindNews, item.PostID, err)
+			continue
+		}
+		if err := i.storagePsql.ImportNews(ctx, i.source, item.PostID, news); err != nil {
+			i.fail(entity.ImportKindNews, item.PostID, err)
+			continue
+		}
+
+		i.report.News.Created++
+		i.news[item.PostID] = news.NewsID
+		i.links.ids[item.PostID] = news.Slug
+		i.created = append(i.created, &wordPressPost{item: item, news: news})
+	}
+}
+
+func (i *wordPressImport) prepareNews(ctx context.Context, item *wxr.Item, status string, imageURL string) (*entity.News, error) {
+	title := truncateRunes(strings.TrimSpace(item.Title), maxNewsTitleLength)
+	if title == "" {
+		return nil, errors.New("post has no title")
+	}
+	if strings.TrimSpace(item.Content) == "" {
+		return nil, errors.New("post has no content")
+	}
+	if item.Creator == "" {
+		return nil, errors.New("post has no author")
+	}
+	authorID, ok := i.users["login:"+item.Creator]
+	if !ok {
+		if authorID, ok = i.importAuthor(ctx, &wxr.Author{Login: item.Creator, DisplayName: item.Creator}); !ok {
+			return nil, errors.Errorf("author %q is not imported", item.Creator)
+		}
+	}
+
+	news := &entity.News{
+		AuthorID: authorID,
+		Title:    title,
+		Slug:     item.Slug(),
+		Content:  i.links.rewrite(item.Content),
+		Status:   status,
+		Locale:   utils.NormalizeLocale(i.export.Language),
+	}
+	for language, locale := range languageLocales {
+		if strings.HasPrefix(news.Locale, locale) && (len(news.Locale) == len(locale) || news.Locale[len(locale)] == '-') {
+			news.Language = language
+		}
+	}
+	if imageURL != "" {
+		news.ImageURL = &imageURL
+	}
+	if published := item.Published(); published != nil {
+		news.CreatedAt = *published
+		if status != entity.NewsStatusDraft {
+			news.PublishAt = published
+		}
+	}
+	if modified := item.Modified(); modified != nil {
+		news.UpdatedAt = *modified
+	}
+
+	if terms := item.TermsOf(wxr.DomainCategory); len(terms) > 0 {
+		if category := i.importCategory(ctx, terms[0].Nicename, terms[0].Name); category != nil {
+			news.CategoryID = &category.CategoryID
+			news.Category = &category.Name
+		}
+	}
+	seen := make(map[string]bool)
+	for _, term := range item.TermsOf(wxr.DomainTag) {
+		name, err := normalizeTag(html.UnescapeString(term.Name))
+		if err != nil || seen[name] || len(news.Tags) == entity.MaxNewsTags {
+			continue
+		}
+		seen[name] = true
+		news.Tags = append(news.Tags, name)
+	}
+
+	if err := prepareNewsStatus(news, ""); err != nil {
+		return nil, err
+	}
+	var err error
+	if news.ContentHTML, err = markdown.ToHTML(news.Content); err != nil {
+		return nil, err
+	}
+	return news, nil
+}
+
+// Rewrite links to posts which were created after the linking ones
+func (i *wordPressImport) rewriteLinks(ctx context.Context) {
+	for _, post := range i.created {
+		content := i.links.rewrite(post.item.Content)
+		if content == post.news.Content {
+			continue
+		}
+		contentHTML, err := markdown.ToHTML(content)
+		if err != nil {
+			i.fail(entity.ImportKindNews, post.item.PostID, err)
+			continue
+		}
+		if err := i.storagePsql.UpdateImportedContent(ctx, post.news.NewsID, content, contentHTML); err != nil {
+			i.fail(entity.ImportKindNews, post.item.PostID, err)
+			continue
+		}
+		post.news.Content, post.news.ContentHTML = content, contentHTML
+	}
+}
+
+// Import approved comments of imported posts, replies follow their parents
+func (i *wordPressImport) importComments(ctx context.Context) {
+	for _, item := range i.export.Items {
+		newsID, ok := i.news[item.PostID]
+		if !ok {
+			continue
+		}
+
+		approved := make(map[string]*wxr.Comment)
+		for _, comment := range item.Comments {
+			if comment.IsApproved() {
+				approved[comment.ID] = comment
+			}
+		}
+
+		done := make(map[string]bool, len(approved))
+		for len(done) < len(approved) {
+			progress := false
+			for _, comment := range item.Comments {
+				if done[comment.ID] || approved[comment.ID] == nil {
+					continue
+				}
+				// replies to comments which aren't imported become top level
+				if parent := approved[comment.Parent]; parent != nil && !done[parent.ID] && parent.ID != comment.ID {
+					continue
+				}
+				done[comment.ID] = true
+				progress = true
+				i.importComment(ctx, newsID, comment)
+			}
+			if !progress {
+				for id := range approved {
+					if !done[id] {
+						done[id] = true
+						i.fail(entity.ImportKindComment, id, errors.New("comment thread loops"))
+					}
+				}
+			}
+		}
+	}
+}
+
+func (i *wordPressImport) importComment(ctx context.Context, newsID uuid.UUID, comment *wxr.Comment) {
+	if _, ok := i.comments[comment.ID]; ok {
+		i.report.Comments.Skipped++
+		return
+	}
+
+	message := truncateRunes(strings.TrimSpace(i.links.rewrite(comment.Content)), maxCommentLength)
+	if message == "" {
+		i.fail(entity.ImportKindComment, comment.ID, errors.New("comment has no content"))
+		return
+	}
+	authorID, ok := i.importCommenter(ctx, comment)
+	if !ok {
+		i.fail(entity.ImportKindComment, comment.ID, errors.New("author of comment is not imported"))
+		return
+	}
+
+	imported := &entity.Comment{
+		AuthorID: authorID,
+		NewsID:   newsID,
+		Message:  message,
+	}
+	if parentID, ok := i.comments[comment.Parent]; ok {
+		imported.ParentID = &parentID
+	}
+	if created := comment.Created(); created != nil {
+		imported.CreatedAt = *created
+	}
+	var err error
+	if imported.MessageHTML, err = markdown.ToHTML(imported.Message); err != nil {
+		i.fail(entity.ImportKindComment, comment.ID, err)
+		return
+	}
+
+	if err := i.storagePsql.ImportComment(ctx, i.source, comment.ID, imported); err != nil {
+		i.fail(entity.ImportKindComment, comment.ID, err)
+		return
+	}
+	i.report.Comments.Created++
+	i.comments[comment.ID] = imported.CommentID
+}
+
+// Index created news, suggestions are rebuilt separately
+func (i *wordPressImport) invalidate(ctx context.Context) {
+	if len(i.created) == 0 {
+		return
+	}
+	for _, post := range i.created {
+		if post.news.Status != entity.NewsStatusPublished {
+			continue
+		}
+		if err := i.sitemaps.InvalidateNews(ctx, post.news.NewsID, post.news.PublishAt); err != nil {
+			i.logger.Errorf("WordPressService.Import.InvalidateNews: %v", err)
+		}
+		if err := i.related.MarkStale(ctx, post.news.NewsID); err != nil {
+			i.logger.Errorf("WordPressService.Import.MarkStale: %v", err)
+		}
+	}
+	if err := i.feedsRedis.InvalidateFeedsCtx(ctx); err != nil {
+		i.logger.Errorf("WordPressService.Import.InvalidateFeedsCtx: %v", err)
+	}
+}
+
+// Links of blog mapped to news and categories
+type wordPressLinks struct {
+	host       string
+	basePath   string
+	posts      map[string]string
+	ids        map[string]string
+	categories map[string]string
+}
+
+func newWordPressLinks(blogURL string) *wordPressLinks {
+	links := &wordPressLinks{
+		posts:      make(map[string]string),
+		ids:        make(map[string]string),
+		categories: make(map[string]string),
+	}
+	if u, err := url.Parse(blogURL); err == nil {
+		links.host = strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+		links.basePath = strings.TrimSuffix(u.Path, "/")
+	}
+	return links
+}
+
+// Permalink and guid of post point to it
+func (l *wordPressLinks) addPost(item *wxr.Item) {
+	for _, link := range []string{item.Link, item.GUID} {
+		u, err := url.Parse(link)
+		if err != nil || u.Query().Get("p") != "" {
+			continue
+		}
+		if path := strings.TrimSuffix(u.Path, "/"); path != "" && path != l.basePath {
+			l.posts[path] = item.PostID
+		}
+	}
+}
+
+// Rewrite links of html to imported posts and categories
+func (l *wordPressLinks) rewrite(content string) string {
+	return hrefPattern.ReplaceAllStringFunc(content, func(match string) string {
+		parts := hrefPattern.FindStringSubmatch(match)
+		if target := l.resolve(html.UnescapeString(parts[2])); target != "" {
+			return parts[1] + target + parts[3]
+		}
+		return match
+	})
+}
+
+func (l *wordPressLinks) resolve(link string) string {
+	u, err := url.Parse(link)
+	if err != nil || (u.Scheme != "" && u.Scheme != "http" && u.Scheme != "https") {
+		return ""
+	}
+	if u.Host != "" && strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.") != l.host {
+		return ""
+	}
+	if u.Host == "" && !strings.HasPrefix(u.Path, "/") {
+		return ""
+	}
+
+	path := strings.TrimSuffix(u.Path, "/")
+	var target string
+	if postID := u.Query().Get("p"); postID != "" && (path == l.basePath || path == l.basePath+"/index.php") {
+		if slug, ok := l.ids[postID]; ok {
+			target = "/news/" + slug
+		}
+	} else if postID, ok := l.posts[path]; ok {
+		if slug, ok := l.ids[postID]; ok {
+			target = "/news/" + slug
+		}
+	} else if strings.HasPrefix(path, l.basePath+"/category/") {
+		nicename := path[strings.LastIndexByte(path, '/')+1:]
+		if slug, ok := l.categories[nicename]; ok {
+			target = "/categories/" + slug
+		}
+	}
+
+	if target != "" && u.Fragment != "" {
+		target += "#" + u.Fragment
+	}
+	return target
+}
+
+// Source of blog by its host and path, scheme changes keep the source
+func wordPressSource(blogURL string) string {
+	u, err := url.Parse(blogURL)
+	if err != nil || u.Host == "" {
+		return ""
+	}
+	return "wordpress:" + strings.TrimPrefix(strings.ToLower(u.Host), "www.") + strings.TrimSuffix(u.Path, "/")
+}
+
+// News status of post, trashed and auto drafts are not imported
+func wordPressStatus(item *wxr.Item) (string, bool) {
+	switch item.Status {
+	case wxr.StatusPublish:
+		return entity.NewsStatusPublished, true
+	case wxr.StatusFuture:
+		if published := item.Published(); published != nil && published.After(time.Now()) {
+			return entity.NewsStatusScheduled, true
+		}
+		return entity.NewsStatusPublished, true
+	case wxr.StatusDraft, wxr.StatusPending, wxr.StatusPrivate:
+		return entity.NewsStatusDraft, true
+	}
+	return "", false
+}
+
+func wordPressCategorySlug(nicename string) string {
+	if unescaped, err := url.PathUnescape(nicename); err == nil {
+		nicename = unescaped
+	}
+	slug := truncateRunes(utils.Slugify(nicename), maxCategorySlugLength)
+	if slug == "" {
+		return "category"
+	}
+	return strings.Trim(slug, "-")
+}
+
+// Placeholder user has random password which never matches a hash
+func placeholderUser(firstName string, lastName string, fallback string, email string) *entity.User {
+	names := strings.Fields(firstName)
+	if strings.TrimSpace(lastName) == "" && len(names) > 1 {
+		firstName, lastName = names[0], strings.Join(names[1:], " ")
+	}
+	firstName, lastName = strings.TrimSpace(firstName), strings.TrimSpace(lastName)
+	if firstName == "" {
+		firstName = fallback
+	}
+	if firstName == "" {
+		firstName = "Anonymous"
+	}
+	if lastName == "" {
+		lastName = wordPressLastName
+	}
+
+	password := make([]byte, 16)
+	_, _ = rand.Read(password)
+	return &entity.User{
+		FirstName: truncateRunes(firstName, maxUserNameLength),
+		LastName:  truncateRunes(lastName, maxUserNameLength),
+		Email:     email,
+		Password:  "!" + hex.EncodeToString(password),
+	}
+}
+
+func truncateRunes(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max])
+}
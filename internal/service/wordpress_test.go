@@ -0,0 +1,271 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	mockservice "github.com/Edbeer/restapi/internal/service/mock"
+	mockstorage "github.com/Edbeer/restapi/internal/storage/psql/mock"
+	mockredis "github.com/Edbeer/restapi/internal/storage/redis/mock"
+	"github.com/Edbeer/restapi/pkg/logger"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+const testWordPressExport = `<?xml version="1.0" encoding="UTF-8" ?>
+<rss version="2.0"
+	xmlns:content="http://purl.org/rss/1.0/modules/content/"
+	xmlns:dc="http://purl.org/dc/elements/1.1/"
+	xmlns:wp="http://wordpress.org/export/1.2/">
+<channel>
+	<title>Old blog</title>
+	<link>https://www.example.com</link>
+	<language>de-DE</language>
+	<wp:base_blog_url>https://www.example.com</wp:base_blog_url>
+	<wp:author>
+		<wp:author_id>1</wp:author_id>
+		<wp:author_login>jane</wp:author_login>
+		<wp:author_email>jane@example.com</wp:author_email>
+		<wp:author_display_name>Jane Doe</wp:author_display_name>
+	</wp:author>
+	<wp:category>
+		<wp:category_nicename>europe</wp:category_nicename>
+		<wp:category_parent>travel</wp:category_parent>
+		<wp:cat_name>Europe</wp:cat_name>
+	</wp:category>
+	<wp:category>
+		<wp:category_nicename>travel</wp:category_nicename>
+		<wp:cat_name>Travel</wp:cat_name>
+	</wp:category>
+	<item>
+		<title>Rain in Berlin</title>
+		<link>https://www.example.com/2020/05/rain-in-berlin/</link>
+		<dc:creator>jane</dc:creator>
+		<content:encoded><![CDATA[<p>See <a href="http://example.com/2020/06/sun-in-madrid/#weather">Madrid</a> in <a href='/category/travel/europe/'>Europe</a>, <a href="https://other.com/2020/06/sun-in-madrid/">elsewhere</a>.</p>]]></content:encoded>
+		<wp:post_id>10</wp:post_id>
+		<wp:post_date_gmt>2020-05-01 10:00:00</wp:post_date_gmt>
+		<wp:post_name>rain-in-berlin</wp:post_name>
+		<wp:status>publish</wp:status>
+		<wp:post_type>post</wp:post_type>
+		<category domain="category" nicename="europe"><![CDATA[Europe]]></category>
+		<category domain="post_tag" nicename="rain"><![CDATA[Rain]]></category>
+		<wp:postmeta>
+			<wp:meta_key>_thumbnail_id</wp:meta_key>
+			<wp:meta_value>12</wp:meta_value>
+		</wp:postmeta>
+		<wp:comment>
+			<wp:comment_id>103</wp:comment_id>
+			<wp:comment_author>Bob</wp:comment_author>
+			<wp:comment_author_email>Bob@example.com</wp:comment_author_email>
+			<wp:comment_content>Thanks again</wp:comment_content>
+			<wp:comment_approved>1</wp:comment_approved>
+			<wp:comment_parent>101</wp:comment_parent>
+		</wp:comment>
+		<wp:comment>
+			<wp:comment_id>100</wp:comment_id>
+			<wp:comment_content>Welcome</wp:comment_content>
+			<wp:comment_approved>1</wp:comment_approved>
+			<wp:comment_parent>0</wp:comment_parent>
+			<wp:comment_user_id>1</wp:comment_user_id>
+		</wp:comment>
+		<wp:comment>
+			<wp:comment_id>101</wp:comment_id>
+			<wp:comment_author>Bob</wp:comment_author>
+			<wp:comment_author_email>bob@example.com</wp:comment_author_email>
+			<wp:comment_content>Thanks</wp:comment_content>
+			<wp:comment_approved>1</wp:comment_approved>
+			<wp:comment_parent>100</wp:comment_parent>
+		</wp:comment>
+		<wp:comment>
+			<wp:comment_id>102</wp:comment_id>
+			<wp:comment_content>Cheap pills</wp:comment_content>
+			<wp:comment_approved>spam</wp:comment_approved>
+		</wp:comment>
+	</item>
+	<item>
+		<title>Sun in Madrid</title>
+		<link>https://www.example.com/2020/06/sun-in-madrid/</link>
+		<dc:creator>jane</dc:creator>
+		<content:encoded><![CDATA[<p>After <a href="https://www.example.com/?p=10">rain</a>.</p>]]></content:encoded>
+		<wp:post_id>11</wp:post_id>
+		<wp:post_date_gmt>2020-06-01 10:00:00</wp:post_date_gmt>
+		<wp:post_name>sun-in-madrid</wp:post_name>
+		<wp:status>draft</wp:status>
+		<wp:post_type>post</wp:post_type>
+	</item>
+	<item>
+		<title>rain.jpg</title>
+		<wp:post_id>12</wp:post_id>
+		<wp:post_type>attachment</wp:post_type>
+		<wp:attachment_url>https://www.example.com/uploads/rain.jpg</wp:attachment_url>
+	</item>
+	<item>
+		<title>Old post</title>
+		<dc:creator>jane</dc:creator>
+		<content:encoded>Old</content:encoded>
+		<wp:post_id>13</wp:post_id>
+		<wp:status>trash</wp:status>
+		<wp:post_type>post</wp:post_type>
+	</item>
+</channel>
+</rss>`
+
+func TestService_ImportWordPress(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockWordPressStorage := mockstorage.NewMockWordPressPsql(ctrl)
+	mockFeedsRedis := mockredis.NewMockFeedsRedis(ctrl)
+	mockSitemaps := mockservice.NewMockSitemaps(ctrl)
+	mockRelated := mockservice.NewMockRelated(ctrl)
+	wordPressService := NewWordPressService(nil, mockWordPressStorage, mockFeedsRedis, mockSitemaps, mockRelated, apiLogger)
+
+	ctx := context.Background()
+	source := "wordpress:example.com"
+	janeID, bobID := uuid.New(), uuid.New()
+	travelID, europeID := uuid.New(), uuid.New()
+	rainID, sunID := uuid.New(), uuid.New()
+	welcomeID, thanksID, againID := uuid.New(), uuid.New(), uuid.New()
+
+	t.Run("Import", func(t *testing.T) {
+		for _, kind := range []string{entity.ImportKindUser, entity.ImportKindNews, entity.ImportKindComment, entity.ImportKindCategory} {
+			mockWordPressStorage.EXPECT().GetImported(ctx, source, kind).Return(map[string]uuid.UUID{}, nil)
+		}
+		mockWordPressStorage.EXPECT().GetImportedSlugs(ctx, source).Return(map[string]string{}, nil)
+
+		mockWordPressStorage.EXPECT().ImportUser(ctx, source, "login:jane", gomock.Any()).DoAndReturn(
+			func(ctx context.Context, source string, sourceID string, user *entity.User) (bool, error) {
+				require.Equal(t, "Jane", user.FirstName)
+				require.Equal(t, "Doe", user.LastName)
+				require.Equal(t, "jane@example.com", user.Email)
+				user.ID = janeID
+				return true, nil
+			},
+		)
+		mockWordPressStorage.EXPECT().ImportCategory(ctx, source, "travel", gomock.Any()).DoAndReturn(
+			func(ctx context.Context, source string, sourceID string, category *entity.Category) (bool, error) {
+				require.Nil(t, category.ParentID)
+				category.CategoryID = travelID
+				return true, nil
+			},
+		)
+		mockWordPressStorage.EXPECT().ImportCategory(ctx, source, "europe", gomock.Any()).DoAndReturn(
+			func(ctx context.Context, source string, sourceID string, category *entity.Category) (bool, error) {
+				require.Equal(t, travelID, *category.ParentID)
+				require.Equal(t, "Europe", category.Name)
+				category.CategoryID = europeID
+				return false, nil
+			},
+		)
+
+		mockWordPressStorage.EXPECT().ImportNews(ctx, source, "10", gomock.Any()).DoAndReturn(
+			func(ctx context.Context, source string, sourceID string, news *entity.News) error {
+				require.Equal(t, janeID, news.AuthorID)
+				require.Equal(t, entity.NewsStatusPublished, news.Status)
+				require.Equal(t, europeID, *news.CategoryID)
+				require.Equal(t, "https://www.example.com/uploads/rain.jpg", *news.ImageURL)
+				require.Equal(t, []string{"rain"}, news.Tags)
+				require.Equal(t, "de-DE", news.Locale)
+				require.Equal(t, "german", news.Language)
+				require.Contains(t, news.Content, `href="http://example.com/2020/06/sun-in-madrid/#weather"`)
+				require.Contains(t, news.Content, `href='/categories/europe'`)
+				news.NewsID, news.Slug = rainID, "rain-in-berlin"
+				return nil
+			},
+		)
+		mockWordPressStorage.EXPECT().ImportNews(ctx, source, "11", gomock.Any()).DoAndReturn(
+			func(ctx context.Context, source string, sourceID string, news *entity.News) error {
+				require.Equal(t, entity.NewsStatusDraft, news.Status)
+				require.Nil(t, news.PublishAt)
+				require.Contains(t, news.Content, `href="/news/rain-in-berlin"`)
+				news.NewsID, news.Slug = sunID, "sun-in-madrid"
+				return nil
+			},
+		)
+		mockWordPressStorage.EXPECT().UpdateImportedContent(ctx, rainID, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, newsID uuid.UUID, content string, contentHTML string) error {
+				require.Contains(t, content, `href="/news/sun-in-madrid#weather"`)
+				require.Contains(t, content, `href="https://other.com/2020/06/sun-in-madrid/"`)
+				return nil
+			},
+		)
+
+		mockWordPressStorage.EXPECT().ImportComment(ctx, source, "100", gomock.Any()).DoAndReturn(
+			func(ctx context.Context, source string, sourceID string, comment *entity.Comment) error {
+				require.Equal(t, janeID, comment.AuthorID)
+				require.Equal(t, rainID, comment.NewsID)
+				require.Nil(t, comment.ParentID)
+				comment.CommentID = welcomeID
+				return nil
+			},
+		)
+		mockWordPressStorage.EXPECT().ImportUser(ctx, source, "email:bob@example.com", gomock.Any()).DoAndReturn(
+			func(ctx context.Context, source string, sourceID string, user *entity.User) (bool, error) {
+				require.Equal(t, "Bob", user.FirstName)
+				require.Equal(t, wordPressLastName, user.LastName)
+				user.ID = bobID
+				return true, nil
+			},
+		)
+		mockWordPressStorage.EXPECT().ImportComment(ctx, source, "101", gomock.Any()).DoAndReturn(
+			func(ctx context.Context, source string, sourceID string, comment *entity.Comment) error {
+				require.Equal(t, bobID, comment.AuthorID)
+				require.Equal(t, welcomeID, *comment.ParentID)
+				comment.CommentID = thanksID
+				return nil
+			},
+		)
+		mockWordPressStorage.EXPECT().ImportComment(ctx, source, "103", gomock.Any()).DoAndReturn(
+			func(ctx context.Context, source string, sourceID string, comment *entity.Comment) error {
+				require.Equal(t, bobID, comment.AuthorID)
+				require.Equal(t, thanksID, *comment.ParentID)
+				comment.CommentID = againID
+				return nil
+			},
+		)
+
+		mockSitemaps.EXPECT().InvalidateNews(ctx, rainID, gomock.Any()).Return(nil)
+		mockRelated.EXPECT().MarkStale(ctx, rainID).Return(nil)
+		mockFeedsRedis.EXPECT().InvalidateFeedsCtx(ctx).Return(nil)
+
+		report, err := wordPressService.Import(ctx, strings.NewReader(testWordPressExport))
+		require.NoError(t, err)
+		require.Empty(t, report.Errors)
+		require.Equal(t, entity.ImportCount{Created: 2}, report.Users)
+		require.Equal(t, entity.ImportCount{Created: 1, Skipped: 1}, report.Categories)
+		require.Equal(t, entity.ImportCount{Created: 2}, report.News)
+		require.Equal(t, entity.ImportCount{Created: 3}, report.Comments)
+	})
+
+	t.Run("Re-run", func(t *testing.T) {
+		mockWordPressStorage.EXPECT().GetImported(ctx, source, entity.ImportKindUser).Return(map[string]uuid.UUID{
+			"login:jane": janeID, "email:bob@example.com": bobID,
+		}, nil)
+		mockWordPressStorage.EXPECT().GetImported(ctx, source, entity.ImportKindNews).Return(map[string]uuid.UUID{
+			"10": rainID, "11": sunID,
+		}, nil)
+		mockWordPressStorage.EXPECT().GetImported(ctx, source, entity.ImportKindComment).Return(map[string]uuid.UUID{
+			"100": welcomeID, "101": thanksID, "103": againID,
+		}, nil)
+		mockWordPressStorage.EXPECT().GetImported(ctx, source, entity.ImportKindCategory).Return(map[string]uuid.UUID{
+			"travel": travelID, "europe": europeID,
+		}, nil)
+		mockWordPressStorage.EXPECT().GetImportedSlugs(ctx, source).Return(map[string]string{
+			"10": "rain-in-berlin", "11": "sun-in-madrid",
+		}, nil)
+
+		report, err := wordPressService.Import(ctx, strings.NewReader(testWordPressExport))
+		require.NoError(t, err)
+		require.Empty(t, report.Errors)
+		require.Equal(t, entity.ImportCount{Skipped: 1}, report.Users)
+		require.Equal(t, entity.ImportCount{Skipped: 2}, report.Categories)
+		require.Equal(t, entity.ImportCount{Skipped: 2}, report.News)
+		require.Equal(t, entity.ImportCount{Skipped: 3}, report.Comments)
+	})
+}
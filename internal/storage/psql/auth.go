@@ -5,12 +5,26 @@ import (
 	"database/sql"
 
 	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/filter"
 	"github.com/Edbeer/restapi/pkg/utils"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
 )
 
+// Fields users lists can be filtered and sorted by
+var usersFilterSchema = filter.Schema{
+	"user_id":    {Column: "user_id", Type: filter.TypeUUID},
+	"first_name": {Column: "first_name", Type: filter.TypeString, Sortable: true},
+	"last_name":  {Column: "last_name", Type: filter.TypeString, Sortable: true},
+	"email":      {Column: "email", Type: filter.TypeString, Sortable: true},
+	"role":       {Column: "role", Type: filter.TypeString},
+	"city":       {Column: "city", Type: filter.TypeString, Sortable: true},
+	"country":    {Column: "country", Type: filter.TypeString, Sortable: true},
+	"created_at": {Column: "created_at", Type: filter.TypeTime, Sortable: true},
+	"updated_at": {Column: "updated_at", Type: filter.TypeTime, Sortable: true},
+}
+
 // Auth Storage
 type AuthStorage struct {
 	psql *sqlx.DB
@@ -35,25 +49,28 @@ func (a *AuthStorage) Register(ctx context.Context, user *entity.User) (*entity.
 	return u, nil
 }
 
-// Update user
+// Update user of version, 0 updates any version
 func (a *AuthStorage) Update(ctx context.Context, user *entity.User) (*entity.User, error) {
 	u := &entity.User{}
 	if err := a.psql.GetContext(ctx, u, updateUserQuery,
 		&user.FirstName, &user.LastName, &user.Email,
 		&user.Role, &user.Avatar, &user.PhoneNumber,
 		&user.Address, &user.City, &user.Country,
-		&user.Postcode, &user.ID,
+		&user.Postcode, &user.ID, &user.Version,
 	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, versionConflict(ctx, a.psql, userExists, user.ID, user.Version, "AuthStoragePsql.Update.GetContext")
+		}
 		return nil, errors.Wrap(err, "AuthStoragePsql.Update.GetContext")
 	}
 
 	return u, nil
 }
 
-// Delete user
-func (a *AuthStorage) Delete(ctx context.Context, userID uuid.UUID) error {
+// Delete user of version, 0 deletes any version
+func (a *AuthStorage) Delete(ctx context.Context, userID uuid.UUID, version int) error {
 
-	result, err := a.psql.ExecContext(ctx, deleteUserQuery, userID)
+	result, err := a.psql.ExecContext(ctx, deleteUserQuery, userID, version)
 	if err != nil {
 		return errors.Wrap(err, "AuthStoragePsql.Delete.Context")
 	}
@@ -63,7 +80,7 @@ func (a *AuthStorage) Delete(ctx context.Context, userID uuid.UUID) error {
 		return errors.Wrap(err, "AuthStoragePsql.Delete.RowsAffected")
 	}
 	if rowsAffected == 0 {
-		return errors.Wrap(sql.ErrNoRows, "AuthStoragePsql.Delete.rowsAffected")
+		return versionConflict(ctx, a.psql, userExists, userID, version, "AuthStoragePsql.Delete.rowsAffected")
 	}
 
 	return nil
@@ -79,12 +96,15 @@ func (a *AuthStorage) GetUserByID(ctx context.Context, userID uuid.UUID) (*entit
 	return u, nil
 }
 
-// Find users by name
+// Fuzzy search users by first name, last name and email ordered by trigram similarity
 func (a *AuthStorage) FindUsersByName(ctx context.Context,
-	name string, pq *utils.PaginationQuery) (*entity.UsersList, error) {
+	search *entity.UserSearchQuery, pq *utils.PaginationQuery) (*entity.UsersList, error) {
 
 	var totalCount int
-	if err := a.psql.GetContext(ctx, &totalCount, getTotalCount, name); err != nil {
+	if err := a.psql.GetContext(ctx, &totalCount, getTotalCount,
+		search.Name, search.Role, search.Country,
+		search.City, search.CreatedFrom, search.CreatedTo,
+	); err != nil {
 		return nil, errors.Wrap(err, "AuthStoragePsql.FindUsersByName.GetContext")
 	}
 
@@ -99,7 +119,11 @@ func (a *AuthStorage) FindUsersByName(ctx context.Context,
 		}, nil
 	}
 
-	rows, err := a.psql.QueryxContext(ctx, findUsersByName, name, pq.GetDifference(), pq.GetLimit())
+	rows, err := a.psql.QueryxContext(ctx, findUsersByName,
+		search.Name, search.Role, search.Country,
+		search.City, search.CreatedFrom, search.CreatedTo,
+		pq.GetLimit(), pq.GetOffset(),
+	)
 	if err != nil {
 		return nil, errors.Wrap(err, "AuthStoragePsql.FindUsersByName.QueryxContext")
 	}
@@ -128,45 +152,55 @@ func (a *AuthStorage) FindUsersByName(ctx context.Context,
 	}, nil
 }
 
-// Get users
+// Get users, newest first
 func (a *AuthStorage) GetUsers(ctx context.Context, pq *utils.PaginationQuery) (*entity.UsersList, error) {
-
-	var totalCount int
-	if err := a.psql.GetContext(ctx, &totalCount, getTotal); err != nil {
-		return nil, errors.Wrap(err, "AuthStoragePsql.GetUsers.GetContext")
+	list := &entity.UsersList{
+		Page: pq.GetPage(),
+		Size: pq.GetSize(),
 	}
-
-	if totalCount == 0 {
-		return &entity.UsersList{
-			TotalCount: totalCount,
-			TotalPages: utils.GetTotalPages(totalCount, pq.GetSize()),
-			Page:       pq.GetPage(),
-			Size:       pq.GetSize(),
-			HasMore:    utils.GetHasMore(pq.GetPage(), totalCount, pq.GetSize()),
-			Users:      make([]*entity.User, 0),
-		}, nil
+	if pq.WithTotal {
+		query, args, err := countQuery(getTotal, pq, usersFilterSchema, "users")
+		if err != nil {
+			return nil, errors.Wrap(err, "AuthStoragePsql.GetUsers.countQuery")
+		}
+		if err := a.psql.GetContext(ctx, &list.TotalCount, query, args...); err != nil {
+			return nil, errors.Wrap(err, "AuthStoragePsql.GetUsers.GetContext")
+		}
+		list.TotalPages = utils.GetTotalPages(list.TotalCount, pq.GetSize())
 	}
 
-	users := make([]*entity.User, 0, pq.GetSize())
-	if err := a.psql.SelectContext(
-		ctx,
-		&users,
+	users := make([]*entity.User, 0, pq.GetKeysetLimit())
+	cursorID, cursorTime := pq.GetCursorArgs()
+	query, args, err := listQuery(
 		getUsers,
-		pq.GetOrderBy(),
-		pq.GetDifference(),
-		pq.GetLimit(),
-	); err != nil {
+		true,
+		pq,
+		usersFilterSchema,
+		"users",
+		pq.GetKeysetLimit(),
+		pq.GetKeysetOffset(),
+		cursorID,
+		cursorTime,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "AuthStoragePsql.GetUsers.listQuery")
+	}
+	if err := a.psql.SelectContext(ctx, &users, query, args...); err != nil {
 		return nil, errors.Wrap(err, "AuthStorage.GetUsers.SelectContext")
 	}
 
-	return &entity.UsersList{
-		TotalCount: totalCount,
-		TotalPages: utils.GetTotalPages(totalCount, pq.GetSize()),
-		Page:       pq.GetPage(),
-		Size:       pq.GetSize(),
-		HasMore:    utils.GetHasMore(pq.GetPage(), totalCount, pq.GetSize()),
-		Users:      users,
-	}, nil
+	users, hasNext, hasPrev := utils.KeysetPage(pq, users)
+	list.Users = users
+	list.HasMore = hasNext
+	if hasNext && pq.IsKeyset() && len(users) > 0 {
+		last := users[len(users)-1]
+		list.NextCursor = (&utils.Cursor{Time: last.CreatedAt, ID: last.ID}).Encode()
+	}
+	if hasPrev && pq.IsKeyset() && len(users) > 0 {
+		first := users[0]
+		list.PrevCursor = (&utils.Cursor{Time: first.CreatedAt, ID: first.ID, Backward: true}).Encode()
+	}
+	return list, nil
 }
 
 // Find user by email
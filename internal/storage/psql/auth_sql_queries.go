@@ -3,6 +3,7 @@ package psql
 const (
 	createUserQuery = `INSERT INTO users (first_name, last_name, email, password, role, avatar, phone_number, address, city, country, postcode, created_at, updated_at) VALUES ($1, $2, $3, $4, COALESCE(NULLIF($5, ''), 'user'), $6, $7, $8, $9, $10, $11, now(), now()) RETURNING *`
 
+	// version $12 of If-Match, 0 updates any version
 	updateUserQuery = `UPDATE users 
 					SET first_name = COALESCE(NULLIF($1, ''), first_name),
 						last_name = COALESCE(NULLIF($2, ''), last_name),
@@ -14,48 +15,64 @@ const (
 						city = COALESCE(NULLIF($8, ''), city),
 						country = COALESCE(NULLIF($9, ''), country),
 						postcode = COALESCE(NULLIF($10, 0), postcode),
+						version = version + 1,
 						updated_at = now()
-					WHERE user_id = $11
+					WHERE user_id = $11 AND ($12::int = 0 OR version = $12)
 					RETURNING *`
 
-	deleteUserQuery = `DELETE FROM users WHERE user_id = $1`
+	// version $2 of If-Match, 0 deletes any version
+	deleteUserQuery = `DELETE FROM users WHERE user_id = $1 AND ($2::int = 0 OR version = $2)`
+
+	userExists = `SELECT EXISTS (SELECT 1 FROM users WHERE user_id = $1)`
 
 	getUserByID = `SELECT user_id, first_name, last_name, 
 					email, password, role, avatar, 
 					phone_number, address, city, country, 
-					postcode, created_at, updated_at
+					postcode, version, created_at, updated_at
 				FROM users
 				WHERE user_id = $1`
 
-	findUsersByName = `SELECT first_name, last_name, 
-						email, password, role, avatar, 
+	findUsersByName = `SELECT user_id, first_name, last_name, 
+						email, role, avatar, 
 						phone_number, address, city, country, 
-						postcode, created_at, updated_at
+						postcode, version, created_at, updated_at
 					FROM users
-					WHERE first_name ILIKE '%' $1 '%' or last_name ILIKE '%' $1 '%'
-					ORDER BY first_name, last_name`
+					WHERE (first_name % $1 or last_name % $1 or email % $1)
+						and ($2 = '' or role = $2)
+						and ($3 = '' or country ILIKE $3)
+						and ($4 = '' or city ILIKE $4)
+						and ($5::timestamp IS NULL or created_at >= $5)
+						and ($6::timestamp IS NULL or created_at < $6)
+					ORDER BY GREATEST(similarity(first_name, $1), similarity(last_name, $1), similarity(email, $1)) DESC,
+						last_name, first_name, user_id
+					LIMIT $7 OFFSET $8`
 
-	getUsers = `SELECT first_name, last_name, 
-				email, password, role, avatar, 
+	// newest first, comparison with cursor $3 $4, order and the filter are filled by listQuery
+	getUsers = `SELECT user_id, first_name, last_name, 
+				email, role, avatar, 
 				phone_number, address, city, country, 
-				postcode, created_at, updated_at
+				postcode, version, created_at, updated_at
 			FROM users
-			WHERE user_id < (user_id + $1)
-			ORDER BY user_id DESC, COALESCE(NULLIF($2, ''), first_name)
-			LIMIT $3
-			`
+			WHERE ($3::uuid IS NULL OR (created_at, user_id) %[1]s ($4::timestamp, $3))%[3]s
+			ORDER BY %[4]screated_at %[2]s, user_id %[2]s
+			LIMIT $1 OFFSET $2`
 
-	getTotal = `SELECT COUNT(user_id) FROM users`
+	// conditions of the filter are filled by countQuery
+	getTotal = `SELECT COUNT(user_id) FROM users WHERE true%s`
 
 	getTotalCount = `SELECT COUNT(user_id) 
 					FROM users 
-					WHERE first_name ILIKE '%' || $1 || '%' 
-						or last_name ILIKE '%' || $1 || '%'`
+					WHERE (first_name % $1 or last_name % $1 or email % $1)
+						and ($2 = '' or role = $2)
+						and ($3 = '' or country ILIKE $3)
+						and ($4 = '' or city ILIKE $4)
+						and ($5::timestamp IS NULL or created_at >= $5)
+						and ($6::timestamp IS NULL or created_at < $6)`
 
 	findUserByEmail = `SELECT first_name, last_name, 
 						email, password, role, avatar, 
 						phone_number, address, city, country, 
-						postcode, created_at, updated_at
+						postcode, version, created_at, updated_at
 					FROM users
 					WHERE email = $1`
 )
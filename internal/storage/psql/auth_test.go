@@ -103,7 +103,7 @@ func TestPsql_Update(t *testing.T) {
 			&user.FirstName, &user.LastName, &user.Email,
 			&user.Role, &user.Avatar, &user.PhoneNumber,
 			&user.Address, &user.City, &user.Country,
-			&user.Postcode, &user.ID,
+			&user.Postcode, &user.ID, &user.Version,
 		).WillReturnRows(rows)
 
 		updatedUser, err := authStorage.Update(context.Background(), user)
@@ -128,18 +128,18 @@ func TestPsql_Delete(t *testing.T) {
 	t.Run("Delete", func(t *testing.T) {
 		uid := uuid.New()
 
-		mock.ExpectExec(deleteUserQuery).WithArgs(uid).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(deleteUserQuery).WithArgs(uid, 0).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		err := authStorage.Delete(context.Background(), uid)
+		err := authStorage.Delete(context.Background(), uid, 0)
 		require.NoError(t, err)
 	})
 
 	t.Run("Delete no rows", func(t *testing.T) {
 		uid := uuid.New()
 
-		mock.ExpectExec(deleteUserQuery).WithArgs(uid).WillReturnResult(sqlmock.NewResult(1, 0))
+		mock.ExpectExec(deleteUserQuery).WithArgs(uid, 0).WillReturnResult(sqlmock.NewResult(1, 0))
 
-		err := authStorage.Delete(context.Background(), uid)
+		err := authStorage.Delete(context.Background(), uid, 0)
 		require.NotNil(t, err)
 	})
 }
@@ -220,13 +220,21 @@ func TestPsql_FindUserByName(t *testing.T) {
 			"edbeermtn@gmail.com",
 		)
 
-		mock.ExpectQuery(getTotalCount).WillReturnRows(totalCountRows)
-		mock.ExpectQuery(findUsersByName).WithArgs("", 0, 10).WillReturnRows(rows)
+		search := &entity.UserSearchQuery{
+			Name: userName,
+			Role: "admin",
+		}
+
+		mock.ExpectQuery(getTotalCount).WithArgs(
+			userName, "admin", "", "", search.CreatedFrom, search.CreatedTo,
+		).WillReturnRows(totalCountRows)
+		mock.ExpectQuery(findUsersByName).WithArgs(
+			userName, "admin", "", "", search.CreatedFrom, search.CreatedTo, 10, 0,
+		).WillReturnRows(rows)
 
-		userList, err := authStorage.FindUsersByName(context.Background(), userName, &utils.PaginationQuery{
-			Size:    10,
-			Page:    0,
-			OrderBy: "",
+		userList, err := authStorage.FindUsersByName(context.Background(), search, &utils.PaginationQuery{
+			Size: 10,
+			Page: 0,
 		})
 
 		require.NoError(t, err)
@@ -249,8 +257,6 @@ func TestPsql_GetUsers(t *testing.T) {
 	t.Run("GetUsers", func(t *testing.T) {
 		uid := uuid.New()
 
-		totalCountRows := sqlmock.NewRows([]string{"count"}).AddRow(0)
-
 		columns := []string{
 			"user_id",
 			"first_name",
@@ -264,17 +270,26 @@ func TestPsql_GetUsers(t *testing.T) {
 			"edbeermtn@gmail.com",
 		)
 
-		mock.ExpectQuery(getTotal).WillReturnRows(totalCountRows)
-		mock.ExpectQuery(getUsers).WithArgs("", 0, 10).WillReturnRows(rows)
+		pq := &utils.PaginationQuery{
+			Size: 10,
+			Page: 1,
+		}
+		query, _, err := listQuery(getUsers, true, pq, usersFilterSchema, "users")
+		require.NoError(t, err)
+		mock.ExpectQuery(query).WithArgs(11, 10, nil, nil).WillReturnRows(rows)
 
-		userList, err := authStorage.GetUsers(context.Background(), &utils.PaginationQuery{
-			Size:    10,
-			Page:    0,
-			OrderBy: "",
-		})
+		userList, err := authStorage.GetUsers(context.Background(), pq)
 
 		require.NoError(t, err)
 		require.NotNil(t, userList)
+		require.Len(t, userList.Users, 1)
+		require.Zero(t, userList.TotalCount)
+		require.Empty(t, userList.NextCursor)
+
+		prev, err := utils.DecodeCursor(userList.PrevCursor)
+		require.NoError(t, err)
+		require.Equal(t, uid, prev.ID)
+		require.True(t, prev.Backward)
 	})
 }
 
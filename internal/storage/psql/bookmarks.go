@@ -0,0 +1,160 @@
+package psql
+
+import (
+	"context"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// Bookmarks and reading lists storage
+type BookmarksStorage struct {
+	psql *sqlx.DB
+}
+
+// Bookmarks storage constructor
+func NewBookmarksStorage(psql *sqlx.DB) *BookmarksStorage {
+	return &BookmarksStorage{psql: psql}
+}
+
+// Bookmark published news, bookmarking again keeps the bookmark time.
+// Missing news returns sql.ErrNoRows.
+func (s *BookmarksStorage) AddBookmark(ctx context.Context, userID uuid.UUID, newsID uuid.UUID) error {
+	var createdAt interface{}
+	if err := s.psql.QueryRowxContext(ctx, addBookmark, userID, newsID).Scan(&createdAt); err != nil {
+		return errors.Wrap(err, "BookmarksStoragePsql.AddBookmark.Scan")
+	}
+	return nil
+}
+
+// Remove bookmark
+func (s *BookmarksStorage) RemoveBookmark(ctx context.Context, userID uuid.UUID, newsID uuid.UUID) error {
+	if _, err := s.psql.ExecContext(ctx, removeBookmark, userID, newsID); err != nil {
+		return errors.Wrap(err, "BookmarksStoragePsql.RemoveBookmark.ExecContext")
+	}
+	return nil
+}
+
+// Get bookmarked published news of user, newest bookmarks first
+func (s *BookmarksStorage) GetBookmarks(ctx context.Context, userID uuid.UUID, pq *utils.PaginationQuery) (*entity.NewsList, error) {
+	var totalCount int
+	if err := s.psql.GetContext(ctx, &totalCount, getBookmarksCount, userID); err != nil {
+		return nil, errors.Wrap(err, "BookmarksStoragePsql.GetBookmarks.GetContext")
+	}
+
+	newsList := make([]*entity.News, 0, pq.GetSize())
+	if totalCount > 0 {
+		if err := s.psql.SelectContext(ctx, &newsList, getBookmarks, userID, pq.GetLimit(), pq.GetOffset()); err != nil {
+			return nil, errors.Wrap(err, "BookmarksStoragePsql.GetBookmarks.SelectContext")
+		}
+	}
+
+	return newsListPage(totalCount, pq, newsList), nil
+}
+
+// Create reading list
+func (s *BookmarksStorage) CreateList(ctx context.Context, list *entity.ReadingList) (*entity.ReadingList, error) {
+	created := &entity.ReadingList{}
+	if err := s.psql.QueryRowxContext(ctx, createReadingList, list.UserID, list.Name, list.IsPublic).StructScan(created); err != nil {
+		return nil, errors.Wrap(err, "BookmarksStoragePsql.CreateList.StructScan")
+	}
+	return created, nil
+}
+
+// Update name and sharing of reading list, empty name and nil sharing keep
+// the current ones
+func (s *BookmarksStorage) UpdateList(ctx context.Context, list *entity.ReadingListUpdate) (*entity.ReadingList, error) {
+	updated := &entity.ReadingList{}
+	if err := s.psql.QueryRowxContext(ctx, updateReadingList, list.ListID, list.Name, list.IsPublic).StructScan(updated); err != nil {
+		return nil, errors.Wrap(err, "BookmarksStoragePsql.UpdateList.StructScan")
+	}
+	return updated, nil
+}
+
+// Delete reading list with its items
+func (s *BookmarksStorage) DeleteList(ctx context.Context, listID uuid.UUID) error {
+	if _, err := s.psql.ExecContext(ctx, deleteReadingList, listID); err != nil {
+		return errors.Wrap(err, "BookmarksStoragePsql.DeleteList.ExecContext")
+	}
+	return nil
+}
+
+// Get reading list by id
+func (s *BookmarksStorage) GetList(ctx context.Context, listID uuid.UUID) (*entity.ReadingList, error) {
+	list := &entity.ReadingList{}
+	if err := s.psql.GetContext(ctx, list, getReadingList, listID); err != nil {
+		return nil, errors.Wrap(err, "BookmarksStoragePsql.GetList.GetContext")
+	}
+	return list, nil
+}
+
+// Get reading lists of user by name
+func (s *BookmarksStorage) GetLists(ctx context.Context, userID uuid.UUID) ([]*entity.ReadingList, error) {
+	lists := []*entity.ReadingList{}
+	if err := s.psql.SelectContext(ctx, &lists, getReadingLists, userID); err != nil {
+		return nil, errors.Wrap(err, "BookmarksStoragePsql.GetLists.SelectContext")
+	}
+	return lists, nil
+}
+
+// Get published news of reading list in list order, marked when bookmarked by viewer
+func (s *BookmarksStorage) GetListNews(ctx context.Context, listID uuid.UUID, viewerID uuid.UUID, pq *utils.PaginationQuery) (*entity.NewsList, error) {
+	var totalCount int
+	if err := s.psql.GetContext(ctx, &totalCount, getReadingListNewsCount, listID); err != nil {
+		return nil, errors.Wrap(err, "BookmarksStoragePsql.GetListNews.GetContext")
+	}
+
+	newsList := make([]*entity.News, 0, pq.GetSize())
+	if totalCount > 0 {
+		if err := s.psql.SelectContext(ctx, &newsList, getReadingListNews, listID, viewerID, pq.GetLimit(), pq.GetOffset()); err != nil {
+			return nil, errors.Wrap(err, "BookmarksStoragePsql.GetListNews.SelectContext")
+		}
+	}
+
+	return newsListPage(totalCount, pq, newsList), nil
+}
+
+// Add published news to the end of reading list, adding again keeps its position.
+// Missing news returns sql.ErrNoRows.
+func (s *BookmarksStorage) AddListItem(ctx context.Context, listID uuid.UUID, newsID uuid.UUID) error {
+	var position int
+	if err := s.psql.QueryRowxContext(ctx, addReadingListItem, listID, newsID).Scan(&position); err != nil {
+		return errors.Wrap(err, "BookmarksStoragePsql.AddListItem.Scan")
+	}
+	return nil
+}
+
+// Remove news from reading list
+func (s *BookmarksStorage) RemoveListItem(ctx context.Context, listID uuid.UUID, newsID uuid.UUID) error {
+	if _, err := s.psql.ExecContext(ctx, removeReadingListItem, listID, newsID); err != nil {
+		return errors.Wrap(err, "BookmarksStoragePsql.RemoveListItem.ExecContext")
+	}
+	return nil
+}
+
+// Move listed news to the start of reading list in the given order
+func (s *BookmarksStorage) ReorderList(ctx context.Context, listID uuid.UUID, newsIDs []uuid.UUID) error {
+	ids := make([]string, 0, len(newsIDs))
+	for _, newsID := range newsIDs {
+		ids = append(ids, newsID.String())
+	}
+
+	if _, err := s.psql.ExecContext(ctx, reorderReadingList, listID, arrayLiteral(ids)); err != nil {
+		return errors.Wrap(err, "BookmarksStoragePsql.ReorderList.ExecContext")
+	}
+	return nil
+}
+
+func newsListPage(totalCount int, pq *utils.PaginationQuery, newsList []*entity.News) *entity.NewsList {
+	return &entity.NewsList{
+		TotalCount: totalCount,
+		TotalPages: utils.GetTotalPages(totalCount, pq.GetSize()),
+		Page:       pq.GetPage(),
+		Size:       pq.GetSize(),
+		HasMore:    utils.GetHasMore(pq.GetPage(), totalCount, pq.GetSize()),
+		News:       newsList,
+	}
+}
@@ -0,0 +1,82 @@
+package psql
+
+const (
+	addBookmark = `INSERT INTO bookmarks (user_id, news_id, created_at)
+				SELECT $1, news_id, now() FROM news WHERE news_id = $2 AND status = 'published'
+				ON CONFLICT (user_id, news_id) DO UPDATE SET created_at = bookmarks.created_at
+				RETURNING created_at`
+
+	removeBookmark = `DELETE FROM bookmarks WHERE user_id = $1 AND news_id = $2`
+
+	getBookmarksCount = `SELECT COUNT(b.news_id)
+				FROM bookmarks b
+					JOIN news n on n.news_id = b.news_id
+				WHERE b.user_id = $1 AND n.status = 'published'`
+
+	getBookmarks = `SELECT n.news_id, n.author_id, n.title, n.slug, n.content, n.content_html, n.image_url, n.category, n.category_id, n.language, n.status, n.publish_at, n.updated_at, n.created_at,
+					true AS bookmarked
+				FROM bookmarks b
+					JOIN news n on n.news_id = b.news_id
+				WHERE b.user_id = $1 AND n.status = 'published'
+				ORDER BY b.created_at DESC, n.news_id
+				LIMIT $2 OFFSET $3`
+
+	createReadingList = `INSERT INTO reading_lists (user_id, name, is_public, created_at, updated_at)
+				VALUES ($1, $2, $3, now(), now())
+				RETURNING list_id, user_id, name, is_public, 0 AS items_count, created_at, updated_at`
+
+	updateReadingList = `UPDATE reading_lists
+				SET name = COALESCE(NULLIF($2, ''), name),
+					is_public = COALESCE($3, is_public),
+					updated_at = now()
+				WHERE list_id = $1
+				RETURNING list_id, user_id, name, is_public,
+					(SELECT COUNT(*) FROM reading_list_items i WHERE i.list_id = reading_lists.list_id) AS items_count,
+					created_at, updated_at`
+
+	deleteReadingList = `DELETE FROM reading_lists WHERE list_id = $1`
+
+	getReadingList = `SELECT l.list_id, l.user_id, l.name, l.is_public,
+					(SELECT COUNT(*) FROM reading_list_items i WHERE i.list_id = l.list_id) AS items_count,
+					l.created_at, l.updated_at
+				FROM reading_lists l
+				WHERE l.list_id = $1`
+
+	getReadingLists = `SELECT l.list_id, l.user_id, l.name, l.is_public,
+					(SELECT COUNT(*) FROM reading_list_items i WHERE i.list_id = l.list_id) AS items_count,
+					l.created_at, l.updated_at
+				FROM reading_lists l
+				WHERE l.user_id = $1
+				ORDER BY l.name`
+
+	getReadingListNewsCount = `SELECT COUNT(i.news_id)
+				FROM reading_list_items i
+					JOIN news n on n.news_id = i.news_id
+				WHERE i.list_id = $1 AND n.status = 'published'`
+
+	getReadingListNews = `SELECT n.news_id, n.author_id, n.title, n.slug, n.content, n.content_html, n.image_url, n.category, n.category_id, n.language, n.status, n.publish_at, n.updated_at, n.created_at,
+					EXISTS (SELECT 1 FROM bookmarks b WHERE b.user_id = $2 AND b.news_id = n.news_id) AS bookmarked
+				FROM reading_list_items i
+					JOIN news n on n.news_id = i.news_id
+				WHERE i.list_id = $1 AND n.status = 'published'
+				ORDER BY i.position, i.created_at
+				LIMIT $3 OFFSET $4`
+
+	addReadingListItem = `INSERT INTO reading_list_items (list_id, news_id, position, created_at)
+				SELECT $1, news_id,
+					COALESCE((SELECT MAX(position) + 1 FROM reading_list_items WHERE list_id = $1), 0),
+					now()
+				FROM news WHERE news_id = $2 AND status = 'published'
+				ON CONFLICT (list_id, news_id) DO UPDATE SET position = reading_list_items.position
+				RETURNING position`
+
+	removeReadingListItem = `DELETE FROM reading_list_items WHERE list_id = $1 AND news_id = $2`
+
+	reorderReadingList = `UPDATE reading_list_items i
+				SET position = r.position
+				FROM (SELECT it.news_id, ROW_NUMBER() OVER (ORDER BY o.ord NULLS LAST, it.position, it.created_at) - 1 AS position
+						FROM reading_list_items it
+							LEFT JOIN unnest($2::uuid[]) WITH ORDINALITY AS o(news_id, ord) ON o.news_id = it.news_id
+						WHERE it.list_id = $1) r
+				WHERE i.list_id = $1 AND i.news_id = r.news_id`
+)
@@ -0,0 +1,192 @@
+package psql
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPsql_AddBookmark(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	bookmarksStorage := NewBookmarksStorage(sqlxDB)
+
+	t.Run("AddBookmark", func(t *testing.T) {
+		userID, newsID := uuid.New(), uuid.New()
+		mock.ExpectQuery(addBookmark).WithArgs(userID, newsID).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(time.Now()))
+
+		require.NoError(t, bookmarksStorage.AddBookmark(context.Background(), userID, newsID))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Missing news", func(t *testing.T) {
+		userID, newsID := uuid.New(), uuid.New()
+		mock.ExpectQuery(addBookmark).WithArgs(userID, newsID).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at"}))
+
+		err := bookmarksStorage.AddBookmark(context.Background(), userID, newsID)
+		require.True(t, errors.Is(err, sql.ErrNoRows))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPsql_GetBookmarks(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	bookmarksStorage := NewBookmarksStorage(sqlxDB)
+
+	userID, newsID := uuid.New(), uuid.New()
+	pq := &utils.PaginationQuery{Size: 10, Page: 0}
+
+	mock.ExpectQuery(getBookmarksCount).WithArgs(userID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(getBookmarks).WithArgs(userID, pq.GetLimit(), pq.GetOffset()).
+		WillReturnRows(sqlmock.NewRows([]string{"news_id", "title", "bookmarked"}).AddRow(newsID, "title", true))
+
+	newsList, err := bookmarksStorage.GetBookmarks(context.Background(), userID, pq)
+	require.NoError(t, err)
+	require.Equal(t, 1, newsList.TotalCount)
+	require.False(t, newsList.HasMore)
+	require.Len(t, newsList.News, 1)
+	require.Equal(t, newsID, newsList.News[0].NewsID)
+	require.True(t, newsList.News[0].Bookmarked)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPsql_CreateReadingList(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	bookmarksStorage := NewBookmarksStorage(sqlxDB)
+
+	list := &entity.ReadingList{UserID: uuid.New(), Name: "later", IsPublic: true}
+	listID := uuid.New()
+
+	mock.ExpectQuery(createReadingList).WithArgs(list.UserID, list.Name, list.IsPublic).
+		WillReturnRows(sqlmock.NewRows([]string{"list_id", "user_id", "name", "is_public", "items_count"}).
+			AddRow(listID, list.UserID, list.Name, list.IsPublic, 0))
+
+	createdList, err := bookmarksStorage.CreateList(context.Background(), list)
+	require.NoError(t, err)
+	require.Equal(t, listID, createdList.ListID)
+	require.Equal(t, "later", createdList.Name)
+	require.True(t, createdList.IsPublic)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPsql_GetReadingListNews(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	bookmarksStorage := NewBookmarksStorage(sqlxDB)
+
+	listID, viewerID := uuid.New(), uuid.New()
+	pq := &utils.PaginationQuery{Size: 1, Page: 0}
+
+	mock.ExpectQuery(getReadingListNewsCount).WithArgs(listID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	mock.ExpectQuery(getReadingListNews).WithArgs(listID, viewerID, pq.GetLimit(), pq.GetOffset()).
+		WillReturnRows(sqlmock.NewRows([]string{"news_id", "title", "bookmarked"}).AddRow(uuid.New(), "first", false))
+
+	newsList, err := bookmarksStorage.GetListNews(context.Background(), listID, viewerID, pq)
+	require.NoError(t, err)
+	require.Equal(t, 2, newsList.TotalCount)
+	require.True(t, newsList.HasMore)
+	require.Len(t, newsList.News, 1)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPsql_ReorderReadingList(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	bookmarksStorage := NewBookmarksStorage(sqlxDB)
+
+	listID, first, second := uuid.New(), uuid.New(), uuid.New()
+	mock.ExpectExec(reorderReadingList).WithArgs(listID, "{"+second.String()+","+first.String()+"}").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	err = bookmarksStorage.ReorderList(context.Background(), listID, []uuid.UUID{second, first})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPsql_UpdateList(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	bookmarksStorage := NewBookmarksStorage(sqlxDB)
+	listID, userID := uuid.New(), uuid.New()
+	columns := []string{"list_id", "user_id", "name", "is_public", "items_count", "created_at", "updated_at"}
+
+	t.Run("Rename only", func(t *testing.T) {
+		mock.ExpectQuery(updateReadingList).WithArgs(listID, "someday", nil).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(listID, userID, "someday", true, 2, time.Now(), time.Now()))
+
+		updated, err := bookmarksStorage.UpdateList(context.Background(), &entity.ReadingListUpdate{ListID: listID, Name: "someday"})
+		require.NoError(t, err)
+		require.Equal(t, "someday", updated.Name)
+		require.True(t, updated.IsPublic)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Sharing only", func(t *testing.T) {
+		isPublic := false
+		mock.ExpectQuery(updateReadingList).WithArgs(listID, "", false).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(listID, userID, "someday", false, 2, time.Now(), time.Now()))
+
+		updated, err := bookmarksStorage.UpdateList(context.Background(), &entity.ReadingListUpdate{ListID: listID, IsPublic: &isPublic})
+		require.NoError(t, err)
+		require.Equal(t, "someday", updated.Name)
+		require.False(t, updated.IsPublic)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,145 @@
+package psql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// Categories storage
+type CategoriesStorage struct {
+	psql *sqlx.DB
+}
+
+// Categories storage constructor
+func NewCategoriesStorage(psql *sqlx.DB) *CategoriesStorage {
+	return &CategoriesStorage{psql: psql}
+}
+
+// Create category
+func (s *CategoriesStorage) Create(ctx context.Context, category *entity.Category) (*entity.Category, error) {
+	c := &entity.Category{}
+	if err := s.psql.QueryRowxContext(ctx,
+		createCategory,
+		&category.ParentID,
+		&category.Name,
+		&category.Slug,
+		&category.Description,
+		&category.Position,
+	).StructScan(c); err != nil {
+		return nil, errors.Wrap(err, "CategoriesStoragePsql.Create.StructScan")
+	}
+	return c, nil
+}
+
+// Update category and category name of its news
+func (s *CategoriesStorage) Update(ctx context.Context, category *entity.Category) (*entity.Category, error) {
+	tx, err := s.psql.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "CategoriesStoragePsql.Update.BeginTxx")
+	}
+	defer tx.Rollback()
+
+	c := &entity.Category{}
+	if err := tx.QueryRowxContext(ctx,
+		updateCategory,
+		&category.CategoryID,
+		&category.ParentID,
+		&category.Name,
+		&category.Slug,
+		&category.Description,
+		&category.Position,
+	).StructScan(c); err != nil {
+		return nil, errors.Wrap(err, "CategoriesStoragePsql.Update.StructScan")
+	}
+
+	if _, err := tx.ExecContext(ctx, renameNewsCategory, c.CategoryID, c.Name); err != nil {
+		return nil, errors.Wrap(err, "CategoriesStoragePsql.Update.renameNewsCategory")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "CategoriesStoragePsql.Update.Commit")
+	}
+	return c, nil
+}
+
+// Delete category
+func (s *CategoriesStorage) Delete(ctx context.Context, categoryID uuid.UUID) error {
+	result, err := s.psql.ExecContext(ctx, deleteCategory, categoryID)
+	if err != nil {
+		return errors.Wrap(err, "CategoriesStoragePsql.Delete.ExecContext")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "CategoriesStoragePsql.Delete.RowsAffected")
+	}
+	if rowsAffected == 0 {
+		return errors.Wrap(sql.ErrNoRows, "CategoriesStoragePsql.Delete.rowsAffected")
+	}
+	return nil
+}
+
+// Get category by id
+func (s *CategoriesStorage) GetByID(ctx context.Context, categoryID uuid.UUID) (*entity.Category, error) {
+	category := &entity.Category{}
+	if err := s.psql.GetContext(ctx, category, getCategoryByID, categoryID); err != nil {
+		return nil, errors.Wrap(err, "CategoriesStoragePsql.GetByID.GetContext")
+	}
+	return category, nil
+}
+
+// Get category by slug
+func (s *CategoriesStorage) GetBySlug(ctx context.Context, slug string) (*entity.Category, error) {
+	category := &entity.Category{}
+	if err := s.psql.GetContext(ctx, category, getCategoryBySlug, slug); err != nil {
+		return nil, errors.Wrap(err, "CategoriesStoragePsql.GetBySlug.GetContext")
+	}
+	return category, nil
+}
+
+// Get all categories ordered by position
+func (s *CategoriesStorage) GetAll(ctx context.Context) ([]*entity.Category, error) {
+	categories := make([]*entity.Category, 0)
+	if err := s.psql.SelectContext(ctx, &categories, getCategories); err != nil {
+		return nil, errors.Wrap(err, "CategoriesStoragePsql.GetAll.SelectContext")
+	}
+	return categories, nil
+}
+
+// Check if category is the ancestor itself or one of its descendants
+func (s *CategoriesStorage) IsDescendant(ctx context.Context, ancestorID uuid.UUID, categoryID uuid.UUID) (bool, error) {
+	var exists bool
+	if err := s.psql.GetContext(ctx, &exists, isCategoryDescendant, ancestorID, categoryID); err != nil {
+		return false, errors.Wrap(err, "CategoriesStoragePsql.IsDescendant.GetContext")
+	}
+	return exists, nil
+}
+
+// Get published news of category, optionally with news of its descendants
+func (s *CategoriesStorage) GetNews(ctx context.Context, categoryID uuid.UUID, descendants bool, pq *utils.PaginationQuery) (*entity.NewsList, error) {
+	var totalCount int
+	if err := s.psql.GetContext(ctx, &totalCount, getCategoryNewsCount, categoryID, descendants); err != nil {
+		return nil, errors.Wrap(err, "CategoriesStoragePsql.GetNews.GetContext")
+	}
+
+	newsList := make([]*entity.News, 0, pq.GetSize())
+	if totalCount > 0 {
+		if err := s.psql.SelectContext(ctx, &newsList, getNewsByCategory, categoryID, descendants, pq.GetLimit(), pq.GetOffset()); err != nil {
+			return nil, errors.Wrap(err, "CategoriesStoragePsql.GetNews.SelectContext")
+		}
+	}
+
+	return &entity.NewsList{
+		TotalCount: totalCount,
+		TotalPages: utils.GetTotalPages(totalCount, pq.GetSize()),
+		Page:       pq.GetPage(),
+		Size:       pq.GetSize(),
+		HasMore:    utils.GetHasMore(pq.GetPage(), totalCount, pq.GetSize()),
+		News:       newsList,
+	}, nil
+}
@@ -0,0 +1,59 @@
+package psql
+
+const (
+	createCategory = `INSERT INTO categories (parent_id, name, slug, description, position, created_at, updated_at)
+				VALUES ($1, $2, $3, NULLIF($4, ''), $5, now(), now())
+				RETURNING category_id, parent_id, name, slug, description, position, created_at, updated_at`
+
+	updateCategory = `UPDATE categories
+				SET parent_id = $2,
+					name = $3,
+					slug = $4,
+					description = NULLIF($5, ''),
+					position = $6,
+					updated_at = now()
+				WHERE category_id = $1
+				RETURNING category_id, parent_id, name, slug, description, position, created_at, updated_at`
+
+	renameNewsCategory = `UPDATE news SET category = $2, version = version + 1
+				WHERE category_id = $1 AND category IS DISTINCT FROM $2`
+
+	deleteCategory = `DELETE FROM categories WHERE category_id = $1`
+
+	getCategoryByID = `SELECT category_id, parent_id, name, slug, description, position, created_at, updated_at
+				FROM categories
+				WHERE category_id = $1`
+
+	getCategoryBySlug = `SELECT category_id, parent_id, name, slug, description, position, created_at, updated_at
+				FROM categories
+				WHERE slug = $1`
+
+	getCategories = `SELECT category_id, parent_id, name, slug, description, position, created_at, updated_at
+				FROM categories
+				ORDER BY position, name`
+
+	isCategoryDescendant = `WITH RECURSIVE tree AS (
+					SELECT category_id FROM categories WHERE category_id = $1
+					UNION ALL
+					SELECT c.category_id FROM categories c JOIN tree t on c.parent_id = t.category_id
+				)
+				SELECT EXISTS (SELECT 1 FROM tree WHERE category_id = $2)`
+
+	categoryTree = `WITH RECURSIVE tree AS (
+					SELECT category_id FROM categories WHERE category_id = $1
+					UNION ALL
+					SELECT c.category_id FROM categories c JOIN tree t on c.parent_id = t.category_id WHERE $2
+				)`
+
+	getCategoryNewsCount = categoryTree + `
+				SELECT COUNT(n.news_id)
+				FROM news n
+				WHERE n.category_id IN (SELECT category_id FROM tree) AND n.status = 'published'`
+
+	getNewsByCategory = categoryTree + `
+				SELECT n.news_id, n.author_id, n.title, n.slug, n.content, n.content_html, n.image_url, n.category, n.category_id, n.language, n.status, n.publish_at, n.updated_at, n.created_at
+				FROM news n
+				WHERE n.category_id IN (SELECT category_id FROM tree) AND n.status = 'published'
+				ORDER BY n.publish_at DESC, n.created_at DESC
+				LIMIT $3 OFFSET $4`
+)
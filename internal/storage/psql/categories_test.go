@@ -0,0 +1,82 @@
+package psql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPsql_UpdateCategory(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	categoriesStorage := NewCategoriesStorage(sqlxDB)
+
+	t.Run("Update", func(t *testing.T) {
+		categoryID := uuid.New()
+		parentID := uuid.New()
+		category := &entity.Category{
+			CategoryID: categoryID,
+			ParentID:   &parentID,
+			Name:       "Football",
+			Slug:       "football",
+			Position:   2,
+		}
+		rows := sqlmock.NewRows([]string{"category_id", "parent_id", "name", "slug", "position"}).
+			AddRow(categoryID, parentID, "Football", "football", 2)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(updateCategory).WithArgs(categoryID, parentID, "Football", "football", nil, 2).WillReturnRows(rows)
+		mock.ExpectExec(renameNewsCategory).WithArgs(categoryID, "Football").WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectCommit()
+
+		updated, err := categoriesStorage.Update(context.Background(), category)
+		require.NoError(t, err)
+		require.Equal(t, "football", updated.Slug)
+		require.Equal(t, parentID, *updated.ParentID)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPsql_GetNewsByCategory(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	categoriesStorage := NewCategoriesStorage(sqlxDB)
+
+	t.Run("GetNews", func(t *testing.T) {
+		categoryID := uuid.New()
+		totalCountRows := sqlmock.NewRows([]string{"count"}).AddRow(1)
+		rows := sqlmock.NewRows([]string{"news_id", "title", "category_id"}).
+			AddRow(uuid.New(), "title", categoryID)
+
+		mock.ExpectQuery(getCategoryNewsCount).WithArgs(categoryID, true).WillReturnRows(totalCountRows)
+		mock.ExpectQuery(getNewsByCategory).WithArgs(categoryID, true, 10, 0).WillReturnRows(rows)
+
+		newsList, err := categoriesStorage.GetNews(context.Background(), categoryID, true, &utils.PaginationQuery{
+			Size: 10,
+			Page: 0,
+		})
+		require.NoError(t, err)
+		require.Len(t, newsList.News, 1)
+		require.Equal(t, 1, newsList.TotalCount)
+	})
+}
@@ -5,12 +5,23 @@ import (
 	"database/sql"
 
 	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/filter"
 	"github.com/Edbeer/restapi/pkg/utils"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
 )
 
+// Fields comments lists can be filtered and sorted by
+var commentsFilterSchema = filter.Schema{
+	"author_id":  {Column: "author_id", Type: filter.TypeUUID},
+	"parent_id":  {Column: "parent_id", Type: filter.TypeUUID},
+	"message":    {Column: "message", Type: filter.TypeString},
+	"likes":      {Column: "likes", Type: filter.TypeInt, Sortable: true},
+	"created_at": {Column: "created_at", Type: filter.TypeTime, Sortable: true},
+	"updated_at": {Column: "updated_at", Type: filter.TypeTime, Sortable: true},
+}
+
 // Comments storage
 type CommentsStorage struct {
 	psql *sqlx.DB
@@ -29,13 +40,14 @@ func (s *CommentsStorage) Create(ctx context.Context, comments *entity.Comment)
 		&comments.AuthorID,
 		&comments.NewsID,
 		&comments.Message,
+		&comments.MessageHTML,
 	).StructScan(c); err != nil {
 		return nil, errors.Wrap(err, "CommentsStoragePsql.Create.StructScan")
 	}
 	return c, nil
 }
 
-// Update comments
+// Update comments of version, 0 updates any version
 func (s *CommentsStorage) Update(ctx context.Context, comments *entity.Comment) (*entity.Comment, error) {
 	c := &entity.Comment{}
 	if err := s.psql.QueryRowxContext(
@@ -43,15 +55,20 @@ func (s *CommentsStorage) Update(ctx context.Context, comments *entity.Comment)
 		updateComment,
 		&comments.CommentID,
 		&comments.Message,
+		&comments.MessageHTML,
+		&comments.Version,
 	).StructScan(c); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, versionConflict(ctx, s.psql, commentExists, comments.CommentID, comments.Version, "CommentsStoragePsql.Update.StructScan")
+		}
 		return nil, errors.Wrap(err, "CommentsStoragePsql.Update.StructScan")
 	}
 	return c, nil
 }
 
-// Delete comments
-func (s *CommentsStorage) Delete(ctx context.Context, commentID uuid.UUID) error {
-	result, err := s.psql.ExecContext(ctx, deleteComment, commentID)
+// Delete comments of version, 0 deletes any version
+func (s *CommentsStorage) Delete(ctx context.Context, commentID uuid.UUID, version int) error {
+	result, err := s.psql.ExecContext(ctx, deleteComment, commentID, version)
 	if err != nil {
 		return errors.Wrap(err, "CommentsStoragePsql.Delete.ExecContext")
 	}
@@ -61,7 +78,7 @@ func (s *CommentsStorage) Delete(ctx context.Context, commentID uuid.UUID) error
 	}
 
 	if rowsAffected == 0 {
-		return errors.Wrap(sql.ErrNoRows, "CommentsStoragePsql.Delete.rowsAffected")
+		return versionConflict(ctx, s.psql, commentExists, commentID, version, "CommentsStoragePsql.Delete.rowsAffected")
 	}
 
 	return nil
@@ -76,33 +93,38 @@ func (s *CommentsStorage) GetByID(ctx context.Context, commentID uuid.UUID) (*en
 	return comment, nil
 }
 
-// Get all comments by news id
+// Get all comments by news id, oldest first
 func (s *CommentsStorage) GetAllByNewsID(ctx context.Context,
 	newsID uuid.UUID, pq *utils.PaginationQuery) (*entity.CommentsList, error) {
 
-	var totalCount int
-	if err := s.psql.QueryRowxContext(ctx, getCommentsCount, newsID).Scan(&totalCount); err != nil {
-		return nil, errors.Wrap(err, "CommentsStoragePsql.GetAllByNewsID.QueryRowxContext")
+	list := &entity.CommentsList{
+		Page: pq.GetPage(),
+		Size: pq.GetSize(),
 	}
-
-	if totalCount == 0 {
-		return &entity.CommentsList{
-			TotalCount: totalCount,
-			TotalPages: utils.GetTotalPages(totalCount, pq.GetSize()),
-			Page:       pq.GetPage(),
-			Size:       pq.GetSize(),
-			HasMore:    utils.GetHasMore(pq.GetPage(), totalCount, pq.GetSize()),
-			Comments:   make([]*entity.CommentBase, 0),
-		}, nil
+	if pq.WithTotal {
+		query, args, err := countQuery(getCommentsCount, pq, commentsFilterSchema, "comments", newsID)
+		if err != nil {
+			return nil, errors.Wrap(err, "CommentsStoragePsql.GetAllByNewsID.countQuery")
+		}
+		if err := s.psql.QueryRowxContext(ctx, query, args...).Scan(&list.TotalCount); err != nil {
+			return nil, errors.Wrap(err, "CommentsStoragePsql.GetAllByNewsID.QueryRowxContext")
+		}
+		list.TotalPages = utils.GetTotalPages(list.TotalCount, pq.GetSize())
 	}
 
-	rows, err := s.psql.QueryxContext(ctx, getCommentsByNewsID, newsID, pq.GetDifference(), pq.GetLimit())
+	cursorID, cursorTime := pq.GetCursorArgs()
+	query, args, err := listQuery(getCommentsByNewsID, false, pq, commentsFilterSchema, "c",
+		newsID, pq.GetKeysetLimit(), pq.GetKeysetOffset(), cursorID, cursorTime)
+	if err != nil {
+		return nil, errors.Wrap(err, "CommentsStoragePsql.GetAllByNewsID.listQuery")
+	}
+	rows, err := s.psql.QueryxContext(ctx, query, args...)
 	if err != nil {
 		return nil, errors.Wrap(err, "CommentsStoragePsql.GetAllByNewsID.QueryxContext")
 	}
 	defer rows.Close()
 
-	var commentsList = make([]*entity.CommentBase, 0, pq.GetSize())
+	var commentsList = make([]*entity.CommentBase, 0, pq.GetKeysetLimit())
 	for rows.Next() {
 		comment := &entity.CommentBase{}
 		if err := rows.StructScan(comment); err != nil {
@@ -115,12 +137,16 @@ func (s *CommentsStorage) GetAllByNewsID(ctx context.Context,
 		return nil, errors.Wrap(err, "CommentsStoragePsql.GetAllByNewsID.rows.Err")
 	}
 
-	return &entity.CommentsList{
-		TotalCount: totalCount,
-		TotalPages: utils.GetTotalPages(totalCount, pq.GetSize()),
-		Page:       pq.GetPage(),
-		Size:       pq.GetSize(),
-		HasMore:    utils.GetHasMore(pq.GetPage(), totalCount, pq.GetSize()),
-		Comments:   commentsList,
-	}, nil
+	commentsList, hasNext, hasPrev := utils.KeysetPage(pq, commentsList)
+	list.Comments = commentsList
+	list.HasMore = hasNext
+	if hasNext && pq.IsKeyset() && len(commentsList) > 0 {
+		last := commentsList[len(commentsList)-1]
+		list.NextCursor = (&utils.Cursor{Time: last.CreatedAt, ID: last.CommentID}).Encode()
+	}
+	if hasPrev && pq.IsKeyset() && len(commentsList) > 0 {
+		first := commentsList[0]
+		list.PrevCursor = (&utils.Cursor{Time: first.CreatedAt, ID: first.CommentID, Backward: true}).Encode()
+	}
+	return list, nil
 }
@@ -1,27 +1,35 @@
 package psql
 
 const (
-	createComments = `INSERT INTO comments (author_id, news_id, message)
-					VALUES ($1, $2, $3)
+	createComments = `INSERT INTO comments (author_id, news_id, message, message_html)
+					VALUES ($1, $2, $3, $4)
 					RETURNING *`
 	
-	deleteComment = `DELETE FROM comments WHERE comment_id = $1`
+	// version $2 of If-Match, 0 deletes any version
+	deleteComment = `DELETE FROM comments WHERE comment_id = $1 AND ($2::int = 0 OR version = $2)`
 
-	updateComment = `UPDATE comments SET message = $1, updated_at = CURRENT_TIMESTAMP WHERE comment_id = $2 RETURNING *`
+	// version $4 of If-Match, 0 updates any version
+	updateComment = `UPDATE comments SET message = $2, message_html = $3, version = version + 1, updated_at = CURRENT_TIMESTAMP
+				WHERE comment_id = $1 AND ($4::int = 0 OR version = $4) RETURNING *`
 
-	getCommentByID = `SELECT concat(u.first_name, ' ', u.last_name) as author, u.avatar as avatar_url, c.message, c.likes, c.updated_at, c.author_id, c.comment_id	
+	commentExists = `SELECT EXISTS (SELECT 1 FROM comments WHERE comment_id = $1)`
+
+	getCommentByID = `SELECT concat(u.first_name, ' ', u.last_name) as author, u.avatar as avatar_url, c.message, c.message_html, c.likes, c.version, c.updated_at, c.created_at, c.author_id, c.parent_id, c.comment_id
 				FROM comments c
 					LEFT JOIN users u on c.author_id = u.user_id
 				WHERE c.comment_id = $1`
 
-	getCommentsCount = `SELECT COUNT (comments_id)
+	// conditions of the filter are filled by countQuery
+	getCommentsCount = `SELECT COUNT(comment_id)
 							FROM comments
-							WHERE news_id = $1`
+							WHERE news_id = $1%s`
 
-	getCommentsByNewsID = `SELECT concat(u.first_name, ' ', u.last_name) as author, u.avatar as avatar_url, c.message, c.likes, c.updated_at, c.author_id, c.comment_id
+	// oldest first, comparison with cursor $4 $5, order and the filter are filled by listQuery
+	getCommentsByNewsID = `SELECT concat(u.first_name, ' ', u.last_name) as author, u.avatar as avatar_url, c.message, c.message_html, c.likes, c.version, c.updated_at, c.created_at, c.author_id, c.parent_id, c.comment_id
 						FROM comments c
 						LEFT JOIN users u on c.author_id = u.user_id
-						WHERE c.news_id = $1 and c.news_id < (c.news_id + $2)
-						ORDER BY updated_at
-						LIMIT $3`
+						WHERE c.news_id = $1
+							AND ($4::uuid IS NULL OR (c.created_at, c.comment_id) %[1]s ($5::timestamptz, $4))%[3]s
+						ORDER BY %[4]sc.created_at %[2]s, c.comment_id %[2]s
+						LIMIT $2 OFFSET $3`
 )
\ No newline at end of file
@@ -3,10 +3,14 @@ package psql
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
 	"github.com/Edbeer/restapi/pkg/utils"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
@@ -47,7 +51,7 @@ func TestPsql_Create(t *testing.T) {
 		}
 
 		mock.ExpectQuery(createComments).WithArgs(
-			&comment.AuthorID, &comment.NewsID, &comment.Message,
+			&comment.AuthorID, &comment.NewsID, &comment.Message, &comment.MessageHTML,
 		).WillReturnRows(rows)
 
 		createdComment, err := commentsStorage.Create(context.Background(), comment)
@@ -66,7 +70,7 @@ func TestPsql_Create(t *testing.T) {
 			Message: message,
 		}
 
-		mock.ExpectQuery(createComments).WithArgs(&comment.AuthorID, &comment.NewsID, &comment.Message).WillReturnError(createErr)
+		mock.ExpectQuery(createComments).WithArgs(&comment.AuthorID, &comment.NewsID, &comment.Message, &comment.MessageHTML).WillReturnError(createErr)
 
 		createdComment, err := commentsStorage.Create(context.Background(), comment)
 		require.NotNil(t, err)
@@ -106,7 +110,7 @@ func TestPsql_UpdateComment(t *testing.T) {
 			Message:   "hello",
 		}
 
-		mock.ExpectQuery(updateComment).WithArgs(&comment.CommentID, &comment.Message).WillReturnRows(rows)
+		mock.ExpectQuery(updateComment).WithArgs(&comment.CommentID, &comment.Message, &comment.MessageHTML, &comment.Version).WillReturnRows(rows)
 
 		updatedComment, err := commentsStorage.Update(context.Background(), comment)
 		require.NoError(t, err)
@@ -114,6 +118,22 @@ func TestPsql_UpdateComment(t *testing.T) {
 		require.Equal(t, updatedComment.Message, comment.Message)
 	})
 
+	t.Run("Update stale version", func(t *testing.T) {
+		comment := &entity.Comment{
+			CommentID: uuid.New(),
+			Message:   "hello",
+			Version:   2,
+		}
+
+		mock.ExpectQuery(updateComment).WithArgs(&comment.CommentID, &comment.Message, &comment.MessageHTML, &comment.Version).
+			WillReturnRows(sqlmock.NewRows([]string{"comment_id"}))
+		mock.ExpectQuery(commentExists).WithArgs(comment.CommentID).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+		updatedComment, err := commentsStorage.Update(context.Background(), comment)
+		require.Nil(t, updatedComment)
+		require.Equal(t, http.StatusPreconditionFailed, httpe.ParseErrors(err).Status())
+	})
+
 	t.Run("Update err", func(t *testing.T) {
 		commentId := uuid.New()
 		errUpdate := errors.New("Update comment err")
@@ -125,7 +145,7 @@ func TestPsql_UpdateComment(t *testing.T) {
 
 		updatedComment, err := commentsStorage.Update(context.Background(), comment)
 
-		mock.ExpectQuery(updateComment).WithArgs(&comment.CommentID, &comment.Message).WillReturnError(errUpdate)
+		mock.ExpectQuery(updateComment).WithArgs(&comment.CommentID, &comment.Message, &comment.MessageHTML, &comment.Version).WillReturnError(errUpdate)
 		require.Error(t, err)
 		require.Nil(t, updatedComment)
 	})
@@ -145,8 +165,8 @@ func TestPsql_DeleteComment(t *testing.T) {
 
 	t.Run("Delete comment", func(t *testing.T) {
 		commID := uuid.New()
-		mock.ExpectExec(deleteComment).WithArgs(commID).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := commentsStorage.Delete(context.Background(), commID)
+		mock.ExpectExec(deleteComment).WithArgs(commID, 0).WillReturnResult(sqlmock.NewResult(1, 1))
+		err := commentsStorage.Delete(context.Background(), commID, 0)
 
 		require.NoError(t, err)
 	})
@@ -154,9 +174,9 @@ func TestPsql_DeleteComment(t *testing.T) {
 	t.Run("Delete err", func(t *testing.T) {
 		commID := uuid.New()
 
-		mock.ExpectExec(deleteComment).WithArgs(commID).WillReturnResult(sqlmock.NewResult(1, 0))
+		mock.ExpectExec(deleteComment).WithArgs(commID, 0).WillReturnResult(sqlmock.NewResult(1, 0))
 
-		err := commentsStorage.Delete(context.Background(), commID)
+		err := commentsStorage.Delete(context.Background(), commID, 0)
 		require.NotNil(t, err)
 	})
 }
@@ -224,16 +244,48 @@ func TestPsql_GetAllByNewsID(t *testing.T) {
 			commentId,
 		)
 
-		mock.ExpectQuery(getCommentsCount).WithArgs(newsID).WillReturnRows(totalCountRows)
-		mock.ExpectQuery(getCommentsByNewsID).WithArgs(newsID, 0, 10).WillReturnRows(rows)
+		pq := &utils.PaginationQuery{
+			Size:      10,
+			Page:      0,
+			WithTotal: true,
+		}
+		mock.ExpectQuery(fmt.Sprintf(getCommentsCount, "")).WithArgs(newsID).WillReturnRows(totalCountRows)
+		query, _, err := listQuery(getCommentsByNewsID, false, pq, commentsFilterSchema, "c")
+		require.NoError(t, err)
+		mock.ExpectQuery(query).WithArgs(newsID, 11, 0, nil, nil).WillReturnRows(rows)
 
-		commentsList, err := commentsStorage.GetAllByNewsID(context.Background(), newsID, &utils.PaginationQuery{
-			Size:    10,
-			Page:    0,
-			OrderBy: "",
-		})
+		commentsList, err := commentsStorage.GetAllByNewsID(context.Background(), newsID, pq)
 
 		require.NoError(t, err)
 		require.NotNil(t, commentsList)
 	})
+
+	t.Run("GetAllByNewsID after cursor", func(t *testing.T) {
+		newsID := uuid.New()
+		cursor := &utils.Cursor{Time: time.Now().UTC(), ID: uuid.New()}
+		pq := &utils.PaginationQuery{
+			Size:   1,
+			Cursor: cursor,
+		}
+
+		commentId := uuid.New()
+		rows := sqlmock.NewRows([]string{"comment_id", "created_at"}).
+			AddRow(commentId, cursor.Time.Add(time.Minute)).
+			AddRow(uuid.New(), cursor.Time.Add(2*time.Minute))
+
+		query, _, err := listQuery(getCommentsByNewsID, false, pq, commentsFilterSchema, "c")
+		require.NoError(t, err)
+		mock.ExpectQuery(query).WithArgs(newsID, 2, 0, cursor.ID, cursor.Time).WillReturnRows(rows)
+
+		commentsList, err := commentsStorage.GetAllByNewsID(context.Background(), newsID, pq)
+
+		require.NoError(t, err)
+		require.Len(t, commentsList.Comments, 1)
+		require.True(t, commentsList.HasMore)
+		require.NotEmpty(t, commentsList.PrevCursor)
+
+		next, err := utils.DecodeCursor(commentsList.NextCursor)
+		require.NoError(t, err)
+		require.Equal(t, commentId, next.ID)
+	})
 }
@@ -0,0 +1,126 @@
+package psql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// News contributors storage
+type ContributorsStorage struct {
+	psql *sqlx.DB
+}
+
+// News contributors storage constructor
+func NewContributorsStorage(psql *sqlx.DB) *ContributorsStorage {
+	return &ContributorsStorage{psql: psql}
+}
+
+// Get author, title and status of news
+func (s *ContributorsStorage) GetNews(ctx context.Context, newsID uuid.UUID) (*entity.News, error) {
+	news := &entity.News{}
+	if err := s.psql.GetContext(ctx, news, getContributedNews, newsID); err != nil {
+		return nil, errors.Wrap(err, "ContributorsStoragePsql.GetNews.GetContext")
+	}
+	return news, nil
+}
+
+// Invite contributor, nil if user is already invited
+func (s *ContributorsStorage) Invite(ctx context.Context, contributor *entity.NewsContributor) (*entity.NewsContributor, error) {
+	c := &entity.NewsContributor{}
+	if err := s.psql.QueryRowxContext(ctx,
+		createContributor,
+		contributor.NewsID,
+		contributor.UserID,
+		contributor.Role,
+		contributor.InvitedBy,
+	).StructScan(c); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "ContributorsStoragePsql.Invite.StructScan")
+	}
+	return c, nil
+}
+
+// Accept invitation of user, news gets a new version with its co-author
+func (s *ContributorsStorage) Accept(ctx context.Context, newsID uuid.UUID, userID uuid.UUID) (*entity.NewsContributor, error) {
+	tx, err := s.psql.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "ContributorsStoragePsql.Accept.BeginTxx")
+	}
+	defer tx.Rollback()
+
+	c := &entity.NewsContributor{}
+	if err := tx.QueryRowxContext(ctx, acceptContributor, newsID, userID).StructScan(c); err != nil {
+		return nil, errors.Wrap(err, "ContributorsStoragePsql.Accept.StructScan")
+	}
+	if _, err := tx.ExecContext(ctx, bumpNewsVersion, newsID); err != nil {
+		return nil, errors.Wrap(err, "ContributorsStoragePsql.Accept.bumpNewsVersion")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "ContributorsStoragePsql.Accept.Commit")
+	}
+	return c, nil
+}
+
+// Remove contributor or invitation, news gets a new version
+func (s *ContributorsStorage) Remove(ctx context.Context, newsID uuid.UUID, userID uuid.UUID) error {
+	tx, err := s.psql.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "ContributorsStoragePsql.Remove.BeginTxx")
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, deleteContributor, newsID, userID)
+	if err != nil {
+		return errors.Wrap(err, "ContributorsStoragePsql.Remove.ExecContext")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "ContributorsStoragePsql.Remove.RowsAffected")
+	}
+	if rowsAffected == 0 {
+		return errors.Wrap(sql.ErrNoRows, "ContributorsStoragePsql.Remove.rowsAffected")
+	}
+	if _, err := tx.ExecContext(ctx, bumpNewsVersion, newsID); err != nil {
+		return errors.Wrap(err, "ContributorsStoragePsql.Remove.bumpNewsVersion")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "ContributorsStoragePsql.Remove.Commit")
+	}
+	return nil
+}
+
+// Get contributors of news with pending invitations, co-authors first
+func (s *ContributorsStorage) GetContributors(ctx context.Context, newsID uuid.UUID) ([]*entity.NewsContributor, error) {
+	contributors := []*entity.NewsContributor{}
+	if err := s.psql.SelectContext(ctx, &contributors, getContributors, newsID); err != nil {
+		return nil, errors.Wrap(err, "ContributorsStoragePsql.GetContributors.SelectContext")
+	}
+	return contributors, nil
+}
+
+// Get pending invitations of user, newest first
+func (s *ContributorsStorage) GetInvitations(ctx context.Context, userID uuid.UUID) ([]*entity.NewsContributor, error) {
+	invitations := []*entity.NewsContributor{}
+	if err := s.psql.SelectContext(ctx, &invitations, getInvitations, userID); err != nil {
+		return nil, errors.Wrap(err, "ContributorsStoragePsql.GetInvitations.SelectContext")
+	}
+	return invitations, nil
+}
+
+// Check if user accepted invitation to news
+func (s *ContributorsStorage) IsCoAuthor(ctx context.Context, newsID uuid.UUID, userID uuid.UUID) (bool, error) {
+	var coAuthor bool
+	if err := s.psql.GetContext(ctx, &coAuthor, isCoAuthor, newsID, userID); err != nil {
+		return false, errors.Wrap(err, "ContributorsStoragePsql.IsCoAuthor.GetContext")
+	}
+	return coAuthor, nil
+}
@@ -0,0 +1,33 @@
+package psql
+
+const (
+	getContributedNews = `SELECT news_id, author_id, title, status FROM news WHERE news_id = $1`
+
+	createContributor = `INSERT INTO news_contributors (news_id, user_id, role, status, invited_by, created_at)
+				VALUES ($1, $2, $3, 'invited', $4, now())
+				ON CONFLICT (news_id, user_id) DO NOTHING
+				RETURNING news_id, user_id, role, status, invited_by, created_at, accepted_at`
+
+	acceptContributor = `UPDATE news_contributors
+				SET status = 'accepted',
+					accepted_at = now()
+				WHERE news_id = $1 AND user_id = $2 AND status = 'invited'
+				RETURNING news_id, user_id, role, status, invited_by, created_at, accepted_at`
+
+	deleteContributor = `DELETE FROM news_contributors WHERE news_id = $1 AND user_id = $2`
+
+	getContributors = `SELECT c.news_id, c.user_id, c.role, c.status, c.invited_by, c.created_at, c.accepted_at,
+					CONCAT_WS(' ', u.first_name, u.last_name) AS name
+				FROM news_contributors c
+					JOIN users u on u.user_id = c.user_id
+				WHERE c.news_id = $1
+				ORDER BY c.status, c.created_at, c.user_id`
+
+	getInvitations = `SELECT c.news_id, n.title AS news_title, c.user_id, c.role, c.status, c.invited_by, c.created_at
+				FROM news_contributors c
+					JOIN news n on n.news_id = c.news_id
+				WHERE c.user_id = $1 AND c.status = 'invited'
+				ORDER BY c.created_at DESC, c.news_id`
+
+	isCoAuthor = `SELECT EXISTS (SELECT 1 FROM news_contributors WHERE news_id = $1 AND user_id = $2 AND status = 'accepted')`
+)
@@ -0,0 +1,123 @@
+package psql
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPsql_InviteContributor(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	contributorsStorage := NewContributorsStorage(sqlxDB)
+
+	authorID := uuid.New()
+	contributor := &entity.NewsContributor{
+		NewsID:    uuid.New(),
+		UserID:    uuid.New(),
+		Role:      entity.ContributorPhotographer,
+		InvitedBy: &authorID,
+	}
+	columns := []string{"news_id", "user_id", "role", "status", "invited_by"}
+
+	t.Run("Invite", func(t *testing.T) {
+		mock.ExpectQuery(createContributor).WithArgs(
+			contributor.NewsID, contributor.UserID, contributor.Role, contributor.InvitedBy,
+		).WillReturnRows(sqlmock.NewRows(columns).AddRow(
+			contributor.NewsID, contributor.UserID, contributor.Role, entity.ContributorInvited, authorID,
+		))
+
+		invited, err := contributorsStorage.Invite(context.Background(), contributor)
+		require.NoError(t, err)
+		require.Equal(t, entity.ContributorInvited, invited.Status)
+	})
+
+	t.Run("Already invited", func(t *testing.T) {
+		mock.ExpectQuery(createContributor).WithArgs(
+			contributor.NewsID, contributor.UserID, contributor.Role, contributor.InvitedBy,
+		).WillReturnRows(sqlmock.NewRows(columns))
+
+		invited, err := contributorsStorage.Invite(context.Background(), contributor)
+		require.NoError(t, err)
+		require.Nil(t, invited)
+	})
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPsql_AcceptContributor(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	contributorsStorage := NewContributorsStorage(sqlxDB)
+
+	newsID, userID := uuid.New(), uuid.New()
+	mock.ExpectBegin()
+	mock.ExpectQuery(acceptContributor).WithArgs(newsID, userID).WillReturnRows(
+		sqlmock.NewRows([]string{"news_id", "user_id", "role", "status"}).AddRow(newsID, userID, entity.ContributorAuthor, entity.ContributorAccepted),
+	)
+	mock.ExpectExec(bumpNewsVersion).WithArgs(newsID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery(isCoAuthor).WithArgs(newsID, userID).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	contributor, err := contributorsStorage.Accept(context.Background(), newsID, userID)
+	require.NoError(t, err)
+	require.Equal(t, entity.ContributorAccepted, contributor.Status)
+
+	coAuthor, err := contributorsStorage.IsCoAuthor(context.Background(), newsID, userID)
+	require.NoError(t, err)
+	require.True(t, coAuthor)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPsql_RemoveContributor(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	contributorsStorage := NewContributorsStorage(sqlxDB)
+	newsID, userID := uuid.New(), uuid.New()
+
+	t.Run("Remove", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(deleteContributor).WithArgs(newsID, userID).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(bumpNewsVersion).WithArgs(newsID).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		require.NoError(t, contributorsStorage.Remove(context.Background(), newsID, userID))
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(deleteContributor).WithArgs(newsID, userID).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		err := contributorsStorage.Remove(context.Background(), newsID, userID)
+		require.True(t, errors.Is(err, sql.ErrNoRows))
+	})
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -7,6 +7,7 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/Edbeer/restapi/internal/entity"
 	utils "github.com/Edbeer/restapi/pkg/utils"
@@ -38,17 +39,17 @@ func (m *MockAuthPsql) EXPECT() *MockAuthPsqlMockRecorder {
 }
 
 // Delete mocks base method.
-func (m *MockAuthPsql) Delete(ctx context.Context, userID uuid.UUID) error {
+func (m *MockAuthPsql) Delete(ctx context.Context, userID uuid.UUID, version int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, userID)
+	ret := m.ctrl.Call(m, "Delete", ctx, userID, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockAuthPsqlMockRecorder) Delete(ctx, userID interface{}) *gomock.Call {
+func (mr *MockAuthPsqlMockRecorder) Delete(ctx, userID, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAuthPsql)(nil).Delete), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAuthPsql)(nil).Delete), ctx, userID, version)
 }
 
 // FindUserByEmail mocks base method.
@@ -67,18 +68,18 @@ func (mr *MockAuthPsqlMockRecorder) FindUserByEmail(ctx, user interface{}) *gomo
 }
 
 // FindUsersByName mocks base method.
-func (m *MockAuthPsql) FindUsersByName(ctx context.Context, name string, pq *utils.PaginationQuery) (*entity.UsersList, error) {
+func (m *MockAuthPsql) FindUsersByName(ctx context.Context, search *entity.UserSearchQuery, pq *utils.PaginationQuery) (*entity.UsersList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindUsersByName", ctx, name, pq)
+	ret := m.ctrl.Call(m, "FindUsersByName", ctx, search, pq)
 	ret0, _ := ret[0].(*entity.UsersList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindUsersByName indicates an expected call of FindUsersByName.
-func (mr *MockAuthPsqlMockRecorder) FindUsersByName(ctx, name, pq interface{}) *gomock.Call {
+func (mr *MockAuthPsqlMockRecorder) FindUsersByName(ctx, search, pq interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUsersByName", reflect.TypeOf((*MockAuthPsql)(nil).FindUsersByName), ctx, name, pq)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUsersByName", reflect.TypeOf((*MockAuthPsql)(nil).FindUsersByName), ctx, search, pq)
 }
 
 // GetUserByID mocks base method.
@@ -180,32 +181,77 @@ func (mr *MockNewsPsqlMockRecorder) Create(ctx, news interface{}) *gomock.Call {
 }
 
 // Delete mocks base method.
-func (m *MockNewsPsql) Delete(ctx context.Context, newsID uuid.UUID) error {
+func (m *MockNewsPsql) Delete(ctx context.Context, newsID uuid.UUID, version int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, newsID)
+	ret := m.ctrl.Call(m, "Delete", ctx, newsID, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockNewsPsqlMockRecorder) Delete(ctx, newsID interface{}) *gomock.Call {
+func (mr *MockNewsPsqlMockRecorder) Delete(ctx, newsID, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockNewsPsql)(nil).Delete), ctx, newsID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockNewsPsql)(nil).Delete), ctx, newsID, version)
+}
+
+// GetAuthors mocks base method.
+func (m *MockNewsPsql) GetAuthors(ctx context.Context, userIDs []uuid.UUID) ([]*entity.NewsAuthor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthors", ctx, userIDs)
+	ret0, _ := ret[0].([]*entity.NewsAuthor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuthors indicates an expected call of GetAuthors.
+func (mr *MockNewsPsqlMockRecorder) GetAuthors(ctx, userIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthors", reflect.TypeOf((*MockNewsPsql)(nil).GetAuthors), ctx, userIDs)
+}
+
+// GetCommentCounts mocks base method.
+func (m *MockNewsPsql) GetCommentCounts(ctx context.Context, newsIDs []uuid.UUID) ([]*entity.NewsCommentCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommentCounts", ctx, newsIDs)
+	ret0, _ := ret[0].([]*entity.NewsCommentCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommentCounts indicates an expected call of GetCommentCounts.
+func (mr *MockNewsPsqlMockRecorder) GetCommentCounts(ctx, newsIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentCounts", reflect.TypeOf((*MockNewsPsql)(nil).GetCommentCounts), ctx, newsIDs)
 }
 
 // GetNews mocks base method.
-func (m *MockNewsPsql) GetNews(ctx context.Context, pq *utils.PaginationQuery) (*entity.NewsList, error) {
+func (m *MockNewsPsql) GetNews(ctx context.Context, viewerID uuid.UUID, locales []string, pq *utils.PaginationQuery) (*entity.NewsList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNews", ctx, pq)
+	ret := m.ctrl.Call(m, "GetNews", ctx, viewerID, locales, pq)
 	ret0, _ := ret[0].(*entity.NewsList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetNews indicates an expected call of GetNews.
-func (mr *MockNewsPsqlMockRecorder) GetNews(ctx, pq interface{}) *gomock.Call {
+func (mr *MockNewsPsqlMockRecorder) GetNews(ctx, viewerID, locales, pq interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNews", reflect.TypeOf((*MockNewsPsql)(nil).GetNews), ctx, pq)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNews", reflect.TypeOf((*MockNewsPsql)(nil).GetNews), ctx, viewerID, locales, pq)
+}
+
+// GetNewsByAuthor mocks base method.
+func (m *MockNewsPsql) GetNewsByAuthor(ctx context.Context, authorID uuid.UUID, pq *utils.PaginationQuery) (*entity.NewsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNewsByAuthor", ctx, authorID, pq)
+	ret0, _ := ret[0].(*entity.NewsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNewsByAuthor indicates an expected call of GetNewsByAuthor.
+func (mr *MockNewsPsqlMockRecorder) GetNewsByAuthor(ctx, authorID, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNewsByAuthor", reflect.TypeOf((*MockNewsPsql)(nil).GetNewsByAuthor), ctx, authorID, pq)
 }
 
 // GetNewsByID mocks base method.
@@ -223,34 +269,79 @@ func (mr *MockNewsPsqlMockRecorder) GetNewsByID(ctx, newsID interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNewsByID", reflect.TypeOf((*MockNewsPsql)(nil).GetNewsByID), ctx, newsID)
 }
 
+// GetNewsIDBySlug mocks base method.
+func (m *MockNewsPsql) GetNewsIDBySlug(ctx context.Context, slug string) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNewsIDBySlug", ctx, slug)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNewsIDBySlug indicates an expected call of GetNewsIDBySlug.
+func (mr *MockNewsPsqlMockRecorder) GetNewsIDBySlug(ctx, slug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNewsIDBySlug", reflect.TypeOf((*MockNewsPsql)(nil).GetNewsIDBySlug), ctx, slug)
+}
+
+// IsBookmarked mocks base method.
+func (m *MockNewsPsql) IsBookmarked(ctx context.Context, userID, newsID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBookmarked", ctx, userID, newsID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBookmarked indicates an expected call of IsBookmarked.
+func (mr *MockNewsPsqlMockRecorder) IsBookmarked(ctx, userID, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBookmarked", reflect.TypeOf((*MockNewsPsql)(nil).IsBookmarked), ctx, userID, newsID)
+}
+
+// PublishScheduled mocks base method.
+func (m *MockNewsPsql) PublishScheduled(ctx context.Context) ([]*entity.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishScheduled", ctx)
+	ret0, _ := ret[0].([]*entity.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishScheduled indicates an expected call of PublishScheduled.
+func (mr *MockNewsPsqlMockRecorder) PublishScheduled(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishScheduled", reflect.TypeOf((*MockNewsPsql)(nil).PublishScheduled), ctx)
+}
+
 // SearchNews mocks base method.
-func (m *MockNewsPsql) SearchNews(ctx context.Context, title string, pq *utils.PaginationQuery) (*entity.NewsList, error) {
+func (m *MockNewsPsql) SearchNews(ctx context.Context, search *entity.NewsSearchQuery, viewerID uuid.UUID, pq *utils.PaginationQuery) (*entity.NewsSearchList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchNews", ctx, title, pq)
-	ret0, _ := ret[0].(*entity.NewsList)
+	ret := m.ctrl.Call(m, "SearchNews", ctx, search, viewerID, pq)
+	ret0, _ := ret[0].(*entity.NewsSearchList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SearchNews indicates an expected call of SearchNews.
-func (mr *MockNewsPsqlMockRecorder) SearchNews(ctx, title, pq interface{}) *gomock.Call {
+func (mr *MockNewsPsqlMockRecorder) SearchNews(ctx, search, viewerID, pq interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchNews", reflect.TypeOf((*MockNewsPsql)(nil).SearchNews), ctx, title, pq)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchNews", reflect.TypeOf((*MockNewsPsql)(nil).SearchNews), ctx, search, viewerID, pq)
 }
 
 // Update mocks base method.
-func (m *MockNewsPsql) Update(ctx context.Context, news *entity.News) (*entity.News, error) {
+func (m *MockNewsPsql) Update(ctx context.Context, news *entity.News, rev *entity.NewsRevision) (*entity.News, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, news)
+	ret := m.ctrl.Call(m, "Update", ctx, news, rev)
 	ret0, _ := ret[0].(*entity.News)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockNewsPsqlMockRecorder) Update(ctx, news interface{}) *gomock.Call {
+func (mr *MockNewsPsqlMockRecorder) Update(ctx, news, rev interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockNewsPsql)(nil).Update), ctx, news)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockNewsPsql)(nil).Update), ctx, news, rev)
 }
 
 // MockCommentsPsql is a mock of CommentsPsql interface.
@@ -292,17 +383,17 @@ func (mr *MockCommentsPsqlMockRecorder) Create(ctx, comments interface{}) *gomoc
 }
 
 // Delete mocks base method.
-func (m *MockCommentsPsql) Delete(ctx context.Context, commentID uuid.UUID) error {
+func (m *MockCommentsPsql) Delete(ctx context.Context, commentID uuid.UUID, version int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, commentID)
+	ret := m.ctrl.Call(m, "Delete", ctx, commentID, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockCommentsPsqlMockRecorder) Delete(ctx, commentID interface{}) *gomock.Call {
+func (mr *MockCommentsPsqlMockRecorder) Delete(ctx, commentID, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCommentsPsql)(nil).Delete), ctx, commentID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCommentsPsql)(nil).Delete), ctx, commentID, version)
 }
 
 // GetAllByNewsID mocks base method.
@@ -349,3 +440,1397 @@ func (mr *MockCommentsPsqlMockRecorder) Update(ctx, comments interface{}) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCommentsPsql)(nil).Update), ctx, comments)
 }
+
+// MockRevisionsPsql is a mock of RevisionsPsql interface.
+type MockRevisionsPsql struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevisionsPsqlMockRecorder
+}
+
+// MockRevisionsPsqlMockRecorder is the mock recorder for MockRevisionsPsql.
+type MockRevisionsPsqlMockRecorder struct {
+	mock *MockRevisionsPsql
+}
+
+// NewMockRevisionsPsql creates a new mock instance.
+func NewMockRevisionsPsql(ctrl *gomock.Controller) *MockRevisionsPsql {
+	mock := &MockRevisionsPsql{ctrl: ctrl}
+	mock.recorder = &MockRevisionsPsqlMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevisionsPsql) EXPECT() *MockRevisionsPsqlMockRecorder {
+	return m.recorder
+}
+
+// GetRevision mocks base method.
+func (m *MockRevisionsPsql) GetRevision(ctx context.Context, newsID uuid.UUID, revision int) (*entity.NewsRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevision", ctx, newsID, revision)
+	ret0, _ := ret[0].(*entity.NewsRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevision indicates an expected call of GetRevision.
+func (mr *MockRevisionsPsqlMockRecorder) GetRevision(ctx, newsID, revision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevision", reflect.TypeOf((*MockRevisionsPsql)(nil).GetRevision), ctx, newsID, revision)
+}
+
+// GetRevisions mocks base method.
+func (m *MockRevisionsPsql) GetRevisions(ctx context.Context, newsID uuid.UUID, pq *utils.PaginationQuery) (*entity.NewsRevisionsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisions", ctx, newsID, pq)
+	ret0, _ := ret[0].(*entity.NewsRevisionsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisions indicates an expected call of GetRevisions.
+func (mr *MockRevisionsPsqlMockRecorder) GetRevisions(ctx, newsID, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisions", reflect.TypeOf((*MockRevisionsPsql)(nil).GetRevisions), ctx, newsID, pq)
+}
+
+// MockTagsPsql is a mock of TagsPsql interface.
+type MockTagsPsql struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagsPsqlMockRecorder
+}
+
+// MockTagsPsqlMockRecorder is the mock recorder for MockTagsPsql.
+type MockTagsPsqlMockRecorder struct {
+	mock *MockTagsPsql
+}
+
+// NewMockTagsPsql creates a new mock instance.
+func NewMockTagsPsql(ctrl *gomock.Controller) *MockTagsPsql {
+	mock := &MockTagsPsql{ctrl: ctrl}
+	mock.recorder = &MockTagsPsqlMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagsPsql) EXPECT() *MockTagsPsqlMockRecorder {
+	return m.recorder
+}
+
+// GetNewsByTag mocks base method.
+func (m *MockTagsPsql) GetNewsByTag(ctx context.Context, tagID uuid.UUID, pq *utils.PaginationQuery) (*entity.NewsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNewsByTag", ctx, tagID, pq)
+	ret0, _ := ret[0].(*entity.NewsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNewsByTag indicates an expected call of GetNewsByTag.
+func (mr *MockTagsPsqlMockRecorder) GetNewsByTag(ctx, tagID, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNewsByTag", reflect.TypeOf((*MockTagsPsql)(nil).GetNewsByTag), ctx, tagID, pq)
+}
+
+// GetTagBySlug mocks base method.
+func (m *MockTagsPsql) GetTagBySlug(ctx context.Context, slug string) (*entity.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTagBySlug", ctx, slug)
+	ret0, _ := ret[0].(*entity.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTagBySlug indicates an expected call of GetTagBySlug.
+func (mr *MockTagsPsqlMockRecorder) GetTagBySlug(ctx, slug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagBySlug", reflect.TypeOf((*MockTagsPsql)(nil).GetTagBySlug), ctx, slug)
+}
+
+// GetTags mocks base method.
+func (m *MockTagsPsql) GetTags(ctx context.Context, pq *utils.PaginationQuery) (*entity.TagsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTags", ctx, pq)
+	ret0, _ := ret[0].(*entity.TagsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTags indicates an expected call of GetTags.
+func (mr *MockTagsPsqlMockRecorder) GetTags(ctx, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockTagsPsql)(nil).GetTags), ctx, pq)
+}
+
+// MergeTags mocks base method.
+func (m *MockTagsPsql) MergeTags(ctx context.Context, sourceID, targetID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeTags", ctx, sourceID, targetID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MergeTags indicates an expected call of MergeTags.
+func (mr *MockTagsPsqlMockRecorder) MergeTags(ctx, sourceID, targetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeTags", reflect.TypeOf((*MockTagsPsql)(nil).MergeTags), ctx, sourceID, targetID)
+}
+
+// RenameTag mocks base method.
+func (m *MockTagsPsql) RenameTag(ctx context.Context, tagID uuid.UUID, name string) (*entity.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameTag", ctx, tagID, name)
+	ret0, _ := ret[0].(*entity.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameTag indicates an expected call of RenameTag.
+func (mr *MockTagsPsqlMockRecorder) RenameTag(ctx, tagID, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameTag", reflect.TypeOf((*MockTagsPsql)(nil).RenameTag), ctx, tagID, name)
+}
+
+// MockCategoriesPsql is a mock of CategoriesPsql interface.
+type MockCategoriesPsql struct {
+	ctrl     *gomock.Controller
+	recorder *MockCategoriesPsqlMockRecorder
+}
+
+// MockCategoriesPsqlMockRecorder is the mock recorder for MockCategoriesPsql.
+type MockCategoriesPsqlMockRecorder struct {
+	mock *MockCategoriesPsql
+}
+
+// NewMockCategoriesPsql creates a new mock instance.
+func NewMockCategoriesPsql(ctrl *gomock.Controller) *MockCategoriesPsql {
+	mock := &MockCategoriesPsql{ctrl: ctrl}
+	mock.recorder = &MockCategoriesPsqlMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCategoriesPsql) EXPECT() *MockCategoriesPsqlMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCategoriesPsql) Create(ctx context.Context, category *entity.Category) (*entity.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, category)
+	ret0, _ := ret[0].(*entity.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCategoriesPsqlMockRecorder) Create(ctx, category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCategoriesPsql)(nil).Create), ctx, category)
+}
+
+// Delete mocks base method.
+func (m *MockCategoriesPsql) Delete(ctx context.Context, categoryID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, categoryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCategoriesPsqlMockRecorder) Delete(ctx, categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCategoriesPsql)(nil).Delete), ctx, categoryID)
+}
+
+// GetAll mocks base method.
+func (m *MockCategoriesPsql) GetAll(ctx context.Context) ([]*entity.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]*entity.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockCategoriesPsqlMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockCategoriesPsql)(nil).GetAll), ctx)
+}
+
+// GetByID mocks base method.
+func (m *MockCategoriesPsql) GetByID(ctx context.Context, categoryID uuid.UUID) (*entity.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, categoryID)
+	ret0, _ := ret[0].(*entity.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockCategoriesPsqlMockRecorder) GetByID(ctx, categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockCategoriesPsql)(nil).GetByID), ctx, categoryID)
+}
+
+// GetBySlug mocks base method.
+func (m *MockCategoriesPsql) GetBySlug(ctx context.Context, slug string) (*entity.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBySlug", ctx, slug)
+	ret0, _ := ret[0].(*entity.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBySlug indicates an expected call of GetBySlug.
+func (mr *MockCategoriesPsqlMockRecorder) GetBySlug(ctx, slug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySlug", reflect.TypeOf((*MockCategoriesPsql)(nil).GetBySlug), ctx, slug)
+}
+
+// GetNews mocks base method.
+func (m *MockCategoriesPsql) GetNews(ctx context.Context, categoryID uuid.UUID, descendants bool, pq *utils.PaginationQuery) (*entity.NewsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNews", ctx, categoryID, descendants, pq)
+	ret0, _ := ret[0].(*entity.NewsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNews indicates an expected call of GetNews.
+func (mr *MockCategoriesPsqlMockRecorder) GetNews(ctx, categoryID, descendants, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNews", reflect.TypeOf((*MockCategoriesPsql)(nil).GetNews), ctx, categoryID, descendants, pq)
+}
+
+// IsDescendant mocks base method.
+func (m *MockCategoriesPsql) IsDescendant(ctx context.Context, ancestorID, categoryID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsDescendant", ctx, ancestorID, categoryID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsDescendant indicates an expected call of IsDescendant.
+func (mr *MockCategoriesPsqlMockRecorder) IsDescendant(ctx, ancestorID, categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDescendant", reflect.TypeOf((*MockCategoriesPsql)(nil).IsDescendant), ctx, ancestorID, categoryID)
+}
+
+// Update mocks base method.
+func (m *MockCategoriesPsql) Update(ctx context.Context, category *entity.Category) (*entity.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, category)
+	ret0, _ := ret[0].(*entity.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCategoriesPsqlMockRecorder) Update(ctx, category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCategoriesPsql)(nil).Update), ctx, category)
+}
+
+// MockSuggestPsql is a mock of SuggestPsql interface.
+type MockSuggestPsql struct {
+	ctrl     *gomock.Controller
+	recorder *MockSuggestPsqlMockRecorder
+}
+
+// MockSuggestPsqlMockRecorder is the mock recorder for MockSuggestPsql.
+type MockSuggestPsqlMockRecorder struct {
+	mock *MockSuggestPsql
+}
+
+// NewMockSuggestPsql creates a new mock instance.
+func NewMockSuggestPsql(ctrl *gomock.Controller) *MockSuggestPsql {
+	mock := &MockSuggestPsql{ctrl: ctrl}
+	mock.recorder = &MockSuggestPsqlMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSuggestPsql) EXPECT() *MockSuggestPsqlMockRecorder {
+	return m.recorder
+}
+
+// GetAuthorSuggestions mocks base method.
+func (m *MockSuggestPsql) GetAuthorSuggestions(ctx context.Context, after uuid.UUID, limit int) ([]*entity.Suggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthorSuggestions", ctx, after, limit)
+	ret0, _ := ret[0].([]*entity.Suggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuthorSuggestions indicates an expected call of GetAuthorSuggestions.
+func (mr *MockSuggestPsqlMockRecorder) GetAuthorSuggestions(ctx, after, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorSuggestions", reflect.TypeOf((*MockSuggestPsql)(nil).GetAuthorSuggestions), ctx, after, limit)
+}
+
+// GetNewsSuggestions mocks base method.
+func (m *MockSuggestPsql) GetNewsSuggestions(ctx context.Context, after uuid.UUID, limit int) ([]*entity.Suggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNewsSuggestions", ctx, after, limit)
+	ret0, _ := ret[0].([]*entity.Suggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNewsSuggestions indicates an expected call of GetNewsSuggestions.
+func (mr *MockSuggestPsqlMockRecorder) GetNewsSuggestions(ctx, after, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNewsSuggestions", reflect.TypeOf((*MockSuggestPsql)(nil).GetNewsSuggestions), ctx, after, limit)
+}
+
+// MockSitemapsPsql is a mock of SitemapsPsql interface.
+type MockSitemapsPsql struct {
+	ctrl     *gomock.Controller
+	recorder *MockSitemapsPsqlMockRecorder
+}
+
+// MockSitemapsPsqlMockRecorder is the mock recorder for MockSitemapsPsql.
+type MockSitemapsPsqlMockRecorder struct {
+	mock *MockSitemapsPsql
+}
+
+// NewMockSitemapsPsql creates a new mock instance.
+func NewMockSitemapsPsql(ctrl *gomock.Controller) *MockSitemapsPsql {
+	mock := &MockSitemapsPsql{ctrl: ctrl}
+	mock.recorder = &MockSitemapsPsqlMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSitemapsPsql) EXPECT() *MockSitemapsPsqlMockRecorder {
+	return m.recorder
+}
+
+// GetLatestNews mocks base method.
+func (m *MockSitemapsPsql) GetLatestNews(ctx context.Context, since time.Time, limit int) ([]*entity.SitemapNews, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestNews", ctx, since, limit)
+	ret0, _ := ret[0].([]*entity.SitemapNews)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestNews indicates an expected call of GetLatestNews.
+func (mr *MockSitemapsPsqlMockRecorder) GetLatestNews(ctx, since, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestNews", reflect.TypeOf((*MockSitemapsPsql)(nil).GetLatestNews), ctx, since, limit)
+}
+
+// GetNews mocks base method.
+func (m *MockSitemapsPsql) GetNews(ctx context.Context, page, size int) ([]*entity.SitemapNews, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNews", ctx, page, size)
+	ret0, _ := ret[0].([]*entity.SitemapNews)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNews indicates an expected call of GetNews.
+func (mr *MockSitemapsPsqlMockRecorder) GetNews(ctx, page, size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNews", reflect.TypeOf((*MockSitemapsPsql)(nil).GetNews), ctx, page, size)
+}
+
+// GetNewsPosition mocks base method.
+func (m *MockSitemapsPsql) GetNewsPosition(ctx context.Context, publishAt time.Time, newsID uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNewsPosition", ctx, publishAt, newsID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNewsPosition indicates an expected call of GetNewsPosition.
+func (mr *MockSitemapsPsqlMockRecorder) GetNewsPosition(ctx, publishAt, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNewsPosition", reflect.TypeOf((*MockSitemapsPsql)(nil).GetNewsPosition), ctx, publishAt, newsID)
+}
+
+// GetPages mocks base method.
+func (m *MockSitemapsPsql) GetPages(ctx context.Context, size int) ([]*entity.SitemapPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPages", ctx, size)
+	ret0, _ := ret[0].([]*entity.SitemapPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPages indicates an expected call of GetPages.
+func (mr *MockSitemapsPsqlMockRecorder) GetPages(ctx, size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPages", reflect.TypeOf((*MockSitemapsPsql)(nil).GetPages), ctx, size)
+}
+
+// MockViewsPsql is a mock of ViewsPsql interface.
+type MockViewsPsql struct {
+	ctrl     *gomock.Controller
+	recorder *MockViewsPsqlMockRecorder
+}
+
+// MockViewsPsqlMockRecorder is the mock recorder for MockViewsPsql.
+type MockViewsPsqlMockRecorder struct {
+	mock *MockViewsPsql
+}
+
+// NewMockViewsPsql creates a new mock instance.
+func NewMockViewsPsql(ctrl *gomock.Controller) *MockViewsPsql {
+	mock := &MockViewsPsql{ctrl: ctrl}
+	mock.recorder = &MockViewsPsqlMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockViewsPsql) EXPECT() *MockViewsPsqlMockRecorder {
+	return m.recorder
+}
+
+// AddViews mocks base method.
+func (m *MockViewsPsql) AddViews(ctx context.Context, views map[uuid.UUID]int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddViews", ctx, views)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddViews indicates an expected call of AddViews.
+func (mr *MockViewsPsqlMockRecorder) AddViews(ctx, views interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddViews", reflect.TypeOf((*MockViewsPsql)(nil).AddViews), ctx, views)
+}
+
+// GetTrendingNews mocks base method.
+func (m *MockViewsPsql) GetTrendingNews(ctx context.Context, newsIDs []uuid.UUID) ([]*entity.TrendingNews, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrendingNews", ctx, newsIDs)
+	ret0, _ := ret[0].([]*entity.TrendingNews)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrendingNews indicates an expected call of GetTrendingNews.
+func (mr *MockViewsPsqlMockRecorder) GetTrendingNews(ctx, newsIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrendingNews", reflect.TypeOf((*MockViewsPsql)(nil).GetTrendingNews), ctx, newsIDs)
+}
+
+// MockReactionsPsql is a mock of ReactionsPsql interface.
+type MockReactionsPsql struct {
+	ctrl     *gomock.Controller
+	recorder *MockReactionsPsqlMockRecorder
+}
+
+// MockReactionsPsqlMockRecorder is the mock recorder for MockReactionsPsql.
+type MockReactionsPsqlMockRecorder struct {
+	mock *MockReactionsPsql
+}
+
+// NewMockReactionsPsql creates a new mock instance.
+func NewMockReactionsPsql(ctrl *gomock.Controller) *MockReactionsPsql {
+	mock := &MockReactionsPsql{ctrl: ctrl}
+	mock.recorder = &MockReactionsPsqlMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReactionsPsql) EXPECT() *MockReactionsPsqlMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockReactionsPsql) Add(ctx context.Context, reaction *entity.Reaction) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, reaction)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockReactionsPsqlMockRecorder) Add(ctx, reaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockReactionsPsql)(nil).Add), ctx, reaction)
+}
+
+// GetReactions mocks base method.
+func (m *MockReactionsPsql) GetReactions(ctx context.Context, targetType string, targetID, userID uuid.UUID) (*entity.Reactions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReactions", ctx, targetType, targetID, userID)
+	ret0, _ := ret[0].(*entity.Reactions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReactions indicates an expected call of GetReactions.
+func (mr *MockReactionsPsqlMockRecorder) GetReactions(ctx, targetType, targetID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReactions", reflect.TypeOf((*MockReactionsPsql)(nil).GetReactions), ctx, targetType, targetID, userID)
+}
+
+// Reconcile mocks base method.
+func (m *MockReactionsPsql) Reconcile(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reconcile", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reconcile indicates an expected call of Reconcile.
+func (mr *MockReactionsPsqlMockRecorder) Reconcile(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockReactionsPsql)(nil).Reconcile), ctx)
+}
+
+// Remove mocks base method.
+func (m *MockReactionsPsql) Remove(ctx context.Context, reaction *entity.Reaction) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, reaction)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockReactionsPsqlMockRecorder) Remove(ctx, reaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockReactionsPsql)(nil).Remove), ctx, reaction)
+}
+
+// MockBookmarksPsql is a mock of BookmarksPsql interface.
+type MockBookmarksPsql struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookmarksPsqlMockRecorder
+}
+
+// MockBookmarksPsqlMockRecorder is the mock recorder for MockBookmarksPsql.
+type MockBookmarksPsqlMockRecorder struct {
+	mock *MockBookmarksPsql
+}
+
+// NewMockBookmarksPsql creates a new mock instance.
+func NewMockBookmarksPsql(ctrl *gomock.Controller) *MockBookmarksPsql {
+	mock := &MockBookmarksPsql{ctrl: ctrl}
+	mock.recorder = &MockBookmarksPsqlMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookmarksPsql) EXPECT() *MockBookmarksPsqlMockRecorder {
+	return m.recorder
+}
+
+// AddBookmark mocks base method.
+func (m *MockBookmarksPsql) AddBookmark(ctx context.Context, userID, newsID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBookmark", ctx, userID, newsID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddBookmark indicates an expected call of AddBookmark.
+func (mr *MockBookmarksPsqlMockRecorder) AddBookmark(ctx, userID, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBookmark", reflect.TypeOf((*MockBookmarksPsql)(nil).AddBookmark), ctx, userID, newsID)
+}
+
+// AddListItem mocks base method.
+func (m *MockBookmarksPsql) AddListItem(ctx context.Context, listID, newsID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddListItem", ctx, listID, newsID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddListItem indicates an expected call of AddListItem.
+func (mr *MockBookmarksPsqlMockRecorder) AddListItem(ctx, listID, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddListItem", reflect.TypeOf((*MockBookmarksPsql)(nil).AddListItem), ctx, listID, newsID)
+}
+
+// CreateList mocks base method.
+func (m *MockBookmarksPsql) CreateList(ctx context.Context, list *entity.ReadingList) (*entity.ReadingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateList", ctx, list)
+	ret0, _ := ret[0].(*entity.ReadingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateList indicates an expected call of CreateList.
+func (mr *MockBookmarksPsqlMockRecorder) CreateList(ctx, list interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateList", reflect.TypeOf((*MockBookmarksPsql)(nil).CreateList), ctx, list)
+}
+
+// DeleteList mocks base method.
+func (m *MockBookmarksPsql) DeleteList(ctx context.Context, listID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteList", ctx, listID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteList indicates an expected call of DeleteList.
+func (mr *MockBookmarksPsqlMockRecorder) DeleteList(ctx, listID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteList", reflect.TypeOf((*MockBookmarksPsql)(nil).DeleteList), ctx, listID)
+}
+
+// GetBookmarks mocks base method.
+func (m *MockBookmarksPsql) GetBookmarks(ctx context.Context, userID uuid.UUID, pq *utils.PaginationQuery) (*entity.NewsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookmarks", ctx, userID, pq)
+	ret0, _ := ret[0].(*entity.NewsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookmarks indicates an expected call of GetBookmarks.
+func (mr *MockBookmarksPsqlMockRecorder) GetBookmarks(ctx, userID, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookmarks", reflect.TypeOf((*MockBookmarksPsql)(nil).GetBookmarks), ctx, userID, pq)
+}
+
+// GetList mocks base method.
+func (m *MockBookmarksPsql) GetList(ctx context.Context, listID uuid.UUID) (*entity.ReadingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetList", ctx, listID)
+	ret0, _ := ret[0].(*entity.ReadingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetList indicates an expected call of GetList.
+func (mr *MockBookmarksPsqlMockRecorder) GetList(ctx, listID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetList", reflect.TypeOf((*MockBookmarksPsql)(nil).GetList), ctx, listID)
+}
+
+// GetListNews mocks base method.
+func (m *MockBookmarksPsql) GetListNews(ctx context.Context, listID, viewerID uuid.UUID, pq *utils.PaginationQuery) (*entity.NewsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListNews", ctx, listID, viewerID, pq)
+	ret0, _ := ret[0].(*entity.NewsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListNews indicates an expected call of GetListNews.
+func (mr *MockBookmarksPsqlMockRecorder) GetListNews(ctx, listID, viewerID, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListNews", reflect.TypeOf((*MockBookmarksPsql)(nil).GetListNews), ctx, listID, viewerID, pq)
+}
+
+// GetLists mocks base method.
+func (m *MockBookmarksPsql) GetLists(ctx context.Context, userID uuid.UUID) ([]*entity.ReadingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLists", ctx, userID)
+	ret0, _ := ret[0].([]*entity.ReadingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLists indicates an expected call of GetLists.
+func (mr *MockBookmarksPsqlMockRecorder) GetLists(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLists", reflect.TypeOf((*MockBookmarksPsql)(nil).GetLists), ctx, userID)
+}
+
+// RemoveBookmark mocks base method.
+func (m *MockBookmarksPsql) RemoveBookmark(ctx context.Context, userID, newsID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveBookmark", ctx, userID, newsID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveBookmark indicates an expected call of RemoveBookmark.
+func (mr *MockBookmarksPsqlMockRecorder) RemoveBookmark(ctx, userID, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBookmark", reflect.TypeOf((*MockBookmarksPsql)(nil).RemoveBookmark), ctx, userID, newsID)
+}
+
+// RemoveListItem mocks base method.
+func (m *MockBookmarksPsql) RemoveListItem(ctx context.Context, listID, newsID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveListItem", ctx, listID, newsID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveListItem indicates an expected call of RemoveListItem.
+func (mr *MockBookmarksPsqlMockRecorder) RemoveListItem(ctx, listID, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveListItem", reflect.TypeOf((*MockBookmarksPsql)(nil).RemoveListItem), ctx, listID, newsID)
+}
+
+// ReorderList mocks base method.
+func (m *MockBookmarksPsql) ReorderList(ctx context.Context, listID uuid.UUID, newsIDs []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderList", ctx, listID, newsIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderList indicates an expected call of ReorderList.
+func (mr *MockBookmarksPsqlMockRecorder) ReorderList(ctx, listID, newsIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderList", reflect.TypeOf((*MockBookmarksPsql)(nil).ReorderList), ctx, listID, newsIDs)
+}
+
+// UpdateList mocks base method.
+func (m *MockBookmarksPsql) UpdateList(ctx context.Context, list *entity.ReadingListUpdate) (*entity.ReadingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateList", ctx, list)
+	ret0, _ := ret[0].(*entity.ReadingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateList indicates an expected call of UpdateList.
+func (mr *MockBookmarksPsqlMockRecorder) UpdateList(ctx, list interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateList", reflect.TypeOf((*MockBookmarksPsql)(nil).UpdateList), ctx, list)
+}
+
+// MockRelatedPsql is a mock of RelatedPsql interface.
+type MockRelatedPsql struct {
+	ctrl     *gomock.Controller
+	recorder *MockRelatedPsqlMockRecorder
+}
+
+// MockRelatedPsqlMockRecorder is the mock recorder for MockRelatedPsql.
+type MockRelatedPsqlMockRecorder struct {
+	mock *MockRelatedPsql
+}
+
+// NewMockRelatedPsql creates a new mock instance.
+func NewMockRelatedPsql(ctrl *gomock.Controller) *MockRelatedPsql {
+	mock := &MockRelatedPsql{ctrl: ctrl}
+	mock.recorder = &MockRelatedPsqlMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRelatedPsql) EXPECT() *MockRelatedPsqlMockRecorder {
+	return m.recorder
+}
+
+// GetCategoryNews mocks base method.
+func (m *MockRelatedPsql) GetCategoryNews(ctx context.Context, categoryID *uuid.UUID, newsID uuid.UUID, limit int) ([]*entity.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryNews", ctx, categoryID, newsID, limit)
+	ret0, _ := ret[0].([]*entity.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryNews indicates an expected call of GetCategoryNews.
+func (mr *MockRelatedPsqlMockRecorder) GetCategoryNews(ctx, categoryID, newsID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryNews", reflect.TypeOf((*MockRelatedPsql)(nil).GetCategoryNews), ctx, categoryID, newsID, limit)
+}
+
+// GetCorpus mocks base method.
+func (m *MockRelatedPsql) GetCorpus(ctx context.Context) ([]*entity.RelatedDocument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCorpus", ctx)
+	ret0, _ := ret[0].([]*entity.RelatedDocument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCorpus indicates an expected call of GetCorpus.
+func (mr *MockRelatedPsqlMockRecorder) GetCorpus(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCorpus", reflect.TypeOf((*MockRelatedPsql)(nil).GetCorpus), ctx)
+}
+
+// GetNews mocks base method.
+func (m *MockRelatedPsql) GetNews(ctx context.Context, newsIDs []uuid.UUID) ([]*entity.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNews", ctx, newsIDs)
+	ret0, _ := ret[0].([]*entity.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNews indicates an expected call of GetNews.
+func (mr *MockRelatedPsqlMockRecorder) GetNews(ctx, newsIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNews", reflect.TypeOf((*MockRelatedPsql)(nil).GetNews), ctx, newsIDs)
+}
+
+// GetNewsCategory mocks base method.
+func (m *MockRelatedPsql) GetNewsCategory(ctx context.Context, newsID uuid.UUID) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNewsCategory", ctx, newsID)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNewsCategory indicates an expected call of GetNewsCategory.
+func (mr *MockRelatedPsqlMockRecorder) GetNewsCategory(ctx, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNewsCategory", reflect.TypeOf((*MockRelatedPsql)(nil).GetNewsCategory), ctx, newsID)
+}
+
+// MockTranslationsPsql is a mock of TranslationsPsql interface.
+type MockTranslationsPsql struct {
+	ctrl     *gomock.Controller
+	recorder *MockTranslationsPsqlMockRecorder
+}
+
+// MockTranslationsPsqlMockRecorder is the mock recorder for MockTranslationsPsql.
+type MockTranslationsPsqlMockRecorder struct {
+	mock *MockTranslationsPsql
+}
+
+// NewMockTranslationsPsql creates a new mock instance.
+func NewMockTranslationsPsql(ctrl *gomock.Controller) *MockTranslationsPsql {
+	mock := &MockTranslationsPsql{ctrl: ctrl}
+	mock.recorder = &MockTranslationsPsqlMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTranslationsPsql) EXPECT() *MockTranslationsPsqlMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTranslationsPsql) Create(ctx context.Context, translation *entity.NewsTranslation) (*entity.NewsTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, translation)
+	ret0, _ := ret[0].(*entity.NewsTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTranslationsPsqlMockRecorder) Create(ctx, translation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTranslationsPsql)(nil).Create), ctx, translation)
+}
+
+// Delete mocks base method.
+func (m *MockTranslationsPsql) Delete(ctx context.Context, newsID uuid.UUID, locale string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, newsID, locale)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTranslationsPsqlMockRecorder) Delete(ctx, newsID, locale interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTranslationsPsql)(nil).Delete), ctx, newsID, locale)
+}
+
+// GetNews mocks base method.
+func (m *MockTranslationsPsql) GetNews(ctx context.Context, newsID uuid.UUID) (*entity.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNews", ctx, newsID)
+	ret0, _ := ret[0].(*entity.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNews indicates an expected call of GetNews.
+func (mr *MockTranslationsPsqlMockRecorder) GetNews(ctx, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNews", reflect.TypeOf((*MockTranslationsPsql)(nil).GetNews), ctx, newsID)
+}
+
+// GetTranslations mocks base method.
+func (m *MockTranslationsPsql) GetTranslations(ctx context.Context, newsID uuid.UUID) ([]*entity.NewsTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTranslations", ctx, newsID)
+	ret0, _ := ret[0].([]*entity.NewsTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTranslations indicates an expected call of GetTranslations.
+func (mr *MockTranslationsPsqlMockRecorder) GetTranslations(ctx, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTranslations", reflect.TypeOf((*MockTranslationsPsql)(nil).GetTranslations), ctx, newsID)
+}
+
+// Update mocks base method.
+func (m *MockTranslationsPsql) Update(ctx context.Context, translation *entity.NewsTranslation) (*entity.NewsTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, translation)
+	ret0, _ := ret[0].(*entity.NewsTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTranslationsPsqlMockRecorder) Update(ctx, translation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTranslationsPsql)(nil).Update), ctx, translation)
+}
+
+// MockReviewsPsql is a mock of ReviewsPsql interface.
+type MockReviewsPsql struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewsPsqlMockRecorder
+}
+
+// MockReviewsPsqlMockRecorder is the mock recorder for MockReviewsPsql.
+type MockReviewsPsqlMockRecorder struct {
+	mock *MockReviewsPsql
+}
+
+// NewMockReviewsPsql creates a new mock instance.
+func NewMockReviewsPsql(ctrl *gomock.Controller) *MockReviewsPsql {
+	mock := &MockReviewsPsql{ctrl: ctrl}
+	mock.recorder = &MockReviewsPsqlMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewsPsql) EXPECT() *MockReviewsPsqlMockRecorder {
+	return m.recorder
+}
+
+// GetQueue mocks base method.
+func (m *MockReviewsPsql) GetQueue(ctx context.Context, pq *utils.PaginationQuery) (*entity.NewsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueue", ctx, pq)
+	ret0, _ := ret[0].(*entity.NewsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueue indicates an expected call of GetQueue.
+func (mr *MockReviewsPsqlMockRecorder) GetQueue(ctx, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueue", reflect.TypeOf((*MockReviewsPsql)(nil).GetQueue), ctx, pq)
+}
+
+// GetReviews mocks base method.
+func (m *MockReviewsPsql) GetReviews(ctx context.Context, newsID uuid.UUID) ([]*entity.NewsReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviews", ctx, newsID)
+	ret0, _ := ret[0].([]*entity.NewsReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviews indicates an expected call of GetReviews.
+func (mr *MockReviewsPsqlMockRecorder) GetReviews(ctx, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviews", reflect.TypeOf((*MockReviewsPsql)(nil).GetReviews), ctx, newsID)
+}
+
+// Transition mocks base method.
+func (m *MockReviewsPsql) Transition(ctx context.Context, review *entity.NewsReview, from []string, to string, publishAt *time.Time) (*entity.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transition", ctx, review, from, to, publishAt)
+	ret0, _ := ret[0].(*entity.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Transition indicates an expected call of Transition.
+func (mr *MockReviewsPsqlMockRecorder) Transition(ctx, review, from, to, publishAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transition", reflect.TypeOf((*MockReviewsPsql)(nil).Transition), ctx, review, from, to, publishAt)
+}
+
+// MockNotificationsPsql is a mock of NotificationsPsql interface.
+type MockNotificationsPsql struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationsPsqlMockRecorder
+}
+
+// MockNotificationsPsqlMockRecorder is the mock recorder for MockNotificationsPsql.
+type MockNotificationsPsqlMockRecorder struct {
+	mock *MockNotificationsPsql
+}
+
+// NewMockNotificationsPsql creates a new mock instance.
+func NewMockNotificationsPsql(ctrl *gomock.Controller) *MockNotificationsPsql {
+	mock := &MockNotificationsPsql{ctrl: ctrl}
+	mock.recorder = &MockNotificationsPsqlMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationsPsql) EXPECT() *MockNotificationsPsqlMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockNotificationsPsql) Create(ctx context.Context, notification *entity.Notification) (*entity.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, notification)
+	ret0, _ := ret[0].(*entity.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockNotificationsPsqlMockRecorder) Create(ctx, notification interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockNotificationsPsql)(nil).Create), ctx, notification)
+}
+
+// GetNotifications mocks base method.
+func (m *MockNotificationsPsql) GetNotifications(ctx context.Context, userID uuid.UUID, unreadOnly bool, pq *utils.PaginationQuery) (*entity.NotificationsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotifications", ctx, userID, unreadOnly, pq)
+	ret0, _ := ret[0].(*entity.NotificationsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotifications indicates an expected call of GetNotifications.
+func (mr *MockNotificationsPsqlMockRecorder) GetNotifications(ctx, userID, unreadOnly, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifications", reflect.TypeOf((*MockNotificationsPsql)(nil).GetNotifications), ctx, userID, unreadOnly, pq)
+}
+
+// MarkAllRead mocks base method.
+func (m *MockNotificationsPsql) MarkAllRead(ctx context.Context, userID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllRead", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAllRead indicates an expected call of MarkAllRead.
+func (mr *MockNotificationsPsqlMockRecorder) MarkAllRead(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllRead", reflect.TypeOf((*MockNotificationsPsql)(nil).MarkAllRead), ctx, userID)
+}
+
+// MarkRead mocks base method.
+func (m *MockNotificationsPsql) MarkRead(ctx context.Context, userID, notificationID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", ctx, userID, notificationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockNotificationsPsqlMockRecorder) MarkRead(ctx, userID, notificationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockNotificationsPsql)(nil).MarkRead), ctx, userID, notificationID)
+}
+
+// MockContributorsPsql is a mock of ContributorsPsql interface.
+type MockContributorsPsql struct {
+	ctrl     *gomock.Controller
+	recorder *MockContributorsPsqlMockRecorder
+}
+
+// MockContributorsPsqlMockRecorder is the mock recorder for MockContributorsPsql.
+type MockContributorsPsqlMockRecorder struct {
+	mock *MockContributorsPsql
+}
+
+// NewMockContributorsPsql creates a new mock instance.
+func NewMockContributorsPsql(ctrl *gomock.Controller) *MockContributorsPsql {
+	mock := &MockContributorsPsql{ctrl: ctrl}
+	mock.recorder = &MockContributorsPsqlMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContributorsPsql) EXPECT() *MockContributorsPsqlMockRecorder {
+	return m.recorder
+}
+
+// Accept mocks base method.
+func (m *MockContributorsPsql) Accept(ctx context.Context, newsID, userID uuid.UUID) (*entity.NewsContributor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Accept", ctx, newsID, userID)
+	ret0, _ := ret[0].(*entity.NewsContributor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Accept indicates an expected call of Accept.
+func (mr *MockContributorsPsqlMockRecorder) Accept(ctx, newsID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MockContributorsPsql)(nil).Accept), ctx, newsID, userID)
+}
+
+// GetContributors mocks base method.
+func (m *MockContributorsPsql) GetContributors(ctx context.Context, newsID uuid.UUID) ([]*entity.NewsContributor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContributors", ctx, newsID)
+	ret0, _ := ret[0].([]*entity.NewsContributor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContributors indicates an expected call of GetContributors.
+func (mr *MockContributorsPsqlMockRecorder) GetContributors(ctx, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContributors", reflect.TypeOf((*MockContributorsPsql)(nil).GetContributors), ctx, newsID)
+}
+
+// GetInvitations mocks base method.
+func (m *MockContributorsPsql) GetInvitations(ctx context.Context, userID uuid.UUID) ([]*entity.NewsContributor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvitations", ctx, userID)
+	ret0, _ := ret[0].([]*entity.NewsContributor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvitations indicates an expected call of GetInvitations.
+func (mr *MockContributorsPsqlMockRecorder) GetInvitations(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvitations", reflect.TypeOf((*MockContributorsPsql)(nil).GetInvitations), ctx, userID)
+}
+
+// GetNews mocks base method.
+func (m *MockContributorsPsql) GetNews(ctx context.Context, newsID uuid.UUID) (*entity.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNews", ctx, newsID)
+	ret0, _ := ret[0].(*entity.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNews indicates an expected call of GetNews.
+func (mr *MockContributorsPsqlMockRecorder) GetNews(ctx, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNews", reflect.TypeOf((*MockContributorsPsql)(nil).GetNews), ctx, newsID)
+}
+
+// Invite mocks base method.
+func (m *MockContributorsPsql) Invite(ctx context.Context, contributor *entity.NewsContributor) (*entity.NewsContributor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Invite", ctx, contributor)
+	ret0, _ := ret[0].(*entity.NewsContributor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Invite indicates an expected call of Invite.
+func (mr *MockContributorsPsqlMockRecorder) Invite(ctx, contributor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invite", reflect.TypeOf((*MockContributorsPsql)(nil).Invite), ctx, contributor)
+}
+
+// IsCoAuthor mocks base method.
+func (m *MockContributorsPsql) IsCoAuthor(ctx context.Context, newsID, userID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsCoAuthor", ctx, newsID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsCoAuthor indicates an expected call of IsCoAuthor.
+func (mr *MockContributorsPsqlMockRecorder) IsCoAuthor(ctx, newsID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsCoAuthor", reflect.TypeOf((*MockContributorsPsql)(nil).IsCoAuthor), ctx, newsID, userID)
+}
+
+// Remove mocks base method.
+func (m *MockContributorsPsql) Remove(ctx context.Context, newsID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, newsID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockContributorsPsqlMockRecorder) Remove(ctx, newsID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockContributorsPsql)(nil).Remove), ctx, newsID, userID)
+}
+
+// MockTransferPsql is a mock of TransferPsql interface.
+type MockTransferPsql struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferPsqlMockRecorder
+}
+
+// MockTransferPsqlMockRecorder is the mock recorder for MockTransferPsql.
+type MockTransferPsqlMockRecorder struct {
+	mock *MockTransferPsql
+}
+
+// NewMockTransferPsql creates a new mock instance.
+func NewMockTransferPsql(ctrl *gomock.Controller) *MockTransferPsql {
+	mock := &MockTransferPsql{ctrl: ctrl}
+	mock.recorder = &MockTransferPsqlMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferPsql) EXPECT() *MockTransferPsqlMockRecorder {
+	return m.recorder
+}
+
+// ExportNews mocks base method.
+func (m *MockTransferPsql) ExportNews(ctx context.Context, withComments bool, fn func(*entity.NewsRecord) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportNews", ctx, withComments, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportNews indicates an expected call of ExportNews.
+func (mr *MockTransferPsqlMockRecorder) ExportNews(ctx, withComments, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportNews", reflect.TypeOf((*MockTransferPsql)(nil).ExportNews), ctx, withComments, fn)
+}
+
+// ImportNews mocks base method.
+func (m *MockTransferPsql) ImportNews(ctx context.Context, newsList []*entity.News, dryRun bool) ([]error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportNews", ctx, newsList, dryRun)
+	ret0, _ := ret[0].([]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportNews indicates an expected call of ImportNews.
+func (mr *MockTransferPsqlMockRecorder) ImportNews(ctx, newsList, dryRun interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportNews", reflect.TypeOf((*MockTransferPsql)(nil).ImportNews), ctx, newsList, dryRun)
+}
+
+// MockWordPressPsql is a mock of WordPressPsql interface.
+type MockWordPressPsql struct {
+	ctrl     *gomock.Controller
+	recorder *MockWordPressPsqlMockRecorder
+}
+
+// MockWordPressPsqlMockRecorder is the mock recorder for MockWordPressPsql.
+type MockWordPressPsqlMockRecorder struct {
+	mock *MockWordPressPsql
+}
+
+// NewMockWordPressPsql creates a new mock instance.
+func NewMockWordPressPsql(ctrl *gomock.Controller) *MockWordPressPsql {
+	mock := &MockWordPressPsql{ctrl: ctrl}
+	mock.recorder = &MockWordPressPsqlMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWordPressPsql) EXPECT() *MockWordPressPsqlMockRecorder {
+	return m.recorder
+}
+
+// GetImported mocks base method.
+func (m *MockWordPressPsql) GetImported(ctx context.Context, source, kind string) (map[string]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImported", ctx, source, kind)
+	ret0, _ := ret[0].(map[string]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImported indicates an expected call of GetImported.
+func (mr *MockWordPressPsqlMockRecorder) GetImported(ctx, source, kind interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImported", reflect.TypeOf((*MockWordPressPsql)(nil).GetImported), ctx, source, kind)
+}
+
+// GetImportedSlugs mocks base method.
+func (m *MockWordPressPsql) GetImportedSlugs(ctx context.Context, source string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImportedSlugs", ctx, source)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImportedSlugs indicates an expected call of GetImportedSlugs.
+func (mr *MockWordPressPsqlMockRecorder) GetImportedSlugs(ctx, source interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImportedSlugs", reflect.TypeOf((*MockWordPressPsql)(nil).GetImportedSlugs), ctx, source)
+}
+
+// ImportCategory mocks base method.
+func (m *MockWordPressPsql) ImportCategory(ctx context.Context, source, sourceID string, category *entity.Category) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportCategory", ctx, source, sourceID, category)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportCategory indicates an expected call of ImportCategory.
+func (mr *MockWordPressPsqlMockRecorder) ImportCategory(ctx, source, sourceID, category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportCategory", reflect.TypeOf((*MockWordPressPsql)(nil).ImportCategory), ctx, source, sourceID, category)
+}
+
+// ImportComment mocks base method.
+func (m *MockWordPressPsql) ImportComment(ctx context.Context, source, sourceID string, comment *entity.Comment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportComment", ctx, source, sourceID, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportComment indicates an expected call of ImportComment.
+func (mr *MockWordPressPsqlMockRecorder) ImportComment(ctx, source, sourceID, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportComment", reflect.TypeOf((*MockWordPressPsql)(nil).ImportComment), ctx, source, sourceID, comment)
+}
+
+// ImportNews mocks base method.
+func (m *MockWordPressPsql) ImportNews(ctx context.Context, source, sourceID string, news *entity.News) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportNews", ctx, source, sourceID, news)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportNews indicates an expected call of ImportNews.
+func (mr *MockWordPressPsqlMockRecorder) ImportNews(ctx, source, sourceID, news interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportNews", reflect.TypeOf((*MockWordPressPsql)(nil).ImportNews), ctx, source, sourceID, news)
+}
+
+// ImportUser mocks base method.
+func (m *MockWordPressPsql) ImportUser(ctx context.Context, source, sourceID string, user *entity.User) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportUser", ctx, source, sourceID, user)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportUser indicates an expected call of ImportUser.
+func (mr *MockWordPressPsqlMockRecorder) ImportUser(ctx, source, sourceID, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportUser", reflect.TypeOf((*MockWordPressPsql)(nil).ImportUser), ctx, source, sourceID, user)
+}
+
+// UpdateImportedContent mocks base method.
+func (m *MockWordPressPsql) UpdateImportedContent(ctx context.Context, newsID uuid.UUID, content, contentHTML string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateImportedContent", ctx, newsID, content, contentHTML)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateImportedContent indicates an expected call of UpdateImportedContent.
+func (mr *MockWordPressPsqlMockRecorder) UpdateImportedContent(ctx, newsID, content, contentHTML interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateImportedContent", reflect.TypeOf((*MockWordPressPsql)(nil).UpdateImportedContent), ctx, newsID, content, contentHTML)
+}
@@ -3,15 +3,44 @@ package psql
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"html"
+	"strings"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
 
 	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/filter"
+	"github.com/Edbeer/restapi/pkg/httpe"
 	"github.com/Edbeer/restapi/pkg/utils"
 	"github.com/google/uuid"
 )
 
+// Fields news lists can be filtered and sorted by
+var newsFilterSchema = filter.Schema{
+	"news_id":     {Column: "news_id", Type: filter.TypeUUID},
+	"author_id":   {Column: "author_id", Type: filter.TypeUUID, Match: authorOrContributor},
+	"title":       {Column: "title", Type: filter.TypeString, Sortable: true},
+	"slug":        {Column: "slug", Type: filter.TypeString},
+	"category":    {Column: "category", Type: filter.TypeString, Sortable: true},
+	"category_id": {Column: "category_id", Type: filter.TypeUUID},
+	"language":    {Column: "language", Type: filter.TypeString},
+	"locale":      {Column: "locale", Type: filter.TypeString},
+	"status":      {Column: "status", Type: filter.TypeString},
+	"publish_at":  {Column: "publish_at", Type: filter.TypeTime, Sortable: true},
+	"created_at":  {Column: "created_at", Type: filter.TypeTime, Sortable: true},
+	"updated_at":  {Column: "updated_at", Type: filter.TypeTime, Sortable: true},
+}
+
+// Sentinels of matches in ts_headline of raw text become marks of its html
+var highlightMarks = strings.NewReplacer("\x02", "<mark>", "\x03", "</mark>")
+
+// Escape highlight of source text to html with its matches marked
+func highlightHTML(highlight string) string {
+	return highlightMarks.Replace(html.EscapeString(highlight))
+}
+
 type NewsStorage struct {
 	psql *sqlx.DB
 }
@@ -21,61 +50,157 @@ func NewNewsStorage(psql *sqlx.DB) *NewsStorage {
 	return &NewsStorage{psql: psql}
 }
 
-// Create news
+// Create news and its first revision
 func (s *NewsStorage) Create(ctx context.Context, news *entity.News) (*entity.News, error) {
+	tx, err := s.psql.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "NewsStoragePsql.Create.BeginTxx")
+	}
+	defer tx.Rollback()
+
+	slug, err := freeNewsSlug(ctx, tx, utils.NewsSlug(news.Title), uuid.Nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "NewsStoragePsql.Create")
+	}
+
 	n := &entity.News{}
-	if err := s.psql.QueryRowxContext(ctx,
+	if err := tx.QueryRowxContext(ctx,
 		createNews,
 		&news.AuthorID,
 		&news.Title,
 		&news.Content,
+		&news.ImageURL,
 		&news.Category,
+		&news.Language,
+		&news.Status,
+		&news.PublishAt,
+		&news.CategoryID,
+		slug,
+		&news.ContentHTML,
+		&news.Locale,
 	).StructScan(n); err != nil {
 		return nil, errors.Wrap(err, "NewsStoragePsql.Create.StructScan")
 	}
 
+	if _, err := tx.ExecContext(ctx, createRevision, n.NewsID, n.Title, n.Content, n.AuthorID, nil); err != nil {
+		return nil, errors.Wrap(err, "NewsStoragePsql.Create.createRevision")
+	}
+
+	if news.Tags != nil {
+		if err := setNewsTags(ctx, tx, n.NewsID, news.Tags); err != nil {
+			return nil, errors.Wrap(err, "NewsStoragePsql.Create")
+		}
+		n.Tags = news.Tags
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "NewsStoragePsql.Create.Commit")
+	}
+
 	return n, nil
 }
 
-// Update news item
-func (s *NewsStorage) Update(ctx context.Context, news *entity.News) (*entity.News, error) {
+// Update news item of version, 0 updates any version, and record the
+// result as a new revision
+func (s *NewsStorage) Update(ctx context.Context, news *entity.News, rev *entity.NewsRevision) (*entity.News, error) {
+	tx, err := s.psql.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "NewsStoragePsql.Update.BeginTxx")
+	}
+	defer tx.Rollback()
+
+	// slug follows the title, the old slug keeps redirecting to the news
+	current := &entity.News{}
+	if err := tx.GetContext(ctx, current, getNewsSlugForUpdate, news.NewsID); err != nil {
+		return nil, errors.Wrap(err, "NewsStoragePsql.Update.getNewsSlugForUpdate")
+	}
+	var slug string
+	if news.Title != "" && news.Title != current.Title {
+		if slug, err = freeNewsSlug(ctx, tx, utils.NewsSlug(news.Title), news.NewsID); err != nil {
+			return nil, errors.Wrap(err, "NewsStoragePsql.Update")
+		}
+	}
+
 	n := &entity.News{}
-	if err := s.psql.QueryRowxContext(
+	if err := tx.QueryRowxContext(
 		ctx,
 		updateNews,
 		&news.Title,
 		&news.Content,
 		&news.ImageURL,
 		&news.Category,
+		&news.Language,
+		&news.Status,
+		&news.PublishAt,
 		&news.NewsID,
+		&news.CategoryID,
+		slug,
+		&news.ContentHTML,
+		&news.Locale,
+		&news.Version,
 	).StructScan(n); err != nil {
+		// the row is locked above, so only its version can miss
+		if errors.Is(err, sql.ErrNoRows) && news.Version != 0 {
+			return nil, errors.Wrap(httpe.PreconditionFailed, "NewsStoragePsql.Update.StructScan")
+		}
 		return nil, errors.Wrap(err, "NewsStoragePsql.Update.StructScan")
 	}
 
-	return n, nil
-}
+	if slug != "" && slug != current.Slug {
+		if _, err := tx.ExecContext(ctx, deleteNewsSlugRedirect, slug); err != nil {
+			return nil, errors.Wrap(err, "NewsStoragePsql.Update.deleteNewsSlugRedirect")
+		}
+		if _, err := tx.ExecContext(ctx, addNewsSlugRedirect, current.Slug, n.NewsID); err != nil {
+			return nil, errors.Wrap(err, "NewsStoragePsql.Update.addNewsSlugRedirect")
+		}
+	}
 
-// Get news
-func (s *NewsStorage) GetNews(ctx context.Context, pq *utils.PaginationQuery) (*entity.NewsList, error) {
+	if _, err := tx.ExecContext(ctx, createRevision, n.NewsID, n.Title, n.Content, rev.EditorID, rev.RollbackOf); err != nil {
+		return nil, errors.Wrap(err, "NewsStoragePsql.Update.createRevision")
+	}
 
-	var totalCount int
-	if err := s.psql.GetContext(ctx, &totalCount, getTotalNewsCount); err != nil {
-		return nil, errors.Wrap(err, "NewsStoragePsql.GetNews.GetContext")
+	if news.Tags != nil {
+		if err := setNewsTags(ctx, tx, n.NewsID, news.Tags); err != nil {
+			return nil, errors.Wrap(err, "NewsStoragePsql.Update")
+		}
+	}
+	if err := tx.SelectContext(ctx, &n.Tags, getNewsTagNames, n.NewsID); err != nil {
+		return nil, errors.Wrap(err, "NewsStoragePsql.Update.getNewsTagNames")
 	}
 
-	if totalCount == 0 {
-		return &entity.NewsList{
-			TotalCount: totalCount,
-			TotalPages: utils.GetTotalPages(totalCount, pq.GetSize()),
-			Page:       pq.GetPage(),
-			Size:       pq.GetSize(),
-			HasMore:    utils.GetHasMore(pq.GetPage(), totalCount, pq.GetSize()),
-			News:       make([]*entity.News, 0),
-		}, nil
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "NewsStoragePsql.Update.Commit")
 	}
 
-	var newsList = make([]*entity.News, 0, pq.GetSize())
-	rows, err := s.psql.QueryxContext(ctx, getNews, pq.GetDifference(), pq.GetLimit())
+	return n, nil
+}
+
+// Get published news and drafts of the viewer in the first locale
+// of the fallback chain they are translated to, newest first
+func (s *NewsStorage) GetNews(ctx context.Context, viewerID uuid.UUID, locales []string, pq *utils.PaginationQuery) (*entity.NewsList, error) {
+	list := &entity.NewsList{
+		Page: pq.GetPage(),
+		Size: pq.GetSize(),
+	}
+	if pq.WithTotal {
+		query, args, err := countQuery(getTotalNewsCount, pq, newsFilterSchema, "news", viewerID)
+		if err != nil {
+			return nil, errors.Wrap(err, "NewsStoragePsql.GetNews.countQuery")
+		}
+		if err := s.psql.GetContext(ctx, &list.TotalCount, query, args...); err != nil {
+			return nil, errors.Wrap(err, "NewsStoragePsql.GetNews.GetContext")
+		}
+		list.TotalPages = utils.GetTotalPages(list.TotalCount, pq.GetSize())
+	}
+
+	var newsList = make([]*entity.News, 0, pq.GetKeysetLimit())
+	cursorID, cursorTime := pq.GetCursorArgs()
+	query, args, err := listQuery(getNews, true, pq, newsFilterSchema, "news",
+		pq.GetKeysetOffset(), pq.GetKeysetLimit(), viewerID, arrayLiteral(locales), cursorID, cursorTime)
+	if err != nil {
+		return nil, errors.Wrap(err, "NewsStoragePsql.GetNews.listQuery")
+	}
+	rows, err := s.psql.QueryxContext(ctx, query, args...)
 	if err != nil {
 		return nil, errors.Wrap(err, "NewsStoragePsql.GetNews.QueryxContext")
 	}
@@ -93,6 +218,34 @@ func (s *NewsStorage) GetNews(ctx context.Context, pq *utils.PaginationQuery) (*
 		return nil, errors.Wrap(err, "NewsStoragePsql.GetNews.rows.Err")
 	}
 
+	newsList, hasNext, hasPrev := utils.KeysetPage(pq, newsList)
+	list.News = newsList
+	list.HasMore = hasNext
+	if hasNext && pq.IsKeyset() && len(newsList) > 0 {
+		last := newsList[len(newsList)-1]
+		list.NextCursor = (&utils.Cursor{Time: last.CreatedAt, ID: last.NewsID}).Encode()
+	}
+	if hasPrev && pq.IsKeyset() && len(newsList) > 0 {
+		first := newsList[0]
+		list.PrevCursor = (&utils.Cursor{Time: first.CreatedAt, ID: first.NewsID, Backward: true}).Encode()
+	}
+	return list, nil
+}
+
+// Get published news of author, newest first
+func (s *NewsStorage) GetNewsByAuthor(ctx context.Context, authorID uuid.UUID, pq *utils.PaginationQuery) (*entity.NewsList, error) {
+	var totalCount int
+	if err := s.psql.GetContext(ctx, &totalCount, getAuthorNewsCount, authorID); err != nil {
+		return nil, errors.Wrap(err, "NewsStoragePsql.GetNewsByAuthor.GetContext")
+	}
+
+	newsList := make([]*entity.News, 0, pq.GetSize())
+	if totalCount > 0 {
+		if err := s.psql.SelectContext(ctx, &newsList, getNewsByAuthor, authorID, pq.GetLimit(), pq.GetOffset()); err != nil {
+			return nil, errors.Wrap(err, "NewsStoragePsql.GetNewsByAuthor.SelectContext")
+		}
+	}
+
 	return &entity.NewsList{
 		TotalCount: totalCount,
 		TotalPages: utils.GetTotalPages(totalCount, pq.GetSize()),
@@ -103,9 +256,9 @@ func (s *NewsStorage) GetNews(ctx context.Context, pq *utils.PaginationQuery) (*
 	}, nil
 }
 
-// Delete news
-func (s *NewsStorage) Delete(ctx context.Context, newsID uuid.UUID) error {
-	result, err := s.psql.ExecContext(ctx, deleteNews, newsID)
+// Delete news of version, 0 deletes any version
+func (s *NewsStorage) Delete(ctx context.Context, newsID uuid.UUID, version int) error {
+	result, err := s.psql.ExecContext(ctx, deleteNews, newsID, version)
 	if err != nil {
 		return errors.Wrap(err, "NewsStoragePsql.Delete.Exec")
 	}
@@ -114,52 +267,85 @@ func (s *NewsStorage) Delete(ctx context.Context, newsID uuid.UUID) error {
 		return errors.Wrap(err, "NewsStoragePsql.Delete.RowsAffected")
 	}
 	if rowsAffected == 0 {
-		return errors.Wrap(sql.ErrNoRows, "NewsStoragePsql.Delete.rowsAffected")
+		return versionConflict(ctx, s.psql, newsExists, newsID, version, "NewsStoragePsql.Delete.rowsAffected")
 	}
 
 	return nil
 }
 
-// Get single news by id
+// Get single news by id with its translations
 func (s *NewsStorage) GetNewsByID(ctx context.Context, newsID uuid.UUID) (*entity.NewsBase, error) {
 	news := &entity.NewsBase{}
 	if err := s.psql.GetContext(ctx, news, getNewsByID, newsID); err != nil {
 		return nil, errors.Wrap(err, "NewsStoragePsql.GetNewsByID.GetContext")
 	}
+	if err := s.psql.SelectContext(ctx, &news.Tags, getNewsTags, newsID); err != nil {
+		return nil, errors.Wrap(err, "NewsStoragePsql.GetNewsByID.SelectContext")
+	}
+	if err := s.psql.SelectContext(ctx, &news.Translations, getNewsTranslations, newsID); err != nil {
+		return nil, errors.Wrap(err, "NewsStoragePsql.GetNewsByID.getNewsTranslations")
+	}
+	if err := s.psql.SelectContext(ctx, &news.Contributors, getNewsContributors, newsID); err != nil {
+		return nil, errors.Wrap(err, "NewsStoragePsql.GetNewsByID.getNewsContributors")
+	}
 	return news, nil
 }
 
-// Find news by title
-func (s *NewsStorage) SearchNews(ctx context.Context, title string, pq *utils.PaginationQuery) (*entity.NewsList, error) {
+// Check if user bookmarked news
+func (s *NewsStorage) IsBookmarked(ctx context.Context, userID uuid.UUID, newsID uuid.UUID) (bool, error) {
+	var bookmarked bool
+	if err := s.psql.GetContext(ctx, &bookmarked, isNewsBookmarked, userID, newsID); err != nil {
+		return false, errors.Wrap(err, "NewsStoragePsql.IsBookmarked.GetContext")
+	}
+	return bookmarked, nil
+}
 
-	var totalCount int
-	if err := s.psql.GetContext(ctx, &totalCount, getTitleCount, title); err != nil {
-		return nil, errors.Wrap(err, "NewsStoragePsql.SearchNews.GetContext")
+// Get news id by current or old slug
+func (s *NewsStorage) GetNewsIDBySlug(ctx context.Context, slug string) (uuid.UUID, error) {
+	var newsID uuid.UUID
+	if err := s.psql.GetContext(ctx, &newsID, getNewsIDBySlug, slug); err != nil {
+		return uuid.Nil, errors.Wrap(err, "NewsStoragePsql.GetNewsIDBySlug.GetContext")
 	}
+	return newsID, nil
+}
 
-	if totalCount == 0 {
-		return &entity.NewsList{
-			TotalCount: totalCount,
-			TotalPages: utils.GetTotalPages(totalCount, pq.GetSize()),
-			Page:       pq.GetPage(),
-			Size:       pq.GetSize(),
-			HasMore:    utils.GetHasMore(pq.GetPage(), totalCount, pq.GetSize()),
-			News:       make([]*entity.News, 0),
-		}, nil
+// Full-text search of news ranked by relevance
+func (s *NewsStorage) SearchNews(ctx context.Context, search *entity.NewsSearchQuery, viewerID uuid.UUID, pq *utils.PaginationQuery) (*entity.NewsSearchList, error) {
+	list := &entity.NewsSearchList{
+		Page: pq.GetPage(),
+		Size: pq.GetSize(),
+	}
+	if pq.WithTotal {
+		query, args, err := countQuery(getSearchCount, pq, newsFilterSchema, "news", search.Query, search.Language, viewerID)
+		if err != nil {
+			return nil, errors.Wrap(err, "NewsStoragePsql.SearchNews.countQuery")
+		}
+		if err := s.psql.GetContext(ctx, &list.TotalCount, query, args...); err != nil {
+			return nil, errors.Wrap(err, "NewsStoragePsql.SearchNews.GetContext")
+		}
+		list.TotalPages = utils.GetTotalPages(list.TotalCount, pq.GetSize())
 	}
 
-	var newsList = make([]*entity.News, 0, pq.GetSize())
-	rows, err := s.psql.QueryxContext(ctx, findByTitle, title, pq.GetDifference(), pq.GetLimit())
+	var newsList = make([]*entity.NewsSearch, 0, pq.GetKeysetLimit())
+	cursorID, cursorTime := pq.GetCursorArgs()
+	query, args, err := listQuery(searchNews, true, pq, newsFilterSchema, "n", search.Query, search.Language,
+		pq.GetKeysetLimit(), pq.GetKeysetOffset(), viewerID, cursorID, pq.GetCursorRank(), cursorTime)
+	if err != nil {
+		return nil, errors.Wrap(err, "NewsStoragePsql.SearchNews.listQuery")
+	}
+	rows, err := s.psql.QueryxContext(ctx, query, args...)
 	if err != nil {
 		return nil, errors.Wrap(err, "NewsStoragePsql.SearchNews.QueryxContext")
 	}
 	defer rows.Close()
 
 	for rows.Next() {
-		var news *entity.News
-		if err := rows.StructScan(&news); err != nil {
+		news := &entity.NewsSearch{}
+		if err := rows.StructScan(news); err != nil {
 			return nil, errors.Wrap(err, "NewsStoragePsql.SearchNews.StructScan")
 		}
+		news.TitleHighlight = highlightHTML(news.TitleHighlight)
+		news.ContentHighlight = highlightHTML(news.ContentHighlight)
 		newsList = append(newsList, news)
 	}
 
@@ -167,12 +353,109 @@ func (s *NewsStorage) SearchNews(ctx context.Context, title string, pq *utils.Pa
 		return nil, errors.Wrap(err, "NewsStoragePsql.SearchNews.rows.Err")
 	}
 
-	return &entity.NewsList{
-		TotalCount: totalCount,
-		TotalPages: utils.GetTotalPages(totalCount, pq.GetSize()),
-		Page:       pq.GetPage(),
-		Size:       pq.GetSize(),
-		HasMore:    utils.GetHasMore(pq.GetPage(), totalCount, pq.GetSize()),
-		News:       newsList,
-	}, nil
+	newsList, hasNext, hasPrev := utils.KeysetPage(pq, newsList)
+	list.News = newsList
+	list.HasMore = hasNext
+	if hasNext && pq.IsKeyset() && len(newsList) > 0 {
+		last := newsList[len(newsList)-1]
+		list.NextCursor = (&utils.Cursor{Time: last.CreatedAt, Rank: last.Rank, ID: last.NewsID}).Encode()
+	}
+	if hasPrev && pq.IsKeyset() && len(newsList) > 0 {
+		first := newsList[0]
+		list.PrevCursor = (&utils.Cursor{Time: first.CreatedAt, Rank: first.Rank, ID: first.NewsID, Backward: true}).Encode()
+	}
+	return list, nil
+}
+
+// Publish scheduled news whose publish time has come
+func (s *NewsStorage) PublishScheduled(ctx context.Context) ([]*entity.News, error) {
+	rows, err := s.psql.QueryxContext(ctx, publishScheduledNews)
+	if err != nil {
+		return nil, errors.Wrap(err, "NewsStoragePsql.PublishScheduled.QueryxContext")
+	}
+	defer rows.Close()
+
+	var newsList = make([]*entity.News, 0)
+	for rows.Next() {
+		news := &entity.News{}
+		if err := rows.StructScan(news); err != nil {
+			return nil, errors.Wrap(err, "NewsStoragePsql.PublishScheduled.StructScan")
+		}
+		newsList = append(newsList, news)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "NewsStoragePsql.PublishScheduled.rows.Err")
+	}
+
+	return newsList, nil
+}
+
+// Pick free slug for news, slugs of other news and their redirects are taken
+func freeNewsSlug(ctx context.Context, tx *sqlx.Tx, base string, newsID uuid.UUID) (string, error) {
+	var taken []string
+	if err := tx.SelectContext(ctx, &taken, getTakenNewsSlugs, base, newsID); err != nil {
+		return "", errors.Wrap(err, "freeNewsSlug.SelectContext")
+	}
+	return utils.UniqueSlug(base, taken), nil
+}
+
+// Get authors of news by their ids
+func (s *NewsStorage) GetAuthors(ctx context.Context, userIDs []uuid.UUID) ([]*entity.NewsAuthor, error) {
+	authors := []*entity.NewsAuthor{}
+	if len(userIDs) == 0 {
+		return authors, nil
+	}
+
+	ids := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		ids = append(ids, userID.String())
+	}
+
+	if err := s.psql.SelectContext(ctx, &authors, getNewsAuthors, arrayLiteral(ids)); err != nil {
+		return nil, errors.Wrap(err, "NewsStoragePsql.GetAuthors.SelectContext")
+	}
+	return authors, nil
+}
+
+// Get comments counts of news, news without comments are left out
+func (s *NewsStorage) GetCommentCounts(ctx context.Context, newsIDs []uuid.UUID) ([]*entity.NewsCommentCount, error) {
+	counts := []*entity.NewsCommentCount{}
+	if len(newsIDs) == 0 {
+		return counts, nil
+	}
+
+	ids := make([]string, 0, len(newsIDs))
+	for _, newsID := range newsIDs {
+		ids = append(ids, newsID.String())
+	}
+
+	if err := s.psql.SelectContext(ctx, &counts, getNewsCommentCounts, arrayLiteral(ids)); err != nil {
+		return nil, errors.Wrap(err, "NewsStoragePsql.GetCommentCounts.SelectContext")
+	}
+	return counts, nil
+}
+
+// Fill comparison with the cursor and order of keyset list query, pages go
+// on in the list order and back against it, then conditions and sort of the
+// filter with args following the args of the query
+func listQuery(query string, descending bool, pq *utils.PaginationQuery, schema filter.Schema, alias string, args ...interface{}) (string, []interface{}, error) {
+	compiled, err := schema.Compile(pq.Filter, alias, len(args)+1)
+	if err != nil {
+		return "", nil, err
+	}
+	op, order := ">", "ASC"
+	if descending != pq.IsBackward() {
+		op, order = "<", "DESC"
+	}
+	return fmt.Sprintf(query, op, order, compiled.Where, compiled.OrderBy), append(args, compiled.Args...), nil
+}
+
+// Fill conditions of the filter in count query of list
+func countQuery(query string, pq *utils.PaginationQuery, schema filter.Schema, alias string, args ...interface{}) (string, []interface{}, error) {
+	compiled, err := schema.Compile(pq.Filter, alias, len(args)+1)
+	if err != nil {
+		return "", nil, err
+	}
+	return fmt.Sprintf(query, compiled.Where), append(args, compiled.Args...), nil
 }
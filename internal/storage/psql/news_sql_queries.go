@@ -1,49 +1,168 @@
 package psql
 
 const (
-	createNews = `INSERT INTO news (author_id, title, content, image_url, category, created_at)
-				VALUES ($1, $2, $3, NULLIF($4, ''), NULLIF($5, ''), now())
-				RETURNING *`
+	createNews = `INSERT INTO news (author_id, title, slug, content, content_html, image_url, category, category_id, language, locale, status, publish_at, created_at)
+				VALUES ($1, $2, $10, $3, $11, NULLIF($4, ''), NULLIF($5, ''), $9, COALESCE(NULLIF($6, ''), 'english'),
+					COALESCE(NULLIF($12, ''), 'en'), COALESCE(NULLIF($7, ''), 'published'), $8, now())
+				RETURNING news_id, author_id, title, slug, content, content_html, image_url, category, category_id, language, locale, status, publish_at, version, created_at, updated_at`
 
+	// version $13 of If-Match, 0 updates any version
 	updateNews = `UPDATE news
 				SET title = COALESCE(NULLIF($1, ''), title),
+					slug = COALESCE(NULLIF($10, ''), slug),
 					content = COALESCE(NULLIF($2, ''), content),
+					content_html = COALESCE(NULLIF($11, ''), content_html),
 					image_url = COALESCE(NULLIF($3, ''), image_url),
 					category = COALESCE(NULLIF($4, ''), category),
+					category_id = COALESCE($9, category_id),
+					language = COALESCE(NULLIF($5, ''), language),
+					locale = COALESCE(NULLIF($12, ''), locale),
+					status = COALESCE(NULLIF($6, ''), status),
+					publish_at = COALESCE($7, publish_at),
+					version = version + 1,
 					updated_at = now()
-				WHERE news_id = $5
-				RETURNING *`
+				WHERE news_id = $8 AND ($13::int = 0 OR version = $13)
+				RETURNING news_id, author_id, title, slug, content, content_html, image_url, category, category_id, language, locale, status, publish_at, version, created_at, updated_at`
 
-	deleteNews = `DELETE FROM news WHERE news_id = $1`
+	getNewsSlugForUpdate = `SELECT title, slug FROM news WHERE news_id = $1 FOR UPDATE`
 
-	getTotalNewsCount = `SELECT COUNT(news_id) FROM news`
+	getTakenNewsSlugs = `SELECT slug FROM news WHERE (slug = $1 OR slug LIKE $1 || '-%') AND news_id <> $2
+				UNION
+				SELECT slug FROM news_slug_redirects WHERE (slug = $1 OR slug LIKE $1 || '-%') AND news_id <> $2`
 
-	getNews = `SELECT news_id, author_id, title, content, image_url, category, updated_at, created_at 
+	addNewsSlugRedirect = `INSERT INTO news_slug_redirects (slug, news_id, created_at)
+				VALUES ($1, $2, now())
+				ON CONFLICT (slug) DO UPDATE SET news_id = EXCLUDED.news_id, created_at = EXCLUDED.created_at`
+
+	deleteNewsSlugRedirect = `DELETE FROM news_slug_redirects WHERE slug = $1`
+
+	getNewsIDBySlug = `SELECT news_id FROM news WHERE slug = $1
+				UNION ALL
+				SELECT news_id FROM news_slug_redirects WHERE slug = $1
+				LIMIT 1`
+
+	// version $2 of If-Match, 0 deletes any version
+	deleteNews = `DELETE FROM news WHERE news_id = $1 AND ($2::int = 0 OR version = $2)`
+
+	// translations, co-authors and tags are part of news without being in its row
+	bumpNewsVersion = `UPDATE news SET version = version + 1 WHERE news_id = $1`
+
+	newsExists = `SELECT EXISTS (SELECT 1 FROM news WHERE news_id = $1)`
+
+	isNewsBookmarked = `SELECT EXISTS (SELECT 1 FROM bookmarks WHERE user_id = $1 AND news_id = $2)`
+
+	// conditions of the filter are filled by countQuery
+	getTotalNewsCount = `SELECT COUNT(news_id) FROM news WHERE (status = 'published' OR author_id = $1 OR news_id IN (SELECT news_id FROM news_contributors WHERE user_id = $1 AND status = 'accepted'))%s`
+
+	// translation wins over the original when its locale comes first in the chain $4,
+	// newest first, comparison with cursor $5 $6, order and the filter are filled by listQuery
+	getNews = `SELECT news.news_id, news.author_id, COALESCE(tr.title, news.title) AS title, news.slug,
+				COALESCE(tr.content, news.content) AS content, COALESCE(tr.content_html, news.content_html) AS content_html,
+				news.image_url, news.category, news.category_id, news.language, COALESCE(tr.locale, news.locale) AS locale,
+				news.status, news.publish_at, news.version, news.updated_at, news.created_at,
+				(SELECT string_agg(l.locale, ',' ORDER BY l.locale)
+					FROM (SELECT news.locale UNION SELECT t.locale FROM news_translations t WHERE t.news_id = news.news_id) l(locale)) AS locales,
+				EXISTS (SELECT 1 FROM bookmarks b WHERE b.user_id = $3 AND b.news_id = news.news_id) AS bookmarked
+			FROM news
+				LEFT JOIN LATERAL (
+					SELECT t.locale, t.title, t.content, t.content_html
+					FROM news_translations t
+					WHERE t.news_id = news.news_id
+						AND array_position($4::text[], t.locale) <
+							COALESCE(array_position($4::text[], news.locale), cardinality($4::text[]) + 1)
+					ORDER BY array_position($4::text[], t.locale)
+					LIMIT 1
+				) tr ON true
+			WHERE (news.status = 'published' OR news.author_id = $3 OR news.news_id IN (SELECT news_id FROM news_contributors WHERE user_id = $3 AND status = 'accepted'))
+				AND ($5::uuid IS NULL OR (news.created_at, news.news_id) %[1]s ($6::timestamptz, $5))%[3]s
+			ORDER BY %[4]snews.created_at %[2]s, news.news_id %[2]s
+			LIMIT $2 OFFSET $1`
+
+	// co-authored news count for their co-authors too
+	getAuthorNewsCount = `SELECT COUNT(news_id) FROM news
+			WHERE (author_id = $1 OR news_id IN (SELECT news_id FROM news_contributors WHERE user_id = $1 AND status = 'accepted'))
+				AND status = 'published'`
+
+	getNewsByAuthor = `SELECT news_id, author_id, title, slug, content, content_html, image_url, category, category_id, language, status, publish_at, updated_at, created_at
 			FROM news
-			WHERE news_id < (news_id + $1)
-			ORDER BY news_id DESC, created_at, updated_at
-			LIMIT $2`
+			WHERE (author_id = $1 OR news_id IN (SELECT news_id FROM news_contributors WHERE user_id = $1 AND status = 'accepted'))
+				AND status = 'published'
+			ORDER BY publish_at DESC, created_at DESC
+			LIMIT $2 OFFSET $3`
 
 	getNewsByID = `SELECT n.news_id,
 				n.title,
+				n.slug,
 				n.content,
+				n.content_html,
 				n.updated_at,
 				n.image_url,
 				n.category,
+				n.category_id,
+				n.language,
+				n.locale,
+				n.status,
+				n.publish_at,
+				n.views,
+				n.version,
 				CONCAT(u.first_name, ' ', u.last_name) as author,
 				u.user_id as author_id
 			FROM news n
 				LEFT JOIN users u on u.user_id = n.author_id
 			WHERE news_id = $1`
 
-	findByTitle = `SELECT  news_id, author_id, title, content, image_url, category, updated_at, created_at
-				FROM news	
-				WHERE title ILIKE '%' || $1 || '%' 
-					and news_id < (news_id + $2)
-				ORDER BY news_id DESC, title, created_at, updated_at
-				LIMIT $3`
+	getNewsTranslations = `SELECT news_id, locale, title, content, content_html, created_at, updated_at
+			FROM news_translations
+			WHERE news_id = $1
+			ORDER BY locale`
+
+	getNewsContributors = `SELECT c.news_id, c.user_id, c.role, c.status, c.invited_by, c.created_at, c.accepted_at,
+				CONCAT_WS(' ', u.first_name, u.last_name) AS name
+			FROM news_contributors c
+				JOIN users u on u.user_id = c.user_id
+			WHERE c.news_id = $1 AND c.status = 'accepted'
+			ORDER BY c.accepted_at, c.user_id`
 
-	getTitleCount = `SELECT COUNT(title)
+	// best rank first, comparison with cursor $6 $7 $8, order and the filter are filled by listQuery,
+	// matches of highlights are between chr(2) and chr(3) until the text is escaped by highlightHTML
+	searchNews = `SELECT n.news_id, n.author_id, n.title, n.slug, n.content, n.content_html, n.image_url, n.category, n.category_id, n.language, n.status, n.publish_at, n.version, n.updated_at, n.created_at,
+					EXISTS (SELECT 1 FROM bookmarks b WHERE b.user_id = $5 AND b.news_id = n.news_id) AS bookmarked,
+					n.rank,
+					ts_headline(n.language::regconfig, n.title, n.query,
+						'StartSel=' || chr(2) || ', StopSel=' || chr(3) || ', HighlightAll=true') AS title_highlight,
+					ts_headline(n.language::regconfig, n.content, n.query,
+						'StartSel=' || chr(2) || ', StopSel=' || chr(3) || ', MaxFragments=2, MaxWords=30, MinWords=10') AS content_highlight
+				FROM (
+					SELECT n.*, q.query, ts_rank_cd(n.search_vector, q.query) AS rank
+					FROM news n, websearch_to_tsquery($2::regconfig, $1) q(query)
+					WHERE n.search_vector @@ q.query AND (n.status = 'published' OR n.author_id = $5 OR n.news_id IN (SELECT news_id FROM news_contributors WHERE user_id = $5 AND status = 'accepted'))
+				) n
+				WHERE ($6::uuid IS NULL OR (n.rank, n.created_at, n.news_id) %[1]s ($7::real, $8::timestamptz, $6))%[3]s
+				ORDER BY %[4]sn.rank %[2]s, n.created_at %[2]s, n.news_id %[2]s
+				LIMIT $3 OFFSET $4`
+
+	// conditions of the filter are filled by countQuery
+	getSearchCount = `SELECT COUNT(news_id)
 					FROM news
-					WHERE title ILIKE '%' || $1 || '%'`
-)
\ No newline at end of file
+					WHERE search_vector @@ websearch_to_tsquery($2::regconfig, $1)
+						AND (status = 'published' OR author_id = $3 OR news_id IN (SELECT news_id FROM news_contributors WHERE user_id = $3 AND status = 'accepted'))%s`
+
+	publishScheduledNews = `UPDATE news
+				SET status = 'published',
+					version = version + 1,
+					updated_at = now()
+				WHERE status = 'scheduled' AND publish_at <= now()
+				RETURNING news_id, author_id, title, slug, content, content_html, image_url, category, category_id, language, status, publish_at, version, created_at, updated_at`
+
+	getNewsAuthors = `SELECT user_id, first_name, last_name, avatar
+				FROM users
+				WHERE user_id = ANY($1::uuid[])`
+
+	getNewsCommentCounts = `SELECT news_id, COUNT(comment_id) AS count
+				FROM comments
+				WHERE news_id = ANY($1::uuid[])
+				GROUP BY news_id`
+
+	// author_id filter of lists, co-authored news belong to the author too
+	authorOrContributor = `(%[1]s.author_id %[2]s OR %[1]s.news_id IN (SELECT news_id FROM news_contributors WHERE user_id %[2]s AND status = 'accepted'))`
+)
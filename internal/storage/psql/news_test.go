@@ -2,10 +2,17 @@ package psql
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/filter"
+	"github.com/Edbeer/restapi/pkg/httpe"
 	"github.com/Edbeer/restapi/pkg/utils"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
@@ -48,7 +55,18 @@ func TestPsql_CreateNews(t *testing.T) {
 			Category: &category,
 		}
 
-		mock.ExpectQuery(createNews).WithArgs(&news.AuthorID, &news.Title, &news.Content, &news.Category).WillReturnRows(rows)
+		mock.ExpectBegin()
+		mock.ExpectQuery(getTakenNewsSlugs).WithArgs("title", uuid.Nil).WillReturnRows(
+			sqlmock.NewRows([]string{"slug"}).AddRow("title").AddRow("title-3"),
+		)
+		mock.ExpectQuery(createNews).WithArgs(
+			&news.AuthorID, &news.Title, &news.Content, &news.ImageURL, &news.Category, &news.Language,
+			&news.Status, &news.PublishAt, &news.CategoryID, "title-2", &news.ContentHTML, &news.Locale,
+		).WillReturnRows(rows)
+		mock.ExpectExec(createRevision).WithArgs(
+			uuid.Nil, news.Title, news.Content, authorId, nil,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
 
 		createdNews, err := newsStorage.Create(context.Background(), news)
 		require.NoError(t, err)
@@ -95,17 +113,56 @@ func TestPsql_UpdateNews(t *testing.T) {
 			Content:  "content",
 			ImageURL: &imageUrl,
 			Category: &category,
+			Tags:     []string{"golang"},
 		}
 
+		editorId := uuid.New()
+		mock.ExpectBegin()
+		mock.ExpectQuery(getNewsSlugForUpdate).WithArgs(newsId).WillReturnRows(
+			sqlmock.NewRows([]string{"title", "slug"}).AddRow("old title", "old-title"),
+		)
+		mock.ExpectQuery(getTakenNewsSlugs).WithArgs("title", newsId).WillReturnRows(sqlmock.NewRows([]string{"slug"}))
 		mock.ExpectQuery(updateNews).WithArgs(
-			&news.Title, &news.Content, &news.ImageURL, &news.Category, &news.NewsID,
+			&news.Title, &news.Content, &news.ImageURL, &news.Category, &news.Language,
+			&news.Status, &news.PublishAt, &news.NewsID, &news.CategoryID, "title", &news.ContentHTML, &news.Locale, &news.Version,
 		).WillReturnRows(rows)
-
-		updatedNews, err := newsStorage.Update(context.Background(), news)
+		mock.ExpectExec(deleteNewsSlugRedirect).WithArgs("title").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(addNewsSlugRedirect).WithArgs("old-title", newsId).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(createRevision).WithArgs(
+			newsId, news.Title, news.Content, editorId, nil,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		tagId := uuid.New()
+		mock.ExpectExec(deleteNewsTags).WithArgs(newsId).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(upsertTag).WithArgs("golang", "golang").WillReturnRows(sqlmock.NewRows([]string{"tag_id"}).AddRow(tagId))
+		mock.ExpectExec(addNewsTag).WithArgs(newsId, tagId).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectQuery(getNewsTagNames).WithArgs(newsId).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("golang"))
+		mock.ExpectCommit()
+
+		updatedNews, err := newsStorage.Update(context.Background(), news, &entity.NewsRevision{EditorID: editorId})
 		require.NoError(t, err)
 		require.NotNil(t, updatedNews)
 		require.Equal(t, updatedNews, news)
 	})
+
+	t.Run("Update stale version", func(t *testing.T) {
+		newsId := uuid.New()
+		news := &entity.News{NewsID: newsId, Content: "content of another editor", Version: 3}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(getNewsSlugForUpdate).WithArgs(newsId).WillReturnRows(
+			sqlmock.NewRows([]string{"title", "slug"}).AddRow("old title", "old-title"),
+		)
+		mock.ExpectQuery(updateNews).WithArgs(
+			&news.Title, &news.Content, &news.ImageURL, &news.Category, &news.Language,
+			&news.Status, &news.PublishAt, &news.NewsID, &news.CategoryID, "", &news.ContentHTML, &news.Locale, 3,
+		).WillReturnRows(sqlmock.NewRows([]string{"news_id"}))
+		mock.ExpectRollback()
+
+		updatedNews, err := newsStorage.Update(context.Background(), news, &entity.NewsRevision{})
+		require.Nil(t, updatedNews)
+		require.Equal(t, http.StatusPreconditionFailed, httpe.ParseErrors(err).Status())
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
 }
 
 func TestPsql_GetNews(t *testing.T) {
@@ -143,16 +200,161 @@ func TestPsql_GetNews(t *testing.T) {
 			&category,
 		)
 
-		mock.ExpectQuery(getTotalNewsCount).WillReturnRows(totalCountRows)
-		mock.ExpectQuery(getNews).WithArgs(0, 10).WillReturnRows(rows)
+		viewerId := uuid.New()
+		pq := &utils.PaginationQuery{
+			Size:      10,
+			Page:      0,
+			WithTotal: true,
+		}
+		mock.ExpectQuery(fmt.Sprintf(getTotalNewsCount, "")).WithArgs(viewerId).WillReturnRows(totalCountRows)
+		query, _, err := listQuery(getNews, true, pq, newsFilterSchema, "news")
+		require.NoError(t, err)
+		mock.ExpectQuery(query).WithArgs(0, 11, viewerId, "{de,en}", nil, nil).WillReturnRows(rows)
 
-		newsList, err := newsStorage.GetNews(context.Background(), &utils.PaginationQuery{
-			Size:    10,
-			Page:    0,
-			OrderBy: "",
-		})
+		newsList, err := newsStorage.GetNews(context.Background(), viewerId, []string{"de", "en"}, pq)
 		require.NoError(t, err)
 		require.NotNil(t, newsList)
+		require.Len(t, newsList.News, 1)
+		require.False(t, newsList.HasMore)
+		require.Empty(t, newsList.NextCursor)
+		require.Empty(t, newsList.PrevCursor)
+	})
+
+	t.Run("GetNews after cursor", func(t *testing.T) {
+		viewerId := uuid.New()
+		cursor := &utils.Cursor{Time: time.Now().UTC(), ID: uuid.New()}
+		pq := &utils.PaginationQuery{
+			Size:   2,
+			Page:   3,
+			Cursor: cursor,
+		}
+
+		lastId := uuid.New()
+		lastCreated := cursor.Time.Add(-2 * time.Hour)
+		rows := sqlmock.NewRows([]string{"news_id", "title", "created_at"}).
+			AddRow(uuid.New(), "title", cursor.Time.Add(-time.Hour)).
+			AddRow(lastId, "title", lastCreated).
+			AddRow(uuid.New(), "title", cursor.Time.Add(-3*time.Hour))
+
+		query, _, err := listQuery(getNews, true, pq, newsFilterSchema, "news")
+		require.NoError(t, err)
+		mock.ExpectQuery(query).WithArgs(0, 3, viewerId, "{en}", cursor.ID, cursor.Time).WillReturnRows(rows)
+
+		newsList, err := newsStorage.GetNews(context.Background(), viewerId, []string{"en"}, pq)
+		require.NoError(t, err)
+		require.Len(t, newsList.News, 2)
+		require.Zero(t, newsList.TotalCount)
+		require.True(t, newsList.HasMore)
+
+		next, err := utils.DecodeCursor(newsList.NextCursor)
+		require.NoError(t, err)
+		require.Equal(t, lastId, next.ID)
+		require.True(t, lastCreated.Equal(next.Time))
+		require.False(t, next.Backward)
+
+		prev, err := utils.DecodeCursor(newsList.PrevCursor)
+		require.NoError(t, err)
+		require.Equal(t, newsList.News[0].NewsID, prev.ID)
+		require.True(t, prev.Backward)
+	})
+
+	t.Run("GetNews filtered and sorted", func(t *testing.T) {
+		viewerId := uuid.New()
+		q, err := filter.Parse("category:eq:tech,created_at:gte:2026-01-01", "title")
+		require.NoError(t, err)
+		pq := &utils.PaginationQuery{
+			Size:      1,
+			Page:      1,
+			WithTotal: true,
+			// sorted lists are paged by offset
+			Cursor: &utils.Cursor{ID: uuid.New()},
+			Filter: q,
+		}
+		since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		count := fmt.Sprintf(getTotalNewsCount, " AND news.category = $2 AND news.created_at >= $3")
+		mock.ExpectQuery(count).WithArgs(viewerId, "tech", since).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+		query := fmt.Sprintf(getNews, "<", "DESC", " AND news.category = $7 AND news.created_at >= $8", "news.title ASC NULLS LAST, ")
+		rows := sqlmock.NewRows([]string{"news_id"}).AddRow(uuid.New()).AddRow(uuid.New())
+		mock.ExpectQuery(query).WithArgs(1, 2, viewerId, "{en}", nil, nil, "tech", since).WillReturnRows(rows)
+
+		newsList, err := newsStorage.GetNews(context.Background(), viewerId, []string{"en"}, pq)
+		require.NoError(t, err)
+		require.Len(t, newsList.News, 1)
+		require.Equal(t, 3, newsList.TotalCount)
+		require.Equal(t, 3, newsList.TotalPages)
+		require.True(t, newsList.HasMore)
+		require.Empty(t, newsList.NextCursor)
+		require.Empty(t, newsList.PrevCursor)
+	})
+
+	t.Run("GetNews by author with co-authored", func(t *testing.T) {
+		viewerId := uuid.New()
+		authorId := uuid.New()
+		q, err := filter.Parse("author_id:eq:"+authorId.String(), "")
+		require.NoError(t, err)
+		pq := &utils.PaginationQuery{Size: 10, WithTotal: true, Filter: q}
+
+		count := fmt.Sprintf(getTotalNewsCount, " AND (news.author_id = $2 OR news.news_id IN (SELECT news_id FROM news_contributors WHERE user_id = $2 AND status = 'accepted'))")
+		mock.ExpectQuery(count).WithArgs(viewerId, authorId).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+		query := fmt.Sprintf(getNews, "<", "DESC", " AND (news.author_id = $7 OR news.news_id IN (SELECT news_id FROM news_contributors WHERE user_id = $7 AND status = 'accepted'))", "")
+		rows := sqlmock.NewRows([]string{"news_id"}).AddRow(uuid.New())
+		mock.ExpectQuery(query).WithArgs(0, 11, viewerId, "{en}", nil, nil, authorId).WillReturnRows(rows)
+
+		newsList, err := newsStorage.GetNews(context.Background(), viewerId, []string{"en"}, pq)
+		require.NoError(t, err)
+		require.Len(t, newsList.News, 1)
+		require.Equal(t, 1, newsList.TotalCount)
+	})
+
+	t.Run("GetNews unknown filter field", func(t *testing.T) {
+		q, err := filter.Parse("password:eq:secret", "")
+		require.NoError(t, err)
+
+		_, err = newsStorage.GetNews(context.Background(), uuid.New(), []string{"en"}, &utils.PaginationQuery{Size: 10, Filter: q})
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, httpe.ParseErrors(err).Status())
+		require.Contains(t, httpe.ParseErrors(err).Error(), "password")
+	})
+}
+
+func TestPsql_GetNewsByAuthor(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsStorage := NewNewsStorage(sqlxDB)
+
+	t.Run("GetNewsByAuthor", func(t *testing.T) {
+		authorId := uuid.New()
+
+		totalCountRows := sqlmock.NewRows([]string{"count"}).AddRow(1)
+		rows := sqlmock.NewRows([]string{"news_id", "author_id", "title", "slug", "status"}).AddRow(
+			uuid.New(),
+			authorId,
+			"title",
+			"title",
+			"published",
+		)
+
+		mock.ExpectQuery(getAuthorNewsCount).WithArgs(authorId).WillReturnRows(totalCountRows)
+		mock.ExpectQuery(getNewsByAuthor).WithArgs(authorId, 10, 20).WillReturnRows(rows)
+
+		newsList, err := newsStorage.GetNewsByAuthor(context.Background(), authorId, &utils.PaginationQuery{
+			Size: 10,
+			Page: 2,
+		})
+		require.NoError(t, err)
+		require.Len(t, newsList.News, 1)
+		require.Equal(t, 1, newsList.TotalCount)
+		require.NoError(t, mock.ExpectationsWereMet())
 	})
 }
 
@@ -170,16 +372,33 @@ func TestPsql_DeleteNews(t *testing.T) {
 
 	t.Run("Delete news", func(t *testing.T) {
 		newsId := uuid.New()
-		mock.ExpectExec(deleteNews).WithArgs(newsId).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := newsStorage.Delete(context.Background(), newsId)
+		mock.ExpectExec(deleteNews).WithArgs(newsId, 0).WillReturnResult(sqlmock.NewResult(1, 1))
+		err := newsStorage.Delete(context.Background(), newsId, 0)
 		require.NoError(t, err)
 	})
 
 	t.Run("Delete err", func(t *testing.T) {
 		newsId := uuid.New()
-		mock.ExpectExec(deleteNews).WithArgs(newsId).WillReturnResult(sqlmock.NewResult(1, 0))
-		err := newsStorage.Delete(context.Background(), newsId)
+		mock.ExpectExec(deleteNews).WithArgs(newsId, 0).WillReturnResult(sqlmock.NewResult(1, 0))
+		err := newsStorage.Delete(context.Background(), newsId, 0)
 		require.NotNil(t, err)
+		require.True(t, errors.Is(err, sql.ErrNoRows))
+	})
+
+	t.Run("Delete stale version", func(t *testing.T) {
+		newsId := uuid.New()
+		mock.ExpectExec(deleteNews).WithArgs(newsId, 2).WillReturnResult(sqlmock.NewResult(1, 0))
+		mock.ExpectQuery(newsExists).WithArgs(newsId).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+		err := newsStorage.Delete(context.Background(), newsId, 2)
+		require.True(t, errors.Is(err, httpe.PreconditionFailed))
+	})
+
+	t.Run("Delete missing with version", func(t *testing.T) {
+		newsId := uuid.New()
+		mock.ExpectExec(deleteNews).WithArgs(newsId, 2).WillReturnResult(sqlmock.NewResult(1, 0))
+		mock.ExpectQuery(newsExists).WithArgs(newsId).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+		err := newsStorage.Delete(context.Background(), newsId, 2)
+		require.True(t, errors.Is(err, sql.ErrNoRows))
 	})
 }
 
@@ -196,7 +415,7 @@ func TestPsql_GetNewsByID(t *testing.T) {
 	newsStorage := NewNewsStorage(sqlxDB)
 
 	t.Run("GetNewsByID", func(t *testing.T) {
-		newsId := uuid.New()
+		newsId, coAuthorID := uuid.New(), uuid.New()
 
 		columns := []string{
 			"news_id",
@@ -213,9 +432,22 @@ func TestPsql_GetNewsByID(t *testing.T) {
 			NewsID:  newsId,
 			Title:   "title",
 			Content: "content",
+			Translations: []*entity.NewsTranslation{
+				{NewsID: newsId, Locale: "de", Title: "titel", Content: "inhalt"},
+			},
+			Contributors: []*entity.NewsContributor{
+				{NewsID: newsId, UserID: coAuthorID, Role: entity.ContributorAuthor, Status: entity.ContributorAccepted},
+			},
 		}
 
 		mock.ExpectQuery(getNewsByID).WithArgs(newsId).WillReturnRows(rows)
+		mock.ExpectQuery(getNewsTags).WithArgs(newsId).WillReturnRows(sqlmock.NewRows([]string{"tag_id", "name", "slug"}))
+		mock.ExpectQuery(getNewsTranslations).WithArgs(newsId).WillReturnRows(
+			sqlmock.NewRows([]string{"news_id", "locale", "title", "content"}).AddRow(newsId, "de", "titel", "inhalt"),
+		)
+		mock.ExpectQuery(getNewsContributors).WithArgs(newsId).WillReturnRows(
+			sqlmock.NewRows([]string{"news_id", "user_id", "role", "status"}).AddRow(newsId, coAuthorID, entity.ContributorAuthor, entity.ContributorAccepted),
+		)
 
 		newsById, err := newsStorage.GetNewsByID(context.Background(), newsId)
 		require.NoError(t, err)
@@ -224,6 +456,28 @@ func TestPsql_GetNewsByID(t *testing.T) {
 	})
 }
 
+func TestPsql_IsBookmarked(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsStorage := NewNewsStorage(sqlxDB)
+
+	userID, newsID := uuid.New(), uuid.New()
+	mock.ExpectQuery(isNewsBookmarked).WithArgs(userID, newsID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	bookmarked, err := newsStorage.IsBookmarked(context.Background(), userID, newsID)
+	require.NoError(t, err)
+	require.True(t, bookmarked)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestPsql_SearchNews(t *testing.T) {
 	t.Parallel()
 
@@ -239,29 +493,168 @@ func TestPsql_SearchNews(t *testing.T) {
 	t.Run("SearchNews", func(t *testing.T) {
 		newsId := uuid.New()
 
-		totalCountRows := sqlmock.NewRows([]string{"count"}).AddRow(0)
+		totalCountRows := sqlmock.NewRows([]string{"count"}).AddRow(1)
 
-		title := "title"
+		search := &entity.NewsSearchQuery{
+			Query:    "title",
+			Language: "english",
+		}
 		columns := []string{
 			"news_id",
-			title,
+			"title",
 			"content",
+			"rank",
+			"title_highlight",
+			"content_highlight",
 		}
 		rows := sqlmock.NewRows(columns).AddRow(
 			newsId,
-			title,
+			"title",
 			"content",
+			0.1,
+			"\x02title\x03",
+			"<img src=x onerror=\"alert(1)\"> \x02title\x03 & more",
 		)
 
-		mock.ExpectQuery(getTitleCount).WithArgs(title).WillReturnRows(totalCountRows)
-		mock.ExpectQuery(findByTitle).WithArgs(title, 0, 10).WillReturnRows(rows)
+		pq := &utils.PaginationQuery{
+			Size:      10,
+			Page:      0,
+			WithTotal: true,
+		}
+		mock.ExpectQuery(fmt.Sprintf(getSearchCount, "")).WithArgs(search.Query, search.Language, uuid.Nil).WillReturnRows(totalCountRows)
+		query, _, err := listQuery(searchNews, true, pq, newsFilterSchema, "n")
+		require.NoError(t, err)
+		mock.ExpectQuery(query).WithArgs(search.Query, search.Language, 11, 0, uuid.Nil, nil, nil, nil).WillReturnRows(rows)
 
-		newsByTitle, err := newsStorage.SearchNews(context.Background(), title, &utils.PaginationQuery{
-			Size:    10,
-			Page:    0,
-			OrderBy: "",
-		})
+		newsByTitle, err := newsStorage.SearchNews(context.Background(), search, uuid.Nil, pq)
 		require.NoError(t, err)
 		require.NotNil(t, newsByTitle)
+		require.Equal(t, 1, newsByTitle.TotalCount)
+		require.Len(t, newsByTitle.News, 1)
+		require.Equal(t, "<mark>title</mark>", newsByTitle.News[0].TitleHighlight)
+		require.Equal(t, "&lt;img src=x onerror=&#34;alert(1)&#34;&gt; <mark>title</mark> &amp; more", newsByTitle.News[0].ContentHighlight)
 	})
+
+	t.Run("SearchNews before cursor", func(t *testing.T) {
+		search := &entity.NewsSearchQuery{
+			Query:    "title",
+			Language: "english",
+		}
+		cursor := &utils.Cursor{Time: time.Now().UTC(), Rank: 0.5, ID: uuid.New(), Backward: true}
+		pq := &utils.PaginationQuery{
+			Size:   10,
+			Cursor: cursor,
+		}
+
+		// rows of a page going back come against the list order
+		firstId, secondId := uuid.New(), uuid.New()
+		rows := sqlmock.NewRows([]string{"news_id", "rank"}).
+			AddRow(secondId, 0.6).
+			AddRow(firstId, 0.7)
+
+		query, _, err := listQuery(searchNews, true, pq, newsFilterSchema, "n")
+		require.NoError(t, err)
+		mock.ExpectQuery(query).WithArgs(search.Query, search.Language, 11, 0, uuid.Nil, cursor.ID, cursor.Rank, cursor.Time).WillReturnRows(rows)
+
+		newsByTitle, err := newsStorage.SearchNews(context.Background(), search, uuid.Nil, pq)
+		require.NoError(t, err)
+		require.Len(t, newsByTitle.News, 2)
+		require.Equal(t, firstId, newsByTitle.News[0].NewsID)
+		require.True(t, newsByTitle.HasMore)
+		require.Empty(t, newsByTitle.PrevCursor)
+
+		next, err := utils.DecodeCursor(newsByTitle.NextCursor)
+		require.NoError(t, err)
+		require.Equal(t, secondId, next.ID)
+		require.Equal(t, 0.6, next.Rank)
+	})
+}
+
+func TestPsql_PublishScheduled(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsStorage := NewNewsStorage(sqlxDB)
+
+	t.Run("PublishScheduled", func(t *testing.T) {
+		newsId := uuid.New()
+
+		columns := []string{
+			"news_id",
+			"title",
+			"status",
+		}
+		rows := sqlmock.NewRows(columns).AddRow(
+			newsId,
+			"title",
+			entity.NewsStatusPublished,
+		)
+
+		mock.ExpectQuery(publishScheduledNews).WillReturnRows(rows)
+
+		newsList, err := newsStorage.PublishScheduled(context.Background())
+		require.NoError(t, err)
+		require.Len(t, newsList, 1)
+		require.Equal(t, newsId, newsList[0].NewsID)
+		require.Equal(t, entity.NewsStatusPublished, newsList[0].Status)
+	})
+}
+
+func TestPsql_GetAuthors(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsStorage := NewNewsStorage(sqlxDB)
+
+	firstID, secondID := uuid.New(), uuid.New()
+	mock.ExpectQuery(getNewsAuthors).WithArgs("{" + firstID.String() + "," + secondID.String() + "}").
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "first_name", "last_name", "avatar"}).
+			AddRow(firstID, "Pavel", "Volkov", nil).
+			AddRow(secondID, "Anna", "Orlova", "avatar.png"))
+
+	authors, err := newsStorage.GetAuthors(context.Background(), []uuid.UUID{firstID, secondID})
+	require.NoError(t, err)
+	require.Len(t, authors, 2)
+	require.Equal(t, firstID, authors[0].UserID)
+	require.Nil(t, authors[0].Avatar)
+	require.Equal(t, "avatar.png", *authors[1].Avatar)
+
+	authors, err = newsStorage.GetAuthors(context.Background(), nil)
+	require.NoError(t, err)
+	require.Empty(t, authors)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPsql_GetCommentCounts(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsStorage := NewNewsStorage(sqlxDB)
+
+	newsID := uuid.New()
+	mock.ExpectQuery(getNewsCommentCounts).WithArgs("{" + newsID.String() + "}").
+		WillReturnRows(sqlmock.NewRows([]string{"news_id", "count"}).AddRow(newsID, 4))
+
+	counts, err := newsStorage.GetCommentCounts(context.Background(), []uuid.UUID{newsID})
+	require.NoError(t, err)
+	require.Equal(t, []*entity.NewsCommentCount{{NewsID: newsID, Count: 4}}, counts)
+	require.NoError(t, mock.ExpectationsWereMet())
 }
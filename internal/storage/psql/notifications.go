@@ -0,0 +1,98 @@
+package psql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// Notifications storage
+type NotificationsStorage struct {
+	psql *sqlx.DB
+}
+
+// Notifications storage constructor
+func NewNotificationsStorage(psql *sqlx.DB) *NotificationsStorage {
+	return &NotificationsStorage{psql: psql}
+}
+
+// Create notification
+func (s *NotificationsStorage) Create(ctx context.Context, notification *entity.Notification) (*entity.Notification, error) {
+	n := &entity.Notification{}
+	if err := s.psql.QueryRowxContext(ctx,
+		createNotification,
+		notification.UserID,
+		notification.Kind,
+		notification.NewsID,
+		notification.Message,
+	).StructScan(n); err != nil {
+		return nil, errors.Wrap(err, "NotificationsStoragePsql.Create.StructScan")
+	}
+	return n, nil
+}
+
+// Get notifications of user, newest first
+func (s *NotificationsStorage) GetNotifications(ctx context.Context, userID uuid.UUID, unreadOnly bool, pq *utils.PaginationQuery) (*entity.NotificationsList, error) {
+	var count struct {
+		Total  int `db:"total"`
+		Unread int `db:"unread"`
+	}
+	if err := s.psql.GetContext(ctx, &count, getNotificationsCount, userID); err != nil {
+		return nil, errors.Wrap(err, "NotificationsStoragePsql.GetNotifications.GetContext")
+	}
+	totalCount := count.Total
+	if unreadOnly {
+		totalCount = count.Unread
+	}
+
+	notifications := make([]*entity.Notification, 0, pq.GetSize())
+	if totalCount > 0 {
+		if err := s.psql.SelectContext(ctx, &notifications, getNotifications, userID, unreadOnly, pq.GetLimit(), pq.GetOffset()); err != nil {
+			return nil, errors.Wrap(err, "NotificationsStoragePsql.GetNotifications.SelectContext")
+		}
+	}
+
+	return &entity.NotificationsList{
+		TotalCount:    totalCount,
+		TotalPages:    utils.GetTotalPages(totalCount, pq.GetSize()),
+		Page:          pq.GetPage(),
+		Size:          pq.GetSize(),
+		HasMore:       utils.GetHasMore(pq.GetPage(), totalCount, pq.GetSize()),
+		Unread:        count.Unread,
+		Notifications: notifications,
+	}, nil
+}
+
+// Mark notification of user read
+func (s *NotificationsStorage) MarkRead(ctx context.Context, userID uuid.UUID, notificationID uuid.UUID) error {
+	result, err := s.psql.ExecContext(ctx, markNotificationRead, notificationID, userID)
+	if err != nil {
+		return errors.Wrap(err, "NotificationsStoragePsql.MarkRead.ExecContext")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "NotificationsStoragePsql.MarkRead.RowsAffected")
+	}
+	if rowsAffected == 0 {
+		return errors.Wrap(sql.ErrNoRows, "NotificationsStoragePsql.MarkRead.rowsAffected")
+	}
+	return nil
+}
+
+// Mark all notifications of user read, returns number of marked ones
+func (s *NotificationsStorage) MarkAllRead(ctx context.Context, userID uuid.UUID) (int64, error) {
+	result, err := s.psql.ExecContext(ctx, markAllNotificationsRead, userID)
+	if err != nil {
+		return 0, errors.Wrap(err, "NotificationsStoragePsql.MarkAllRead.ExecContext")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "NotificationsStoragePsql.MarkAllRead.RowsAffected")
+	}
+	return rowsAffected, nil
+}
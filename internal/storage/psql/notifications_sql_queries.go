@@ -0,0 +1,22 @@
+package psql
+
+const (
+	createNotification = `INSERT INTO notifications (user_id, kind, news_id, message, created_at)
+				VALUES ($1, $2, $3, $4, now())
+				RETURNING notification_id, user_id, kind, news_id, message, read_at, created_at`
+
+	getNotificationsCount = `SELECT COUNT(*) AS total, COUNT(*) FILTER (WHERE read_at IS NULL) AS unread
+				FROM notifications
+				WHERE user_id = $1`
+
+	getNotifications = `SELECT notification_id, user_id, kind, news_id, message, read_at, created_at
+				FROM notifications
+				WHERE user_id = $1 AND ($2 = false OR read_at IS NULL)
+				ORDER BY created_at DESC, notification_id
+				LIMIT $3 OFFSET $4`
+
+	markNotificationRead = `UPDATE notifications SET read_at = COALESCE(read_at, now())
+				WHERE notification_id = $1 AND user_id = $2`
+
+	markAllNotificationsRead = `UPDATE notifications SET read_at = now() WHERE user_id = $1 AND read_at IS NULL`
+)
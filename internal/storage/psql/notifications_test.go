@@ -0,0 +1,82 @@
+package psql
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPsql_GetNotifications(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	notificationsStorage := NewNotificationsStorage(sqlxDB)
+
+	userID, notificationID := uuid.New(), uuid.New()
+	mock.ExpectQuery(getNotificationsCount).WithArgs(userID).WillReturnRows(
+		sqlmock.NewRows([]string{"total", "unread"}).AddRow(5, 1),
+	)
+	mock.ExpectQuery(getNotifications).WithArgs(userID, true, 10, 0).WillReturnRows(
+		sqlmock.NewRows([]string{"notification_id", "user_id", "kind"}).AddRow(notificationID, userID, entity.NotificationReviewApproved),
+	)
+
+	notifications, err := notificationsStorage.GetNotifications(context.Background(), userID, true, &utils.PaginationQuery{Size: 10})
+	require.NoError(t, err)
+	require.Equal(t, 1, notifications.TotalCount)
+	require.Equal(t, 1, notifications.Unread)
+	require.Len(t, notifications.Notifications, 1)
+	require.Equal(t, notificationID, notifications.Notifications[0].NotificationID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPsql_MarkNotificationRead(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	notificationsStorage := NewNotificationsStorage(sqlxDB)
+
+	userID, notificationID := uuid.New(), uuid.New()
+
+	t.Run("MarkRead", func(t *testing.T) {
+		mock.ExpectExec(markNotificationRead).WithArgs(notificationID, userID).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := notificationsStorage.MarkRead(context.Background(), userID, notificationID)
+		require.NoError(t, err)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		mock.ExpectExec(markNotificationRead).WithArgs(notificationID, userID).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := notificationsStorage.MarkRead(context.Background(), userID, notificationID)
+		require.True(t, errors.Is(err, sql.ErrNoRows))
+	})
+
+	t.Run("MarkAllRead", func(t *testing.T) {
+		mock.ExpectExec(markAllNotificationsRead).WithArgs(userID).WillReturnResult(sqlmock.NewResult(0, 3))
+
+		marked, err := notificationsStorage.MarkAllRead(context.Background(), userID)
+		require.NoError(t, err)
+		require.Equal(t, int64(3), marked)
+	})
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,159 @@
+package psql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+var reactionTargetExists = map[string]string{
+	entity.ReactionTargetNews:    newsReactionTargetExists,
+	entity.ReactionTargetComment: commentReactionTargetExists,
+}
+
+// Reactions storage, counters are changed in the same transaction as reactions
+type ReactionsStorage struct {
+	psql *sqlx.DB
+}
+
+// Reactions storage constructor
+func NewReactionsStorage(psql *sqlx.DB) *ReactionsStorage {
+	return &ReactionsStorage{psql: psql}
+}
+
+// Add reaction, false if user already reacted with the kind.
+// Missing target returns sql.ErrNoRows.
+func (s *ReactionsStorage) Add(ctx context.Context, reaction *entity.Reaction) (bool, error) {
+	tx, err := s.psql.BeginTxx(ctx, nil)
+	if err != nil {
+		return false, errors.Wrap(err, "ReactionsStoragePsql.Add.BeginTxx")
+	}
+	defer tx.Rollback()
+
+	var exists bool
+	if err := tx.GetContext(ctx, &exists, reactionTargetExists[reaction.TargetType], reaction.TargetID); err != nil {
+		return false, errors.Wrap(err, "ReactionsStoragePsql.Add.GetContext")
+	}
+	if !exists {
+		return false, errors.Wrap(sql.ErrNoRows, "ReactionsStoragePsql.Add.targetExists")
+	}
+
+	result, err := tx.ExecContext(ctx, addReaction, reaction.TargetType, reaction.TargetID, reaction.UserID, reaction.Kind)
+	if err != nil {
+		return false, errors.Wrap(err, "ReactionsStoragePsql.Add.ExecContext")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Wrap(err, "ReactionsStoragePsql.Add.RowsAffected")
+	}
+	if rowsAffected == 0 {
+		return false, nil
+	}
+
+	if err := incrReaction(ctx, tx, reaction, 1); err != nil {
+		return false, errors.Wrap(err, "ReactionsStoragePsql.Add")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, errors.Wrap(err, "ReactionsStoragePsql.Add.Commit")
+	}
+	return true, nil
+}
+
+// Remove reaction, false if user has not reacted with the kind
+func (s *ReactionsStorage) Remove(ctx context.Context, reaction *entity.Reaction) (bool, error) {
+	tx, err := s.psql.BeginTxx(ctx, nil)
+	if err != nil {
+		return false, errors.Wrap(err, "ReactionsStoragePsql.Remove.BeginTxx")
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, removeReaction, reaction.TargetType, reaction.TargetID, reaction.UserID, reaction.Kind)
+	if err != nil {
+		return false, errors.Wrap(err, "ReactionsStoragePsql.Remove.ExecContext")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Wrap(err, "ReactionsStoragePsql.Remove.RowsAffected")
+	}
+	if rowsAffected == 0 {
+		return false, nil
+	}
+
+	if err := incrReaction(ctx, tx, reaction, -1); err != nil {
+		return false, errors.Wrap(err, "ReactionsStoragePsql.Remove")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, errors.Wrap(err, "ReactionsStoragePsql.Remove.Commit")
+	}
+	return true, nil
+}
+
+// Get reaction counts of target and kinds the user reacted with, no kinds for nil user
+func (s *ReactionsStorage) GetReactions(ctx context.Context, targetType string, targetID uuid.UUID, userID uuid.UUID) (*entity.Reactions, error) {
+	counts := []*entity.ReactionCount{}
+	if err := s.psql.SelectContext(ctx, &counts, getReactionCounts, targetType, targetID); err != nil {
+		return nil, errors.Wrap(err, "ReactionsStoragePsql.GetReactions.SelectContext")
+	}
+
+	reactions := &entity.Reactions{
+		Counts: make(map[string]int64, len(counts)),
+		Mine:   []string{},
+	}
+	for _, count := range counts {
+		reactions.Counts[count.Kind] = count.Count
+	}
+
+	if userID != uuid.Nil {
+		if err := s.psql.SelectContext(ctx, &reactions.Mine, getUserReactions, targetType, targetID, userID); err != nil {
+			return nil, errors.Wrap(err, "ReactionsStoragePsql.GetReactions.getUserReactions")
+		}
+	}
+	return reactions, nil
+}
+
+// Recount counters and comment likes from reactions and drop reactions
+// of deleted targets, returns number of changed rows
+func (s *ReactionsStorage) Reconcile(ctx context.Context) (int64, error) {
+	tx, err := s.psql.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "ReactionsStoragePsql.Reconcile.BeginTxx")
+	}
+	defer tx.Rollback()
+
+	var changed int64
+	for _, query := range []string{deleteOrphanReactions, reconcileReactionCounts, deleteStaleReactionCounts, reconcileCommentLikes} {
+		result, err := tx.ExecContext(ctx, query)
+		if err != nil {
+			return 0, errors.Wrap(err, "ReactionsStoragePsql.Reconcile.ExecContext")
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return 0, errors.Wrap(err, "ReactionsStoragePsql.Reconcile.RowsAffected")
+		}
+		changed += rowsAffected
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, errors.Wrap(err, "ReactionsStoragePsql.Reconcile.Commit")
+	}
+	return changed, nil
+}
+
+// Change counter of reaction kind, likes of comments are kept in comments too
+func incrReaction(ctx context.Context, tx *sqlx.Tx, reaction *entity.Reaction, incr int64) error {
+	if _, err := tx.ExecContext(ctx, incrReactionCount, reaction.TargetType, reaction.TargetID, reaction.Kind, incr); err != nil {
+		return errors.Wrap(err, "incrReaction.incrReactionCount")
+	}
+	if reaction.TargetType == entity.ReactionTargetComment && reaction.Kind == entity.ReactionLike {
+		if _, err := tx.ExecContext(ctx, incrCommentLikes, reaction.TargetID, incr); err != nil {
+			return errors.Wrap(err, "incrReaction.incrCommentLikes")
+		}
+	}
+	return nil
+}
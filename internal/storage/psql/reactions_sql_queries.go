@@ -0,0 +1,46 @@
+package psql
+
+const (
+	newsReactionTargetExists = `SELECT EXISTS (SELECT 1 FROM news WHERE news_id = $1 AND status = 'published')`
+
+	commentReactionTargetExists = `SELECT EXISTS (SELECT 1 FROM comments WHERE comment_id = $1)`
+
+	addReaction = `INSERT INTO reactions (target_type, target_id, user_id, kind, created_at)
+				VALUES ($1, $2, $3, $4, now())
+				ON CONFLICT DO NOTHING`
+
+	removeReaction = `DELETE FROM reactions WHERE target_type = $1 AND target_id = $2 AND user_id = $3 AND kind = $4`
+
+	incrReactionCount = `INSERT INTO reaction_counts (target_type, target_id, kind, count)
+				VALUES ($1, $2, $3, GREATEST($4::bigint, 0))
+				ON CONFLICT (target_type, target_id, kind) DO UPDATE SET count = GREATEST(reaction_counts.count + $4, 0)`
+
+	incrCommentLikes = `UPDATE comments SET likes = GREATEST(COALESCE(likes, 0) + $2, 0), version = version + 1 WHERE comment_id = $1`
+
+	getReactionCounts = `SELECT kind, count FROM reaction_counts
+				WHERE target_type = $1 AND target_id = $2 AND count > 0
+				ORDER BY count DESC, kind`
+
+	getUserReactions = `SELECT kind FROM reactions
+				WHERE target_type = $1 AND target_id = $2 AND user_id = $3
+				ORDER BY kind`
+
+	deleteOrphanReactions = `DELETE FROM reactions r
+				WHERE (r.target_type = 'news' AND NOT EXISTS (SELECT 1 FROM news n WHERE n.news_id = r.target_id))
+					OR (r.target_type = 'comment' AND NOT EXISTS (SELECT 1 FROM comments c WHERE c.comment_id = r.target_id))`
+
+	reconcileReactionCounts = `INSERT INTO reaction_counts (target_type, target_id, kind, count)
+				SELECT target_type, target_id, kind, COUNT(*) FROM reactions GROUP BY target_type, target_id, kind
+				ON CONFLICT (target_type, target_id, kind) DO UPDATE SET count = EXCLUDED.count
+				WHERE reaction_counts.count <> EXCLUDED.count`
+
+	deleteStaleReactionCounts = `DELETE FROM reaction_counts rc
+				WHERE NOT EXISTS (SELECT 1 FROM reactions r
+					WHERE r.target_type = rc.target_type AND r.target_id = rc.target_id AND r.kind = rc.kind)`
+
+	reconcileCommentLikes = `UPDATE comments c SET likes = COALESCE(rc.count, 0), version = c.version + 1
+				FROM comments c2
+					LEFT JOIN reaction_counts rc
+						ON rc.target_type = 'comment' AND rc.target_id = c2.comment_id AND rc.kind = 'like'
+				WHERE c.comment_id = c2.comment_id AND COALESCE(c.likes, 0) <> COALESCE(rc.count, 0)`
+)
@@ -0,0 +1,173 @@
+package psql
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPsql_AddReaction(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	reactionsStorage := NewReactionsStorage(sqlxDB)
+
+	t.Run("Comment like", func(t *testing.T) {
+		reaction := &entity.Reaction{
+			TargetType: entity.ReactionTargetComment,
+			TargetID:   uuid.New(),
+			UserID:     uuid.New(),
+			Kind:       entity.ReactionLike,
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(commentReactionTargetExists).WithArgs(reaction.TargetID).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+		mock.ExpectExec(addReaction).WithArgs(reaction.TargetType, reaction.TargetID, reaction.UserID, reaction.Kind).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(incrReactionCount).WithArgs(reaction.TargetType, reaction.TargetID, reaction.Kind, int64(1)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(incrCommentLikes).WithArgs(reaction.TargetID, int64(1)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		added, err := reactionsStorage.Add(context.Background(), reaction)
+		require.NoError(t, err)
+		require.True(t, added)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Already reacted", func(t *testing.T) {
+		reaction := &entity.Reaction{
+			TargetType: entity.ReactionTargetNews,
+			TargetID:   uuid.New(),
+			UserID:     uuid.New(),
+			Kind:       "wow",
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(newsReactionTargetExists).WithArgs(reaction.TargetID).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+		mock.ExpectExec(addReaction).WithArgs(reaction.TargetType, reaction.TargetID, reaction.UserID, reaction.Kind).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		added, err := reactionsStorage.Add(context.Background(), reaction)
+		require.NoError(t, err)
+		require.False(t, added)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Missing target", func(t *testing.T) {
+		reaction := &entity.Reaction{
+			TargetType: entity.ReactionTargetNews,
+			TargetID:   uuid.New(),
+			UserID:     uuid.New(),
+			Kind:       entity.ReactionLike,
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(newsReactionTargetExists).WithArgs(reaction.TargetID).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+		mock.ExpectRollback()
+
+		_, err := reactionsStorage.Add(context.Background(), reaction)
+		require.True(t, errors.Is(err, sql.ErrNoRows))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPsql_RemoveReaction(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	reactionsStorage := NewReactionsStorage(sqlxDB)
+
+	reaction := &entity.Reaction{
+		TargetType: entity.ReactionTargetNews,
+		TargetID:   uuid.New(),
+		UserID:     uuid.New(),
+		Kind:       entity.ReactionLike,
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(removeReaction).WithArgs(reaction.TargetType, reaction.TargetID, reaction.UserID, reaction.Kind).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(incrReactionCount).WithArgs(reaction.TargetType, reaction.TargetID, reaction.Kind, int64(-1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	removed, err := reactionsStorage.Remove(context.Background(), reaction)
+	require.NoError(t, err)
+	require.True(t, removed)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPsql_GetReactions(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	reactionsStorage := NewReactionsStorage(sqlxDB)
+	newsID, userID := uuid.New(), uuid.New()
+
+	mock.ExpectQuery(getReactionCounts).WithArgs(entity.ReactionTargetNews, newsID).
+		WillReturnRows(sqlmock.NewRows([]string{"kind", "count"}).AddRow("like", 3).AddRow("wow", 1))
+	mock.ExpectQuery(getUserReactions).WithArgs(entity.ReactionTargetNews, newsID, userID).
+		WillReturnRows(sqlmock.NewRows([]string{"kind"}).AddRow("like"))
+
+	reactions, err := reactionsStorage.GetReactions(context.Background(), entity.ReactionTargetNews, newsID, userID)
+	require.NoError(t, err)
+	require.Equal(t, map[string]int64{"like": 3, "wow": 1}, reactions.Counts)
+	require.Equal(t, []string{"like"}, reactions.Mine)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPsql_ReconcileReactions(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	reactionsStorage := NewReactionsStorage(sqlxDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(deleteOrphanReactions).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(reconcileReactionCounts).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(deleteStaleReactionCounts).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(reconcileCommentLikes).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	changed, err := reactionsStorage.Reconcile(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(4), changed)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
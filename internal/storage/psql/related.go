@@ -0,0 +1,66 @@
+package psql
+
+import (
+	"context"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// Related news storage
+type RelatedStorage struct {
+	psql *sqlx.DB
+}
+
+// Related news storage constructor
+func NewRelatedStorage(psql *sqlx.DB) *RelatedStorage {
+	return &RelatedStorage{psql: psql}
+}
+
+// Get text of all published news
+func (s *RelatedStorage) GetCorpus(ctx context.Context) ([]*entity.RelatedDocument, error) {
+	documents := []*entity.RelatedDocument{}
+	if err := s.psql.SelectContext(ctx, &documents, getRelatedCorpus); err != nil {
+		return nil, errors.Wrap(err, "RelatedStoragePsql.GetCorpus.SelectContext")
+	}
+	return documents, nil
+}
+
+// Get category of published news, missing news returns sql.ErrNoRows
+func (s *RelatedStorage) GetNewsCategory(ctx context.Context, newsID uuid.UUID) (*uuid.UUID, error) {
+	var categoryID *uuid.UUID
+	if err := s.psql.GetContext(ctx, &categoryID, getRelatedNewsCategory, newsID); err != nil {
+		return nil, errors.Wrap(err, "RelatedStoragePsql.GetNewsCategory.GetContext")
+	}
+	return categoryID, nil
+}
+
+// Get published news by ids, in no particular order
+func (s *RelatedStorage) GetNews(ctx context.Context, newsIDs []uuid.UUID) ([]*entity.News, error) {
+	news := []*entity.News{}
+	if len(newsIDs) == 0 {
+		return news, nil
+	}
+
+	ids := make([]string, 0, len(newsIDs))
+	for _, newsID := range newsIDs {
+		ids = append(ids, newsID.String())
+	}
+
+	if err := s.psql.SelectContext(ctx, &news, getRelatedNews, arrayLiteral(ids)); err != nil {
+		return nil, errors.Wrap(err, "RelatedStoragePsql.GetNews.SelectContext")
+	}
+	return news, nil
+}
+
+// Get latest published news of category but the given one, nil category
+// matches news without category
+func (s *RelatedStorage) GetCategoryNews(ctx context.Context, categoryID *uuid.UUID, newsID uuid.UUID, limit int) ([]*entity.News, error) {
+	news := []*entity.News{}
+	if err := s.psql.SelectContext(ctx, &news, getCategoryRecentNews, categoryID, newsID, limit); err != nil {
+		return nil, errors.Wrap(err, "RelatedStoragePsql.GetCategoryNews.SelectContext")
+	}
+	return news, nil
+}
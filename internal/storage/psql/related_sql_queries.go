@@ -0,0 +1,23 @@
+package psql
+
+const (
+	getRelatedCorpus = `SELECT n.news_id, n.title, n.content, n.category_id,
+					COALESCE(string_agg(t.slug, ' '), '') AS tags
+				FROM news n
+					LEFT JOIN news_tags nt on nt.news_id = n.news_id
+					LEFT JOIN tags t on t.tag_id = nt.tag_id
+				WHERE n.status = 'published'
+				GROUP BY n.news_id`
+
+	getRelatedNewsCategory = `SELECT category_id FROM news WHERE news_id = $1 AND status = 'published'`
+
+	getRelatedNews = `SELECT news_id, author_id, title, slug, content, content_html, image_url, category, category_id, language, status, publish_at, updated_at, created_at
+				FROM news
+				WHERE news_id = ANY($1::uuid[]) AND status = 'published'`
+
+	getCategoryRecentNews = `SELECT news_id, author_id, title, slug, content, content_html, image_url, category, category_id, language, status, publish_at, updated_at, created_at
+				FROM news
+				WHERE category_id IS NOT DISTINCT FROM $1 AND news_id <> $2 AND status = 'published'
+				ORDER BY publish_at DESC, news_id
+				LIMIT $3`
+)
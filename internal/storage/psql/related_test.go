@@ -0,0 +1,96 @@
+package psql
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPsql_GetRelatedCorpus(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	relatedStorage := NewRelatedStorage(sqlxDB)
+
+	newsID, categoryID := uuid.New(), uuid.New()
+	mock.ExpectQuery(getRelatedCorpus).WillReturnRows(sqlmock.NewRows([]string{"news_id", "title", "content", "category_id", "tags"}).
+		AddRow(newsID, "Rain in Berlin", "content", categoryID, "weather berlin").
+		AddRow(uuid.New(), "Chess", "content", nil, ""))
+
+	corpus, err := relatedStorage.GetCorpus(context.Background())
+	require.NoError(t, err)
+	require.Len(t, corpus, 2)
+	require.Equal(t, newsID, corpus[0].NewsID)
+	require.Equal(t, categoryID, *corpus[0].CategoryID)
+	require.Equal(t, "weather berlin", corpus[0].Tags)
+	require.Nil(t, corpus[1].CategoryID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPsql_GetRelatedNewsCategory(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	relatedStorage := NewRelatedStorage(sqlxDB)
+
+	t.Run("Without category", func(t *testing.T) {
+		newsID := uuid.New()
+		mock.ExpectQuery(getRelatedNewsCategory).WithArgs(newsID).
+			WillReturnRows(sqlmock.NewRows([]string{"category_id"}).AddRow(nil))
+
+		categoryID, err := relatedStorage.GetNewsCategory(context.Background(), newsID)
+		require.NoError(t, err)
+		require.Nil(t, categoryID)
+	})
+
+	t.Run("Missing news", func(t *testing.T) {
+		newsID := uuid.New()
+		mock.ExpectQuery(getRelatedNewsCategory).WithArgs(newsID).
+			WillReturnRows(sqlmock.NewRows([]string{"category_id"}))
+
+		_, err := relatedStorage.GetNewsCategory(context.Background(), newsID)
+		require.True(t, errors.Is(err, sql.ErrNoRows))
+	})
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPsql_GetCategoryRecentNews(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	relatedStorage := NewRelatedStorage(sqlxDB)
+
+	categoryID, newsID := uuid.New(), uuid.New()
+	mock.ExpectQuery(getCategoryRecentNews).WithArgs(&categoryID, newsID, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"news_id", "title"}).AddRow(uuid.New(), "latest"))
+
+	news, err := relatedStorage.GetCategoryNews(context.Background(), &categoryID, newsID, 5)
+	require.NoError(t, err)
+	require.Len(t, news, 1)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
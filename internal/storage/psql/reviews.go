@@ -0,0 +1,81 @@
+package psql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// News reviews storage
+type ReviewsStorage struct {
+	psql *sqlx.DB
+}
+
+// News reviews storage constructor
+func NewReviewsStorage(psql *sqlx.DB) *ReviewsStorage {
+	return &ReviewsStorage{psql: psql}
+}
+
+// Move news from one of statuses to another and record review action,
+// nil if news status has changed meanwhile
+func (s *ReviewsStorage) Transition(ctx context.Context, review *entity.NewsReview, from []string, to string, publishAt *time.Time) (*entity.News, error) {
+	tx, err := s.psql.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "ReviewsStoragePsql.Transition.BeginTxx")
+	}
+	defer tx.Rollback()
+
+	news := &entity.News{}
+	if err := tx.QueryRowxContext(ctx, transitionNewsStatus, review.NewsID, to, arrayLiteral(from), publishAt).StructScan(news); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "ReviewsStoragePsql.Transition.StructScan")
+	}
+
+	if err := tx.QueryRowxContext(ctx,
+		createReview,
+		review.NewsID,
+		review.ReviewerID,
+		review.Action,
+		review.Comment,
+	).Scan(&review.ReviewID, &review.CreatedAt); err != nil {
+		return nil, errors.Wrap(err, "ReviewsStoragePsql.Transition.createReview")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "ReviewsStoragePsql.Transition.Commit")
+	}
+	return news, nil
+}
+
+// Get review history of news, oldest first
+func (s *ReviewsStorage) GetReviews(ctx context.Context, newsID uuid.UUID) ([]*entity.NewsReview, error) {
+	reviews := []*entity.NewsReview{}
+	if err := s.psql.SelectContext(ctx, &reviews, getReviews, newsID); err != nil {
+		return nil, errors.Wrap(err, "ReviewsStoragePsql.GetReviews.SelectContext")
+	}
+	return reviews, nil
+}
+
+// Get news waiting for review, longest waiting first
+func (s *ReviewsStorage) GetQueue(ctx context.Context, pq *utils.PaginationQuery) (*entity.NewsList, error) {
+	var totalCount int
+	if err := s.psql.GetContext(ctx, &totalCount, getReviewQueueCount); err != nil {
+		return nil, errors.Wrap(err, "ReviewsStoragePsql.GetQueue.GetContext")
+	}
+
+	newsList := make([]*entity.News, 0, pq.GetSize())
+	if totalCount > 0 {
+		if err := s.psql.SelectContext(ctx, &newsList, getReviewQueue, pq.GetLimit(), pq.GetOffset()); err != nil {
+			return nil, errors.Wrap(err, "ReviewsStoragePsql.GetQueue.SelectContext")
+		}
+	}
+	return newsListPage(totalCount, pq, newsList), nil
+}
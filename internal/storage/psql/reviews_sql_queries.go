@@ -0,0 +1,35 @@
+package psql
+
+const (
+	transitionNewsStatus = `UPDATE news
+				SET status = $2,
+					publish_at = COALESCE($4, publish_at),
+					version = version + 1,
+					updated_at = now()
+				WHERE news_id = $1 AND status = ANY($3::text[])
+				RETURNING news_id, author_id, title, slug, content, content_html, image_url, category, category_id, language, locale, status, publish_at, version, created_at, updated_at`
+
+	createReview = `INSERT INTO news_reviews (news_id, reviewer_id, action, comment, created_at)
+				VALUES ($1, $2, $3, $4, now())
+				RETURNING review_id, created_at`
+
+	getReviews = `SELECT r.review_id, r.news_id, r.reviewer_id, r.action, r.comment, r.created_at,
+					COALESCE(CONCAT_WS(' ', u.first_name, u.last_name), '') AS reviewer
+				FROM news_reviews r
+					LEFT JOIN users u on u.user_id = r.reviewer_id
+				WHERE r.news_id = $1
+				ORDER BY r.created_at, r.review_id`
+
+	getReviewQueueCount = `SELECT COUNT(news_id) FROM news WHERE status = 'pending_review'`
+
+	getReviewQueue = `SELECT n.news_id, n.author_id, n.title, n.slug, n.content, n.content_html, n.image_url, n.category, n.category_id, n.language, n.locale, n.status, n.publish_at, n.updated_at, n.created_at
+				FROM news n
+					LEFT JOIN LATERAL (
+						SELECT max(r.created_at) AS submitted_at
+						FROM news_reviews r
+						WHERE r.news_id = n.news_id AND r.action = 'submitted'
+					) s ON true
+				WHERE n.status = 'pending_review'
+				ORDER BY s.submitted_at NULLS LAST, n.created_at, n.news_id
+				LIMIT $1 OFFSET $2`
+)
@@ -0,0 +1,91 @@
+package psql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPsql_TransitionNews(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	reviewsStorage := NewReviewsStorage(sqlxDB)
+
+	newsID, reviewerID := uuid.New(), uuid.New()
+	from := []string{entity.NewsStatusDraft, entity.NewsStatusChangesRequested}
+
+	t.Run("Transition", func(t *testing.T) {
+		review := &entity.NewsReview{NewsID: newsID, ReviewerID: &reviewerID, Action: entity.ReviewSubmitted, Comment: "ready"}
+		reviewID, createdAt := uuid.New(), time.Now()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(transitionNewsStatus).WithArgs(
+			newsID, entity.NewsStatusPendingReview, "{draft,changes_requested}", nil,
+		).WillReturnRows(sqlmock.NewRows([]string{"news_id", "title", "status"}).AddRow(newsID, "title", entity.NewsStatusPendingReview))
+		mock.ExpectQuery(createReview).WithArgs(
+			newsID, &reviewerID, entity.ReviewSubmitted, "ready",
+		).WillReturnRows(sqlmock.NewRows([]string{"review_id", "created_at"}).AddRow(reviewID, createdAt))
+		mock.ExpectCommit()
+
+		news, err := reviewsStorage.Transition(context.Background(), review, from, entity.NewsStatusPendingReview, nil)
+		require.NoError(t, err)
+		require.Equal(t, entity.NewsStatusPendingReview, news.Status)
+		require.Equal(t, reviewID, review.ReviewID)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Status has changed", func(t *testing.T) {
+		review := &entity.NewsReview{NewsID: newsID, ReviewerID: &reviewerID, Action: entity.ReviewSubmitted}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(transitionNewsStatus).WithArgs(
+			newsID, entity.NewsStatusPendingReview, "{draft,changes_requested}", nil,
+		).WillReturnRows(sqlmock.NewRows([]string{"news_id"}))
+		mock.ExpectRollback()
+
+		news, err := reviewsStorage.Transition(context.Background(), review, from, entity.NewsStatusPendingReview, nil)
+		require.NoError(t, err)
+		require.Nil(t, news)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPsql_GetReviewQueue(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	reviewsStorage := NewReviewsStorage(sqlxDB)
+
+	newsID := uuid.New()
+	mock.ExpectQuery(getReviewQueueCount).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(getReviewQueue).WithArgs(10, 0).WillReturnRows(
+		sqlmock.NewRows([]string{"news_id", "status"}).AddRow(newsID, entity.NewsStatusPendingReview),
+	)
+
+	queue, err := reviewsStorage.GetQueue(context.Background(), &utils.PaginationQuery{Size: 10})
+	require.NoError(t, err)
+	require.Equal(t, 1, queue.TotalCount)
+	require.Len(t, queue.News, 1)
+	require.Equal(t, newsID, queue.News[0].NewsID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,63 @@
+package psql
+
+import (
+	"context"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// News revisions storage
+type RevisionsStorage struct {
+	psql *sqlx.DB
+}
+
+// News revisions storage constructor
+func NewRevisionsStorage(psql *sqlx.DB) *RevisionsStorage {
+	return &RevisionsStorage{psql: psql}
+}
+
+// Get revisions of news without content, newest first
+func (s *RevisionsStorage) GetRevisions(ctx context.Context, newsID uuid.UUID, pq *utils.PaginationQuery) (*entity.NewsRevisionsList, error) {
+	var totalCount int
+	if err := s.psql.GetContext(ctx, &totalCount, getRevisionsCount, newsID); err != nil {
+		return nil, errors.Wrap(err, "RevisionsStoragePsql.GetRevisions.GetContext")
+	}
+
+	if totalCount == 0 {
+		return &entity.NewsRevisionsList{
+			TotalCount: totalCount,
+			TotalPages: utils.GetTotalPages(totalCount, pq.GetSize()),
+			Page:       pq.GetPage(),
+			Size:       pq.GetSize(),
+			HasMore:    utils.GetHasMore(pq.GetPage(), totalCount, pq.GetSize()),
+			Revisions:  make([]*entity.NewsRevision, 0),
+		}, nil
+	}
+
+	revisions := make([]*entity.NewsRevision, 0, pq.GetSize())
+	if err := s.psql.SelectContext(ctx, &revisions, getRevisions, newsID, pq.GetLimit(), pq.GetOffset()); err != nil {
+		return nil, errors.Wrap(err, "RevisionsStoragePsql.GetRevisions.SelectContext")
+	}
+
+	return &entity.NewsRevisionsList{
+		TotalCount: totalCount,
+		TotalPages: utils.GetTotalPages(totalCount, pq.GetSize()),
+		Page:       pq.GetPage(),
+		Size:       pq.GetSize(),
+		HasMore:    utils.GetHasMore(pq.GetPage(), totalCount, pq.GetSize()),
+		Revisions:  revisions,
+	}, nil
+}
+
+// Get single revision of news by number
+func (s *RevisionsStorage) GetRevision(ctx context.Context, newsID uuid.UUID, revision int) (*entity.NewsRevision, error) {
+	rev := &entity.NewsRevision{}
+	if err := s.psql.GetContext(ctx, rev, getRevision, newsID, revision); err != nil {
+		return nil, errors.Wrap(err, "RevisionsStoragePsql.GetRevision.GetContext")
+	}
+	return rev, nil
+}
@@ -0,0 +1,22 @@
+package psql
+
+const (
+	createRevision = `INSERT INTO news_revisions (news_id, revision, title, content, editor_id, rollback_of, created_at)
+				VALUES ($1, (SELECT COALESCE(MAX(revision), 0) + 1 FROM news_revisions WHERE news_id = $1), $2, $3, $4, $5, now())`
+
+	getRevisionsCount = `SELECT COUNT(revision_id) FROM news_revisions WHERE news_id = $1`
+
+	getRevisions = `SELECT r.revision_id, r.news_id, r.revision, r.title, r.editor_id, r.rollback_of, r.created_at,
+					CONCAT_WS(' ', u.first_name, u.last_name) AS editor
+				FROM news_revisions r
+					LEFT JOIN users u on u.user_id = r.editor_id
+				WHERE r.news_id = $1
+				ORDER BY r.revision DESC
+				LIMIT $2 OFFSET $3`
+
+	getRevision = `SELECT r.revision_id, r.news_id, r.revision, r.title, r.content, r.editor_id, r.rollback_of, r.created_at,
+					CONCAT_WS(' ', u.first_name, u.last_name) AS editor
+				FROM news_revisions r
+					LEFT JOIN users u on u.user_id = r.editor_id
+				WHERE r.news_id = $1 AND r.revision = $2`
+)
@@ -0,0 +1,74 @@
+package psql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPsql_GetRevisions(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	revisionsStorage := NewRevisionsStorage(sqlxDB)
+
+	t.Run("GetRevisions", func(t *testing.T) {
+		newsId := uuid.New()
+
+		totalCountRows := sqlmock.NewRows([]string{"count"}).AddRow(2)
+		rows := sqlmock.NewRows([]string{"revision_id", "news_id", "revision", "title", "editor"}).
+			AddRow(uuid.New(), newsId, 2, "title 2", "Pavel Volkov").
+			AddRow(uuid.New(), newsId, 1, "title 1", "Pavel Volkov")
+
+		mock.ExpectQuery(getRevisionsCount).WithArgs(newsId).WillReturnRows(totalCountRows)
+		mock.ExpectQuery(getRevisions).WithArgs(newsId, 10, 0).WillReturnRows(rows)
+
+		revisions, err := revisionsStorage.GetRevisions(context.Background(), newsId, &utils.PaginationQuery{
+			Size: 10,
+			Page: 0,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, revisions.TotalCount)
+		require.Len(t, revisions.Revisions, 2)
+		require.Equal(t, 2, revisions.Revisions[0].Revision)
+	})
+}
+
+func TestPsql_GetRevision(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	revisionsStorage := NewRevisionsStorage(sqlxDB)
+
+	t.Run("GetRevision", func(t *testing.T) {
+		newsId := uuid.New()
+
+		rows := sqlmock.NewRows([]string{"revision_id", "news_id", "revision", "title", "content", "rollback_of"}).
+			AddRow(uuid.New(), newsId, 3, "title", "content", 1)
+
+		mock.ExpectQuery(getRevision).WithArgs(newsId, 3).WillReturnRows(rows)
+
+		rev, err := revisionsStorage.GetRevision(context.Background(), newsId, 3)
+		require.NoError(t, err)
+		require.Equal(t, "content", rev.Content)
+		require.NotNil(t, rev.RollbackOf)
+		require.Equal(t, 1, *rev.RollbackOf)
+	})
+}
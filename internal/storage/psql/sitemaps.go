@@ -0,0 +1,58 @@
+package psql
+
+import (
+	"context"
+	"time"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// Sitemaps storage, published news are paged in order of publication
+// so pages before a changed news stay the same
+type SitemapsStorage struct {
+	psql *sqlx.DB
+}
+
+// Sitemaps storage constructor
+func NewSitemapsStorage(psql *sqlx.DB) *SitemapsStorage {
+	return &SitemapsStorage{psql: psql}
+}
+
+// Get sitemap pages with last modification time of their news
+func (s *SitemapsStorage) GetPages(ctx context.Context, size int) ([]*entity.SitemapPage, error) {
+	pages := []*entity.SitemapPage{}
+	if err := s.psql.SelectContext(ctx, &pages, getSitemapPages, size); err != nil {
+		return nil, errors.Wrap(err, "SitemapsStoragePsql.GetPages.SelectContext")
+	}
+	return pages, nil
+}
+
+// Get published news of sitemap page
+func (s *SitemapsStorage) GetNews(ctx context.Context, page int, size int) ([]*entity.SitemapNews, error) {
+	news := []*entity.SitemapNews{}
+	if err := s.psql.SelectContext(ctx, &news, getSitemapNews, size, page*size); err != nil {
+		return nil, errors.Wrap(err, "SitemapsStoragePsql.GetNews.SelectContext")
+	}
+	return news, nil
+}
+
+// Get number of published news before the given one
+func (s *SitemapsStorage) GetNewsPosition(ctx context.Context, publishAt time.Time, newsID uuid.UUID) (int, error) {
+	var position int
+	if err := s.psql.GetContext(ctx, &position, getSitemapNewsPosition, publishAt, newsID); err != nil {
+		return 0, errors.Wrap(err, "SitemapsStoragePsql.GetNewsPosition.GetContext")
+	}
+	return position, nil
+}
+
+// Get news published since time, newest first
+func (s *SitemapsStorage) GetLatestNews(ctx context.Context, since time.Time, limit int) ([]*entity.SitemapNews, error) {
+	news := []*entity.SitemapNews{}
+	if err := s.psql.SelectContext(ctx, &news, getLatestSitemapNews, since, limit); err != nil {
+		return nil, errors.Wrap(err, "SitemapsStoragePsql.GetLatestNews.SelectContext")
+	}
+	return news, nil
+}
@@ -0,0 +1,28 @@
+package psql
+
+const (
+	getSitemapPages = `SELECT page, MAX(updated_at) AS lastmod
+				FROM (
+					SELECT (ROW_NUMBER() OVER (ORDER BY publish_at, news_id) - 1) / $1 AS page, updated_at
+					FROM news
+					WHERE status = 'published'
+				) AS pages
+				GROUP BY page
+				ORDER BY page`
+
+	getSitemapNews = `SELECT news_id, title, slug, language, publish_at, updated_at
+				FROM news
+				WHERE status = 'published'
+				ORDER BY publish_at, news_id
+				LIMIT $1 OFFSET $2`
+
+	getSitemapNewsPosition = `SELECT COUNT(news_id)
+				FROM news
+				WHERE status = 'published' AND (publish_at, news_id) < ($1, $2)`
+
+	getLatestSitemapNews = `SELECT news_id, title, slug, language, publish_at, updated_at
+				FROM news
+				WHERE status = 'published' AND publish_at >= $1
+				ORDER BY publish_at DESC, news_id DESC
+				LIMIT $2`
+)
@@ -0,0 +1,75 @@
+package psql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPsql_GetSitemapPages(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	sitemapsStorage := NewSitemapsStorage(sqlxDB)
+
+	t.Run("GetPages", func(t *testing.T) {
+		lastmod := time.Now()
+		rows := sqlmock.NewRows([]string{"page", "lastmod"}).
+			AddRow(0, lastmod).
+			AddRow(1, lastmod)
+
+		mock.ExpectQuery(getSitemapPages).WithArgs(50000).WillReturnRows(rows)
+
+		pages, err := sitemapsStorage.GetPages(context.Background(), 50000)
+		require.NoError(t, err)
+		require.Len(t, pages, 2)
+		require.Equal(t, 1, pages[1].Page)
+	})
+
+	t.Run("GetNews", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"news_id", "title", "slug", "language", "publish_at", "updated_at"}).
+			AddRow(uuid.New(), "title", "title", "english", time.Now(), time.Now())
+
+		mock.ExpectQuery(getSitemapNews).WithArgs(100, 200).WillReturnRows(rows)
+
+		news, err := sitemapsStorage.GetNews(context.Background(), 2, 100)
+		require.NoError(t, err)
+		require.Len(t, news, 1)
+	})
+}
+
+func TestPsql_GetSitemapNewsPosition(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	sitemapsStorage := NewSitemapsStorage(sqlxDB)
+
+	t.Run("GetNewsPosition", func(t *testing.T) {
+		newsId := uuid.New()
+		publishAt := time.Now()
+
+		mock.ExpectQuery(getSitemapNewsPosition).WithArgs(publishAt, newsId).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(120000))
+
+		position, err := sitemapsStorage.GetNewsPosition(context.Background(), publishAt, newsId)
+		require.NoError(t, err)
+		require.Equal(t, 120000, position)
+	})
+}
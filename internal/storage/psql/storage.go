@@ -3,6 +3,7 @@ package psql
 
 import (
 	"context"
+	"time"
 
 	"github.com/Edbeer/restapi/internal/entity"
 	"github.com/Edbeer/restapi/pkg/utils"
@@ -14,9 +15,9 @@ import (
 type AuthPsql interface {
 	Register(ctx context.Context, user *entity.User) (*entity.User, error)
 	Update(ctx context.Context, user *entity.User) (*entity.User, error)
-	Delete(ctx context.Context, userID uuid.UUID) error
+	Delete(ctx context.Context, userID uuid.UUID, version int) error
 	GetUserByID(ctx context.Context, userID uuid.UUID) (*entity.User, error)
-	FindUsersByName(ctx context.Context, name string, pq *utils.PaginationQuery) (*entity.UsersList, error)
+	FindUsersByName(ctx context.Context, search *entity.UserSearchQuery, pq *utils.PaginationQuery) (*entity.UsersList, error)
 	GetUsers(ctx context.Context, pq *utils.PaginationQuery) (*entity.UsersList, error)
 	FindUserByEmail(ctx context.Context, user *entity.User) (*entity.User, error)
 }
@@ -24,11 +25,17 @@ type AuthPsql interface {
 // News StoragePsql interface
 type NewsPsql interface {
 	Create(ctx context.Context, news *entity.News) (*entity.News, error)
-	Update(ctx context.Context, news *entity.News) (*entity.News, error)
-	GetNews(ctx context.Context, pq *utils.PaginationQuery) (*entity.NewsList, error)
+	Update(ctx context.Context, news *entity.News, rev *entity.NewsRevision) (*entity.News, error)
+	GetNews(ctx context.Context, viewerID uuid.UUID, locales []string, pq *utils.PaginationQuery) (*entity.NewsList, error)
 	GetNewsByID(ctx context.Context, newsID uuid.UUID) (*entity.NewsBase, error)
-	SearchNews(ctx context.Context, title string, pq *utils.PaginationQuery) (*entity.NewsList, error)
-	Delete(ctx context.Context, newsID uuid.UUID) error
+	GetNewsIDBySlug(ctx context.Context, slug string) (uuid.UUID, error)
+	IsBookmarked(ctx context.Context, userID uuid.UUID, newsID uuid.UUID) (bool, error)
+	GetNewsByAuthor(ctx context.Context, authorID uuid.UUID, pq *utils.PaginationQuery) (*entity.NewsList, error)
+	SearchNews(ctx context.Context, search *entity.NewsSearchQuery, viewerID uuid.UUID, pq *utils.PaginationQuery) (*entity.NewsSearchList, error)
+	GetAuthors(ctx context.Context, userIDs []uuid.UUID) ([]*entity.NewsAuthor, error)
+	GetCommentCounts(ctx context.Context, newsIDs []uuid.UUID) ([]*entity.NewsCommentCount, error)
+	PublishScheduled(ctx context.Context) ([]*entity.News, error)
+	Delete(ctx context.Context, newsID uuid.UUID, version int) error
 }
 
 // Comments storage interface
@@ -37,19 +44,180 @@ type CommentsPsql interface {
 	Update(ctx context.Context, comments *entity.Comment) (*entity.Comment, error)
 	GetByID(ctx context.Context, commentID uuid.UUID) (*entity.CommentBase, error)
 	GetAllByNewsID(ctx context.Context, newsID uuid.UUID, pq *utils.PaginationQuery) (*entity.CommentsList, error)
-	Delete(ctx context.Context, commentID uuid.UUID) error
+	Delete(ctx context.Context, commentID uuid.UUID, version int) error
+}
+
+// News revisions storage interface
+type RevisionsPsql interface {
+	GetRevisions(ctx context.Context, newsID uuid.UUID, pq *utils.PaginationQuery) (*entity.NewsRevisionsList, error)
+	GetRevision(ctx context.Context, newsID uuid.UUID, revision int) (*entity.NewsRevision, error)
+}
+
+// Tags storage interface
+type TagsPsql interface {
+	GetTags(ctx context.Context, pq *utils.PaginationQuery) (*entity.TagsList, error)
+	GetTagBySlug(ctx context.Context, slug string) (*entity.Tag, error)
+	GetNewsByTag(ctx context.Context, tagID uuid.UUID, pq *utils.PaginationQuery) (*entity.NewsList, error)
+	RenameTag(ctx context.Context, tagID uuid.UUID, name string) (*entity.Tag, error)
+	MergeTags(ctx context.Context, sourceID uuid.UUID, targetID uuid.UUID) error
+}
+
+// Categories storage interface
+type CategoriesPsql interface {
+	Create(ctx context.Context, category *entity.Category) (*entity.Category, error)
+	Update(ctx context.Context, category *entity.Category) (*entity.Category, error)
+	Delete(ctx context.Context, categoryID uuid.UUID) error
+	GetByID(ctx context.Context, categoryID uuid.UUID) (*entity.Category, error)
+	GetBySlug(ctx context.Context, slug string) (*entity.Category, error)
+	GetAll(ctx context.Context) ([]*entity.Category, error)
+	IsDescendant(ctx context.Context, ancestorID uuid.UUID, categoryID uuid.UUID) (bool, error)
+	GetNews(ctx context.Context, categoryID uuid.UUID, descendants bool, pq *utils.PaginationQuery) (*entity.NewsList, error)
+}
+
+// Suggest storage interface
+type SuggestPsql interface {
+	GetNewsSuggestions(ctx context.Context, after uuid.UUID, limit int) ([]*entity.Suggestion, error)
+	GetAuthorSuggestions(ctx context.Context, after uuid.UUID, limit int) ([]*entity.Suggestion, error)
+}
+
+// Sitemaps storage interface
+type SitemapsPsql interface {
+	GetPages(ctx context.Context, size int) ([]*entity.SitemapPage, error)
+	GetNews(ctx context.Context, page int, size int) ([]*entity.SitemapNews, error)
+	GetNewsPosition(ctx context.Context, publishAt time.Time, newsID uuid.UUID) (int, error)
+	GetLatestNews(ctx context.Context, since time.Time, limit int) ([]*entity.SitemapNews, error)
+}
+
+// Views storage interface
+type ViewsPsql interface {
+	AddViews(ctx context.Context, views map[uuid.UUID]int64) error
+	GetTrendingNews(ctx context.Context, newsIDs []uuid.UUID) ([]*entity.TrendingNews, error)
+}
+
+// Reactions storage interface
+type ReactionsPsql interface {
+	Add(ctx context.Context, reaction *entity.Reaction) (bool, error)
+	Remove(ctx context.Context, reaction *entity.Reaction) (bool, error)
+	GetReactions(ctx context.Context, targetType string, targetID uuid.UUID, userID uuid.UUID) (*entity.Reactions, error)
+	Reconcile(ctx context.Context) (int64, error)
+}
+
+// Bookmarks storage interface
+type BookmarksPsql interface {
+	AddBookmark(ctx context.Context, userID uuid.UUID, newsID uuid.UUID) error
+	RemoveBookmark(ctx context.Context, userID uuid.UUID, newsID uuid.UUID) error
+	GetBookmarks(ctx context.Context, userID uuid.UUID, pq *utils.PaginationQuery) (*entity.NewsList, error)
+	CreateList(ctx context.Context, list *entity.ReadingList) (*entity.ReadingList, error)
+	UpdateList(ctx context.Context, list *entity.ReadingListUpdate) (*entity.ReadingList, error)
+	DeleteList(ctx context.Context, listID uuid.UUID) error
+	GetList(ctx context.Context, listID uuid.UUID) (*entity.ReadingList, error)
+	GetLists(ctx context.Context, userID uuid.UUID) ([]*entity.ReadingList, error)
+	GetListNews(ctx context.Context, listID uuid.UUID, viewerID uuid.UUID, pq *utils.PaginationQuery) (*entity.NewsList, error)
+	AddListItem(ctx context.Context, listID uuid.UUID, newsID uuid.UUID) error
+	RemoveListItem(ctx context.Context, listID uuid.UUID, newsID uuid.UUID) error
+	ReorderList(ctx context.Context, listID uuid.UUID, newsIDs []uuid.UUID) error
+}
+
+// Related news storage interface
+type RelatedPsql interface {
+	GetCorpus(ctx context.Context) ([]*entity.RelatedDocument, error)
+	GetNewsCategory(ctx context.Context, newsID uuid.UUID) (*uuid.UUID, error)
+	GetNews(ctx context.Context, newsIDs []uuid.UUID) ([]*entity.News, error)
+	GetCategoryNews(ctx context.Context, categoryID *uuid.UUID, newsID uuid.UUID, limit int) ([]*entity.News, error)
+}
+
+// Translations storage interface
+type TranslationsPsql interface {
+	GetNews(ctx context.Context, newsID uuid.UUID) (*entity.News, error)
+	GetTranslations(ctx context.Context, newsID uuid.UUID) ([]*entity.NewsTranslation, error)
+	Create(ctx context.Context, translation *entity.NewsTranslation) (*entity.NewsTranslation, error)
+	Update(ctx context.Context, translation *entity.NewsTranslation) (*entity.NewsTranslation, error)
+	Delete(ctx context.Context, newsID uuid.UUID, locale string) error
+}
+
+// News reviews storage interface
+type ReviewsPsql interface {
+	Transition(ctx context.Context, review *entity.NewsReview, from []string, to string, publishAt *time.Time) (*entity.News, error)
+	GetReviews(ctx context.Context, newsID uuid.UUID) ([]*entity.NewsReview, error)
+	GetQueue(ctx context.Context, pq *utils.PaginationQuery) (*entity.NewsList, error)
+}
+
+// Notifications storage interface
+type NotificationsPsql interface {
+	Create(ctx context.Context, notification *entity.Notification) (*entity.Notification, error)
+	GetNotifications(ctx context.Context, userID uuid.UUID, unreadOnly bool, pq *utils.PaginationQuery) (*entity.NotificationsList, error)
+	MarkRead(ctx context.Context, userID uuid.UUID, notificationID uuid.UUID) error
+	MarkAllRead(ctx context.Context, userID uuid.UUID) (int64, error)
+}
+
+// News contributors storage interface
+type ContributorsPsql interface {
+	GetNews(ctx context.Context, newsID uuid.UUID) (*entity.News, error)
+	Invite(ctx context.Context, contributor *entity.NewsContributor) (*entity.NewsContributor, error)
+	Accept(ctx context.Context, newsID uuid.UUID, userID uuid.UUID) (*entity.NewsContributor, error)
+	Remove(ctx context.Context, newsID uuid.UUID, userID uuid.UUID) error
+	GetContributors(ctx context.Context, newsID uuid.UUID) ([]*entity.NewsContributor, error)
+	GetInvitations(ctx context.Context, userID uuid.UUID) ([]*entity.NewsContributor, error)
+	IsCoAuthor(ctx context.Context, newsID uuid.UUID, userID uuid.UUID) (bool, error)
+}
+
+// News import and export storage interface
+type TransferPsql interface {
+	ExportNews(ctx context.Context, withComments bool, fn func(record *entity.NewsRecord) error) error
+	ImportNews(ctx context.Context, newsList []*entity.News, dryRun bool) ([]error, error)
+}
+
+// WordPress StoragePsql interface
+type WordPressPsql interface {
+	GetImported(ctx context.Context, source string, kind string) (map[string]uuid.UUID, error)
+	GetImportedSlugs(ctx context.Context, source string) (map[string]string, error)
+	ImportUser(ctx context.Context, source string, sourceID string, user *entity.User) (bool, error)
+	ImportCategory(ctx context.Context, source string, sourceID string, category *entity.Category) (bool, error)
+	ImportNews(ctx context.Context, source string, sourceID string, news *entity.News) error
+	UpdateImportedContent(ctx context.Context, newsID uuid.UUID, content string, contentHTML string) error
+	ImportComment(ctx context.Context, source string, sourceID string, comment *entity.Comment) error
 }
 
 type Storage struct {
-	Auth     *AuthStorage
-	News     *NewsStorage
-	Comments *CommentsStorage
+	Auth          *AuthStorage
+	News          *NewsStorage
+	Comments      *CommentsStorage
+	Suggest       *SuggestStorage
+	Revisions     *RevisionsStorage
+	Tags          *TagsStorage
+	Categories    *CategoriesStorage
+	Sitemaps      *SitemapsStorage
+	Views         *ViewsStorage
+	Reactions     *ReactionsStorage
+	Bookmarks     *BookmarksStorage
+	Related       *RelatedStorage
+	Translations  *TranslationsStorage
+	Reviews       *ReviewsStorage
+	Notifications *NotificationsStorage
+	Contributors  *ContributorsStorage
+	Transfer      *TransferStorage
+	WordPress     *WordPressStorage
 }
 
 func NewStorage(psql *sqlx.DB) *Storage {
 	return &Storage{
-		Auth:     NewAuthStorage(psql),
-		News:     NewNewsStorage(psql),
-		Comments: NewCommentsStorage(psql),
+		Auth:          NewAuthStorage(psql),
+		News:          NewNewsStorage(psql),
+		Comments:      NewCommentsStorage(psql),
+		Suggest:       NewSuggestStorage(psql),
+		Revisions:     NewRevisionsStorage(psql),
+		Tags:          NewTagsStorage(psql),
+		Categories:    NewCategoriesStorage(psql),
+		Sitemaps:      NewSitemapsStorage(psql),
+		Views:         NewViewsStorage(psql),
+		Reactions:     NewReactionsStorage(psql),
+		Bookmarks:     NewBookmarksStorage(psql),
+		Related:       NewRelatedStorage(psql),
+		Translations:  NewTranslationsStorage(psql),
+		Reviews:       NewReviewsStorage(psql),
+		Notifications: NewNotificationsStorage(psql),
+		Contributors:  NewContributorsStorage(psql),
+		Transfer:      NewTransferStorage(psql),
+		WordPress:     NewWordPressStorage(psql),
 	}
 }
@@ -0,0 +1,38 @@
+package psql
+
+import (
+	"context"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// Suggest storage
+type SuggestStorage struct {
+	psql *sqlx.DB
+}
+
+// Suggest storage constructor
+func NewSuggestStorage(psql *sqlx.DB) *SuggestStorage {
+	return &SuggestStorage{psql: psql}
+}
+
+// Get batch of news titles for the suggestion index, ordered by id
+func (s *SuggestStorage) GetNewsSuggestions(ctx context.Context, after uuid.UUID, limit int) ([]*entity.Suggestion, error) {
+	suggestions := make([]*entity.Suggestion, 0, limit)
+	if err := s.psql.SelectContext(ctx, &suggestions, getNewsSuggestions, after, limit); err != nil {
+		return nil, errors.Wrap(err, "SuggestStoragePsql.GetNewsSuggestions.SelectContext")
+	}
+	return suggestions, nil
+}
+
+// Get batch of author names for the suggestion index, ordered by id and scored by news count
+func (s *SuggestStorage) GetAuthorSuggestions(ctx context.Context, after uuid.UUID, limit int) ([]*entity.Suggestion, error) {
+	suggestions := make([]*entity.Suggestion, 0, limit)
+	if err := s.psql.SelectContext(ctx, &suggestions, getAuthorSuggestions, after, limit); err != nil {
+		return nil, errors.Wrap(err, "SuggestStoragePsql.GetAuthorSuggestions.SelectContext")
+	}
+	return suggestions, nil
+}
@@ -0,0 +1,17 @@
+package psql
+
+const (
+	getNewsSuggestions = `SELECT news_id AS id, title AS text, 0 AS score
+					FROM news
+					WHERE news_id > $1 AND status = 'published'
+					ORDER BY news_id
+					LIMIT $2`
+
+	getAuthorSuggestions = `SELECT u.user_id AS id, CONCAT(u.first_name, ' ', u.last_name) AS text, COUNT(n.news_id) AS score
+					FROM users u
+						LEFT JOIN news n on n.author_id = u.user_id AND n.status = 'published'
+					WHERE u.user_id > $1
+					GROUP BY u.user_id
+					ORDER BY u.user_id
+					LIMIT $2`
+)
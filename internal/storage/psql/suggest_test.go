@@ -0,0 +1,63 @@
+package psql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPsql_GetNewsSuggestions(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	suggestStorage := NewSuggestStorage(sqlxDB)
+
+	t.Run("GetNewsSuggestions", func(t *testing.T) {
+		newsId := uuid.New()
+
+		rows := sqlmock.NewRows([]string{"id", "text", "score"}).AddRow(newsId, "title", 0)
+
+		mock.ExpectQuery(getNewsSuggestions).WithArgs(uuid.Nil, 500).WillReturnRows(rows)
+
+		suggestions, err := suggestStorage.GetNewsSuggestions(context.Background(), uuid.Nil, 500)
+		require.NoError(t, err)
+		require.Len(t, suggestions, 1)
+		require.Equal(t, newsId, suggestions[0].ID)
+	})
+}
+
+func TestPsql_GetAuthorSuggestions(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	suggestStorage := NewSuggestStorage(sqlxDB)
+
+	t.Run("GetAuthorSuggestions", func(t *testing.T) {
+		userId := uuid.New()
+
+		rows := sqlmock.NewRows([]string{"id", "text", "score"}).AddRow(userId, "Pavel Volkov", 3)
+
+		mock.ExpectQuery(getAuthorSuggestions).WithArgs(uuid.Nil, 500).WillReturnRows(rows)
+
+		suggestions, err := suggestStorage.GetAuthorSuggestions(context.Background(), uuid.Nil, 500)
+		require.NoError(t, err)
+		require.Len(t, suggestions, 1)
+		require.Equal(t, float64(3), suggestions[0].Score)
+	})
+}
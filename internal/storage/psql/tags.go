@@ -0,0 +1,142 @@
+package psql
+
+import (
+	"context"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// Tags storage
+type TagsStorage struct {
+	psql *sqlx.DB
+}
+
+// Tags storage constructor
+func NewTagsStorage(psql *sqlx.DB) *TagsStorage {
+	return &TagsStorage{psql: psql}
+}
+
+// Get tags with published news counts, most used first
+func (s *TagsStorage) GetTags(ctx context.Context, pq *utils.PaginationQuery) (*entity.TagsList, error) {
+	var totalCount int
+	if err := s.psql.GetContext(ctx, &totalCount, getTagsCount); err != nil {
+		return nil, errors.Wrap(err, "TagsStoragePsql.GetTags.GetContext")
+	}
+
+	tags := make([]*entity.Tag, 0, pq.GetSize())
+	if totalCount > 0 {
+		if err := s.psql.SelectContext(ctx, &tags, getTags, pq.GetLimit(), pq.GetOffset()); err != nil {
+			return nil, errors.Wrap(err, "TagsStoragePsql.GetTags.SelectContext")
+		}
+	}
+
+	return &entity.TagsList{
+		TotalCount: totalCount,
+		TotalPages: utils.GetTotalPages(totalCount, pq.GetSize()),
+		Page:       pq.GetPage(),
+		Size:       pq.GetSize(),
+		HasMore:    utils.GetHasMore(pq.GetPage(), totalCount, pq.GetSize()),
+		Tags:       tags,
+	}, nil
+}
+
+// Get tag by slug
+func (s *TagsStorage) GetTagBySlug(ctx context.Context, slug string) (*entity.Tag, error) {
+	tag := &entity.Tag{}
+	if err := s.psql.GetContext(ctx, tag, getTagBySlug, slug); err != nil {
+		return nil, errors.Wrap(err, "TagsStoragePsql.GetTagBySlug.GetContext")
+	}
+	return tag, nil
+}
+
+// Get published news of tag, newest first
+func (s *TagsStorage) GetNewsByTag(ctx context.Context, tagID uuid.UUID, pq *utils.PaginationQuery) (*entity.NewsList, error) {
+	var totalCount int
+	if err := s.psql.GetContext(ctx, &totalCount, getTagNewsCount, tagID); err != nil {
+		return nil, errors.Wrap(err, "TagsStoragePsql.GetNewsByTag.GetContext")
+	}
+
+	newsList := make([]*entity.News, 0, pq.GetSize())
+	if totalCount > 0 {
+		if err := s.psql.SelectContext(ctx, &newsList, getNewsByTag, tagID, pq.GetLimit(), pq.GetOffset()); err != nil {
+			return nil, errors.Wrap(err, "TagsStoragePsql.GetNewsByTag.SelectContext")
+		}
+	}
+
+	return &entity.NewsList{
+		TotalCount: totalCount,
+		TotalPages: utils.GetTotalPages(totalCount, pq.GetSize()),
+		Page:       pq.GetPage(),
+		Size:       pq.GetSize(),
+		HasMore:    utils.GetHasMore(pq.GetPage(), totalCount, pq.GetSize()),
+		News:       newsList,
+	}, nil
+}
+
+// Rename tag, news of the tag get new versions
+func (s *TagsStorage) RenameTag(ctx context.Context, tagID uuid.UUID, name string) (*entity.Tag, error) {
+	tx, err := s.psql.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "TagsStoragePsql.RenameTag.BeginTxx")
+	}
+	defer tx.Rollback()
+
+	tag := &entity.Tag{}
+	if err := tx.QueryRowxContext(ctx, renameTag, tagID, name, utils.Slugify(name)).StructScan(tag); err != nil {
+		return nil, errors.Wrap(err, "TagsStoragePsql.RenameTag.StructScan")
+	}
+	if _, err := tx.ExecContext(ctx, bumpTagNewsVersions, tagID); err != nil {
+		return nil, errors.Wrap(err, "TagsStoragePsql.RenameTag.bumpTagNewsVersions")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "TagsStoragePsql.RenameTag.Commit")
+	}
+	return tag, nil
+}
+
+// Move news of source tag to target tag and delete source tag
+func (s *TagsStorage) MergeTags(ctx context.Context, sourceID uuid.UUID, targetID uuid.UUID) error {
+	tx, err := s.psql.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "TagsStoragePsql.MergeTags.BeginTxx")
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, bumpTagNewsVersions, sourceID); err != nil {
+		return errors.Wrap(err, "TagsStoragePsql.MergeTags.bumpTagNewsVersions")
+	}
+	if _, err := tx.ExecContext(ctx, moveNewsTags, sourceID, targetID); err != nil {
+		return errors.Wrap(err, "TagsStoragePsql.MergeTags.moveNewsTags")
+	}
+	if _, err := tx.ExecContext(ctx, deleteTag, sourceID); err != nil {
+		return errors.Wrap(err, "TagsStoragePsql.MergeTags.deleteTag")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "TagsStoragePsql.MergeTags.Commit")
+	}
+	return nil
+}
+
+// Replace tags of news, tags are created on first use
+func setNewsTags(ctx context.Context, tx *sqlx.Tx, newsID uuid.UUID, names []string) error {
+	if _, err := tx.ExecContext(ctx, deleteNewsTags, newsID); err != nil {
+		return errors.Wrap(err, "setNewsTags.deleteNewsTags")
+	}
+
+	for _, name := range names {
+		var tagID uuid.UUID
+		if err := tx.GetContext(ctx, &tagID, upsertTag, name, utils.Slugify(name)); err != nil {
+			return errors.Wrap(err, "setNewsTags.upsertTag")
+		}
+		if _, err := tx.ExecContext(ctx, addNewsTag, newsID, tagID); err != nil {
+			return errors.Wrap(err, "setNewsTags.addNewsTag")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package psql
+
+const (
+	upsertTag = `INSERT INTO tags (name, slug)
+				VALUES ($1, $2)
+				ON CONFLICT (slug) DO UPDATE SET slug = EXCLUDED.slug
+				RETURNING tag_id`
+
+	deleteNewsTags = `DELETE FROM news_tags WHERE news_id = $1`
+
+	addNewsTag = `INSERT INTO news_tags (news_id, tag_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+
+	getNewsTagNames = `SELECT t.name
+				FROM tags t
+					JOIN news_tags nt on nt.tag_id = t.tag_id
+				WHERE nt.news_id = $1
+				ORDER BY t.name`
+
+	getNewsTags = `SELECT t.tag_id, t.name, t.slug, t.created_at
+				FROM tags t
+					JOIN news_tags nt on nt.tag_id = t.tag_id
+				WHERE nt.news_id = $1
+				ORDER BY t.name`
+
+	getTagsCount = `SELECT COUNT(tag_id) FROM tags`
+
+	getTags = `SELECT t.tag_id, t.name, t.slug, t.created_at, COUNT(n.news_id) AS news_count
+				FROM tags t
+					LEFT JOIN news_tags nt on nt.tag_id = t.tag_id
+					LEFT JOIN news n on n.news_id = nt.news_id AND n.status = 'published'
+				GROUP BY t.tag_id
+				ORDER BY news_count DESC, t.name
+				LIMIT $1 OFFSET $2`
+
+	getTagBySlug = `SELECT t.tag_id, t.name, t.slug, t.created_at, COUNT(n.news_id) AS news_count
+				FROM tags t
+					LEFT JOIN news_tags nt on nt.tag_id = t.tag_id
+					LEFT JOIN news n on n.news_id = nt.news_id AND n.status = 'published'
+				WHERE t.slug = $1
+				GROUP BY t.tag_id`
+
+	getTagNewsCount = `SELECT COUNT(n.news_id)
+				FROM news n
+					JOIN news_tags nt on nt.news_id = n.news_id
+				WHERE nt.tag_id = $1 AND n.status = 'published'`
+
+	getNewsByTag = `SELECT n.news_id, n.author_id, n.title, n.slug, n.content, n.content_html, n.image_url, n.category, n.category_id, n.language, n.status, n.publish_at, n.updated_at, n.created_at
+				FROM news n
+					JOIN news_tags nt on nt.news_id = n.news_id
+				WHERE nt.tag_id = $1 AND n.status = 'published'
+				ORDER BY n.publish_at DESC, n.created_at DESC
+				LIMIT $2 OFFSET $3`
+
+	renameTag = `UPDATE tags SET name = $2, slug = $3
+				WHERE tag_id = $1
+				RETURNING tag_id, name, slug, created_at`
+
+	bumpTagNewsVersions = `UPDATE news SET version = version + 1
+				WHERE news_id IN (SELECT news_id FROM news_tags WHERE tag_id = $1)`
+
+	moveNewsTags = `INSERT INTO news_tags (news_id, tag_id)
+				SELECT news_id, $2 FROM news_tags WHERE tag_id = $1
+				ON CONFLICT DO NOTHING`
+
+	deleteTag = `DELETE FROM tags WHERE tag_id = $1`
+)
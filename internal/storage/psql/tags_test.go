@@ -0,0 +1,98 @@
+package psql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPsql_GetTags(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	tagsStorage := NewTagsStorage(sqlxDB)
+
+	t.Run("GetTags", func(t *testing.T) {
+		totalCountRows := sqlmock.NewRows([]string{"count"}).AddRow(1)
+		rows := sqlmock.NewRows([]string{"tag_id", "name", "slug", "news_count"}).
+			AddRow(uuid.New(), "golang", "golang", 3)
+
+		mock.ExpectQuery(getTagsCount).WillReturnRows(totalCountRows)
+		mock.ExpectQuery(getTags).WithArgs(10, 0).WillReturnRows(rows)
+
+		tags, err := tagsStorage.GetTags(context.Background(), &utils.PaginationQuery{
+			Size: 10,
+			Page: 0,
+		})
+		require.NoError(t, err)
+		require.Len(t, tags.Tags, 1)
+		require.Equal(t, 3, tags.Tags[0].NewsCount)
+	})
+}
+
+func TestPsql_RenameTag(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	tagsStorage := NewTagsStorage(sqlxDB)
+
+	t.Run("RenameTag", func(t *testing.T) {
+		tagId := uuid.New()
+		rows := sqlmock.NewRows([]string{"tag_id", "name", "slug"}).
+			AddRow(tagId, "новости спорта", "novosti-sporta")
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(renameTag).WithArgs(tagId, "новости спорта", "novosti-sporta").WillReturnRows(rows)
+		mock.ExpectExec(bumpTagNewsVersions).WithArgs(tagId).WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		tag, err := tagsStorage.RenameTag(context.Background(), tagId, "новости спорта")
+		require.NoError(t, err)
+		require.Equal(t, "novosti-sporta", tag.Slug)
+	})
+}
+
+func TestPsql_MergeTags(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	tagsStorage := NewTagsStorage(sqlxDB)
+
+	t.Run("MergeTags", func(t *testing.T) {
+		sourceId := uuid.New()
+		targetId := uuid.New()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(bumpTagNewsVersions).WithArgs(sourceId).WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(moveNewsTags).WithArgs(sourceId, targetId).WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(deleteTag).WithArgs(sourceId).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := tagsStorage.MergeTags(context.Background(), sourceId, targetId)
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,156 @@
+package psql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// News import and export storage
+type TransferStorage struct {
+	psql *sqlx.DB
+}
+
+// News import and export storage constructor
+func NewTransferStorage(psql *sqlx.DB) *TransferStorage {
+	return &TransferStorage{psql: psql}
+}
+
+// Pass news one by one to fn, oldest first, optionally with comments
+func (s *TransferStorage) ExportNews(ctx context.Context, withComments bool, fn func(record *entity.NewsRecord) error) error {
+	rows, err := s.psql.QueryxContext(ctx, exportNews)
+	if err != nil {
+		return errors.Wrap(err, "TransferStoragePsql.ExportNews.QueryxContext")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		record := &entity.NewsRecord{}
+		if err := rows.StructScan(record); err != nil {
+			return errors.Wrap(err, "TransferStoragePsql.ExportNews.StructScan")
+		}
+		if withComments {
+			if err := s.psql.SelectContext(ctx, &record.Comments, exportComments, record.NewsID); err != nil {
+				return errors.Wrap(err, "TransferStoragePsql.ExportNews.exportComments")
+			}
+		}
+		if err := fn(record); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return errors.Wrap(err, "TransferStoragePsql.ExportNews.Err")
+	}
+	return nil
+}
+
+// Insert batch of news in one transaction. Every news is inserted in its
+// own savepoint so failed ones are reported by index and the rest are kept,
+// nothing is kept on dry run. Ids of inserted news are set.
+func (s *TransferStorage) ImportNews(ctx context.Context, newsList []*entity.News, dryRun bool) ([]error, error) {
+	tx, err := s.psql.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "TransferStoragePsql.ImportNews.BeginTxx")
+	}
+	defer tx.Rollback()
+
+	errs := make([]error, len(newsList))
+	for i, news := range newsList {
+		if _, err := tx.ExecContext(ctx, savepointImport); err != nil {
+			return nil, errors.Wrap(err, "TransferStoragePsql.ImportNews.savepointImport")
+		}
+		if errs[i] = importOne(ctx, tx, news); errs[i] != nil {
+			if _, err := tx.ExecContext(ctx, rollbackToImport); err != nil {
+				return nil, errors.Wrap(err, "TransferStoragePsql.ImportNews.rollbackToImport")
+			}
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, releaseImport); err != nil {
+			return nil, errors.Wrap(err, "TransferStoragePsql.ImportNews.releaseImport")
+		}
+	}
+
+	if dryRun {
+		return errs, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "TransferStoragePsql.ImportNews.Commit")
+	}
+	return errs, nil
+}
+
+func importOne(ctx context.Context, tx *sqlx.Tx, news *entity.News) error {
+	// category is resolved by name unless the importer did
+	if news.CategoryID == nil && news.Category != nil && *news.Category != "" {
+		category := &entity.Category{}
+		if err := tx.GetContext(ctx, category, getImportCategory, utils.Slugify(*news.Category)); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errors.Errorf("unknown category: %s", *news.Category)
+			}
+			return errors.Wrap(err, "importOne.getImportCategory")
+		}
+		news.CategoryID = &category.CategoryID
+		news.Category = &category.Name
+	}
+
+	// slug of record is kept unless taken
+	base := news.Slug
+	if base == "" {
+		base = news.Title
+	}
+	slug, err := freeNewsSlug(ctx, tx, utils.NewsSlug(base), uuid.Nil)
+	if err != nil {
+		return errors.Wrap(err, "importOne")
+	}
+
+	var newsID *uuid.UUID
+	if news.NewsID != uuid.Nil {
+		newsID = &news.NewsID
+	}
+	var createdAt, updatedAt *time.Time
+	if !news.CreatedAt.IsZero() {
+		createdAt = &news.CreatedAt
+	}
+	if !news.UpdatedAt.IsZero() {
+		updatedAt = &news.UpdatedAt
+	}
+
+	if err := tx.GetContext(ctx,
+		&news.NewsID,
+		importNews,
+		newsID,
+		news.AuthorID,
+		news.Title,
+		slug,
+		news.Content,
+		news.ContentHTML,
+		news.ImageURL,
+		news.Category,
+		news.CategoryID,
+		news.Language,
+		news.Locale,
+		news.Status,
+		news.PublishAt,
+		createdAt,
+		updatedAt,
+	); err != nil {
+		return errors.Wrap(err, "importOne.importNews")
+	}
+	news.Slug = slug
+
+	if _, err := tx.ExecContext(ctx, createRevision, news.NewsID, news.Title, news.Content, news.AuthorID, nil); err != nil {
+		return errors.Wrap(err, "importOne.createRevision")
+	}
+	if news.Tags != nil {
+		if err := setNewsTags(ctx, tx, news.NewsID, news.Tags); err != nil {
+			return errors.Wrap(err, "importOne")
+		}
+	}
+	return nil
+}
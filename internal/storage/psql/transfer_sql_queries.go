@@ -0,0 +1,32 @@
+package psql
+
+const (
+	exportNews = `SELECT n.news_id, n.author_id, n.title, n.slug, n.content, n.image_url, n.category, n.language, n.locale,
+					n.status, n.publish_at, n.created_at, n.updated_at,
+					COALESCE((SELECT json_agg(t.name ORDER BY t.name)
+						FROM news_tags nt
+							JOIN tags t on t.tag_id = nt.tag_id
+						WHERE nt.news_id = n.news_id), '[]') AS tags
+				FROM news n
+				ORDER BY n.created_at, n.news_id`
+
+	exportComments = `SELECT comment_id, author_id, parent_id, message, created_at, updated_at
+				FROM comments
+				WHERE news_id = $1
+				ORDER BY created_at, comment_id`
+
+	getImportCategory = `SELECT category_id, name FROM categories WHERE slug = $1`
+
+	importNews = `INSERT INTO news (news_id, author_id, title, slug, content, content_html, image_url, category, category_id,
+					language, locale, status, publish_at, created_at, updated_at)
+				VALUES (COALESCE($1, uuid_generate_v4()), $2, $3, $4, $5, $6, NULLIF($7, ''), NULLIF($8, ''), $9,
+					COALESCE(NULLIF($10, ''), 'english'), COALESCE(NULLIF($11, ''), 'en'), $12, $13,
+					COALESCE($14, now()), COALESCE($15, now()))
+				RETURNING news_id`
+
+	savepointImport = `SAVEPOINT import_news`
+
+	rollbackToImport = `ROLLBACK TO SAVEPOINT import_news`
+
+	releaseImport = `RELEASE SAVEPOINT import_news`
+)
@@ -0,0 +1,111 @@
+package psql
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPsql_ExportNews(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	transferStorage := NewTransferStorage(sqlxDB)
+
+	newsID, authorID := uuid.New(), uuid.New()
+	createdAt := time.Now().UTC()
+	mock.ExpectQuery(exportNews).WillReturnRows(
+		sqlmock.NewRows([]string{"news_id", "author_id", "title", "slug", "content", "status", "tags", "created_at"}).
+			AddRow(newsID, authorID, "title", "title", "content", entity.NewsStatusPublished, []byte(`["go","sql"]`), createdAt),
+	)
+	mock.ExpectQuery(exportComments).WithArgs(newsID).WillReturnRows(
+		sqlmock.NewRows([]string{"comment_id", "author_id", "message", "created_at"}).
+			AddRow(uuid.New(), authorID, "message", createdAt),
+	)
+
+	var records []*entity.NewsRecord
+	err = transferStorage.ExportNews(context.Background(), true, func(record *entity.NewsRecord) error {
+		records = append(records, record)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, entity.StringList{"go", "sql"}, records[0].Tags)
+	require.Len(t, records[0].Comments, 1)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPsql_ImportNews(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	transferStorage := NewTransferStorage(sqlxDB)
+
+	importArgs := make([]driver.Value, 15)
+	for i := range importArgs {
+		importArgs[i] = sqlmock.AnyArg()
+	}
+
+	t.Run("Import with failed news", func(t *testing.T) {
+		newsID := uuid.New()
+		unknown := "unknown"
+		newsList := []*entity.News{
+			{AuthorID: uuid.New(), Title: "first news", Content: "content"},
+			{AuthorID: uuid.New(), Title: "second news", Content: "content", Category: &unknown},
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectExec(savepointImport).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(getTakenNewsSlugs).WithArgs("first-news", uuid.Nil).WillReturnRows(sqlmock.NewRows([]string{"slug"}))
+		mock.ExpectQuery(importNews).WithArgs(importArgs...).WillReturnRows(sqlmock.NewRows([]string{"news_id"}).AddRow(newsID))
+		mock.ExpectExec(createRevision).WithArgs(newsID, "first news", "content", newsList[0].AuthorID, nil).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(releaseImport).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(savepointImport).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(getImportCategory).WithArgs("unknown").WillReturnRows(sqlmock.NewRows([]string{"category_id", "name"}))
+		mock.ExpectExec(rollbackToImport).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		errs, err := transferStorage.ImportNews(context.Background(), newsList, false)
+		require.NoError(t, err)
+		require.NoError(t, errs[0])
+		require.Error(t, errs[1])
+		require.Equal(t, newsID, newsList[0].NewsID)
+		require.Equal(t, "first-news", newsList[0].Slug)
+	})
+
+	t.Run("Dry run", func(t *testing.T) {
+		newsList := []*entity.News{{AuthorID: uuid.New(), Title: "dry news", Content: "content"}}
+
+		mock.ExpectBegin()
+		mock.ExpectExec(savepointImport).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(getTakenNewsSlugs).WithArgs("dry-news", uuid.Nil).WillReturnRows(sqlmock.NewRows([]string{"slug"}))
+		mock.ExpectQuery(importNews).WithArgs(importArgs...).WillReturnError(errors.New("duplicate key"))
+		mock.ExpectExec(rollbackToImport).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		errs, err := transferStorage.ImportNews(context.Background(), newsList, true)
+		require.NoError(t, err)
+		require.Error(t, errs[0])
+	})
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,130 @@
+package psql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// News translations storage
+type TranslationsStorage struct {
+	psql *sqlx.DB
+}
+
+// News translations storage constructor
+func NewTranslationsStorage(psql *sqlx.DB) *TranslationsStorage {
+	return &TranslationsStorage{psql: psql}
+}
+
+// Get author, status and original locale of news
+func (s *TranslationsStorage) GetNews(ctx context.Context, newsID uuid.UUID) (*entity.News, error) {
+	news := &entity.News{}
+	if err := s.psql.GetContext(ctx, news, getTranslatedNews, newsID); err != nil {
+		return nil, errors.Wrap(err, "TranslationsStoragePsql.GetNews.GetContext")
+	}
+	return news, nil
+}
+
+// Get translations of news ordered by locale
+func (s *TranslationsStorage) GetTranslations(ctx context.Context, newsID uuid.UUID) ([]*entity.NewsTranslation, error) {
+	translations := []*entity.NewsTranslation{}
+	if err := s.psql.SelectContext(ctx, &translations, getTranslations, newsID); err != nil {
+		return nil, errors.Wrap(err, "TranslationsStoragePsql.GetTranslations.SelectContext")
+	}
+	return translations, nil
+}
+
+// Create translation, nil if news is already translated to the locale.
+// News gets a new version with its translation.
+func (s *TranslationsStorage) Create(ctx context.Context, translation *entity.NewsTranslation) (*entity.NewsTranslation, error) {
+	tx, err := s.psql.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "TranslationsStoragePsql.Create.BeginTxx")
+	}
+	defer tx.Rollback()
+
+	t := &entity.NewsTranslation{}
+	if err := tx.QueryRowxContext(ctx,
+		createTranslation,
+		translation.NewsID,
+		translation.Locale,
+		translation.Title,
+		translation.Content,
+		translation.ContentHTML,
+	).StructScan(t); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "TranslationsStoragePsql.Create.StructScan")
+	}
+	if _, err := tx.ExecContext(ctx, bumpNewsVersion, translation.NewsID); err != nil {
+		return nil, errors.Wrap(err, "TranslationsStoragePsql.Create.bumpNewsVersion")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "TranslationsStoragePsql.Create.Commit")
+	}
+	return t, nil
+}
+
+// Replace title and content of translation, news gets a new version
+func (s *TranslationsStorage) Update(ctx context.Context, translation *entity.NewsTranslation) (*entity.NewsTranslation, error) {
+	tx, err := s.psql.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "TranslationsStoragePsql.Update.BeginTxx")
+	}
+	defer tx.Rollback()
+
+	t := &entity.NewsTranslation{}
+	if err := tx.QueryRowxContext(ctx,
+		updateTranslation,
+		translation.NewsID,
+		translation.Locale,
+		translation.Title,
+		translation.Content,
+		translation.ContentHTML,
+	).StructScan(t); err != nil {
+		return nil, errors.Wrap(err, "TranslationsStoragePsql.Update.StructScan")
+	}
+	if _, err := tx.ExecContext(ctx, bumpNewsVersion, translation.NewsID); err != nil {
+		return nil, errors.Wrap(err, "TranslationsStoragePsql.Update.bumpNewsVersion")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "TranslationsStoragePsql.Update.Commit")
+	}
+	return t, nil
+}
+
+// Delete translation, news gets a new version
+func (s *TranslationsStorage) Delete(ctx context.Context, newsID uuid.UUID, locale string) error {
+	tx, err := s.psql.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "TranslationsStoragePsql.Delete.BeginTxx")
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, deleteTranslation, newsID, locale)
+	if err != nil {
+		return errors.Wrap(err, "TranslationsStoragePsql.Delete.ExecContext")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "TranslationsStoragePsql.Delete.RowsAffected")
+	}
+	if rowsAffected == 0 {
+		return errors.Wrap(sql.ErrNoRows, "TranslationsStoragePsql.Delete.rowsAffected")
+	}
+	if _, err := tx.ExecContext(ctx, bumpNewsVersion, newsID); err != nil {
+		return errors.Wrap(err, "TranslationsStoragePsql.Delete.bumpNewsVersion")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "TranslationsStoragePsql.Delete.Commit")
+	}
+	return nil
+}
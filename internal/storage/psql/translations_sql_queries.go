@@ -0,0 +1,25 @@
+package psql
+
+const (
+	getTranslatedNews = `SELECT news_id, author_id, locale, status FROM news WHERE news_id = $1`
+
+	getTranslations = `SELECT news_id, locale, title, content, content_html, created_at, updated_at
+			FROM news_translations
+			WHERE news_id = $1
+			ORDER BY locale`
+
+	createTranslation = `INSERT INTO news_translations (news_id, locale, title, content, content_html, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, now(), now())
+			ON CONFLICT (news_id, locale) DO NOTHING
+			RETURNING news_id, locale, title, content, content_html, created_at, updated_at`
+
+	updateTranslation = `UPDATE news_translations
+			SET title = $3,
+				content = $4,
+				content_html = $5,
+				updated_at = now()
+			WHERE news_id = $1 AND locale = $2
+			RETURNING news_id, locale, title, content, content_html, created_at, updated_at`
+
+	deleteTranslation = `DELETE FROM news_translations WHERE news_id = $1 AND locale = $2`
+)
@@ -0,0 +1,120 @@
+package psql
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPsql_CreateTranslation(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	translationsStorage := NewTranslationsStorage(sqlxDB)
+
+	translation := &entity.NewsTranslation{
+		NewsID:      uuid.New(),
+		Locale:      "de",
+		Title:       "Regen in Berlin",
+		Content:     "Es regnet in Berlin",
+		ContentHTML: "<p>Es regnet in Berlin</p>\n",
+	}
+	columns := []string{"news_id", "locale", "title", "content", "content_html"}
+
+	t.Run("Create", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(createTranslation).WithArgs(
+			translation.NewsID, translation.Locale, translation.Title, translation.Content, translation.ContentHTML,
+		).WillReturnRows(sqlmock.NewRows(columns).AddRow(
+			translation.NewsID, translation.Locale, translation.Title, translation.Content, translation.ContentHTML,
+		))
+		mock.ExpectExec(bumpNewsVersion).WithArgs(translation.NewsID).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		created, err := translationsStorage.Create(context.Background(), translation)
+		require.NoError(t, err)
+		require.Equal(t, translation, created)
+	})
+
+	t.Run("Already translated", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(createTranslation).WithArgs(
+			translation.NewsID, translation.Locale, translation.Title, translation.Content, translation.ContentHTML,
+		).WillReturnRows(sqlmock.NewRows(columns))
+		mock.ExpectRollback()
+
+		created, err := translationsStorage.Create(context.Background(), translation)
+		require.NoError(t, err)
+		require.Nil(t, created)
+	})
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPsql_UpdateTranslation(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	translationsStorage := NewTranslationsStorage(sqlxDB)
+
+	translation := &entity.NewsTranslation{NewsID: uuid.New(), Locale: "fr", Title: "Pluie à Berlin", Content: "Il pleut à Berlin"}
+	mock.ExpectBegin()
+	mock.ExpectQuery(updateTranslation).WithArgs(
+		translation.NewsID, translation.Locale, translation.Title, translation.Content, translation.ContentHTML,
+	).WillReturnRows(sqlmock.NewRows([]string{"news_id", "locale", "title", "content", "content_html"}))
+	mock.ExpectRollback()
+
+	_, err = translationsStorage.Update(context.Background(), translation)
+	require.True(t, errors.Is(err, sql.ErrNoRows))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPsql_DeleteTranslation(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	translationsStorage := NewTranslationsStorage(sqlxDB)
+
+	newsID := uuid.New()
+
+	t.Run("Delete", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(deleteTranslation).WithArgs(newsID, "de").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(bumpNewsVersion).WithArgs(newsID).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+		require.NoError(t, translationsStorage.Delete(context.Background(), newsID, "de"))
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(deleteTranslation).WithArgs(newsID, "it").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+		err := translationsStorage.Delete(context.Background(), newsID, "it")
+		require.True(t, errors.Is(err, sql.ErrNoRows))
+	})
+	require.NoError(t, mock.ExpectationsWereMet())
+}
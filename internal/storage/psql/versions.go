@@ -0,0 +1,27 @@
+package psql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// Error of a write conditional on version that matched no rows: the row is
+// gone, or another writer got there first when there was a version
+func versionConflict(ctx context.Context, db sqlx.QueryerContext, exists string, id uuid.UUID, version int, op string) error {
+	if version == 0 {
+		return errors.Wrap(sql.ErrNoRows, op)
+	}
+	var found bool
+	if err := sqlx.GetContext(ctx, db, &found, exists, id); err != nil {
+		return errors.Wrap(err, op)
+	}
+	if !found {
+		return errors.Wrap(sql.ErrNoRows, op)
+	}
+	return errors.Wrap(httpe.PreconditionFailed, op)
+}
@@ -0,0 +1,64 @@
+package psql
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// News views storage
+type ViewsStorage struct {
+	psql *sqlx.DB
+}
+
+// News views storage constructor
+func NewViewsStorage(psql *sqlx.DB) *ViewsStorage {
+	return &ViewsStorage{psql: psql}
+}
+
+// Add views to news totals in one statement
+func (s *ViewsStorage) AddViews(ctx context.Context, views map[uuid.UUID]int64) error {
+	if len(views) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(views))
+	counts := make([]string, 0, len(views))
+	for newsID, count := range views {
+		ids = append(ids, newsID.String())
+		counts = append(counts, strconv.FormatInt(count, 10))
+	}
+
+	if _, err := s.psql.ExecContext(ctx, addNewsViews, arrayLiteral(ids), arrayLiteral(counts)); err != nil {
+		return errors.Wrap(err, "ViewsStoragePsql.AddViews.ExecContext")
+	}
+	return nil
+}
+
+// Get published news by ids with their total views, in no particular order
+func (s *ViewsStorage) GetTrendingNews(ctx context.Context, newsIDs []uuid.UUID) ([]*entity.TrendingNews, error) {
+	news := []*entity.TrendingNews{}
+	if len(newsIDs) == 0 {
+		return news, nil
+	}
+
+	ids := make([]string, 0, len(newsIDs))
+	for _, newsID := range newsIDs {
+		ids = append(ids, newsID.String())
+	}
+
+	if err := s.psql.SelectContext(ctx, &news, getTrendingNews, arrayLiteral(ids)); err != nil {
+		return nil, errors.Wrap(err, "ViewsStoragePsql.GetTrendingNews.SelectContext")
+	}
+	return news, nil
+}
+
+// Postgres array literal of values which need no quoting
+func arrayLiteral(values []string) string {
+	return "{" + strings.Join(values, ",") + "}"
+}
@@ -0,0 +1,12 @@
+package psql
+
+const (
+	addNewsViews = `UPDATE news n
+				SET views = n.views + v.views
+				FROM unnest($1::uuid[], $2::bigint[]) AS v(news_id, views)
+				WHERE n.news_id = v.news_id`
+
+	getTrendingNews = `SELECT news_id, author_id, title, slug, content, content_html, image_url, category, category_id, language, status, publish_at, views, updated_at, created_at
+			FROM news
+			WHERE news_id = ANY($1::uuid[]) AND status = 'published'`
+)
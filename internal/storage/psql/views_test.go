@@ -0,0 +1,72 @@
+package psql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPsql_AddViews(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	viewsStorage := NewViewsStorage(sqlxDB)
+
+	t.Run("AddViews", func(t *testing.T) {
+		newsID := uuid.New()
+
+		mock.ExpectExec(addNewsViews).
+			WithArgs("{"+newsID.String()+"}", "{42}").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := viewsStorage.AddViews(context.Background(), map[uuid.UUID]int64{newsID: 42})
+		require.NoError(t, err)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		err := viewsStorage.AddViews(context.Background(), nil)
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPsql_GetTrendingNews(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	viewsStorage := NewViewsStorage(sqlxDB)
+
+	t.Run("GetTrendingNews", func(t *testing.T) {
+		firstID, secondID := uuid.New(), uuid.New()
+
+		rows := sqlmock.NewRows([]string{"news_id", "title", "status", "publish_at", "views"}).
+			AddRow(secondID, "second", "published", time.Now(), 7)
+
+		mock.ExpectQuery(getTrendingNews).
+			WithArgs("{" + firstID.String() + "," + secondID.String() + "}").
+			WillReturnRows(rows)
+
+		news, err := viewsStorage.GetTrendingNews(context.Background(), []uuid.UUID{firstID, secondID})
+		require.NoError(t, err)
+		require.Len(t, news, 1)
+		require.Equal(t, secondID, news[0].NewsID)
+		require.Equal(t, int64(7), news[0].Views)
+	})
+}
@@ -0,0 +1,185 @@
+package psql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// WordPress import storage, imported entities are recorded by their
+// source ids so re-runs skip them
+type WordPressStorage struct {
+	psql *sqlx.DB
+}
+
+// WordPress import storage constructor
+func NewWordPressStorage(psql *sqlx.DB) *WordPressStorage {
+	return &WordPressStorage{psql: psql}
+}
+
+// Ids of entities imported from source by source id
+func (s *WordPressStorage) GetImported(ctx context.Context, source string, kind string) (map[string]uuid.UUID, error) {
+	var sources []*entity.ImportSource
+	if err := s.psql.SelectContext(ctx, &sources, getImportSources, source, kind); err != nil {
+		return nil, errors.Wrap(err, "WordPressStoragePsql.GetImported.SelectContext")
+	}
+
+	imported := make(map[string]uuid.UUID, len(sources))
+	for _, source := range sources {
+		imported[source.SourceID] = source.TargetID
+	}
+	return imported, nil
+}
+
+// Current slugs of news imported from source by source id
+func (s *WordPressStorage) GetImportedSlugs(ctx context.Context, source string) (map[string]string, error) {
+	rows, err := s.psql.QueryxContext(ctx, getImportedNewsSlugs, source)
+	if err != nil {
+		return nil, errors.Wrap(err, "WordPressStoragePsql.GetImportedSlugs.QueryxContext")
+	}
+	defer rows.Close()
+
+	slugs := make(map[string]string)
+	for rows.Next() {
+		var sourceID, slug string
+		if err := rows.Scan(&sourceID, &slug); err != nil {
+			return nil, errors.Wrap(err, "WordPressStoragePsql.GetImportedSlugs.Scan")
+		}
+		slugs[sourceID] = slug
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "WordPressStoragePsql.GetImportedSlugs.Err")
+	}
+	return slugs, nil
+}
+
+// Create placeholder user, user with the same email is taken instead.
+// Reports whether the user was created.
+func (s *WordPressStorage) ImportUser(ctx context.Context, source string, sourceID string, user *entity.User) (bool, error) {
+	tx, err := s.psql.BeginTxx(ctx, nil)
+	if err != nil {
+		return false, errors.Wrap(err, "WordPressStoragePsql.ImportUser.BeginTxx")
+	}
+	defer tx.Rollback()
+
+	created := false
+	if err := tx.GetContext(ctx, &user.ID, findImportUser, user.Email); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return false, errors.Wrap(err, "WordPressStoragePsql.ImportUser.findImportUser")
+		}
+		if err := tx.GetContext(ctx, &user.ID, createImportUser, user.FirstName, user.LastName, user.Email, user.Password); err != nil {
+			return false, errors.Wrap(err, "WordPressStoragePsql.ImportUser.createImportUser")
+		}
+		created = true
+	}
+
+	if _, err := tx.ExecContext(ctx, createImportSource, source, entity.ImportKindUser, sourceID, user.ID); err != nil {
+		return false, errors.Wrap(err, "WordPressStoragePsql.ImportUser.createImportSource")
+	}
+	if err := tx.Commit(); err != nil {
+		return false, errors.Wrap(err, "WordPressStoragePsql.ImportUser.Commit")
+	}
+	return created, nil
+}
+
+// Create category, category with the same slug is taken instead.
+// Reports whether the category was created.
+func (s *WordPressStorage) ImportCategory(ctx context.Context, source string, sourceID string, category *entity.Category) (bool, error) {
+	tx, err := s.psql.BeginTxx(ctx, nil)
+	if err != nil {
+		return false, errors.Wrap(err, "WordPressStoragePsql.ImportCategory.BeginTxx")
+	}
+	defer tx.Rollback()
+
+	created := false
+	if err := tx.GetContext(ctx, category, getImportCategory, category.Slug); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return false, errors.Wrap(err, "WordPressStoragePsql.ImportCategory.getImportCategory")
+		}
+		if err := tx.GetContext(ctx, category, createImportCategory,
+			category.ParentID, category.Name, category.Slug, category.Description,
+		); err != nil {
+			return false, errors.Wrap(err, "WordPressStoragePsql.ImportCategory.createImportCategory")
+		}
+		created = true
+	}
+
+	if _, err := tx.ExecContext(ctx, createImportSource, source, entity.ImportKindCategory, sourceID, category.CategoryID); err != nil {
+		return false, errors.Wrap(err, "WordPressStoragePsql.ImportCategory.createImportSource")
+	}
+	if err := tx.Commit(); err != nil {
+		return false, errors.Wrap(err, "WordPressStoragePsql.ImportCategory.Commit")
+	}
+	return created, nil
+}
+
+// Create news like bulk import does, id and slug of news are set
+func (s *WordPressStorage) ImportNews(ctx context.Context, source string, sourceID string, news *entity.News) error {
+	tx, err := s.psql.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "WordPressStoragePsql.ImportNews.BeginTxx")
+	}
+	defer tx.Rollback()
+
+	if err := importOne(ctx, tx, news); err != nil {
+		return errors.Wrap(err, "WordPressStoragePsql.ImportNews")
+	}
+	if _, err := tx.ExecContext(ctx, createImportSource, source, entity.ImportKindNews, sourceID, news.NewsID); err != nil {
+		return errors.Wrap(err, "WordPressStoragePsql.ImportNews.createImportSource")
+	}
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "WordPressStoragePsql.ImportNews.Commit")
+	}
+	return nil
+}
+
+// Replace content of imported news, the import revision is kept in line
+func (s *WordPressStorage) UpdateImportedContent(ctx context.Context, newsID uuid.UUID, content string, contentHTML string) error {
+	tx, err := s.psql.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "WordPressStoragePsql.UpdateImportedContent.BeginTxx")
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, updateImportedContent, newsID, content, contentHTML); err != nil {
+		return errors.Wrap(err, "WordPressStoragePsql.UpdateImportedContent.updateImportedContent")
+	}
+	if _, err := tx.ExecContext(ctx, updateImportRevision, newsID, content); err != nil {
+		return errors.Wrap(err, "WordPressStoragePsql.UpdateImportedContent.updateImportRevision")
+	}
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "WordPressStoragePsql.UpdateImportedContent.Commit")
+	}
+	return nil
+}
+
+// Create comment with its date, id of comment is set
+func (s *WordPressStorage) ImportComment(ctx context.Context, source string, sourceID string, comment *entity.Comment) error {
+	tx, err := s.psql.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "WordPressStoragePsql.ImportComment.BeginTxx")
+	}
+	defer tx.Rollback()
+
+	var createdAt *time.Time
+	if !comment.CreatedAt.IsZero() {
+		createdAt = &comment.CreatedAt
+	}
+	if err := tx.GetContext(ctx, &comment.CommentID, importComment,
+		comment.AuthorID, comment.NewsID, comment.ParentID, comment.Message, comment.MessageHTML, createdAt,
+	); err != nil {
+		return errors.Wrap(err, "WordPressStoragePsql.ImportComment.importComment")
+	}
+	if _, err := tx.ExecContext(ctx, createImportSource, source, entity.ImportKindComment, sourceID, comment.CommentID); err != nil {
+		return errors.Wrap(err, "WordPressStoragePsql.ImportComment.createImportSource")
+	}
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "WordPressStoragePsql.ImportComment.Commit")
+	}
+	return nil
+}
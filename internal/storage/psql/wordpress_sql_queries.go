@@ -0,0 +1,32 @@
+package psql
+
+const (
+	getImportSources = `SELECT source, kind, source_id, target_id FROM import_sources WHERE source = $1 AND kind = $2`
+
+	getImportedNewsSlugs = `SELECT s.source_id, n.slug
+				FROM import_sources s
+					JOIN news n on n.news_id = s.target_id
+				WHERE s.source = $1 AND s.kind = 'news'`
+
+	createImportSource = `INSERT INTO import_sources (source, kind, source_id, target_id)
+				VALUES ($1, $2, $3, $4)
+				ON CONFLICT DO NOTHING`
+
+	findImportUser = `SELECT user_id FROM users WHERE lower(email) = lower($1) ORDER BY created_at LIMIT 1`
+
+	createImportUser = `INSERT INTO users (first_name, last_name, email, password, role, created_at, updated_at)
+				VALUES ($1, $2, $3, $4, 'user', now(), now())
+				RETURNING user_id`
+
+	createImportCategory = `INSERT INTO categories (parent_id, name, slug, description)
+				VALUES ($1, $2, $3, NULLIF($4, ''))
+				RETURNING category_id, name`
+
+	importComment = `INSERT INTO comments (author_id, news_id, parent_id, message, message_html, created_at, updated_at)
+				VALUES ($1, $2, $3, $4, $5, COALESCE($6, now()), COALESCE($6, now()))
+				RETURNING comment_id`
+
+	updateImportedContent = `UPDATE news SET content = $2, content_html = $3, version = version + 1 WHERE news_id = $1`
+
+	updateImportRevision = `UPDATE news_revisions SET content = $2 WHERE news_id = $1 AND revision = 1`
+)
@@ -0,0 +1,97 @@
+package psql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPsql_ImportWordPressUser(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	wordPressStorage := NewWordPressStorage(sqlxDB)
+	source := "wordpress:example.com"
+
+	t.Run("Placeholder", func(t *testing.T) {
+		userID := uuid.New()
+		user := &entity.User{FirstName: "Jane", LastName: "Doe", Email: "jane@example.com", Password: "!secret"}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(findImportUser).WithArgs(user.Email).WillReturnRows(sqlmock.NewRows([]string{"user_id"}))
+		mock.ExpectQuery(createImportUser).WithArgs("Jane", "Doe", user.Email, "!secret").WillReturnRows(
+			sqlmock.NewRows([]string{"user_id"}).AddRow(userID),
+		)
+		mock.ExpectExec(createImportSource).WithArgs(source, entity.ImportKindUser, "login:jane", userID).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		created, err := wordPressStorage.ImportUser(context.Background(), source, "login:jane", user)
+		require.NoError(t, err)
+		require.True(t, created)
+		require.Equal(t, userID, user.ID)
+	})
+
+	t.Run("Existing user", func(t *testing.T) {
+		userID := uuid.New()
+		user := &entity.User{FirstName: "Bob", LastName: "Doe", Email: "bob@example.com", Password: "!secret"}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(findImportUser).WithArgs(user.Email).WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(userID))
+		mock.ExpectExec(createImportSource).WithArgs(source, entity.ImportKindUser, "email:bob@example.com", userID).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		created, err := wordPressStorage.ImportUser(context.Background(), source, "email:bob@example.com", user)
+		require.NoError(t, err)
+		require.False(t, created)
+		require.Equal(t, userID, user.ID)
+	})
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPsql_ImportWordPressComment(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	wordPressStorage := NewWordPressStorage(sqlxDB)
+	source := "wordpress:example.com"
+
+	parentID, commentID := uuid.New(), uuid.New()
+	comment := &entity.Comment{
+		AuthorID:    uuid.New(),
+		NewsID:      uuid.New(),
+		ParentID:    &parentID,
+		Message:     "Thanks",
+		MessageHTML: "<p>Thanks</p>",
+		CreatedAt:   time.Date(2020, 5, 2, 10, 0, 0, 0, time.UTC),
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(importComment).WithArgs(
+		comment.AuthorID, comment.NewsID, comment.ParentID, comment.Message, comment.MessageHTML, &comment.CreatedAt,
+	).WillReturnRows(sqlmock.NewRows([]string{"comment_id"}).AddRow(commentID))
+	mock.ExpectExec(createImportSource).WithArgs(source, entity.ImportKindComment, "101", commentID).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err = wordPressStorage.ImportComment(context.Background(), source, "101", comment)
+	require.NoError(t, err)
+	require.Equal(t, commentID, comment.CommentID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,83 @@
+package redisrepo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/go-redis/redis/v9"
+	"github.com/pkg/errors"
+)
+
+const feedsPrefix = "api-feeds:"
+
+// Feeds storage, cached feeds are keyed by version so bumping
+// the version invalidates all of them at once
+type FeedsStorage struct {
+	redis *redis.Client
+}
+
+// Feeds storage constructor
+func NewFeedsStorage(redis *redis.Client) *FeedsStorage {
+	return &FeedsStorage{redis: redis}
+}
+
+// Get cached feed, nil if not cached
+func (f *FeedsStorage) GetFeedCtx(ctx context.Context, version int64, key string) (*entity.FeedDocument, error) {
+	feedBytes, err := f.redis.Get(ctx, f.feedKey(version, key)).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "FeedsStorageRedis.GetFeedCtx.Get")
+	}
+
+	feed := &entity.FeedDocument{}
+	if err := json.Unmarshal(feedBytes, feed); err != nil {
+		return nil, errors.Wrap(err, "FeedsStorageRedis.GetFeedCtx.Unmarshal")
+	}
+	return feed, nil
+}
+
+// Cache feed
+func (f *FeedsStorage) SetFeedCtx(ctx context.Context, version int64, key string, seconds int, feed *entity.FeedDocument) error {
+	feedBytes, err := json.Marshal(feed)
+	if err != nil {
+		return errors.Wrap(err, "FeedsStorageRedis.SetFeedCtx.Marshal")
+	}
+
+	if err := f.redis.Set(ctx, f.feedKey(version, key), feedBytes, time.Second*time.Duration(seconds)).Err(); err != nil {
+		return errors.Wrap(err, "FeedsStorageRedis.SetFeedCtx.Set")
+	}
+	return nil
+}
+
+// Get current version of feeds
+func (f *FeedsStorage) GetFeedsVersionCtx(ctx context.Context) (int64, error) {
+	version, err := f.redis.Get(ctx, f.versionKey()).Int64()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, errors.Wrap(err, "FeedsStorageRedis.GetFeedsVersionCtx.Get")
+	}
+	return version, nil
+}
+
+// Invalidate all cached feeds, old ones expire by ttl
+func (f *FeedsStorage) InvalidateFeedsCtx(ctx context.Context) error {
+	if err := f.redis.Incr(ctx, f.versionKey()).Err(); err != nil {
+		return errors.Wrap(err, "FeedsStorageRedis.InvalidateFeedsCtx.Incr")
+	}
+	return nil
+}
+
+func (f *FeedsStorage) versionKey() string {
+	return feedsPrefix + "version"
+}
+
+func (f *FeedsStorage) feedKey(version int64, key string) string {
+	return fmt.Sprintf("%s%d:%s", feedsPrefix, version, key)
+}
@@ -0,0 +1,61 @@
+package redisrepo
+
+import (
+	"context"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func SetupFeedsRedis() *FeedsStorage {
+	mr, err := miniredis.Run()
+	if err != nil {
+		log.Fatal(err)
+	}
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+
+	return NewFeedsStorage(client)
+}
+
+func TestRedis_FeedsCache(t *testing.T) {
+	t.Parallel()
+
+	feedsRedisStorage := SetupFeedsRedis()
+	ctx := context.Background()
+
+	version, err := feedsRedisStorage.GetFeedsVersionCtx(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), version)
+
+	cached, err := feedsRedisStorage.GetFeedCtx(ctx, version, "all::rss")
+	require.NoError(t, err)
+	require.Nil(t, cached)
+
+	feed := &entity.FeedDocument{
+		Body:         []byte("<rss></rss>"),
+		ContentType:  "application/rss+xml",
+		ETag:         `"etag"`,
+		LastModified: time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, feedsRedisStorage.SetFeedCtx(ctx, version, "all::rss", 60, feed))
+
+	cached, err = feedsRedisStorage.GetFeedCtx(ctx, version, "all::rss")
+	require.NoError(t, err)
+	require.Equal(t, feed, cached)
+
+	require.NoError(t, feedsRedisStorage.InvalidateFeedsCtx(ctx))
+	version, err = feedsRedisStorage.GetFeedsVersionCtx(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), version)
+
+	cached, err = feedsRedisStorage.GetFeedCtx(ctx, version, "all::rss")
+	require.NoError(t, err)
+	require.Nil(t, cached)
+}
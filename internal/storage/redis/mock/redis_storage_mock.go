@@ -10,6 +10,7 @@ import (
 
 	entity "github.com/Edbeer/restapi/internal/entity"
 	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
 )
 
 // MockNewsRedis is a mock of NewsRedis interface.
@@ -210,3 +211,522 @@ func (mr *MockSessionredisMockRecorder) GetSessionByID(ctx, sessionID interface{
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionByID", reflect.TypeOf((*MockSessionredis)(nil).GetSessionByID), ctx, sessionID)
 }
+
+// MockSuggestRedis is a mock of SuggestRedis interface.
+type MockSuggestRedis struct {
+	ctrl     *gomock.Controller
+	recorder *MockSuggestRedisMockRecorder
+}
+
+// MockSuggestRedisMockRecorder is the mock recorder for MockSuggestRedis.
+type MockSuggestRedisMockRecorder struct {
+	mock *MockSuggestRedis
+}
+
+// NewMockSuggestRedis creates a new mock instance.
+func NewMockSuggestRedis(ctrl *gomock.Controller) *MockSuggestRedis {
+	mock := &MockSuggestRedis{ctrl: ctrl}
+	mock.recorder = &MockSuggestRedisMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSuggestRedis) EXPECT() *MockSuggestRedisMockRecorder {
+	return m.recorder
+}
+
+// AddSuggestionCtx mocks base method.
+func (m *MockSuggestRedis) AddSuggestionCtx(ctx context.Context, kind string, suggestion *entity.Suggestion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSuggestionCtx", ctx, kind, suggestion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSuggestionCtx indicates an expected call of AddSuggestionCtx.
+func (mr *MockSuggestRedisMockRecorder) AddSuggestionCtx(ctx, kind, suggestion interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSuggestionCtx", reflect.TypeOf((*MockSuggestRedis)(nil).AddSuggestionCtx), ctx, kind, suggestion)
+}
+
+// ClearSuggestionsCtx mocks base method.
+func (m *MockSuggestRedis) ClearSuggestionsCtx(ctx context.Context, kind string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearSuggestionsCtx", ctx, kind)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearSuggestionsCtx indicates an expected call of ClearSuggestionsCtx.
+func (mr *MockSuggestRedisMockRecorder) ClearSuggestionsCtx(ctx, kind interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearSuggestionsCtx", reflect.TypeOf((*MockSuggestRedis)(nil).ClearSuggestionsCtx), ctx, kind)
+}
+
+// DeleteSuggestionCtx mocks base method.
+func (m *MockSuggestRedis) DeleteSuggestionCtx(ctx context.Context, kind string, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSuggestionCtx", ctx, kind, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSuggestionCtx indicates an expected call of DeleteSuggestionCtx.
+func (mr *MockSuggestRedisMockRecorder) DeleteSuggestionCtx(ctx, kind, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSuggestionCtx", reflect.TypeOf((*MockSuggestRedis)(nil).DeleteSuggestionCtx), ctx, kind, id)
+}
+
+// GetSuggestionsCtx mocks base method.
+func (m *MockSuggestRedis) GetSuggestionsCtx(ctx context.Context, kind, prefix string, limit int) ([]*entity.Suggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSuggestionsCtx", ctx, kind, prefix, limit)
+	ret0, _ := ret[0].([]*entity.Suggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSuggestionsCtx indicates an expected call of GetSuggestionsCtx.
+func (mr *MockSuggestRedisMockRecorder) GetSuggestionsCtx(ctx, kind, prefix, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSuggestionsCtx", reflect.TypeOf((*MockSuggestRedis)(nil).GetSuggestionsCtx), ctx, kind, prefix, limit)
+}
+
+// IncrSuggestionCtx mocks base method.
+func (m *MockSuggestRedis) IncrSuggestionCtx(ctx context.Context, kind string, id uuid.UUID, incr float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrSuggestionCtx", ctx, kind, id, incr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrSuggestionCtx indicates an expected call of IncrSuggestionCtx.
+func (mr *MockSuggestRedisMockRecorder) IncrSuggestionCtx(ctx, kind, id, incr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrSuggestionCtx", reflect.TypeOf((*MockSuggestRedis)(nil).IncrSuggestionCtx), ctx, kind, id, incr)
+}
+
+// MockFeedsRedis is a mock of FeedsRedis interface.
+type MockFeedsRedis struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeedsRedisMockRecorder
+}
+
+// MockFeedsRedisMockRecorder is the mock recorder for MockFeedsRedis.
+type MockFeedsRedisMockRecorder struct {
+	mock *MockFeedsRedis
+}
+
+// NewMockFeedsRedis creates a new mock instance.
+func NewMockFeedsRedis(ctrl *gomock.Controller) *MockFeedsRedis {
+	mock := &MockFeedsRedis{ctrl: ctrl}
+	mock.recorder = &MockFeedsRedisMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeedsRedis) EXPECT() *MockFeedsRedisMockRecorder {
+	return m.recorder
+}
+
+// GetFeedCtx mocks base method.
+func (m *MockFeedsRedis) GetFeedCtx(ctx context.Context, version int64, key string) (*entity.FeedDocument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeedCtx", ctx, version, key)
+	ret0, _ := ret[0].(*entity.FeedDocument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeedCtx indicates an expected call of GetFeedCtx.
+func (mr *MockFeedsRedisMockRecorder) GetFeedCtx(ctx, version, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeedCtx", reflect.TypeOf((*MockFeedsRedis)(nil).GetFeedCtx), ctx, version, key)
+}
+
+// GetFeedsVersionCtx mocks base method.
+func (m *MockFeedsRedis) GetFeedsVersionCtx(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeedsVersionCtx", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeedsVersionCtx indicates an expected call of GetFeedsVersionCtx.
+func (mr *MockFeedsRedisMockRecorder) GetFeedsVersionCtx(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeedsVersionCtx", reflect.TypeOf((*MockFeedsRedis)(nil).GetFeedsVersionCtx), ctx)
+}
+
+// InvalidateFeedsCtx mocks base method.
+func (m *MockFeedsRedis) InvalidateFeedsCtx(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvalidateFeedsCtx", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InvalidateFeedsCtx indicates an expected call of InvalidateFeedsCtx.
+func (mr *MockFeedsRedisMockRecorder) InvalidateFeedsCtx(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateFeedsCtx", reflect.TypeOf((*MockFeedsRedis)(nil).InvalidateFeedsCtx), ctx)
+}
+
+// SetFeedCtx mocks base method.
+func (m *MockFeedsRedis) SetFeedCtx(ctx context.Context, version int64, key string, seconds int, feed *entity.FeedDocument) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFeedCtx", ctx, version, key, seconds, feed)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFeedCtx indicates an expected call of SetFeedCtx.
+func (mr *MockFeedsRedisMockRecorder) SetFeedCtx(ctx, version, key, seconds, feed interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFeedCtx", reflect.TypeOf((*MockFeedsRedis)(nil).SetFeedCtx), ctx, version, key, seconds, feed)
+}
+
+// MockSitemapsRedis is a mock of SitemapsRedis interface.
+type MockSitemapsRedis struct {
+	ctrl     *gomock.Controller
+	recorder *MockSitemapsRedisMockRecorder
+}
+
+// MockSitemapsRedisMockRecorder is the mock recorder for MockSitemapsRedis.
+type MockSitemapsRedisMockRecorder struct {
+	mock *MockSitemapsRedis
+}
+
+// NewMockSitemapsRedis creates a new mock instance.
+func NewMockSitemapsRedis(ctrl *gomock.Controller) *MockSitemapsRedis {
+	mock := &MockSitemapsRedis{ctrl: ctrl}
+	mock.recorder = &MockSitemapsRedisMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSitemapsRedis) EXPECT() *MockSitemapsRedisMockRecorder {
+	return m.recorder
+}
+
+// GetIndexCtx mocks base method.
+func (m *MockSitemapsRedis) GetIndexCtx(ctx context.Context) (*entity.SitemapDocument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIndexCtx", ctx)
+	ret0, _ := ret[0].(*entity.SitemapDocument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIndexCtx indicates an expected call of GetIndexCtx.
+func (mr *MockSitemapsRedisMockRecorder) GetIndexCtx(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIndexCtx", reflect.TypeOf((*MockSitemapsRedis)(nil).GetIndexCtx), ctx)
+}
+
+// GetLatestCtx mocks base method.
+func (m *MockSitemapsRedis) GetLatestCtx(ctx context.Context) (*entity.SitemapDocument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestCtx", ctx)
+	ret0, _ := ret[0].(*entity.SitemapDocument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestCtx indicates an expected call of GetLatestCtx.
+func (mr *MockSitemapsRedisMockRecorder) GetLatestCtx(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestCtx", reflect.TypeOf((*MockSitemapsRedis)(nil).GetLatestCtx), ctx)
+}
+
+// GetPageCtx mocks base method.
+func (m *MockSitemapsRedis) GetPageCtx(ctx context.Context, page int) (*entity.SitemapDocument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPageCtx", ctx, page)
+	ret0, _ := ret[0].(*entity.SitemapDocument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPageCtx indicates an expected call of GetPageCtx.
+func (mr *MockSitemapsRedisMockRecorder) GetPageCtx(ctx, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPageCtx", reflect.TypeOf((*MockSitemapsRedis)(nil).GetPageCtx), ctx, page)
+}
+
+// InvalidateFromPageCtx mocks base method.
+func (m *MockSitemapsRedis) InvalidateFromPageCtx(ctx context.Context, page int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvalidateFromPageCtx", ctx, page)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InvalidateFromPageCtx indicates an expected call of InvalidateFromPageCtx.
+func (mr *MockSitemapsRedisMockRecorder) InvalidateFromPageCtx(ctx, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateFromPageCtx", reflect.TypeOf((*MockSitemapsRedis)(nil).InvalidateFromPageCtx), ctx, page)
+}
+
+// SetIndexCtx mocks base method.
+func (m *MockSitemapsRedis) SetIndexCtx(ctx context.Context, seconds int, sitemap *entity.SitemapDocument) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetIndexCtx", ctx, seconds, sitemap)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetIndexCtx indicates an expected call of SetIndexCtx.
+func (mr *MockSitemapsRedisMockRecorder) SetIndexCtx(ctx, seconds, sitemap interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIndexCtx", reflect.TypeOf((*MockSitemapsRedis)(nil).SetIndexCtx), ctx, seconds, sitemap)
+}
+
+// SetLatestCtx mocks base method.
+func (m *MockSitemapsRedis) SetLatestCtx(ctx context.Context, seconds int, sitemap *entity.SitemapDocument) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLatestCtx", ctx, seconds, sitemap)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLatestCtx indicates an expected call of SetLatestCtx.
+func (mr *MockSitemapsRedisMockRecorder) SetLatestCtx(ctx, seconds, sitemap interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLatestCtx", reflect.TypeOf((*MockSitemapsRedis)(nil).SetLatestCtx), ctx, seconds, sitemap)
+}
+
+// SetPageCtx mocks base method.
+func (m *MockSitemapsRedis) SetPageCtx(ctx context.Context, page, seconds int, sitemap *entity.SitemapDocument) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPageCtx", ctx, page, seconds, sitemap)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPageCtx indicates an expected call of SetPageCtx.
+func (mr *MockSitemapsRedisMockRecorder) SetPageCtx(ctx, page, seconds, sitemap interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPageCtx", reflect.TypeOf((*MockSitemapsRedis)(nil).SetPageCtx), ctx, page, seconds, sitemap)
+}
+
+// MockPagesRedis is a mock of PagesRedis interface.
+type MockPagesRedis struct {
+	ctrl     *gomock.Controller
+	recorder *MockPagesRedisMockRecorder
+}
+
+// MockPagesRedisMockRecorder is the mock recorder for MockPagesRedis.
+type MockPagesRedisMockRecorder struct {
+	mock *MockPagesRedis
+}
+
+// NewMockPagesRedis creates a new mock instance.
+func NewMockPagesRedis(ctrl *gomock.Controller) *MockPagesRedis {
+	mock := &MockPagesRedis{ctrl: ctrl}
+	mock.recorder = &MockPagesRedisMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPagesRedis) EXPECT() *MockPagesRedisMockRecorder {
+	return m.recorder
+}
+
+// GetPageCtx mocks base method.
+func (m *MockPagesRedis) GetPageCtx(ctx context.Context, key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPageCtx", ctx, key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPageCtx indicates an expected call of GetPageCtx.
+func (mr *MockPagesRedisMockRecorder) GetPageCtx(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPageCtx", reflect.TypeOf((*MockPagesRedis)(nil).GetPageCtx), ctx, key)
+}
+
+// SetPageCtx mocks base method.
+func (m *MockPagesRedis) SetPageCtx(ctx context.Context, key string, seconds int, page []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPageCtx", ctx, key, seconds, page)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPageCtx indicates an expected call of SetPageCtx.
+func (mr *MockPagesRedisMockRecorder) SetPageCtx(ctx, key, seconds, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPageCtx", reflect.TypeOf((*MockPagesRedis)(nil).SetPageCtx), ctx, key, seconds, page)
+}
+
+// MockViewsRedis is a mock of ViewsRedis interface.
+type MockViewsRedis struct {
+	ctrl     *gomock.Controller
+	recorder *MockViewsRedisMockRecorder
+}
+
+// MockViewsRedisMockRecorder is the mock recorder for MockViewsRedis.
+type MockViewsRedisMockRecorder struct {
+	mock *MockViewsRedis
+}
+
+// NewMockViewsRedis creates a new mock instance.
+func NewMockViewsRedis(ctrl *gomock.Controller) *MockViewsRedis {
+	mock := &MockViewsRedis{ctrl: ctrl}
+	mock.recorder = &MockViewsRedisMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockViewsRedis) EXPECT() *MockViewsRedisMockRecorder {
+	return m.recorder
+}
+
+// AckViewsCtx mocks base method.
+func (m *MockViewsRedis) AckViewsCtx(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AckViewsCtx", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AckViewsCtx indicates an expected call of AckViewsCtx.
+func (mr *MockViewsRedisMockRecorder) AckViewsCtx(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AckViewsCtx", reflect.TypeOf((*MockViewsRedis)(nil).AckViewsCtx), ctx)
+}
+
+// RankViewsCtx mocks base method.
+func (m *MockViewsRedis) RankViewsCtx(ctx context.Context, key string, weights map[int64]float64, seconds, limit int) ([]*entity.NewsScore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RankViewsCtx", ctx, key, weights, seconds, limit)
+	ret0, _ := ret[0].([]*entity.NewsScore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RankViewsCtx indicates an expected call of RankViewsCtx.
+func (mr *MockViewsRedisMockRecorder) RankViewsCtx(ctx, key, weights, seconds, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RankViewsCtx", reflect.TypeOf((*MockViewsRedis)(nil).RankViewsCtx), ctx, key, weights, seconds, limit)
+}
+
+// RecordViewCtx mocks base method.
+func (m *MockViewsRedis) RecordViewCtx(ctx context.Context, newsID uuid.UUID, visitor string, bucket int64, seconds int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordViewCtx", ctx, newsID, visitor, bucket, seconds)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordViewCtx indicates an expected call of RecordViewCtx.
+func (mr *MockViewsRedisMockRecorder) RecordViewCtx(ctx, newsID, visitor, bucket, seconds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordViewCtx", reflect.TypeOf((*MockViewsRedis)(nil).RecordViewCtx), ctx, newsID, visitor, bucket, seconds)
+}
+
+// TakeViewsCtx mocks base method.
+func (m *MockViewsRedis) TakeViewsCtx(ctx context.Context, seconds int) (map[uuid.UUID]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TakeViewsCtx", ctx, seconds)
+	ret0, _ := ret[0].(map[uuid.UUID]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TakeViewsCtx indicates an expected call of TakeViewsCtx.
+func (mr *MockViewsRedisMockRecorder) TakeViewsCtx(ctx, seconds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakeViewsCtx", reflect.TypeOf((*MockViewsRedis)(nil).TakeViewsCtx), ctx, seconds)
+}
+
+// MockRelatedRedis is a mock of RelatedRedis interface.
+type MockRelatedRedis struct {
+	ctrl     *gomock.Controller
+	recorder *MockRelatedRedisMockRecorder
+}
+
+// MockRelatedRedisMockRecorder is the mock recorder for MockRelatedRedis.
+type MockRelatedRedisMockRecorder struct {
+	mock *MockRelatedRedis
+}
+
+// NewMockRelatedRedis creates a new mock instance.
+func NewMockRelatedRedis(ctrl *gomock.Controller) *MockRelatedRedis {
+	mock := &MockRelatedRedis{ctrl: ctrl}
+	mock.recorder = &MockRelatedRedisMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRelatedRedis) EXPECT() *MockRelatedRedisMockRecorder {
+	return m.recorder
+}
+
+// GetRelatedCtx mocks base method.
+func (m *MockRelatedRedis) GetRelatedCtx(ctx context.Context, newsID uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelatedCtx", ctx, newsID)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRelatedCtx indicates an expected call of GetRelatedCtx.
+func (mr *MockRelatedRedisMockRecorder) GetRelatedCtx(ctx, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelatedCtx", reflect.TypeOf((*MockRelatedRedis)(nil).GetRelatedCtx), ctx, newsID)
+}
+
+// MarkStaleCtx mocks base method.
+func (m *MockRelatedRedis) MarkStaleCtx(ctx context.Context, newsIDs ...uuid.UUID) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range newsIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MarkStaleCtx", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkStaleCtx indicates an expected call of MarkStaleCtx.
+func (mr *MockRelatedRedisMockRecorder) MarkStaleCtx(ctx interface{}, newsIDs ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, newsIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkStaleCtx", reflect.TypeOf((*MockRelatedRedis)(nil).MarkStaleCtx), varargs...)
+}
+
+// SetRelatedCtx mocks base method.
+func (m *MockRelatedRedis) SetRelatedCtx(ctx context.Context, newsID uuid.UUID, seconds int, related []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRelatedCtx", ctx, newsID, seconds, related)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRelatedCtx indicates an expected call of SetRelatedCtx.
+func (mr *MockRelatedRedisMockRecorder) SetRelatedCtx(ctx, newsID, seconds, related interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRelatedCtx", reflect.TypeOf((*MockRelatedRedis)(nil).SetRelatedCtx), ctx, newsID, seconds, related)
+}
+
+// TakeStaleCtx mocks base method.
+func (m *MockRelatedRedis) TakeStaleCtx(ctx context.Context, limit int) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TakeStaleCtx", ctx, limit)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TakeStaleCtx indicates an expected call of TakeStaleCtx.
+func (mr *MockRelatedRedisMockRecorder) TakeStaleCtx(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakeStaleCtx", reflect.TypeOf((*MockRelatedRedis)(nil).TakeStaleCtx), ctx, limit)
+}
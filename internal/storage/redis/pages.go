@@ -0,0 +1,41 @@
+package redisrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v9"
+	"github.com/pkg/errors"
+)
+
+const pagesPrefix = "api-pages:"
+
+// Rendered pages storage
+type PagesStorage struct {
+	redis *redis.Client
+}
+
+// Rendered pages storage constructor
+func NewPagesStorage(redis *redis.Client) *PagesStorage {
+	return &PagesStorage{redis: redis}
+}
+
+// Get cached page, nil if not cached
+func (p *PagesStorage) GetPageCtx(ctx context.Context, key string) ([]byte, error) {
+	page, err := p.redis.Get(ctx, pagesPrefix+key).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "PagesStorageRedis.GetPageCtx.Get")
+	}
+	return page, nil
+}
+
+// Cache page
+func (p *PagesStorage) SetPageCtx(ctx context.Context, key string, seconds int, page []byte) error {
+	if err := p.redis.Set(ctx, pagesPrefix+key, page, time.Second*time.Duration(seconds)).Err(); err != nil {
+		return errors.Wrap(err, "PagesStorageRedis.SetPageCtx.Set")
+	}
+	return nil
+}
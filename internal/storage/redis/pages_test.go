@@ -0,0 +1,40 @@
+package redisrepo
+
+import (
+	"context"
+	"log"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func SetupPagesRedis() *PagesStorage {
+	mr, err := miniredis.Run()
+	if err != nil {
+		log.Fatal(err)
+	}
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+
+	return NewPagesStorage(client)
+}
+
+func TestRedis_PagesCache(t *testing.T) {
+	t.Parallel()
+
+	pagesRedisStorage := SetupPagesRedis()
+	ctx := context.Background()
+
+	page, err := pagesRedisStorage.GetPageCtx(ctx, "article:rain")
+	require.NoError(t, err)
+	require.Nil(t, page)
+
+	require.NoError(t, pagesRedisStorage.SetPageCtx(ctx, "article:rain", 60, []byte("<html></html>")))
+
+	page, err = pagesRedisStorage.GetPageCtx(ctx, "article:rain")
+	require.NoError(t, err)
+	require.Equal(t, "<html></html>", string(page))
+}
@@ -0,0 +1,94 @@
+package redisrepo
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-redis/redis/v9"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	relatedPrefix   = "api-related:"
+	relatedStaleKey = relatedPrefix + "stale"
+)
+
+// Related news storage, neighbors of news are precomputed
+// and news waiting for recomputation are kept in a set
+type RelatedStorage struct {
+	redis *redis.Client
+}
+
+// Related news storage constructor
+func NewRelatedStorage(redis *redis.Client) *RelatedStorage {
+	return &RelatedStorage{redis: redis}
+}
+
+// Get related news ids, most related first. Nil if not computed.
+func (r *RelatedStorage) GetRelatedCtx(ctx context.Context, newsID uuid.UUID) ([]uuid.UUID, error) {
+	bytes, err := r.redis.Get(ctx, relatedPrefix+newsID.String()).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "RelatedStorageRedis.GetRelatedCtx.Get")
+	}
+
+	related := []uuid.UUID{}
+	if err := json.Unmarshal(bytes, &related); err != nil {
+		return nil, errors.Wrap(err, "RelatedStorageRedis.GetRelatedCtx.Unmarshal")
+	}
+	return related, nil
+}
+
+// Set related news ids of news for seconds
+func (r *RelatedStorage) SetRelatedCtx(ctx context.Context, newsID uuid.UUID, seconds int, related []uuid.UUID) error {
+	if related == nil {
+		related = []uuid.UUID{}
+	}
+	bytes, err := json.Marshal(related)
+	if err != nil {
+		return errors.Wrap(err, "RelatedStorageRedis.SetRelatedCtx.Marshal")
+	}
+
+	if err := r.redis.Set(ctx, relatedPrefix+newsID.String(), bytes, time.Second*time.Duration(seconds)).Err(); err != nil {
+		return errors.Wrap(err, "RelatedStorageRedis.SetRelatedCtx.Set")
+	}
+	return nil
+}
+
+// Queue news for recomputation of related news
+func (r *RelatedStorage) MarkStaleCtx(ctx context.Context, newsIDs ...uuid.UUID) error {
+	if len(newsIDs) == 0 {
+		return nil
+	}
+
+	members := make([]interface{}, 0, len(newsIDs))
+	for _, newsID := range newsIDs {
+		members = append(members, newsID.String())
+	}
+	if err := r.redis.SAdd(ctx, relatedStaleKey, members...).Err(); err != nil {
+		return errors.Wrap(err, "RelatedStorageRedis.MarkStaleCtx.SAdd")
+	}
+	return nil
+}
+
+// Take up to limit queued news, taken news are removed from the queue
+func (r *RelatedStorage) TakeStaleCtx(ctx context.Context, limit int) ([]uuid.UUID, error) {
+	members, err := r.redis.SPopN(ctx, relatedStaleKey, int64(limit)).Result()
+	if err != nil && err != redis.Nil {
+		return nil, errors.Wrap(err, "RelatedStorageRedis.TakeStaleCtx.SPopN")
+	}
+
+	newsIDs := make([]uuid.UUID, 0, len(members))
+	for _, member := range members {
+		newsID, err := uuid.Parse(member)
+		if err != nil {
+			return nil, errors.Wrap(err, "RelatedStorageRedis.TakeStaleCtx.Parse")
+		}
+		newsIDs = append(newsIDs, newsID)
+	}
+	return newsIDs, nil
+}
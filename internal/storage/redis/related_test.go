@@ -0,0 +1,71 @@
+package redisrepo
+
+import (
+	"context"
+	"log"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v9"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func SetupRelatedRedis() *RelatedStorage {
+	mr, err := miniredis.Run()
+	if err != nil {
+		log.Fatal(err)
+	}
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+
+	return NewRelatedStorage(client)
+}
+
+func TestRedis_Related(t *testing.T) {
+	t.Parallel()
+
+	relatedRedisStorage := SetupRelatedRedis()
+	ctx := context.Background()
+	newsID := uuid.New()
+
+	related, err := relatedRedisStorage.GetRelatedCtx(ctx, newsID)
+	require.NoError(t, err)
+	require.Nil(t, related)
+
+	require.NoError(t, relatedRedisStorage.SetRelatedCtx(ctx, newsID, 60, nil))
+	related, err = relatedRedisStorage.GetRelatedCtx(ctx, newsID)
+	require.NoError(t, err)
+	require.NotNil(t, related)
+	require.Empty(t, related)
+
+	neighbors := []uuid.UUID{uuid.New(), uuid.New()}
+	require.NoError(t, relatedRedisStorage.SetRelatedCtx(ctx, newsID, 60, neighbors))
+	related, err = relatedRedisStorage.GetRelatedCtx(ctx, newsID)
+	require.NoError(t, err)
+	require.Equal(t, neighbors, related)
+}
+
+func TestRedis_RelatedStale(t *testing.T) {
+	t.Parallel()
+
+	relatedRedisStorage := SetupRelatedRedis()
+	ctx := context.Background()
+
+	stale, err := relatedRedisStorage.TakeStaleCtx(ctx, 10)
+	require.NoError(t, err)
+	require.Empty(t, stale)
+
+	first, second := uuid.New(), uuid.New()
+	require.NoError(t, relatedRedisStorage.MarkStaleCtx(ctx, first, second))
+	require.NoError(t, relatedRedisStorage.MarkStaleCtx(ctx, first))
+
+	stale, err = relatedRedisStorage.TakeStaleCtx(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, stale, 1)
+
+	rest, err := relatedRedisStorage.TakeStaleCtx(ctx, 10)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []uuid.UUID{first, second}, append(stale, rest...))
+}
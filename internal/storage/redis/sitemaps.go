@@ -0,0 +1,146 @@
+package redisrepo
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/go-redis/redis/v9"
+	"github.com/pkg/errors"
+)
+
+const (
+	sitemapsKey       = "api-sitemaps:"
+	sitemapsLatestKey = "api-sitemaps-latest:"
+	sitemapIndexField = "index"
+	sitemapPagePrefix = "news:"
+)
+
+// Sitemaps storage, index and pages live in one hash
+// so pages can be dropped one by one. Latest news sitemap
+// is kept under its own key as it expires much sooner
+type SitemapsStorage struct {
+	redis *redis.Client
+}
+
+// Sitemaps storage constructor
+func NewSitemapsStorage(redis *redis.Client) *SitemapsStorage {
+	return &SitemapsStorage{redis: redis}
+}
+
+// Get cached sitemap index
+func (s *SitemapsStorage) GetIndexCtx(ctx context.Context) (*entity.SitemapDocument, error) {
+	return s.get(ctx, sitemapIndexField)
+}
+
+// Cache sitemap index
+func (s *SitemapsStorage) SetIndexCtx(ctx context.Context, seconds int, sitemap *entity.SitemapDocument) error {
+	return s.set(ctx, sitemapIndexField, seconds, sitemap)
+}
+
+// Get cached sitemap page
+func (s *SitemapsStorage) GetPageCtx(ctx context.Context, page int) (*entity.SitemapDocument, error) {
+	return s.get(ctx, sitemapPagePrefix+strconv.Itoa(page))
+}
+
+// Cache sitemap page
+func (s *SitemapsStorage) SetPageCtx(ctx context.Context, page int, seconds int, sitemap *entity.SitemapDocument) error {
+	return s.set(ctx, sitemapPagePrefix+strconv.Itoa(page), seconds, sitemap)
+}
+
+// Get cached news sitemap of latest news
+func (s *SitemapsStorage) GetLatestCtx(ctx context.Context) (*entity.SitemapDocument, error) {
+	sitemapBytes, err := s.redis.Get(ctx, sitemapsLatestKey).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "SitemapsStorageRedis.GetLatestCtx.Get")
+	}
+
+	sitemap := &entity.SitemapDocument{}
+	if err := json.Unmarshal(sitemapBytes, sitemap); err != nil {
+		return nil, errors.Wrap(err, "SitemapsStorageRedis.GetLatestCtx.Unmarshal")
+	}
+	return sitemap, nil
+}
+
+// Cache news sitemap of latest news
+func (s *SitemapsStorage) SetLatestCtx(ctx context.Context, seconds int, sitemap *entity.SitemapDocument) error {
+	sitemapBytes, err := json.Marshal(sitemap)
+	if err != nil {
+		return errors.Wrap(err, "SitemapsStorageRedis.SetLatestCtx.Marshal")
+	}
+
+	if err := s.redis.Set(ctx, sitemapsLatestKey, sitemapBytes, time.Second*time.Duration(seconds)).Err(); err != nil {
+		return errors.Wrap(err, "SitemapsStorageRedis.SetLatestCtx.Set")
+	}
+	return nil
+}
+
+// Drop index, latest news and pages starting from page
+func (s *SitemapsStorage) InvalidateFromPageCtx(ctx context.Context, page int) error {
+	if err := s.redis.Del(ctx, sitemapsLatestKey).Err(); err != nil {
+		return errors.Wrap(err, "SitemapsStorageRedis.InvalidateFromPageCtx.Del")
+	}
+
+	fields, err := s.redis.HKeys(ctx, sitemapsKey).Result()
+	if err != nil {
+		return errors.Wrap(err, "SitemapsStorageRedis.InvalidateFromPageCtx.HKeys")
+	}
+
+	stale := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if !strings.HasPrefix(field, sitemapPagePrefix) {
+			stale = append(stale, field)
+			continue
+		}
+		n, err := strconv.Atoi(strings.TrimPrefix(field, sitemapPagePrefix))
+		if err != nil || n >= page {
+			stale = append(stale, field)
+		}
+	}
+	if len(stale) == 0 {
+		return nil
+	}
+
+	if err := s.redis.HDel(ctx, sitemapsKey, stale...).Err(); err != nil {
+		return errors.Wrap(err, "SitemapsStorageRedis.InvalidateFromPageCtx.HDel")
+	}
+	return nil
+}
+
+func (s *SitemapsStorage) get(ctx context.Context, field string) (*entity.SitemapDocument, error) {
+	sitemapBytes, err := s.redis.HGet(ctx, sitemapsKey, field).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "SitemapsStorageRedis.get.HGet")
+	}
+
+	sitemap := &entity.SitemapDocument{}
+	if err := json.Unmarshal(sitemapBytes, sitemap); err != nil {
+		return nil, errors.Wrap(err, "SitemapsStorageRedis.get.Unmarshal")
+	}
+	return sitemap, nil
+}
+
+// Cached index and pages share ttl of the hash, the ttl only guards against missed invalidations
+func (s *SitemapsStorage) set(ctx context.Context, field string, seconds int, sitemap *entity.SitemapDocument) error {
+	sitemapBytes, err := json.Marshal(sitemap)
+	if err != nil {
+		return errors.Wrap(err, "SitemapsStorageRedis.set.Marshal")
+	}
+
+	pipe := s.redis.TxPipeline()
+	pipe.HSet(ctx, sitemapsKey, field, sitemapBytes)
+	pipe.Expire(ctx, sitemapsKey, time.Second*time.Duration(seconds))
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errors.Wrap(err, "SitemapsStorageRedis.set.Exec")
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package redisrepo
+
+import (
+	"context"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func SetupSitemapsRedis() *SitemapsStorage {
+	storage, _ := setupSitemapsMiniredis()
+	return storage
+}
+
+func setupSitemapsMiniredis() (*SitemapsStorage, *miniredis.Miniredis) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		log.Fatal(err)
+	}
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+
+	return NewSitemapsStorage(client), mr
+}
+
+func TestRedis_SitemapsCache(t *testing.T) {
+	t.Parallel()
+
+	sitemapsRedisStorage := SetupSitemapsRedis()
+	ctx := context.Background()
+
+	cached, err := sitemapsRedisStorage.GetIndexCtx(ctx)
+	require.NoError(t, err)
+	require.Nil(t, cached)
+
+	sitemap := &entity.SitemapDocument{Body: []byte("<urlset/>")}
+	require.NoError(t, sitemapsRedisStorage.SetIndexCtx(ctx, 60, sitemap))
+	require.NoError(t, sitemapsRedisStorage.SetLatestCtx(ctx, 60, sitemap))
+	for page := 0; page < 3; page++ {
+		require.NoError(t, sitemapsRedisStorage.SetPageCtx(ctx, page, 60, sitemap))
+	}
+
+	cached, err = sitemapsRedisStorage.GetPageCtx(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, sitemap.Body, cached.Body)
+
+	require.NoError(t, sitemapsRedisStorage.InvalidateFromPageCtx(ctx, 1))
+
+	cached, err = sitemapsRedisStorage.GetPageCtx(ctx, 0)
+	require.NoError(t, err)
+	require.NotNil(t, cached)
+
+	for _, get := range []func(context.Context) (*entity.SitemapDocument, error){
+		sitemapsRedisStorage.GetIndexCtx,
+		sitemapsRedisStorage.GetLatestCtx,
+		func(ctx context.Context) (*entity.SitemapDocument, error) {
+			return sitemapsRedisStorage.GetPageCtx(ctx, 1)
+		},
+		func(ctx context.Context) (*entity.SitemapDocument, error) {
+			return sitemapsRedisStorage.GetPageCtx(ctx, 2)
+		},
+	} {
+		cached, err = get(ctx)
+		require.NoError(t, err)
+		require.Nil(t, cached)
+	}
+}
+
+func TestRedis_SitemapsLatestTTL(t *testing.T) {
+	t.Parallel()
+
+	sitemapsRedisStorage, mr := setupSitemapsMiniredis()
+	ctx := context.Background()
+
+	sitemap := &entity.SitemapDocument{Body: []byte("<urlset/>")}
+	require.NoError(t, sitemapsRedisStorage.SetPageCtx(ctx, 0, 86400, sitemap))
+	require.NoError(t, sitemapsRedisStorage.SetLatestCtx(ctx, 3600, sitemap))
+	require.NoError(t, sitemapsRedisStorage.SetIndexCtx(ctx, 86400, sitemap))
+
+	require.Equal(t, 86400*time.Second, mr.TTL(sitemapsKey))
+	require.Equal(t, 3600*time.Second, mr.TTL(sitemapsLatestKey))
+
+	mr.FastForward(2 * time.Hour)
+
+	cached, err := sitemapsRedisStorage.GetLatestCtx(ctx)
+	require.NoError(t, err)
+	require.Nil(t, cached)
+
+	cached, err = sitemapsRedisStorage.GetPageCtx(ctx, 0)
+	require.NoError(t, err)
+	require.NotNil(t, cached)
+}
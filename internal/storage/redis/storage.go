@@ -7,6 +7,7 @@ import (
 	"github.com/Edbeer/restapi/config"
 	"github.com/Edbeer/restapi/internal/entity"
 	"github.com/go-redis/redis/v9"
+	"github.com/google/uuid"
 )
 
 // News StorageRedis interface
@@ -30,16 +31,78 @@ type Sessionredis interface {
 	DeleteSessionByID(ctx context.Context, sessionID string) error
 }
 
+// Suggest StorageRedis interface
+type SuggestRedis interface {
+	AddSuggestionCtx(ctx context.Context, kind string, suggestion *entity.Suggestion) error
+	DeleteSuggestionCtx(ctx context.Context, kind string, id uuid.UUID) error
+	IncrSuggestionCtx(ctx context.Context, kind string, id uuid.UUID, incr float64) error
+	GetSuggestionsCtx(ctx context.Context, kind string, prefix string, limit int) ([]*entity.Suggestion, error)
+	ClearSuggestionsCtx(ctx context.Context, kind string) error
+}
+
+// Feeds StorageRedis interface
+type FeedsRedis interface {
+	GetFeedCtx(ctx context.Context, version int64, key string) (*entity.FeedDocument, error)
+	SetFeedCtx(ctx context.Context, version int64, key string, seconds int, feed *entity.FeedDocument) error
+	GetFeedsVersionCtx(ctx context.Context) (int64, error)
+	InvalidateFeedsCtx(ctx context.Context) error
+}
+
+// Sitemaps StorageRedis interface
+type SitemapsRedis interface {
+	GetIndexCtx(ctx context.Context) (*entity.SitemapDocument, error)
+	SetIndexCtx(ctx context.Context, seconds int, sitemap *entity.SitemapDocument) error
+	GetPageCtx(ctx context.Context, page int) (*entity.SitemapDocument, error)
+	SetPageCtx(ctx context.Context, page int, seconds int, sitemap *entity.SitemapDocument) error
+	GetLatestCtx(ctx context.Context) (*entity.SitemapDocument, error)
+	SetLatestCtx(ctx context.Context, seconds int, sitemap *entity.SitemapDocument) error
+	InvalidateFromPageCtx(ctx context.Context, page int) error
+}
+
+// Pages StorageRedis interface
+type PagesRedis interface {
+	GetPageCtx(ctx context.Context, key string) ([]byte, error)
+	SetPageCtx(ctx context.Context, key string, seconds int, page []byte) error
+}
+
+// Views StorageRedis interface
+type ViewsRedis interface {
+	RecordViewCtx(ctx context.Context, newsID uuid.UUID, visitor string, bucket int64, seconds int) (int64, error)
+	TakeViewsCtx(ctx context.Context, seconds int) (map[uuid.UUID]int64, error)
+	AckViewsCtx(ctx context.Context) error
+	RankViewsCtx(ctx context.Context, key string, weights map[int64]float64, seconds int, limit int) ([]*entity.NewsScore, error)
+}
+
+// Related StorageRedis interface
+type RelatedRedis interface {
+	GetRelatedCtx(ctx context.Context, newsID uuid.UUID) ([]uuid.UUID, error)
+	SetRelatedCtx(ctx context.Context, newsID uuid.UUID, seconds int, related []uuid.UUID) error
+	MarkStaleCtx(ctx context.Context, newsIDs ...uuid.UUID) error
+	TakeStaleCtx(ctx context.Context, limit int) ([]uuid.UUID, error)
+}
+
 type Storage struct {
-	Auth    *AuthStorage
-	News    *NewsStorage
-	Session *SessionStorage
+	Auth     *AuthStorage
+	News     *NewsStorage
+	Session  *SessionStorage
+	Suggest  *SuggestStorage
+	Feeds    *FeedsStorage
+	Sitemaps *SitemapsStorage
+	Pages    *PagesStorage
+	Views    *ViewsStorage
+	Related  *RelatedStorage
 }
 
 func NewStorage(redis *redis.Client, config *config.Config) *Storage {
 	return &Storage{
-		Auth:    NewAuthStorage(redis),
-		News:    NewNewsStorage(redis),
-		Session: NewSessionStorage(redis, config),
+		Auth:     NewAuthStorage(redis),
+		News:     NewNewsStorage(redis),
+		Session:  NewSessionStorage(redis, config),
+		Suggest:  NewSuggestStorage(redis),
+		Feeds:    NewFeedsStorage(redis),
+		Sitemaps: NewSitemapsStorage(redis),
+		Pages:    NewPagesStorage(redis),
+		Views:    NewViewsStorage(redis),
+		Related:  NewRelatedStorage(redis),
 	}
 }
@@ -0,0 +1,224 @@
+package redisrepo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/go-redis/redis/v9"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	suggestPrefix         = "api-suggest:"
+	suggestMaxPrefixLen   = 20
+	suggestMaxIndexWords  = 8
+	suggestScanBatchCount = 500
+)
+
+// Suggest storage, prefix index of sorted sets ranked by popularity
+type SuggestStorage struct {
+	redis *redis.Client
+}
+
+// Suggest storage constructor
+func NewSuggestStorage(redis *redis.Client) *SuggestStorage {
+	return &SuggestStorage{redis: redis}
+}
+
+// Add or replace suggestion in the prefix index
+func (s *SuggestStorage) AddSuggestionCtx(ctx context.Context, kind string, suggestion *entity.Suggestion) error {
+	id := suggestion.ID.String()
+
+	oldText, err := s.redis.HGet(ctx, s.termsKey(kind), id).Result()
+	if err != nil && err != redis.Nil {
+		return errors.Wrap(err, "SuggestStorageRedis.AddSuggestionCtx.HGet")
+	}
+
+	score, err := s.redis.HGet(ctx, s.scoresKey(kind), id).Float64()
+	switch {
+	case err == redis.Nil:
+		score = suggestion.Score
+	case err != nil:
+		return errors.Wrap(err, "SuggestStorageRedis.AddSuggestionCtx.HGet")
+	}
+
+	if _, err := s.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, prefix := range suggestPrefixes(oldText) {
+			pipe.ZRem(ctx, s.prefixKey(kind, prefix), id)
+		}
+		for _, prefix := range suggestPrefixes(suggestion.Text) {
+			pipe.ZAdd(ctx, s.prefixKey(kind, prefix), redis.Z{Score: score, Member: id})
+		}
+		pipe.HSet(ctx, s.termsKey(kind), id, suggestion.Text)
+		pipe.HSet(ctx, s.scoresKey(kind), id, score)
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "SuggestStorageRedis.AddSuggestionCtx.TxPipelined")
+	}
+
+	return nil
+}
+
+// Remove suggestion from the prefix index
+func (s *SuggestStorage) DeleteSuggestionCtx(ctx context.Context, kind string, id uuid.UUID) error {
+	text, err := s.redis.HGet(ctx, s.termsKey(kind), id.String()).Result()
+	if err == redis.Nil {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "SuggestStorageRedis.DeleteSuggestionCtx.HGet")
+	}
+
+	if _, err := s.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, prefix := range suggestPrefixes(text) {
+			pipe.ZRem(ctx, s.prefixKey(kind, prefix), id.String())
+		}
+		pipe.HDel(ctx, s.termsKey(kind), id.String())
+		pipe.HDel(ctx, s.scoresKey(kind), id.String())
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "SuggestStorageRedis.DeleteSuggestionCtx.TxPipelined")
+	}
+
+	return nil
+}
+
+// Increase popularity of indexed suggestion
+func (s *SuggestStorage) IncrSuggestionCtx(ctx context.Context, kind string, id uuid.UUID, incr float64) error {
+	text, err := s.redis.HGet(ctx, s.termsKey(kind), id.String()).Result()
+	if err == redis.Nil {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "SuggestStorageRedis.IncrSuggestionCtx.HGet")
+	}
+
+	if _, err := s.redis.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, prefix := range suggestPrefixes(text) {
+			pipe.ZIncrBy(ctx, s.prefixKey(kind, prefix), incr, id.String())
+		}
+		pipe.HIncrByFloat(ctx, s.scoresKey(kind), id.String(), incr)
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "SuggestStorageRedis.IncrSuggestionCtx.Pipelined")
+	}
+
+	return nil
+}
+
+// Get top suggestions for prefix
+func (s *SuggestStorage) GetSuggestionsCtx(ctx context.Context, kind string, prefix string, limit int) ([]*entity.Suggestion, error) {
+	prefix = normalizeSuggestion(prefix)
+	if prefix == "" {
+		return make([]*entity.Suggestion, 0), nil
+	}
+	if runes := []rune(prefix); len(runes) > suggestMaxPrefixLen {
+		prefix = string(runes[:suggestMaxPrefixLen])
+	}
+
+	members, err := s.redis.ZRevRangeWithScores(ctx, s.prefixKey(kind, prefix), 0, int64(limit-1)).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "SuggestStorageRedis.GetSuggestionsCtx.ZRevRangeWithScores")
+	}
+	if len(members) == 0 {
+		return make([]*entity.Suggestion, 0), nil
+	}
+
+	ids := make([]string, 0, len(members))
+	for _, m := range members {
+		ids = append(ids, fmt.Sprint(m.Member))
+	}
+
+	texts, err := s.redis.HMGet(ctx, s.termsKey(kind), ids...).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "SuggestStorageRedis.GetSuggestionsCtx.HMGet")
+	}
+
+	suggestions := make([]*entity.Suggestion, 0, len(members))
+	for i, m := range members {
+		text, ok := texts[i].(string)
+		if !ok {
+			continue
+		}
+		id, err := uuid.Parse(ids[i])
+		if err != nil {
+			continue
+		}
+		suggestions = append(suggestions, &entity.Suggestion{
+			ID:    id,
+			Text:  text,
+			Score: m.Score,
+		})
+	}
+
+	return suggestions, nil
+}
+
+// Drop prefix index and terms of kind, popularity scores are kept
+func (s *SuggestStorage) ClearSuggestionsCtx(ctx context.Context, kind string) error {
+	var cursor uint64
+	for {
+		keys, next, err := s.redis.Scan(ctx, cursor, s.prefixKey(kind, "*"), suggestScanBatchCount).Result()
+		if err != nil {
+			return errors.Wrap(err, "SuggestStorageRedis.ClearSuggestionsCtx.Scan")
+		}
+		if len(keys) > 0 {
+			if err := s.redis.Del(ctx, keys...).Err(); err != nil {
+				return errors.Wrap(err, "SuggestStorageRedis.ClearSuggestionsCtx.Del")
+			}
+		}
+		if next == 0 {
+			break
+		}
+		cursor = next
+	}
+
+	if err := s.redis.Del(ctx, s.termsKey(kind)).Err(); err != nil {
+		return errors.Wrap(err, "SuggestStorageRedis.ClearSuggestionsCtx.Del")
+	}
+
+	return nil
+}
+
+func (s *SuggestStorage) prefixKey(kind, prefix string) string {
+	return fmt.Sprintf("%s%s:p:%s", suggestPrefix, kind, prefix)
+}
+
+func (s *SuggestStorage) termsKey(kind string) string {
+	return fmt.Sprintf("%s%s:terms", suggestPrefix, kind)
+}
+
+func (s *SuggestStorage) scoresKey(kind string) string {
+	return fmt.Sprintf("%s%s:scores", suggestPrefix, kind)
+}
+
+// Lowercase text and collapse whitespaces
+func normalizeSuggestion(text string) string {
+	return strings.Join(strings.Fields(strings.ToLower(text)), " ")
+}
+
+// Prefixes of the whole text and of every word start, so "Pavel Volkov" is found by "vol"
+func suggestPrefixes(text string) []string {
+	words := strings.Fields(normalizeSuggestion(text))
+	if len(words) > suggestMaxIndexWords {
+		words = words[:suggestMaxIndexWords]
+	}
+
+	seen := make(map[string]struct{})
+	prefixes := make([]string, 0)
+	for i := range words {
+		runes := []rune(strings.Join(words[i:], " "))
+		for l := 1; l <= len(runes) && l <= suggestMaxPrefixLen; l++ {
+			prefix := string(runes[:l])
+			if _, ok := seen[prefix]; ok {
+				continue
+			}
+			seen[prefix] = struct{}{}
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	return prefixes
+}
@@ -0,0 +1,128 @@
+package redisrepo
+
+import (
+	"context"
+	"log"
+	"testing"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v9"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func SetupSuggestRedis() *SuggestStorage {
+	mr, err := miniredis.Run()
+	if err != nil {
+		log.Fatal(err)
+	}
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+
+	suggestRedisStorage := NewSuggestStorage(client)
+	return suggestRedisStorage
+}
+
+func TestRedis_AddSuggestionCtx(t *testing.T) {
+	t.Parallel()
+
+	suggestRedisStorage := SetupSuggestRedis()
+
+	t.Run("AddSuggestionCtx", func(t *testing.T) {
+		ctx := context.Background()
+		user := &entity.Suggestion{
+			ID:   uuid.New(),
+			Text: "Pavel Volkov",
+		}
+		err := suggestRedisStorage.AddSuggestionCtx(ctx, entity.SuggestAuthors, user)
+		require.NoError(t, err)
+
+		byFirstName, err := suggestRedisStorage.GetSuggestionsCtx(ctx, entity.SuggestAuthors, "Pav", 10)
+		require.NoError(t, err)
+		require.Len(t, byFirstName, 1)
+		require.Equal(t, user.Text, byFirstName[0].Text)
+
+		byLastName, err := suggestRedisStorage.GetSuggestionsCtx(ctx, entity.SuggestAuthors, "vol", 10)
+		require.NoError(t, err)
+		require.Len(t, byLastName, 1)
+
+		user.Text = "Ivan Petrov"
+		err = suggestRedisStorage.AddSuggestionCtx(ctx, entity.SuggestAuthors, user)
+		require.NoError(t, err)
+
+		renamed, err := suggestRedisStorage.GetSuggestionsCtx(ctx, entity.SuggestAuthors, "vol", 10)
+		require.NoError(t, err)
+		require.Len(t, renamed, 0)
+	})
+}
+
+func TestRedis_IncrSuggestionCtx(t *testing.T) {
+	t.Parallel()
+
+	suggestRedisStorage := SetupSuggestRedis()
+
+	t.Run("IncrSuggestionCtx", func(t *testing.T) {
+		ctx := context.Background()
+		first := &entity.Suggestion{ID: uuid.New(), Text: "Golang generics"}
+		second := &entity.Suggestion{ID: uuid.New(), Text: "Golang goroutines"}
+		require.NoError(t, suggestRedisStorage.AddSuggestionCtx(ctx, entity.SuggestNews, first))
+		require.NoError(t, suggestRedisStorage.AddSuggestionCtx(ctx, entity.SuggestNews, second))
+
+		err := suggestRedisStorage.IncrSuggestionCtx(ctx, entity.SuggestNews, second.ID, 5)
+		require.NoError(t, err)
+
+		suggestions, err := suggestRedisStorage.GetSuggestionsCtx(ctx, entity.SuggestNews, "golang g", 10)
+		require.NoError(t, err)
+		require.Len(t, suggestions, 2)
+		require.Equal(t, second.ID, suggestions[0].ID)
+		require.Equal(t, float64(5), suggestions[0].Score)
+	})
+}
+
+func TestRedis_DeleteSuggestionCtx(t *testing.T) {
+	t.Parallel()
+
+	suggestRedisStorage := SetupSuggestRedis()
+
+	t.Run("DeleteSuggestionCtx", func(t *testing.T) {
+		ctx := context.Background()
+		news := &entity.Suggestion{ID: uuid.New(), Text: "Redis prefix index"}
+		require.NoError(t, suggestRedisStorage.AddSuggestionCtx(ctx, entity.SuggestNews, news))
+
+		err := suggestRedisStorage.DeleteSuggestionCtx(ctx, entity.SuggestNews, news.ID)
+		require.NoError(t, err)
+
+		suggestions, err := suggestRedisStorage.GetSuggestionsCtx(ctx, entity.SuggestNews, "redis", 10)
+		require.NoError(t, err)
+		require.Len(t, suggestions, 0)
+	})
+}
+
+func TestRedis_ClearSuggestionsCtx(t *testing.T) {
+	t.Parallel()
+
+	suggestRedisStorage := SetupSuggestRedis()
+
+	t.Run("ClearSuggestionsCtx", func(t *testing.T) {
+		ctx := context.Background()
+		news := &entity.Suggestion{ID: uuid.New(), Text: "Popular news"}
+		require.NoError(t, suggestRedisStorage.AddSuggestionCtx(ctx, entity.SuggestNews, news))
+		require.NoError(t, suggestRedisStorage.IncrSuggestionCtx(ctx, entity.SuggestNews, news.ID, 3))
+
+		err := suggestRedisStorage.ClearSuggestionsCtx(ctx, entity.SuggestNews)
+		require.NoError(t, err)
+
+		suggestions, err := suggestRedisStorage.GetSuggestionsCtx(ctx, entity.SuggestNews, "pop", 10)
+		require.NoError(t, err)
+		require.Len(t, suggestions, 0)
+
+		// popularity survives the rebuild
+		require.NoError(t, suggestRedisStorage.AddSuggestionCtx(ctx, entity.SuggestNews, news))
+		suggestions, err = suggestRedisStorage.GetSuggestionsCtx(ctx, entity.SuggestNews, "pop", 10)
+		require.NoError(t, err)
+		require.Len(t, suggestions, 1)
+		require.Equal(t, float64(3), suggestions[0].Score)
+	})
+}
@@ -0,0 +1,168 @@
+package redisrepo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/go-redis/redis/v9"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	viewsPrefix      = "api-views:"
+	viewsPendingKey  = viewsPrefix + "pending"
+	viewsFlushingKey = viewsPrefix + "flushing"
+	viewsLockKey     = viewsPrefix + "flush-lock"
+	// views of buckets are kept for the longest trending window and a day
+	viewsRetention = 8 * 24 * time.Hour
+)
+
+// Visitors of news are deduplicated by hyperloglog per bucket. When the
+// hyperloglog changes the visitor is new, so the bucket score and pending
+// views of news are incremented. Returns views not flushed yet.
+var recordViewScript = redis.NewScript(`
+if redis.call('PFADD', KEYS[1], ARGV[1]) == 1 then
+	redis.call('ZINCRBY', KEYS[2], 1, ARGV[2])
+	redis.call('HINCRBY', KEYS[3], ARGV[2], 1)
+end
+redis.call('EXPIRE', KEYS[1], ARGV[3])
+redis.call('EXPIRE', KEYS[2], ARGV[4])
+local pending = tonumber(redis.call('HGET', KEYS[3], ARGV[2]) or '0')
+return pending + tonumber(redis.call('HGET', KEYS[4], ARGV[2]) or '0')
+`)
+
+// Pending views are renamed to flushing unless a failed flush left them
+// there, the lock keeps other instances from flushing the same views
+var takeViewsScript = redis.NewScript(`
+if not redis.call('SET', KEYS[3], '1', 'NX', 'EX', ARGV[1]) then
+	return false
+end
+if redis.call('EXISTS', KEYS[2]) == 0 and redis.call('EXISTS', KEYS[1]) == 1 then
+	redis.call('RENAME', KEYS[1], KEYS[2])
+end
+return redis.call('HGETALL', KEYS[2])
+`)
+
+// News views storage, unique views are counted per time bucket
+type ViewsStorage struct {
+	redis *redis.Client
+}
+
+// News views storage constructor
+func NewViewsStorage(redis *redis.Client) *ViewsStorage {
+	return &ViewsStorage{redis: redis}
+}
+
+// Record view of news by visitor in bucket lasting seconds, returns views not flushed yet
+func (v *ViewsStorage) RecordViewCtx(ctx context.Context, newsID uuid.UUID, visitor string, bucket int64, seconds int) (int64, error) {
+	keys := []string{
+		fmt.Sprintf("%svisitors:%d:%s", viewsPrefix, bucket, newsID),
+		v.bucketKey(bucket),
+		viewsPendingKey,
+		viewsFlushingKey,
+	}
+	pending, err := recordViewScript.Run(ctx, v.redis, keys,
+		visitor,
+		newsID.String(),
+		2*seconds,
+		int(viewsRetention/time.Second),
+	).Int64()
+	if err != nil {
+		return 0, errors.Wrap(err, "ViewsStorageRedis.RecordViewCtx.Run")
+	}
+	return pending, nil
+}
+
+// Take views to flush, views stay taken until ack or lock expiry after seconds.
+// Nil if another flush holds the lock.
+func (v *ViewsStorage) TakeViewsCtx(ctx context.Context, seconds int) (map[uuid.UUID]int64, error) {
+	values, err := takeViewsScript.Run(ctx, v.redis, []string{viewsPendingKey, viewsFlushingKey, viewsLockKey}, seconds).StringSlice()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "ViewsStorageRedis.TakeViewsCtx.Run")
+	}
+
+	views := make(map[uuid.UUID]int64, len(values)/2)
+	for i := 0; i+1 < len(values); i += 2 {
+		newsID, err := uuid.Parse(values[i])
+		if err != nil {
+			return nil, errors.Wrap(err, "ViewsStorageRedis.TakeViewsCtx.Parse")
+		}
+		count, err := strconv.ParseInt(values[i+1], 10, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, "ViewsStorageRedis.TakeViewsCtx.ParseInt")
+		}
+		views[newsID] = count
+	}
+	return views, nil
+}
+
+// Drop taken views after they are flushed and release the lock
+func (v *ViewsStorage) AckViewsCtx(ctx context.Context) error {
+	if err := v.redis.Del(ctx, viewsFlushingKey, viewsLockKey).Err(); err != nil {
+		return errors.Wrap(err, "ViewsStorageRedis.AckViewsCtx.Del")
+	}
+	return nil
+}
+
+// Rank news by views of buckets multiplied by their weights, highest first.
+// Ranking is cached by key for seconds.
+func (v *ViewsStorage) RankViewsCtx(ctx context.Context, key string, weights map[int64]float64, seconds int, limit int) ([]*entity.NewsScore, error) {
+	dest := viewsPrefix + "trending:" + key
+
+	exists, err := v.redis.Exists(ctx, dest).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "ViewsStorageRedis.RankViewsCtx.Exists")
+	}
+	if exists == 0 && len(weights) > 0 {
+		buckets := make([]int64, 0, len(weights))
+		for bucket := range weights {
+			buckets = append(buckets, bucket)
+		}
+		sort.Slice(buckets, func(i, j int) bool { return buckets[i] < buckets[j] })
+
+		store := &redis.ZStore{
+			Keys:    make([]string, 0, len(buckets)),
+			Weights: make([]float64, 0, len(buckets)),
+		}
+		for _, bucket := range buckets {
+			store.Keys = append(store.Keys, v.bucketKey(bucket))
+			store.Weights = append(store.Weights, weights[bucket])
+		}
+
+		if _, err := v.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.ZUnionStore(ctx, dest, store)
+			pipe.Expire(ctx, dest, time.Second*time.Duration(seconds))
+			return nil
+		}); err != nil {
+			return nil, errors.Wrap(err, "ViewsStorageRedis.RankViewsCtx.ZUnionStore")
+		}
+	}
+
+	ranked, err := v.redis.ZRevRangeWithScores(ctx, dest, 0, int64(limit-1)).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "ViewsStorageRedis.RankViewsCtx.ZRevRangeWithScores")
+	}
+
+	scores := make([]*entity.NewsScore, 0, len(ranked))
+	for _, z := range ranked {
+		member, _ := z.Member.(string)
+		newsID, err := uuid.Parse(member)
+		if err != nil {
+			return nil, errors.Wrap(err, "ViewsStorageRedis.RankViewsCtx.Parse")
+		}
+		scores = append(scores, &entity.NewsScore{NewsID: newsID, Score: z.Score})
+	}
+	return scores, nil
+}
+
+func (v *ViewsStorage) bucketKey(bucket int64) string {
+	return fmt.Sprintf("%sbucket:%d", viewsPrefix, bucket)
+}
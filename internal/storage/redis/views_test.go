@@ -0,0 +1,116 @@
+package redisrepo
+
+import (
+	"context"
+	"log"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v9"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func SetupViewsRedis() *ViewsStorage {
+	mr, err := miniredis.Run()
+	if err != nil {
+		log.Fatal(err)
+	}
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+
+	return NewViewsStorage(client)
+}
+
+func TestRedis_RecordView(t *testing.T) {
+	t.Parallel()
+
+	viewsRedisStorage := SetupViewsRedis()
+	ctx := context.Background()
+	newsID := uuid.New()
+
+	pending, err := viewsRedisStorage.RecordViewCtx(ctx, newsID, "alice", 100, 3600)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), pending)
+
+	pending, err = viewsRedisStorage.RecordViewCtx(ctx, newsID, "alice", 100, 3600)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), pending)
+
+	pending, err = viewsRedisStorage.RecordViewCtx(ctx, newsID, "bob", 100, 3600)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), pending)
+
+	// visitors are unique per bucket
+	pending, err = viewsRedisStorage.RecordViewCtx(ctx, newsID, "alice", 101, 3600)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), pending)
+}
+
+func TestRedis_TakeViews(t *testing.T) {
+	t.Parallel()
+
+	viewsRedisStorage := SetupViewsRedis()
+	ctx := context.Background()
+	newsID := uuid.New()
+
+	views, err := viewsRedisStorage.TakeViewsCtx(ctx, 60)
+	require.NoError(t, err)
+	require.Empty(t, views)
+	require.NoError(t, viewsRedisStorage.AckViewsCtx(ctx))
+
+	_, err = viewsRedisStorage.RecordViewCtx(ctx, newsID, "alice", 100, 3600)
+	require.NoError(t, err)
+
+	views, err = viewsRedisStorage.TakeViewsCtx(ctx, 60)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), views[newsID])
+
+	// taken views are still counted until ack
+	pending, err := viewsRedisStorage.RecordViewCtx(ctx, newsID, "bob", 100, 3600)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), pending)
+
+	// locked by the running flush
+	views, err = viewsRedisStorage.TakeViewsCtx(ctx, 60)
+	require.NoError(t, err)
+	require.Nil(t, views)
+
+	require.NoError(t, viewsRedisStorage.AckViewsCtx(ctx))
+
+	views, err = viewsRedisStorage.TakeViewsCtx(ctx, 60)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), views[newsID])
+}
+
+func TestRedis_RankViews(t *testing.T) {
+	t.Parallel()
+
+	viewsRedisStorage := SetupViewsRedis()
+	ctx := context.Background()
+	oldID, newID := uuid.New(), uuid.New()
+
+	for _, visitor := range []string{"a", "b", "c"} {
+		_, err := viewsRedisStorage.RecordViewCtx(ctx, oldID, visitor, 100, 3600)
+		require.NoError(t, err)
+	}
+	for _, visitor := range []string{"a", "b"} {
+		_, err := viewsRedisStorage.RecordViewCtx(ctx, newID, visitor, 101, 3600)
+		require.NoError(t, err)
+	}
+
+	scores, err := viewsRedisStorage.RankViewsCtx(ctx, "1h", map[int64]float64{100: 0.5, 101: 1}, 60, 10)
+	require.NoError(t, err)
+	require.Len(t, scores, 2)
+	require.Equal(t, newID, scores[0].NewsID)
+	require.Equal(t, 2.0, scores[0].Score)
+	require.Equal(t, oldID, scores[1].NewsID)
+	require.Equal(t, 1.5, scores[1].Score)
+
+	// cached ranking is returned until it expires
+	scores, err = viewsRedisStorage.RankViewsCtx(ctx, "1h", map[int64]float64{100: 1}, 60, 1)
+	require.NoError(t, err)
+	require.Len(t, scores, 1)
+	require.Equal(t, newID, scores[0].NewsID)
+}
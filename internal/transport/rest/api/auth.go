@@ -19,9 +19,9 @@ import (
 type AuthService interface {
 	Register(ctx context.Context, user *entity.User) (*entity.UserWithToken, error)
 	Update(ctx context.Context, user *entity.User) (*entity.User, error)
-	Delete(ctx context.Context, userID uuid.UUID) error
+	Delete(ctx context.Context, userID uuid.UUID, version int) error
 	GetUserByID(ctx context.Context, userID uuid.UUID) (*entity.User, error)
-	FindUsersByName(ctx context.Context, name string, pq *utils.PaginationQuery) (*entity.UsersList, error)
+	FindUsersByName(ctx context.Context, search *entity.UserSearchQuery, pq *utils.PaginationQuery) (*entity.UsersList, error)
 	GetUsers(ctx context.Context, pq *utils.PaginationQuery) (*entity.UsersList, error)
 	Login(ctx context.Context, user *entity.User) (*entity.UserWithToken, error)
 }
@@ -92,8 +92,12 @@ func (h *AuthHandler) Register() echo.HandlerFunc {
 // @Tags Auth
 // @Accept json
 // @Param id path int true "user_id"
+// @Param If-Match header string false "ETag of the user, required in strict mode"
 // @Produce json
 // @Success 200 {object} entity.User
+// @Header 200 {string} ETag "entity tag of the updated user"
+// @Failure 412 {object} httpe.RestError
+// @Failure 428 {object} httpe.RestError
 // @Router /auth/{id} [put]
 func (h *AuthHandler) Update() echo.HandlerFunc {
 	return func(c echo.Context) error {
@@ -110,12 +114,16 @@ func (h *AuthHandler) Update() echo.HandlerFunc {
 			return c.JSON(http.StatusBadRequest, httpe.BadRequest)
 		}
 
+		if u.Version, err = utils.GetIfMatchVersion(c, h.config); err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
 		updatedUser, err := h.authService.Update(ctx, u)
 		if err != nil {
 			return c.JSON(httpe.ErrorResponse(err))
 		}
 
-		return c.JSON(http.StatusOK, updatedUser)
+		return utils.JSONWithETag(c, http.StatusOK, updatedUser.Version, updatedUser)
 	}
 
 }
@@ -126,8 +134,11 @@ func (h *AuthHandler) Update() echo.HandlerFunc {
 // @Tags Auth
 // @Accept json
 // @Param id path int true "user_id"
+// @Param If-Match header string false "ETag of the user, required in strict mode"
 // @Produce json
 // @Success 200 {string} string	"ok"
+// @Failure 412 {object} httpe.RestError
+// @Failure 428 {object} httpe.RestError
 // @Failure 500 {object} httpe.RestError
 // @Router /auth/{id} [delete]
 func (h *AuthHandler) Delete() echo.HandlerFunc {
@@ -139,7 +150,12 @@ func (h *AuthHandler) Delete() echo.HandlerFunc {
 			return c.JSON(http.StatusBadRequest, httpe.NewBadRequestError(err.Error()))
 		}
 
-		if err := h.authService.Delete(ctx, uID); err != nil {
+		version, err := utils.GetIfMatchVersion(c, h.config)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		if err := h.authService.Delete(ctx, uID, version); err != nil {
 			return c.JSON(httpe.ErrorResponse(err))
 		}
 
@@ -154,7 +170,10 @@ func (h *AuthHandler) Delete() echo.HandlerFunc {
 // @Accept  json
 // @Produce  json
 // @Param id path int true "user_id"
+// @Param If-None-Match header string false "ETag of a cached copy"
 // @Success 200 {object} entity.User
+// @Success 304 {string} string "cached copy is current"
+// @Header 200 {string} ETag "entity tag of the user"
 // @Failure 500 {object} httpe.RestError
 // @Router /auth/{id} [get]
 func (h *AuthHandler) GetUserByID() echo.HandlerFunc {
@@ -171,16 +190,23 @@ func (h *AuthHandler) GetUserByID() echo.HandlerFunc {
 			return c.JSON(httpe.ErrorResponse(err))
 		}
 
-		return c.JSON(http.StatusOK, user)
+		return utils.JSONWithETag(c, http.StatusOK, user.Version, user)
 	}
 }
 
 // FindUsersByName godoc
-// @Summary Find by name
-// @Description Find user by name
+// @Summary Find users
+// @Description Typo-tolerant users search by first name, last name and email ordered by similarity, admin only
 // @Tags Auth
 // @Accept json
-// @Param name query string false "username" Format(username)
+// @Param name query string true "name or email" Format(username)
+// @Param role query string false "role filter" Format(role)
+// @Param country query string false "country filter" Format(country)
+// @Param city query string false "city filter" Format(city)
+// @Param created_from query string false "created at from, RFC3339 or YYYY-MM-DD" Format(created_from)
+// @Param created_to query string false "created at to, RFC3339 or YYYY-MM-DD" Format(created_to)
+// @Param page query int false "page number" Format(page)
+// @Param size query int false "number of elements per page" Format(size)
 // @Produce json
 // @Success 200 {object} entity.UsersList
 // @Failure 500 {object} httpe.RestError
@@ -198,7 +224,23 @@ func (h *AuthHandler) FindUsersByName() echo.HandlerFunc {
 			return c.JSON(httpe.ErrorResponse(err))
 		}
 
-		users, err := h.authService.FindUsersByName(ctx, c.QueryParam("name"), pq)
+		createdFrom, err := utils.ParseTimeQuery(c.QueryParam("created_from"))
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		createdTo, err := utils.ParseTimeQuery(c.QueryParam("created_to"))
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		users, err := h.authService.FindUsersByName(ctx, &entity.UserSearchQuery{
+			Name:        c.QueryParam("name"),
+			Role:        c.QueryParam("role"),
+			Country:     c.QueryParam("country"),
+			City:        c.QueryParam("city"),
+			CreatedFrom: createdFrom,
+			CreatedTo:   createdTo,
+		}, pq)
 		if err != nil {
 			return c.JSON(httpe.ErrorResponse(err))
 		}
@@ -214,9 +256,13 @@ func (h *AuthHandler) FindUsersByName() echo.HandlerFunc {
 // @Accept json
 // @Param page query int false "page number" Format(page)
 // @Param size query int false "number of elements per page" Format(size)
-// @Param orderBy query int false "filter name" Format(orderBy)
+// @Param cursor query string false "next_cursor or prev_cursor of another page"
+// @Param total query bool false "count total of the list"
+// @Param filter query string false "conditions field:operator:value separated by commas, e.g. category:eq:tech" Format(filter)
+// @Param sort query string false "fields separated by commas, descending with -, e.g. -created_at,title" Format(sort)
 // @Produce json
 // @Success 200 {object} entity.UsersList
+// @Header 200 {string} Link "RFC 8288 links of next and prev pages"
 // @Failure 500 {object} httpe.RestError
 // @Router /auth/all [get]
 func (h *AuthHandler) GetUsers() echo.HandlerFunc {
@@ -233,6 +279,7 @@ func (h *AuthHandler) GetUsers() echo.HandlerFunc {
 			return c.JSON(httpe.ErrorResponse(err))
 		}
 
+		utils.SetCursorLinks(c, users.NextCursor, users.PrevCursor)
 		return c.JSON(http.StatusOK, users)
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/Edbeer/restapi/config"
 	"github.com/Edbeer/restapi/internal/entity"
@@ -195,4 +196,48 @@ func TestHandler_Logout(t *testing.T) {
 	err = logout(c)
 	require.NoError(t, err)
 	require.Nil(t, err)
-}
\ No newline at end of file
+}
+func TestHandler_FindUsersByName(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthService := mockservice.NewMockAuth(ctrl)
+	apiLogger := logger.NewApiLogger(nil)
+	authHandler := NewAuthHandler(nil, mockAuthService, nil, apiLogger)
+
+	handlerFunc := authHandler.FindUsersByName()
+
+	t.Run("FindUsersByName", func(t *testing.T) {
+		e := echo.New()
+		request := httptest.NewRequest(http.MethodGet, "/api/auth/find?name=Pavle&role=admin&created_from=2026-01-01", nil)
+		recorder := httptest.NewRecorder()
+		c := e.NewContext(request, recorder)
+		ctx := utils.GetRequestCtx(c)
+
+		from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		search := &entity.UserSearchQuery{
+			Name:        "Pavle",
+			Role:        "admin",
+			CreatedFrom: &from,
+		}
+
+		mockAuthService.EXPECT().FindUsersByName(ctx, gomock.Eq(search), gomock.Any()).Return(&entity.UsersList{}, nil)
+
+		err := handlerFunc(c)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Invalid created_to", func(t *testing.T) {
+		e := echo.New()
+		request := httptest.NewRequest(http.MethodGet, "/api/auth/find?name=Pavle&created_to=yesterday", nil)
+		recorder := httptest.NewRecorder()
+		c := e.NewContext(request, recorder)
+
+		err := handlerFunc(c)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
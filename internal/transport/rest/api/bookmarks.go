@@ -0,0 +1,338 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// Bookmarks service interface
+type BookmarksService interface {
+	AddBookmark(ctx context.Context, newsID uuid.UUID) error
+	RemoveBookmark(ctx context.Context, newsID uuid.UUID) error
+	GetBookmarks(ctx context.Context, pq *utils.PaginationQuery) (*entity.NewsList, error)
+	CreateList(ctx context.Context, list *entity.ReadingList) (*entity.ReadingList, error)
+	UpdateList(ctx context.Context, list *entity.ReadingListUpdate) (*entity.ReadingList, error)
+	DeleteList(ctx context.Context, listID uuid.UUID) error
+	GetLists(ctx context.Context) ([]*entity.ReadingList, error)
+	GetList(ctx context.Context, listID uuid.UUID, pq *utils.PaginationQuery) (*entity.ReadingListNews, error)
+	AddListItem(ctx context.Context, listID uuid.UUID, newsID uuid.UUID) error
+	RemoveListItem(ctx context.Context, listID uuid.UUID, newsID uuid.UUID) error
+	ReorderList(ctx context.Context, listID uuid.UUID, order *entity.ReadingListOrder) error
+}
+
+// BookmarksHandler
+type BookmarksHandler struct {
+	bookmarksService BookmarksService
+	config           *config.Config
+	logger           logger.Logger
+}
+
+// BookmarksHandler constructor
+func NewBookmarksHandler(bookmarksService BookmarksService, config *config.Config, logger logger.Logger) *BookmarksHandler {
+	return &BookmarksHandler{
+		bookmarksService: bookmarksService,
+		config:           config,
+		logger:           logger,
+	}
+}
+
+// GetBookmarks godoc
+// @Summary Get bookmarks
+// @Description Get bookmarked news of current user, newest bookmarks first
+// @Tags Bookmarks
+// @Produce json
+// @Param page query int false "page number" Format(page)
+// @Param size query int false "number of elements per page" Format(size)
+// @Success 200 {object} entity.NewsList
+// @Failure 401 {object} httpe.RestError
+// @Router /bookmarks [get]
+func (h *BookmarksHandler) GetBookmarks() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		pq, err := utils.GetPaginationFromCtx(c)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		newsList, err := h.bookmarksService.GetBookmarks(ctx, pq)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		return c.JSON(http.StatusOK, newsList)
+	}
+}
+
+// AddBookmark godoc
+// @Summary Bookmark news
+// @Description Bookmark published news for current user
+// @Tags Bookmarks
+// @Param news_id path string true "news id"
+// @Success 200 {string} string	"ok"
+// @Failure 404 {object} httpe.RestError
+// @Router /bookmarks/{news_id} [put]
+func (h *BookmarksHandler) AddBookmark() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		newsID, err := uuid.Parse(c.Param("news_id"))
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		if err := h.bookmarksService.AddBookmark(ctx, newsID); err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		return c.NoContent(http.StatusOK)
+	}
+}
+
+// RemoveBookmark godoc
+// @Summary Remove bookmark
+// @Description Remove bookmark of current user
+// @Tags Bookmarks
+// @Param news_id path string true "news id"
+// @Success 200 {string} string	"ok"
+// @Router /bookmarks/{news_id} [delete]
+func (h *BookmarksHandler) RemoveBookmark() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		newsID, err := uuid.Parse(c.Param("news_id"))
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		if err := h.bookmarksService.RemoveBookmark(ctx, newsID); err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		return c.NoContent(http.StatusOK)
+	}
+}
+
+// GetLists godoc
+// @Summary Get reading lists
+// @Description Get reading lists of current user by name
+// @Tags Bookmarks
+// @Produce json
+// @Success 200 {array} entity.ReadingList
+// @Failure 401 {object} httpe.RestError
+// @Router /reading-lists [get]
+func (h *BookmarksHandler) GetLists() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		lists, err := h.bookmarksService.GetLists(ctx)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		return c.JSON(http.StatusOK, lists)
+	}
+}
+
+// CreateList godoc
+// @Summary Create reading list
+// @Description Create named reading list of current user, public lists can be read by anyone
+// @Tags Bookmarks
+// @Accept json
+// @Produce json
+// @Param list body entity.ReadingList true "reading list"
+// @Success 201 {object} entity.ReadingList
+// @Failure 400 {object} httpe.RestError
+// @Router /reading-lists [post]
+func (h *BookmarksHandler) CreateList() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		list := &entity.ReadingList{}
+		if err := c.Bind(list); err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		createdList, err := h.bookmarksService.CreateList(ctx, list)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		return c.JSON(http.StatusCreated, createdList)
+	}
+}
+
+// GetList godoc
+// @Summary Get reading list
+// @Description Get reading list with its published news in list order, private lists are visible to their owner only
+// @Tags Bookmarks
+// @Produce json
+// @Param list_id path string true "reading list id"
+// @Param page query int false "page number" Format(page)
+// @Param size query int false "number of elements per page" Format(size)
+// @Success 200 {object} entity.ReadingListNews
+// @Failure 404 {object} httpe.RestError
+// @Router /reading-lists/{list_id} [get]
+func (h *BookmarksHandler) GetList() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		listID, err := uuid.Parse(c.Param("list_id"))
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		pq, err := utils.GetPaginationFromCtx(c)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		list, err := h.bookmarksService.GetList(ctx, listID, pq)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		return c.JSON(http.StatusOK, list)
+	}
+}
+
+// UpdateList godoc
+// @Summary Update reading list
+// @Description Rename reading list or change its sharing, owner only
+// @Tags Bookmarks
+// @Accept json
+// @Produce json
+// @Param list_id path string true "reading list id"
+// @Param list body entity.ReadingListUpdate true "new name or sharing"
+// @Success 200 {object} entity.ReadingList
+// @Failure 403 {object} httpe.RestError
+// @Router /reading-lists/{list_id} [put]
+func (h *BookmarksHandler) UpdateList() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		listID, err := uuid.Parse(c.Param("list_id"))
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		list := &entity.ReadingListUpdate{}
+		if err := c.Bind(list); err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		list.ListID = listID
+
+		updatedList, err := h.bookmarksService.UpdateList(ctx, list)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		return c.JSON(http.StatusOK, updatedList)
+	}
+}
+
+// DeleteList godoc
+// @Summary Delete reading list
+// @Description Delete reading list with its items, owner only
+// @Tags Bookmarks
+// @Param list_id path string true "reading list id"
+// @Success 200 {string} string	"ok"
+// @Failure 403 {object} httpe.RestError
+// @Router /reading-lists/{list_id} [delete]
+func (h *BookmarksHandler) DeleteList() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		listID, err := uuid.Parse(c.Param("list_id"))
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		if err := h.bookmarksService.DeleteList(ctx, listID); err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		return c.NoContent(http.StatusOK)
+	}
+}
+
+// AddListItem godoc
+// @Summary Add news to reading list
+// @Description Add published news to the end of reading list, owner only
+// @Tags Bookmarks
+// @Param list_id path string true "reading list id"
+// @Param news_id path string true "news id"
+// @Success 200 {string} string	"ok"
+// @Failure 404 {object} httpe.RestError
+// @Router /reading-lists/{list_id}/items/{news_id} [put]
+func (h *BookmarksHandler) AddListItem() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return h.listItem(c, h.bookmarksService.AddListItem)
+	}
+}
+
+// RemoveListItem godoc
+// @Summary Remove news from reading list
+// @Description Remove news from reading list, owner only
+// @Tags Bookmarks
+// @Param list_id path string true "reading list id"
+// @Param news_id path string true "news id"
+// @Success 200 {string} string	"ok"
+// @Failure 403 {object} httpe.RestError
+// @Router /reading-lists/{list_id}/items/{news_id} [delete]
+func (h *BookmarksHandler) RemoveListItem() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return h.listItem(c, h.bookmarksService.RemoveListItem)
+	}
+}
+
+// ReorderList godoc
+// @Summary Reorder reading list
+// @Description Move listed news to the start of reading list in the given order, owner only
+// @Tags Bookmarks
+// @Accept json
+// @Param list_id path string true "reading list id"
+// @Param order body entity.ReadingListOrder true "news order"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} httpe.RestError
+// @Router /reading-lists/{list_id}/order [put]
+func (h *BookmarksHandler) ReorderList() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		listID, err := uuid.Parse(c.Param("list_id"))
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		order := &entity.ReadingListOrder{}
+		if err := c.Bind(order); err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		if err := h.bookmarksService.ReorderList(ctx, listID, order); err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		return c.NoContent(http.StatusOK)
+	}
+}
+
+type listItemFunc func(ctx context.Context, listID uuid.UUID, newsID uuid.UUID) error
+
+func (h *BookmarksHandler) listItem(c echo.Context, change listItemFunc) error {
+	ctx := utils.GetRequestCtx(c)
+
+	listID, err := uuid.Parse(c.Param("list_id"))
+	if err != nil {
+		return c.JSON(httpe.ErrorResponse(err))
+	}
+	newsID, err := uuid.Parse(c.Param("news_id"))
+	if err != nil {
+		return c.JSON(httpe.ErrorResponse(err))
+	}
+
+	if err := change(ctx, listID, newsID); err != nil {
+		return c.JSON(httpe.ErrorResponse(err))
+	}
+	return c.NoContent(http.StatusOK)
+}
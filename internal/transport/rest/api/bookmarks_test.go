@@ -0,0 +1,106 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	mockservice "github.com/Edbeer/restapi/internal/service/mock"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBookmarksHandler(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockBookmarksService := mockservice.NewMockBookmarks(ctrl)
+	bookmarksHandler := NewBookmarksHandler(mockBookmarksService, nil, apiLogger)
+
+	e := echo.New()
+	e.GET("/api/bookmarks", bookmarksHandler.GetBookmarks())
+	e.PUT("/api/bookmarks/:news_id", bookmarksHandler.AddBookmark())
+	e.GET("/api/reading-lists/:list_id", bookmarksHandler.GetList())
+	e.PUT("/api/reading-lists/:list_id/items/:news_id", bookmarksHandler.AddListItem())
+	e.PUT("/api/reading-lists/:list_id/order", bookmarksHandler.ReorderList())
+
+	t.Run("AddBookmark", func(t *testing.T) {
+		newsID := uuid.New()
+		mockBookmarksService.EXPECT().AddBookmark(gomock.Any(), newsID).Return(nil)
+
+		req := httptest.NewRequest(http.MethodPut, "/api/bookmarks/"+newsID.String(), nil)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("GetBookmarks", func(t *testing.T) {
+		newsList := &entity.NewsList{TotalCount: 1, News: []*entity.News{{NewsID: uuid.New(), Bookmarked: true}}}
+		mockBookmarksService.EXPECT().GetBookmarks(gomock.Any(), gomock.Any()).Return(newsList, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/bookmarks?page=1&size=10", nil)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusOK, res.Code)
+		result := &entity.NewsList{}
+		require.NoError(t, json.Unmarshal(res.Body.Bytes(), result))
+		require.True(t, result.News[0].Bookmarked)
+	})
+
+	t.Run("GetList", func(t *testing.T) {
+		listID := uuid.New()
+		mockBookmarksService.EXPECT().GetList(gomock.Any(), listID, gomock.Any()).Return(&entity.ReadingListNews{
+			List: &entity.ReadingList{ListID: listID, Name: "later", IsPublic: true},
+			News: &entity.NewsList{},
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/reading-lists/"+listID.String(), nil)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("AddListItem", func(t *testing.T) {
+		listID, newsID := uuid.New(), uuid.New()
+		mockBookmarksService.EXPECT().AddListItem(gomock.Any(), listID, newsID).Return(nil)
+
+		req := httptest.NewRequest(http.MethodPut, "/api/reading-lists/"+listID.String()+"/items/"+newsID.String(), nil)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("ReorderList", func(t *testing.T) {
+		listID, newsID := uuid.New(), uuid.New()
+		mockBookmarksService.EXPECT().ReorderList(gomock.Any(), listID, &entity.ReadingListOrder{NewsIDs: []uuid.UUID{newsID}}).Return(nil)
+
+		body := `{"news_ids":["` + newsID.String() + `"]}`
+		req := httptest.NewRequest(http.MethodPut, "/api/reading-lists/"+listID.String()+"/order", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("Invalid id", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPut, "/api/bookmarks/abc", nil)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
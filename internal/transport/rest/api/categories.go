@@ -0,0 +1,200 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/labstack/echo/v4"
+)
+
+// Categories service interface
+type CategoriesService interface {
+	Create(ctx context.Context, category *entity.Category) (*entity.Category, error)
+	Update(ctx context.Context, slug string, category *entity.Category) (*entity.Category, error)
+	Delete(ctx context.Context, slug string) error
+	GetBySlug(ctx context.Context, slug string) (*entity.Category, error)
+	GetTree(ctx context.Context) ([]*entity.Category, error)
+	GetNews(ctx context.Context, slug string, descendants bool, pq *utils.PaginationQuery) (*entity.NewsList, error)
+}
+
+// CategoriesHandler
+type CategoriesHandler struct {
+	categoriesService CategoriesService
+	config            *config.Config
+	logger            logger.Logger
+}
+
+// CategoriesHandler constructor
+func NewCategoriesHandler(categoriesService CategoriesService, config *config.Config, logger logger.Logger) *CategoriesHandler {
+	return &CategoriesHandler{
+		categoriesService: categoriesService,
+		config:            config,
+		logger:            logger,
+	}
+}
+
+// Create godoc
+// @Summary Create category
+// @Description Create category, admin only
+// @Tags Categories
+// @Accept json
+// @Produce json
+// @Param category body entity.Category true "category"
+// @Success 201 {object} entity.Category
+// @Failure 400 {object} httpe.RestError
+// @Router /categories [post]
+func (h *CategoriesHandler) Create() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		category := &entity.Category{}
+		if err := c.Bind(category); err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		createdCategory, err := h.categoriesService.Create(ctx, category)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusCreated, createdCategory)
+	}
+}
+
+// Update godoc
+// @Summary Update category
+// @Description Update category, admin only
+// @Tags Categories
+// @Accept json
+// @Produce json
+// @Param slug path string true "category slug"
+// @Param category body entity.Category true "category"
+// @Success 200 {object} entity.Category
+// @Failure 400 {object} httpe.RestError
+// @Router /categories/{slug} [put]
+func (h *CategoriesHandler) Update() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		category := &entity.Category{}
+		if err := c.Bind(category); err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		updatedCategory, err := h.categoriesService.Update(ctx, c.Param("slug"), category)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, updatedCategory)
+	}
+}
+
+// Delete godoc
+// @Summary Delete category
+// @Description Delete category without subcategories, admin only
+// @Tags Categories
+// @Accept json
+// @Produce json
+// @Param slug path string true "category slug"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} httpe.RestError
+// @Router /categories/{slug} [delete]
+func (h *CategoriesHandler) Delete() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		if err := h.categoriesService.Delete(ctx, c.Param("slug")); err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		return c.NoContent(http.StatusOK)
+	}
+}
+
+// GetTree godoc
+// @Summary Get categories
+// @Description Get categories tree ordered by position
+// @Tags Categories
+// @Accept json
+// @Produce json
+// @Success 200 {array} entity.Category
+// @Router /categories [get]
+func (h *CategoriesHandler) GetTree() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		categories, err := h.categoriesService.GetTree(ctx)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, categories)
+	}
+}
+
+// GetBySlug godoc
+// @Summary Get category
+// @Description Get category by slug
+// @Tags Categories
+// @Accept json
+// @Produce json
+// @Param slug path string true "category slug"
+// @Success 200 {object} entity.Category
+// @Failure 404 {object} httpe.RestError
+// @Router /categories/{slug} [get]
+func (h *CategoriesHandler) GetBySlug() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		category, err := h.categoriesService.GetBySlug(ctx, c.Param("slug"))
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, category)
+	}
+}
+
+// GetNews godoc
+// @Summary Get news by category
+// @Description Get published news of category, newest first
+// @Tags Categories
+// @Accept json
+// @Produce json
+// @Param slug path string true "category slug"
+// @Param descendants query bool false "include news of subcategories"
+// @Param page query int false "page number" Format(page)
+// @Param size query int false "number of elements per page" Format(size)
+// @Success 200 {object} entity.NewsList
+// @Router /categories/{slug}/news [get]
+func (h *CategoriesHandler) GetNews() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		pq, err := utils.GetPaginationFromCtx(c)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		var descendants bool
+		if value := c.QueryParam("descendants"); value != "" {
+			if descendants, err = strconv.ParseBool(value); err != nil {
+				return c.JSON(httpe.ErrorResponse(httpe.NewBadRequestError(err)))
+			}
+		}
+
+		newsList, err := h.categoriesService.GetNews(ctx, c.Param("slug"), descendants, pq)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, newsList)
+	}
+}
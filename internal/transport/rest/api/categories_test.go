@@ -0,0 +1,88 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	mockservice "github.com/Edbeer/restapi/internal/service/mock"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCategoriesHandler_Create(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockCategoriesService := mockservice.NewMockCategories(ctrl)
+	categoriesHandler := NewCategoriesHandler(mockCategoriesService, nil, apiLogger)
+
+	handlerFunc := categoriesHandler.Create()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/categories", strings.NewReader(`{"name":"Sport","position":1}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	res := httptest.NewRecorder()
+	e := echo.New()
+	ctx := e.NewContext(req, res)
+	ctxWithReqID := utils.GetRequestCtx(ctx)
+
+	category := &entity.Category{Name: "Sport", Position: 1}
+	mockCategoriesService.EXPECT().Create(ctxWithReqID, category).Return(&entity.Category{Name: "Sport", Slug: "sport"}, nil)
+
+	err := handlerFunc(ctx)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, res.Code)
+}
+
+func TestCategoriesHandler_GetNews(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockCategoriesService := mockservice.NewMockCategories(ctrl)
+	categoriesHandler := NewCategoriesHandler(mockCategoriesService, nil, apiLogger)
+
+	handlerFunc := categoriesHandler.GetNews()
+
+	t.Run("Descendants", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/categories/sport/news?descendants=true&page=1&size=5", nil)
+		res := httptest.NewRecorder()
+		e := echo.New()
+		ctx := e.NewContext(req, res)
+		ctx.SetParamNames("slug")
+		ctx.SetParamValues("sport")
+		ctxWithReqID := utils.GetRequestCtx(ctx)
+
+		mockCategoriesService.EXPECT().GetNews(ctxWithReqID, "sport", true, &utils.PaginationQuery{
+			Size: 5,
+			Page: 1,
+		}).Return(&entity.NewsList{}, nil)
+
+		err := handlerFunc(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("Invalid descendants", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/categories/sport/news?descendants=maybe", nil)
+		res := httptest.NewRecorder()
+		e := echo.New()
+		ctx := e.NewContext(req, res)
+		ctx.SetParamNames("slug")
+		ctx.SetParamValues("sport")
+
+		err := handlerFunc(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
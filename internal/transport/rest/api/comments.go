@@ -8,6 +8,7 @@ import (
 	"github.com/Edbeer/restapi/internal/entity"
 	"github.com/Edbeer/restapi/pkg/httpe"
 	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/markdown"
 	"github.com/Edbeer/restapi/pkg/utils"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -19,7 +20,7 @@ type CommentsService interface {
 	Update(ctx context.Context, comments *entity.Comment) (*entity.Comment, error)
 	GetAllByNewsID(ctx context.Context, newsID uuid.UUID, pq *utils.PaginationQuery) (*entity.CommentsList, error)
 	GetByID(ctx context.Context, commentID uuid.UUID) (*entity.CommentBase, error)
-	Delete(ctx context.Context, commentID uuid.UUID) error
+	Delete(ctx context.Context, commentID uuid.UUID, version int) error
 }
 
 // Comments Handler
@@ -73,35 +74,48 @@ func (h *CommentsHandler) Create() echo.HandlerFunc {
 // @Tags Comments
 // @Accept  json
 // @Produce  json
-// @Param id path int true "comment_id"
+// @Param comments_id path string true "comment id"
+// @Param If-Match header string false "ETag of the comment, required in strict mode"
 // @Success 200 {object} entity.Comment
+// @Header 200 {string} ETag "entity tag of the updated comment"
+// @Failure 412 {object} httpe.RestErr
+// @Failure 428 {object} httpe.RestErr
 // @Failure 500 {object} httpe.RestErr
-// @Router /comments/{id} [put]
+// @Router /comments/{comments_id} [put]
 func (h *CommentsHandler) Update() echo.HandlerFunc {
 	type UpdatedComment struct {
-		Message string `json:"message" db:"message" validate:"required,gte=0"`
+		Message string `json:"message" db:"message" validate:"required,gte=5"`
 		Likes   int64  `json:"likes" db:"likes" validate:"omitempty"`
 	}
 	return func(c echo.Context) error {
 		ctx := utils.GetRequestCtx(c)
 
-		commentUUID, err := uuid.Parse(c.Param("comment_id"))
+		commentUUID, err := uuid.Parse(c.Param("comments_id"))
 		if err != nil {
 			return c.JSON(httpe.ErrorResponse(err))
 		}
 
 		comm := &UpdatedComment{}
+		if err := utils.ReadRequest(c, comm); err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		version, err := utils.GetIfMatchVersion(c, h.config)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
 
 		updatedComment, err := h.commentsService.Update(ctx, &entity.Comment{
 			CommentID: commentUUID,
 			Message: comm.Message,
 			Likes: comm.Likes,
+			Version: version,
 		})
 		if err != nil {
 			return c.JSON(httpe.ErrorResponse(err))
 		}
 
-		return c.JSON(http.StatusOK, updatedComment)
+		return utils.JSONWithETag(c, http.StatusOK, updatedComment.Version, updatedComment)
 	}
 }
 
@@ -111,20 +125,28 @@ func (h *CommentsHandler) Update() echo.HandlerFunc {
 // @Tags Comments
 // @Accept  json
 // @Produce  json
-// @Param id path int true "comment_id"
+// @Param comments_id path string true "comment id"
+// @Param If-Match header string false "ETag of the comment, required in strict mode"
 // @Success 200 {string} string	"ok"
+// @Failure 412 {object} httpe.RestErr
+// @Failure 428 {object} httpe.RestErr
 // @Failure 500 {object} httpe.RestErr
-// @Router /comments/{id} [delete]
+// @Router /comments/{comments_id} [delete]
 func (h *CommentsHandler) Delete() echo.HandlerFunc {
 	return func(c echo.Context) error {
 		ctx := utils.GetRequestCtx(c)
 
-		commentUUID, err := uuid.Parse(c.Param("comment_id"))
+		commentUUID, err := uuid.Parse(c.Param("comments_id"))
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		version, err := utils.GetIfMatchVersion(c, h.config)
 		if err != nil {
 			return c.JSON(httpe.ErrorResponse(err))
 		}
 
-		if err := h.commentsService.Delete(ctx, commentUUID); err != nil {
+		if err := h.commentsService.Delete(ctx, commentUUID, version); err != nil {
 			return c.JSON(httpe.ErrorResponse(err))
 		}
 
@@ -138,15 +160,24 @@ func (h *CommentsHandler) Delete() echo.HandlerFunc {
 // @Tags Comments
 // @Accept  json
 // @Produce  json
-// @Param id path int true "comment_id"
+// @Param comments_id path string true "comment id"
+// @Param format query string false "message format: markdown, html or text"
+// @Param If-None-Match header string false "ETag of a cached copy"
 // @Success 200 {object} entity.Comment
+// @Success 304 {string} string "cached copy is current"
+// @Header 200 {string} ETag "entity tag of the comment"
 // @Failure 500 {object} httpe.RestErr
-// @Router /comments/{id} [get]
+// @Router /comments/{comments_id} [get]
 func (h *CommentsHandler) GetByID() echo.HandlerFunc {
 	return func(c echo.Context) error {
 		ctx := utils.GetRequestCtx(c)
 
-		commentID, err := uuid.Parse(c.Param("comment_id"))
+		commentID, err := uuid.Parse(c.Param("comments_id"))
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		format, err := utils.GetContentFormat(c)
 		if err != nil {
 			return c.JSON(httpe.ErrorResponse(err))
 		}
@@ -155,8 +186,9 @@ func (h *CommentsHandler) GetByID() echo.HandlerFunc {
 		if err != nil {
 			return c.JSON(httpe.ErrorResponse(err))
 		}
+		comment.Message, comment.MessageHTML = markdown.Format(format, comment.Message, comment.MessageHTML)
 
-		return c.JSON(http.StatusOK, comment)
+		return utils.JSONWithETag(c, http.StatusOK, comment.Version, comment, format)
 	}
 }
 
@@ -169,8 +201,13 @@ func (h *CommentsHandler) GetByID() echo.HandlerFunc {
 // @Param id path int true "news_id"
 // @Param page query int false "page number" Format(page)
 // @Param size query int false "number of elements per page" Format(size)
-// @Param orderBy query int false "filter name" Format(orderBy)
+// @Param cursor query string false "next_cursor or prev_cursor of another page"
+// @Param total query bool false "count total of the list"
+// @Param filter query string false "conditions field:operator:value separated by commas, e.g. category:eq:tech" Format(filter)
+// @Param sort query string false "fields separated by commas, descending with -, e.g. -created_at,title" Format(sort)
+// @Param format query string false "message format: markdown, html or text"
 // @Success 200 {object} entity.CommentsList
+// @Header 200 {string} Link "RFC 8288 links of next and prev pages"
 // @Failure 500 {object} httpe.RestErr
 // @Router /comments/byNewsId/{id} [get]
 func (h *CommentsHandler) GetAllByNewsID() echo.HandlerFunc {
@@ -187,11 +224,20 @@ func (h *CommentsHandler) GetAllByNewsID() echo.HandlerFunc {
 			return c.JSON(httpe.ErrorResponse(err))
 		}
 
+		format, err := utils.GetContentFormat(c)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
 		comentsList, err := h.commentsService.GetAllByNewsID(ctx, newsID, pq)
 		if err != nil {
 			return c.JSON(httpe.ErrorResponse(err))
 		}
+		for _, comment := range comentsList.Comments {
+			comment.Message, comment.MessageHTML = markdown.Format(format, comment.Message, comment.MessageHTML)
+		}
 
+		utils.SetCursorLinks(c, comentsList.NextCursor, comentsList.PrevCursor)
 		return c.JSON(http.StatusOK, comentsList)
 	}
 }
\ No newline at end of file
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/Edbeer/restapi/config"
 	"github.com/Edbeer/restapi/internal/entity"
 	"github.com/Edbeer/restapi/internal/service"
 	mockservice "github.com/Edbeer/restapi/internal/service/mock"
@@ -91,7 +92,7 @@ func TestHandler_GetByID(t *testing.T) {
 	w := httptest.NewRecorder()
 	e := echo.New()
 	c := e.NewContext(r, w)
-	c.SetParamNames("comment_id")
+	c.SetParamNames("comments_id")
 	c.SetParamValues("5c9a9d67-ad38-499c-9858-086bfdeaf7d2")
 	
 	comm := &entity.CommentBase{}
@@ -131,12 +132,100 @@ func TestHandlers_Delete(t *testing.T) {
 	r = r.WithContext(ctxWithValue)
 	e := echo.New()
 	c := e.NewContext(r, w)
-	c.SetParamNames("comment_id")
+	c.SetParamNames("comments_id")
 	c.SetParamValues(commID.String())
 
 	mockCommentsService.EXPECT().GetByID(gomock.Any(), commID).Return(comm, nil)
-	mockCommentsService.EXPECT().Delete(gomock.Any(), commID).Return(nil)
+	mockCommentsService.EXPECT().Delete(gomock.Any(), commID, 0).Return(nil)
 
 	err := handlerFunc(c)
 	require.NoError(t, err)
 }
+
+func TestHandlers_CommentsIfMatch(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockCommentsService := mockservice.NewMockComments(ctrl)
+	commentsService := service.NewCommentsService(nil, mockCommentsService, apiLogger)
+	commHandlers := NewCommentsHandler(commentsService, &config.Config{Server: config.ServerConfig{RequireIfMatch: true}}, apiLogger)
+
+	e := echo.New()
+	e.GET("/api/comments/:comments_id", commHandlers.GetByID())
+	e.PUT("/api/comments/:comments_id", commHandlers.Update())
+	e.DELETE("/api/comments/:comments_id", commHandlers.Delete())
+
+	userID := uuid.New()
+	commID := uuid.New()
+	target := "/api/comments/" + commID.String()
+	comm := &entity.CommentBase{
+		CommentID: commID,
+		AuthorID:  userID,
+		Message:   "message",
+		Version:   2,
+	}
+	ctxWithUser := context.WithValue(context.Background(), utils.UserCtxKey{}, &entity.User{ID: userID})
+
+	mockCommentsService.EXPECT().GetByID(gomock.Any(), commID).Return(comm, nil)
+	r := httptest.NewRequest(http.MethodGet, target, nil)
+	w := httptest.NewRecorder()
+	e.ServeHTTP(w, r)
+	require.Equal(t, http.StatusOK, w.Code)
+	etag := w.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	t.Run("Update", func(t *testing.T) {
+		mockCommentsService.EXPECT().GetByID(gomock.Any(), commID).Return(comm, nil)
+		mockCommentsService.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, comment *entity.Comment) (*entity.Comment, error) {
+				require.Equal(t, commID, comment.CommentID)
+				require.Equal(t, "updated message", comment.Message)
+				require.Equal(t, 2, comment.Version)
+				comment.Version++
+				return comment, nil
+			})
+
+		r := httptest.NewRequest(http.MethodPut, target, strings.NewReader(`{"message":"updated message"}`))
+		r.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		r.Header.Set("If-Match", etag)
+		r = r.WithContext(ctxWithUser)
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, r)
+		require.Equal(t, http.StatusOK, w.Code)
+		require.NotEqual(t, etag, w.Header().Get("ETag"))
+	})
+
+	t.Run("Update invalid body", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodPut, target, strings.NewReader(`{"message":""}`))
+		r.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		r.Header.Set("If-Match", etag)
+		r = r.WithContext(ctxWithUser)
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, r)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Update without If-Match", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodPut, target, strings.NewReader(`{"message":"updated message"}`))
+		r.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		r = r.WithContext(ctxWithUser)
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, r)
+		require.Equal(t, http.StatusPreconditionRequired, w.Code)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		mockCommentsService.EXPECT().GetByID(gomock.Any(), commID).Return(comm, nil)
+		mockCommentsService.EXPECT().Delete(gomock.Any(), commID, 2).Return(nil)
+
+		r := httptest.NewRequest(http.MethodDelete, target, nil)
+		r.Header.Set("If-Match", etag)
+		r = r.WithContext(ctxWithUser)
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, r)
+		require.Equal(t, http.StatusOK, w.Code)
+	})
+}
@@ -0,0 +1,176 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// Contributors service interface
+type ContributorsService interface {
+	GetContributors(ctx context.Context, newsID uuid.UUID) ([]*entity.NewsContributor, error)
+	Invite(ctx context.Context, contributor *entity.NewsContributor) (*entity.NewsContributor, error)
+	Accept(ctx context.Context, newsID uuid.UUID) (*entity.NewsContributor, error)
+	Remove(ctx context.Context, newsID uuid.UUID, userID uuid.UUID) error
+	GetInvitations(ctx context.Context) ([]*entity.NewsContributor, error)
+}
+
+// ContributorsHandler
+type ContributorsHandler struct {
+	contributorsService ContributorsService
+	config              *config.Config
+	logger              logger.Logger
+}
+
+// ContributorsHandler constructor
+func NewContributorsHandler(contributorsService ContributorsService, config *config.Config, logger logger.Logger) *ContributorsHandler {
+	return &ContributorsHandler{
+		contributorsService: contributorsService,
+		config:              config,
+		logger:              logger,
+	}
+}
+
+// GetContributors godoc
+// @Summary Get news contributors
+// @Description Get co-authors and other contributors of news, pending invitations are visible to the authors only
+// @Tags News
+// @Produce json
+// @Param news_id path string true "news id"
+// @Success 200 {array} entity.NewsContributor
+// @Failure 404 {object} httpe.RestError
+// @Router /news/{news_id}/contributors [get]
+func (h *ContributorsHandler) GetContributors() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		newsID, err := uuid.Parse(c.Param("news_id"))
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		contributors, err := h.contributorsService.GetContributors(ctx, newsID)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		return c.JSON(http.StatusOK, contributors)
+	}
+}
+
+// Invite godoc
+// @Summary Invite contributor
+// @Description Invite user to contribute to news as author, editor or photographer, only the author of news can invite. Contributors edit news once they accept.
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param news_id path string true "news id"
+// @Param contributor body entity.NewsContributor true "user id and role"
+// @Success 201 {object} entity.NewsContributor
+// @Failure 400 {object} httpe.RestError
+// @Failure 403 {object} httpe.RestError
+// @Router /news/{news_id}/contributors [post]
+func (h *ContributorsHandler) Invite() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		newsID, err := uuid.Parse(c.Param("news_id"))
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		contributor := &entity.NewsContributor{}
+		if err := c.Bind(contributor); err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		contributor.NewsID = newsID
+
+		invited, err := h.contributorsService.Invite(ctx, contributor)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		return c.JSON(http.StatusCreated, invited)
+	}
+}
+
+// Accept godoc
+// @Summary Accept invitation
+// @Description Accept invitation of current user to contribute to news
+// @Tags News
+// @Produce json
+// @Param news_id path string true "news id"
+// @Success 200 {object} entity.NewsContributor
+// @Failure 404 {object} httpe.RestError
+// @Router /news/{news_id}/contributors/accept [post]
+func (h *ContributorsHandler) Accept() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		newsID, err := uuid.Parse(c.Param("news_id"))
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		contributor, err := h.contributorsService.Accept(ctx, newsID)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		return c.JSON(http.StatusOK, contributor)
+	}
+}
+
+// Remove godoc
+// @Summary Remove contributor
+// @Description Remove contributor or invitation of news by the author, contributors decline invitations or leave themselves
+// @Tags News
+// @Param news_id path string true "news id"
+// @Param user_id path string true "user id"
+// @Success 200 {string} string	"ok"
+// @Failure 403 {object} httpe.RestError
+// @Failure 404 {object} httpe.RestError
+// @Router /news/{news_id}/contributors/{user_id} [delete]
+func (h *ContributorsHandler) Remove() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		newsID, err := uuid.Parse(c.Param("news_id"))
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		userID, err := uuid.Parse(c.Param("user_id"))
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		if err := h.contributorsService.Remove(ctx, newsID, userID); err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		return c.NoContent(http.StatusOK)
+	}
+}
+
+// GetInvitations godoc
+// @Summary Get invitations
+// @Description Get pending invitations of current user to contribute to news, newest first
+// @Tags News
+// @Produce json
+// @Success 200 {array} entity.NewsContributor
+// @Failure 401 {object} httpe.RestError
+// @Router /invitations [get]
+func (h *ContributorsHandler) GetInvitations() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		invitations, err := h.contributorsService.GetInvitations(ctx)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		return c.JSON(http.StatusOK, invitations)
+	}
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	mockservice "github.com/Edbeer/restapi/internal/service/mock"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContributorsHandler(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockContributorsService := mockservice.NewMockContributors(ctrl)
+	contributorsHandler := NewContributorsHandler(mockContributorsService, nil, apiLogger)
+
+	e := echo.New()
+	e.POST("/api/news/:news_id/contributors", contributorsHandler.Invite())
+	e.POST("/api/news/:news_id/contributors/accept", contributorsHandler.Accept())
+	e.DELETE("/api/news/:news_id/contributors/:user_id", contributorsHandler.Remove())
+
+	newsID, userID := uuid.New(), uuid.New()
+
+	t.Run("Invite", func(t *testing.T) {
+		contributor := &entity.NewsContributor{NewsID: newsID, UserID: userID, Role: entity.ContributorEditor}
+		invited := &entity.NewsContributor{NewsID: newsID, UserID: userID, Role: entity.ContributorEditor, Status: entity.ContributorInvited}
+		mockContributorsService.EXPECT().Invite(gomock.Any(), contributor).Return(invited, nil)
+
+		body := `{"user_id": "` + userID.String() + `", "role": "editor"}`
+		req := httptest.NewRequest(http.MethodPost, "/api/news/"+newsID.String()+"/contributors", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusCreated, res.Code)
+		result := &entity.NewsContributor{}
+		require.NoError(t, json.Unmarshal(res.Body.Bytes(), result))
+		require.Equal(t, entity.ContributorInvited, result.Status)
+	})
+
+	t.Run("Accept", func(t *testing.T) {
+		accepted := &entity.NewsContributor{NewsID: newsID, UserID: userID, Status: entity.ContributorAccepted}
+		mockContributorsService.EXPECT().Accept(gomock.Any(), newsID).Return(accepted, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/news/"+newsID.String()+"/contributors/accept", nil)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		mockContributorsService.EXPECT().Remove(gomock.Any(), newsID, userID).Return(nil)
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/news/"+newsID.String()+"/contributors/"+userID.String(), nil)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusOK, res.Code)
+	})
+}
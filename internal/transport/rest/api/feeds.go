@@ -0,0 +1,97 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/labstack/echo/v4"
+)
+
+// Feeds service interface
+type FeedsService interface {
+	GetFeed(ctx context.Context, query *entity.FeedQuery) (*entity.FeedDocument, error)
+}
+
+// FeedsHandler
+type FeedsHandler struct {
+	feedsService FeedsService
+	config       *config.Config
+	logger       logger.Logger
+}
+
+// FeedsHandler constructor
+func NewFeedsHandler(feedsService FeedsService, config *config.Config, logger logger.Logger) *FeedsHandler {
+	return &FeedsHandler{
+		feedsService: feedsService,
+		config:       config,
+		logger:       logger,
+	}
+}
+
+// Feed of all published news: /feeds/news.{rss|atom|json}
+func (h *FeedsHandler) GetNewsFeed() echo.HandlerFunc {
+	return h.getFeed(entity.FeedAll, "")
+}
+
+// Feed of category and its subcategories: /feeds/categories/{slug}/news.{format}
+func (h *FeedsHandler) GetCategoryFeed() echo.HandlerFunc {
+	return h.getFeed(entity.FeedCategory, "slug")
+}
+
+// Feed of tag: /feeds/tags/{slug}/news.{format}
+func (h *FeedsHandler) GetTagFeed() echo.HandlerFunc {
+	return h.getFeed(entity.FeedTag, "slug")
+}
+
+// Feed of author: /feeds/authors/{author_id}/news.{format}
+func (h *FeedsHandler) GetAuthorFeed() echo.HandlerFunc {
+	return h.getFeed(entity.FeedAuthor, "author_id")
+}
+
+func (h *FeedsHandler) getFeed(kind string, keyParam string) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		query := &entity.FeedQuery{
+			Kind:   kind,
+			Format: c.Param("format"),
+		}
+		if keyParam != "" {
+			query.Key = c.Param(keyParam)
+		}
+
+		document, err := h.feedsService.GetFeed(ctx, query)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		header := c.Response().Header()
+		header.Set("ETag", document.ETag)
+		header.Set("Last-Modified", document.LastModified.UTC().Format(http.TimeFormat))
+		header.Set("Cache-Control", "public, max-age=60")
+		if isFeedNotModified(c.Request(), document) {
+			return c.NoContent(http.StatusNotModified)
+		}
+
+		return c.Blob(http.StatusOK, document.ContentType, document.Body)
+	}
+}
+
+// If-None-Match wins over If-Modified-Since as in RFC 7232
+func isFeedNotModified(r *http.Request, document *entity.FeedDocument) bool {
+	if match := r.Header.Get("If-None-Match"); match != "" {
+		return utils.NoneMatch(match, document.ETag)
+	}
+
+	since, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+	return !document.LastModified.Truncate(time.Second).After(since)
+}
@@ -0,0 +1,79 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	mockservice "github.com/Edbeer/restapi/internal/service/mock"
+	"github.com/Edbeer/restapi/pkg/feed"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeedsHandler_GetTagFeed(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockFeedsService := mockservice.NewMockFeeds(ctrl)
+	feedsHandler := NewFeedsHandler(mockFeedsService, nil, apiLogger)
+
+	e := echo.New()
+	e.GET("/feeds/tags/:slug/news.:format", feedsHandler.GetTagFeed())
+
+	lastModified := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	document := &entity.FeedDocument{
+		Body:         []byte("<rss></rss>"),
+		ContentType:  feed.ContentTypeRSS,
+		ETag:         `"abc"`,
+		LastModified: lastModified,
+	}
+	mockFeedsService.EXPECT().GetFeed(gomock.Any(), &entity.FeedQuery{
+		Kind:   entity.FeedTag,
+		Key:    "golang",
+		Format: feed.FormatRSS,
+	}).Return(document, nil).Times(4)
+
+	t.Run("OK", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/feeds/tags/golang/news.rss", nil)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusOK, res.Code)
+		require.Equal(t, feed.ContentTypeRSS, res.Header().Get(echo.HeaderContentType))
+		require.Equal(t, `"abc"`, res.Header().Get("ETag"))
+		require.Equal(t, "Thu, 01 Oct 2026 12:00:00 GMT", res.Header().Get("Last-Modified"))
+		require.Equal(t, "<rss></rss>", res.Body.String())
+	})
+
+	t.Run("IfNoneMatch", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/feeds/tags/golang/news.rss", nil)
+		req.Header.Set("If-None-Match", `"old", W/"abc"`)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusNotModified, res.Code)
+		require.Empty(t, res.Body.String())
+	})
+
+	t.Run("IfModifiedSince", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/feeds/tags/golang/news.rss", nil)
+		req.Header.Set("If-Modified-Since", "Thu, 01 Oct 2026 12:00:00 GMT")
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+		require.Equal(t, http.StatusNotModified, res.Code)
+
+		req = httptest.NewRequest(http.MethodGet, "/feeds/tags/golang/news.rss", nil)
+		req.Header.Set("If-Modified-Since", "Thu, 01 Oct 2026 11:00:00 GMT")
+		res = httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+		require.Equal(t, http.StatusOK, res.Code)
+	})
+}
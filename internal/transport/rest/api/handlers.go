@@ -13,25 +13,70 @@ import (
 )
 
 type Deps struct {
-	AuthService     AuthService
-	NewsService     NewsService
-	CommentsService CommentsService
-	SessionService 	SessionService
-	Config          *config.Config
-	Logger          logger.Logger
+	AuthService          AuthService
+	NewsService          NewsService
+	CommentsService      CommentsService
+	SessionService       SessionService
+	SuggestService       SuggestService
+	TagsService          TagsService
+	CategoriesService    CategoriesService
+	FeedsService         FeedsService
+	SitemapsService      SitemapsService
+	PagesService         PagesService
+	ViewsService         ViewsService
+	ReactionsService     ReactionsService
+	BookmarksService     BookmarksService
+	RelatedService       RelatedService
+	TranslationsService  TranslationsService
+	NotificationsService NotificationsService
+	ContributorsService  ContributorsService
+	TransferService      TransferService
+	WordPressService     WordPressService
+	Config               *config.Config
+	Logger               logger.Logger
 }
 
 type Handlers struct {
-	auth     *AuthHandler
-	news     *NewsHandler
-	comments *CommentsHandler
+	auth          *AuthHandler
+	news          *NewsHandler
+	comments      *CommentsHandler
+	suggest       *SuggestHandler
+	tags          *TagsHandler
+	categories    *CategoriesHandler
+	feeds         *FeedsHandler
+	sitemaps      *SitemapsHandler
+	pages         *PagesHandler
+	views         *ViewsHandler
+	reactions     *ReactionsHandler
+	bookmarks     *BookmarksHandler
+	related       *RelatedHandler
+	translations  *TranslationsHandler
+	notifications *NotificationsHandler
+	contributors  *ContributorsHandler
+	transfer      *TransferHandler
+	wordPress     *WordPressHandler
 }
 
 func NewHandlers(deps Deps) *Handlers {
 	return &Handlers{
-		auth:     NewAuthHandler(deps.Config, deps.AuthService, deps.SessionService, deps.Logger),
-		news:     NewNewsHandler(deps.NewsService, deps.Config, deps.Logger),
-		comments: NewCommentsHandler(deps.CommentsService, deps.Config, deps.Logger),
+		auth:          NewAuthHandler(deps.Config, deps.AuthService, deps.SessionService, deps.Logger),
+		news:          NewNewsHandler(deps.NewsService, deps.Config, deps.Logger),
+		comments:      NewCommentsHandler(deps.CommentsService, deps.Config, deps.Logger),
+		suggest:       NewSuggestHandler(deps.SuggestService, deps.Config, deps.Logger),
+		tags:          NewTagsHandler(deps.TagsService, deps.Config, deps.Logger),
+		categories:    NewCategoriesHandler(deps.CategoriesService, deps.Config, deps.Logger),
+		feeds:         NewFeedsHandler(deps.FeedsService, deps.Config, deps.Logger),
+		sitemaps:      NewSitemapsHandler(deps.SitemapsService, deps.Config, deps.Logger),
+		pages:         NewPagesHandler(deps.PagesService, deps.Config, deps.Logger),
+		views:         NewViewsHandler(deps.ViewsService, deps.Config, deps.Logger),
+		reactions:     NewReactionsHandler(deps.ReactionsService, deps.Config, deps.Logger),
+		bookmarks:     NewBookmarksHandler(deps.BookmarksService, deps.Config, deps.Logger),
+		related:       NewRelatedHandler(deps.RelatedService, deps.Config, deps.Logger),
+		translations:  NewTranslationsHandler(deps.TranslationsService, deps.Config, deps.Logger),
+		notifications: NewNotificationsHandler(deps.NotificationsService, deps.Config, deps.Logger),
+		contributors:  NewContributorsHandler(deps.ContributorsService, deps.Config, deps.Logger),
+		transfer:      NewTransferHandler(deps.TransferService, deps.Config, deps.Logger),
+		wordPress:     NewWordPressHandler(deps.WordPressService, deps.Config, deps.Logger),
 	}
 }
 
@@ -46,7 +91,9 @@ func (h *Handlers) Init(e *echo.Echo) error {
 
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins: []string{"*"},
-		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderXRequestID, csrf.CSRFHeader},
+		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderXRequestID, csrf.CSRFHeader,
+			"If-Match", "If-None-Match"},
+		ExposeHeaders: []string{"ETag"},
 	}))
 	e.Use(middleware.GzipWithConfig(middleware.GzipConfig{
 		Level: 5,
@@ -66,6 +113,9 @@ func (h *Handlers) Init(e *echo.Echo) error {
 	e.GET("/swagger/*", echoSwagger.WrapHandler)
 	
 	h.initApi(e, mw)
+	h.initFeeds(e)
+	h.initSitemaps(e)
+	h.initPages(e)
 
 	return nil
 }
@@ -79,10 +129,10 @@ func (h *Handlers) initApi(e *echo.Echo, mw *middle.MiddlewareManager) {
 			auth.POST("/login", h.auth.Login())
 			auth.POST("/logout", h.auth.Logout())
 			auth.GET("/:user_id", h.auth.GetUserByID())
-			auth.GET("/find", h.auth.FindUsersByName())
 			auth.GET("/all", h.auth.GetUsers())
 			auth.Use(mw.AuthSessionMiddleware)
 			auth.GET("/token", h.auth.GetCSRFToken())
+			auth.GET("/find", h.auth.FindUsersByName(), mw.RoleBasedAuthMiddleware([]string{"admin"}))
 			auth.PUT("/:user_id", h.auth.Update(), mw.OwnerOrAdminMiddleware(), mw.CSRF)
 			auth.DELETE("/:user_id", h.auth.Delete(), mw.RoleBasedAuthMiddleware([]string{"admin"}))
 			auth.GET("/me", h.auth.GetMe())
@@ -93,18 +143,126 @@ func (h *Handlers) initApi(e *echo.Echo, mw *middle.MiddlewareManager) {
 			news.POST("/create", h.news.Create(), mw.AuthSessionMiddleware, mw.CSRF)
 			news.PUT("/:news_id", h.news.Update(), mw.AuthSessionMiddleware, mw.CSRF)
 			news.DELETE("/:news_id", h.news.Delete(), mw.AuthSessionMiddleware, mw.CSRF)
-			news.GET("/all", h.news.GetNews())
-			news.GET("/:news_id", h.news.GetNewsByID())
-			news.GET("/search", h.news.SearchNews())
+			news.GET("/all", h.news.GetNews(), mw.OptionalAuthSessionMiddleware)
+			news.GET("/:news_id", h.news.GetNewsByID(), mw.OptionalAuthSessionMiddleware)
+			news.GET("/search", h.news.SearchNews(), mw.OptionalAuthSessionMiddleware)
+			news.GET("/export", h.transfer.Export(), mw.AuthSessionMiddleware, mw.RoleBasedAuthMiddleware([]string{"admin"}))
+			news.POST("/import", h.transfer.Import(), mw.AuthSessionMiddleware, mw.RoleBasedAuthMiddleware([]string{"admin"}), mw.CSRF)
+			news.POST("/import/wordpress", h.wordPress.Import(), mw.AuthSessionMiddleware, mw.RoleBasedAuthMiddleware([]string{"admin"}), mw.CSRF)
+			news.GET("/trending", h.views.GetTrending())
+			news.GET("/:news_id/related", h.related.GetRelated())
+			news.GET("/:news_id/translations", h.translations.GetTranslations(), mw.OptionalAuthSessionMiddleware)
+			news.POST("/:news_id/translations", h.translations.Create(), mw.AuthSessionMiddleware, mw.CSRF)
+			news.PUT("/:news_id/translations/:locale", h.translations.Update(), mw.AuthSessionMiddleware, mw.CSRF)
+			news.DELETE("/:news_id/translations/:locale", h.translations.Delete(), mw.AuthSessionMiddleware, mw.CSRF)
+			news.GET("/by-slug/:slug", h.news.GetNewsBySlug(), mw.OptionalAuthSessionMiddleware)
+			news.GET("/:news_id/revisions", h.news.GetRevisions(), mw.OptionalAuthSessionMiddleware)
+			news.GET("/:news_id/revisions/diff", h.news.DiffRevisions(), mw.OptionalAuthSessionMiddleware)
+			news.GET("/:news_id/revisions/:revision", h.news.GetRevision(), mw.OptionalAuthSessionMiddleware)
+			news.POST("/:news_id/revisions/:revision/rollback", h.news.RollbackRevision(), mw.AuthSessionMiddleware, mw.CSRF)
+			news.GET("/review-queue", h.news.GetReviewQueue(), mw.AuthSessionMiddleware, mw.RoleBasedAuthMiddleware([]string{"admin", "editor"}))
+			news.POST("/:news_id/submit", h.news.SubmitForReview(), mw.AuthSessionMiddleware, mw.CSRF)
+			news.POST("/:news_id/review", h.news.ReviewNews(), mw.AuthSessionMiddleware, mw.RoleBasedAuthMiddleware([]string{"admin", "editor"}), mw.CSRF)
+			news.GET("/:news_id/reviews", h.news.GetReviews(), mw.OptionalAuthSessionMiddleware)
+			news.GET("/:news_id/contributors", h.contributors.GetContributors(), mw.OptionalAuthSessionMiddleware)
+			news.POST("/:news_id/contributors", h.contributors.Invite(), mw.AuthSessionMiddleware, mw.CSRF)
+			news.POST("/:news_id/contributors/accept", h.contributors.Accept(), mw.AuthSessionMiddleware, mw.CSRF)
+			news.DELETE("/:news_id/contributors/:user_id", h.contributors.Remove(), mw.AuthSessionMiddleware, mw.CSRF)
+			news.GET("/:news_id/reactions", h.reactions.GetNewsReactions(), mw.OptionalAuthSessionMiddleware)
+			news.PUT("/:news_id/reactions/:kind", h.reactions.ReactNews(), mw.AuthSessionMiddleware, mw.CSRF)
+			news.DELETE("/:news_id/reactions/:kind", h.reactions.UnreactNews(), mw.AuthSessionMiddleware, mw.CSRF)
 		}
 
 		comments := api.Group("/comments")
 		{
 			comments.POST("", h.comments.Create(), mw.AuthSessionMiddleware, mw.CSRF)
 			comments.PUT("/:comments_id", h.comments.Update(), mw.AuthSessionMiddleware, mw.CSRF)
-			comments.DELETE("/delete", h.comments.Delete(), mw.AuthSessionMiddleware, mw.CSRF)
+			comments.DELETE("/:comments_id", h.comments.Delete(), mw.AuthSessionMiddleware, mw.CSRF)
 			comments.GET("/:comments_id", h.comments.GetByID())
 			comments.GET("/byNewsID/:news_id", h.comments.GetAllByNewsID())
+			comments.GET("/:comments_id/reactions", h.reactions.GetCommentReactions(), mw.OptionalAuthSessionMiddleware)
+			comments.PUT("/:comments_id/reactions/:kind", h.reactions.ReactComment(), mw.AuthSessionMiddleware, mw.CSRF)
+			comments.DELETE("/:comments_id/reactions/:kind", h.reactions.UnreactComment(), mw.AuthSessionMiddleware, mw.CSRF)
+		}
+
+		suggest := api.Group("/suggest")
+		{
+			suggest.GET("", h.suggest.Suggest())
+			suggest.POST("/rebuild", h.suggest.Rebuild(), mw.AuthSessionMiddleware, mw.RoleBasedAuthMiddleware([]string{"admin"}), mw.CSRF)
+		}
+
+		tags := api.Group("/tags")
+		{
+			tags.GET("", h.tags.GetTags())
+			tags.GET("/:slug/news", h.tags.GetNewsByTag())
+			tags.PUT("/:slug", h.tags.RenameTag(), mw.AuthSessionMiddleware, mw.RoleBasedAuthMiddleware([]string{"admin"}), mw.CSRF)
+			tags.POST("/:slug/merge", h.tags.MergeTags(), mw.AuthSessionMiddleware, mw.RoleBasedAuthMiddleware([]string{"admin"}), mw.CSRF)
+		}
+
+		categories := api.Group("/categories")
+		{
+			categories.GET("", h.categories.GetTree())
+			categories.GET("/:slug", h.categories.GetBySlug())
+			categories.GET("/:slug/news", h.categories.GetNews())
+			categories.POST("", h.categories.Create(), mw.AuthSessionMiddleware, mw.RoleBasedAuthMiddleware([]string{"admin"}), mw.CSRF)
+			categories.PUT("/:slug", h.categories.Update(), mw.AuthSessionMiddleware, mw.RoleBasedAuthMiddleware([]string{"admin"}), mw.CSRF)
+			categories.DELETE("/:slug", h.categories.Delete(), mw.AuthSessionMiddleware, mw.RoleBasedAuthMiddleware([]string{"admin"}), mw.CSRF)
+		}
+
+		bookmarks := api.Group("/bookmarks")
+		{
+			bookmarks.GET("", h.bookmarks.GetBookmarks(), mw.AuthSessionMiddleware)
+			bookmarks.PUT("/:news_id", h.bookmarks.AddBookmark(), mw.AuthSessionMiddleware, mw.CSRF)
+			bookmarks.DELETE("/:news_id", h.bookmarks.RemoveBookmark(), mw.AuthSessionMiddleware, mw.CSRF)
+		}
+
+		readingLists := api.Group("/reading-lists")
+		{
+			readingLists.GET("", h.bookmarks.GetLists(), mw.AuthSessionMiddleware)
+			readingLists.POST("", h.bookmarks.CreateList(), mw.AuthSessionMiddleware, mw.CSRF)
+			readingLists.GET("/:list_id", h.bookmarks.GetList(), mw.OptionalAuthSessionMiddleware)
+			readingLists.PUT("/:list_id", h.bookmarks.UpdateList(), mw.AuthSessionMiddleware, mw.CSRF)
+			readingLists.DELETE("/:list_id", h.bookmarks.DeleteList(), mw.AuthSessionMiddleware, mw.CSRF)
+			readingLists.PUT("/:list_id/items/:news_id", h.bookmarks.AddListItem(), mw.AuthSessionMiddleware, mw.CSRF)
+			readingLists.DELETE("/:list_id/items/:news_id", h.bookmarks.RemoveListItem(), mw.AuthSessionMiddleware, mw.CSRF)
+			readingLists.PUT("/:list_id/order", h.bookmarks.ReorderList(), mw.AuthSessionMiddleware, mw.CSRF)
+		}
+
+		notifications := api.Group("/notifications")
+		{
+			notifications.GET("", h.notifications.GetNotifications(), mw.AuthSessionMiddleware)
+			notifications.PUT("/read", h.notifications.MarkAllRead(), mw.AuthSessionMiddleware, mw.CSRF)
+			notifications.PUT("/:notification_id/read", h.notifications.MarkRead(), mw.AuthSessionMiddleware, mw.CSRF)
+		}
+
+		invitations := api.Group("/invitations")
+		{
+			invitations.GET("", h.contributors.GetInvitations(), mw.AuthSessionMiddleware)
 		}
 	}
 }
+
+func (h *Handlers) initFeeds(e *echo.Echo) {
+	feeds := e.Group("/feeds")
+	{
+		feeds.GET("/news.:format", h.feeds.GetNewsFeed())
+		feeds.GET("/categories/:slug/news.:format", h.feeds.GetCategoryFeed())
+		feeds.GET("/tags/:slug/news.:format", h.feeds.GetTagFeed())
+		feeds.GET("/authors/:author_id/news.:format", h.feeds.GetAuthorFeed())
+	}
+}
+
+func (h *Handlers) initSitemaps(e *echo.Echo) {
+	e.GET("/sitemap.xml", h.sitemaps.GetIndex())
+	sitemaps := e.Group("/sitemaps")
+	{
+		sitemaps.GET("/latest.xml", h.sitemaps.GetLatest())
+		sitemaps.GET("/news/:page", h.sitemaps.GetPage())
+	}
+}
+
+func (h *Handlers) initPages(e *echo.Echo) {
+	e.GET("/news", h.pages.GetList())
+	e.GET("/news/:slug", h.pages.GetArticle())
+	e.GET("/categories/:slug", h.pages.GetCategory())
+}
@@ -3,11 +3,13 @@ package api
 import (
 	"context"
 	"net/http"
+	"net/url"
 
 	"github.com/Edbeer/restapi/config"
 	"github.com/Edbeer/restapi/internal/entity"
 	"github.com/Edbeer/restapi/pkg/httpe"
 	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/markdown"
 	"github.com/Edbeer/restapi/pkg/utils"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -19,8 +21,18 @@ type NewsService interface {
 	Update(ctx context.Context, news *entity.News) (*entity.News, error)
 	GetNews(ctx context.Context, pq *utils.PaginationQuery) (*entity.NewsList, error)
 	GetNewsByID(ctx context.Context, newsID uuid.UUID) (*entity.NewsBase, error)
-	SearchNews(ctx context.Context, title string, pq *utils.PaginationQuery) (*entity.NewsList, error)
-	Delete(ctx context.Context, newsID uuid.UUID) error
+	GetNewsBySlug(ctx context.Context, slug string) (*entity.NewsBase, error)
+	SearchNews(ctx context.Context, search *entity.NewsSearchQuery, pq *utils.PaginationQuery) (*entity.NewsSearchList, error)
+	IncludeRelations(ctx context.Context, newsList []*entity.News, include []string) error
+	Delete(ctx context.Context, newsID uuid.UUID, version int) error
+	GetRevisions(ctx context.Context, newsID uuid.UUID, pq *utils.PaginationQuery) (*entity.NewsRevisionsList, error)
+	GetRevision(ctx context.Context, newsID uuid.UUID, revision int) (*entity.NewsRevision, error)
+	DiffRevisions(ctx context.Context, newsID uuid.UUID, from, to int) (*entity.NewsRevisionDiff, error)
+	RollbackRevision(ctx context.Context, newsID uuid.UUID, revision int) (*entity.News, error)
+	SubmitForReview(ctx context.Context, newsID uuid.UUID, comment string) (*entity.News, error)
+	ReviewNews(ctx context.Context, review *entity.NewsReview) (*entity.News, error)
+	GetReviewQueue(ctx context.Context, pq *utils.PaginationQuery) (*entity.NewsList, error)
+	GetReviews(ctx context.Context, newsID uuid.UUID) ([]*entity.NewsReview, error)
 }
 
 // NewsHandler
@@ -67,12 +79,17 @@ func (h *NewsHandler) Create() echo.HandlerFunc {
 
 // Update godoc
 // @Summary Update news
-// @Description Update news handler
+// @Description Update news. Edits of published or scheduled news by contributors move it to pending_review,
+// @Description so it is offline until an editor approves it again, editors' edits go live right away.
 // @Tags News
 // @Accept json
 // @Produce json
 // @Param id path int true "news_id"
+// @Param If-Match header string false "ETag of the news, required in strict mode"
 // @Success 200 {object} entity.News
+// @Header 200 {string} ETag "entity tag of the updated news"
+// @Failure 412 {object} httpe.RestError
+// @Failure 428 {object} httpe.RestError
 // @Router /news/{id} [put]
 func (h *NewsHandler) Update() echo.HandlerFunc {
 	return func(c echo.Context) error {
@@ -89,12 +106,16 @@ func (h *NewsHandler) Update() echo.HandlerFunc {
 		}
 		n.NewsID = newsUUID
 
+		if n.Version, err = utils.GetIfMatchVersion(c, h.config); err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
 		updatedNews, err := h.newsService.Update(ctx, n)
 		if err != nil {
 			return c.JSON(httpe.ErrorResponse(err))
 		}
 
-		return c.JSON(http.StatusOK, updatedNews)
+		return utils.JSONWithETag(c, http.StatusOK, updatedNews.Version, updatedNews, updatedNews.Locale)
 	}
 }
 
@@ -105,7 +126,10 @@ func (h *NewsHandler) Update() echo.HandlerFunc {
 // @Accept json
 // @Produce json
 // @Param id path int true "news_id"
+// @Param If-Match header string false "ETag of the news, required in strict mode"
 // @Success 200 {string} string	"ok"
+// @Failure 412 {object} httpe.RestError
+// @Failure 428 {object} httpe.RestError
 // @Router /news/{id} [delete]
 func (h *NewsHandler) Delete() echo.HandlerFunc {
 	return func(c echo.Context) error {
@@ -116,7 +140,12 @@ func (h *NewsHandler) Delete() echo.HandlerFunc {
 			return c.JSON(httpe.ErrorResponse(err))
 		}
 
-		if err := h.newsService.Delete(ctx, newsUUID); err != nil {
+		version, err := utils.GetIfMatchVersion(c, h.config)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		if err := h.newsService.Delete(ctx, newsUUID, version); err != nil {
 			return c.JSON(httpe.ErrorResponse(err))
 		}
 
@@ -132,24 +161,61 @@ func (h *NewsHandler) Delete() echo.HandlerFunc {
 // @Produce json
 // @Param page query int false "page number" Format(page)
 // @Param size query int false "number of elements per page" Format(size)
-// @Param orderBy query int false "filter name" Format(orderBy)
+// @Param cursor query string false "next_cursor or prev_cursor of another page"
+// @Param total query bool false "count total of the list"
+// @Param filter query string false "conditions field:operator:value separated by commas, e.g. category:eq:tech" Format(filter)
+// @Param sort query string false "fields separated by commas, descending with -, e.g. -created_at,title" Format(sort)
+// @Param fields query string false "fields of news separated by commas, e.g. news_id,title,author" Format(fields)
+// @Param include query string false "relations to embed: author, comment_count" Format(include)
+// @Param format query string false "content format: markdown, html or text"
+// @Param lang query string false "preferred locale, overrides Accept-Language"
+// @Param Accept-Language header string false "preferred locales"
 // @Success 200 {object} entity.NewsList
+// @Header 200 {string} Link "RFC 8288 links of next and prev pages"
 // @Router /news [get]
 func (h *NewsHandler) GetNews() echo.HandlerFunc {
 	return func(c echo.Context) error {
-		ctx := utils.GetRequestCtx(c)
+		ctx, err := utils.GetLocalesCtx(utils.GetRequestCtx(c), c)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
 
 		pq, err := utils.GetPaginationFromCtx(c)
 		if err != nil {
 			return c.JSON(httpe.ErrorResponse(err))
 		}
 
+		format, err := utils.GetContentFormat(c)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		fields, include, err := newsListFields(c, entity.News{})
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
 		newsList, err := h.newsService.GetNews(ctx, pq)
 		if err != nil {
 			return c.JSON(httpe.ErrorResponse(err))
 		}
+		if len(include) > 0 {
+			if err := h.newsService.IncludeRelations(ctx, newsList.News, include); err != nil {
+				return c.JSON(httpe.ErrorResponse(err))
+			}
+		}
+		for _, news := range newsList.News {
+			news.Content, news.ContentHTML = markdown.Format(format, news.Content, news.ContentHTML)
+		}
+
+		response, err := utils.SparseList(newsList, "news", fields)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
 
-		return c.JSON(http.StatusOK, newsList)
+		utils.SetCursorLinks(c, newsList.NextCursor, newsList.PrevCursor)
+		c.Response().Header().Add(echo.HeaderVary, "Accept-Language")
+		return c.JSON(http.StatusOK, response)
 	}
 }
 
@@ -160,36 +226,110 @@ func (h *NewsHandler) GetNews() echo.HandlerFunc {
 // @Accept json
 // @Produce json
 // @Param id path int true "news_id"
+// @Param format query string false "content format: markdown, html or text"
+// @Param lang query string false "preferred locale, overrides Accept-Language"
+// @Param Accept-Language header string false "preferred locales"
+// @Param If-None-Match header string false "ETag of a cached copy"
 // @Success 200 {object} entity.News
+// @Success 304 {string} string "cached copy is current"
+// @Header 200 {string} ETag "entity tag of the news"
 // @Router /news/{id} [get]
 func (h *NewsHandler) GetNewsByID() echo.HandlerFunc {
 	return func(c echo.Context) error {
-		ctx := utils.GetRequestCtx(c)
+		ctx, err := utils.GetLocalesCtx(utils.GetVisitorCtx(c), c)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
 
 		newsUUID, err := uuid.Parse(c.Param("news_id"))
 		if err != nil {
 			return c.JSON(httpe.ErrorResponse(err))
 		}
 
+		format, err := utils.GetContentFormat(c)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
 		news, err := h.newsService.GetNewsByID(ctx, newsUUID)
 		if err != nil {
 			return c.JSON(httpe.ErrorResponse(err))
 		}
+		news.Content, news.ContentHTML = markdown.Format(format, news.Content, news.ContentHTML)
 
-		return c.JSON(http.StatusOK, news)
+		setContentLanguage(c, news.Locale)
+		return utils.JSONWithETag(c, http.StatusOK, news.Version, news, news.Locale, format)
 	}
 }
 
-// SearchByTitle godoc
-// @Summary Search by title
-// @Description Search news by title
+// GetNewsBySlug godoc
+// @Summary Get news by slug
+// @Description Get news by slug, old slugs redirect to the current one
 // @Tags News
 // @Accept json
 // @Produce json
+// @Param slug path string true "news slug"
+// @Param format query string false "content format: markdown, html or text"
+// @Param lang query string false "preferred locale, overrides Accept-Language"
+// @Param Accept-Language header string false "preferred locales"
+// @Param If-None-Match header string false "ETag of a cached copy"
+// @Success 200 {object} entity.NewsBase
+// @Success 301 {string} string "redirect to the current slug"
+// @Success 304 {string} string "cached copy is current"
+// @Header 200 {string} ETag "entity tag of the news"
+// @Failure 404 {object} httpe.RestError
+// @Router /news/by-slug/{slug} [get]
+func (h *NewsHandler) GetNewsBySlug() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx, err := utils.GetLocalesCtx(utils.GetVisitorCtx(c), c)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		format, err := utils.GetContentFormat(c)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		slug := c.Param("slug")
+		news, err := h.newsService.GetNewsBySlug(ctx, slug)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		if news.Slug != "" && news.Slug != slug {
+			location := "/api/news/by-slug/" + url.PathEscape(news.Slug)
+			if query := c.QueryString(); query != "" {
+				location += "?" + query
+			}
+			return c.Redirect(http.StatusMovedPermanently, location)
+		}
+		news.Content, news.ContentHTML = markdown.Format(format, news.Content, news.ContentHTML)
+
+		setContentLanguage(c, news.Locale)
+		return utils.JSONWithETag(c, http.StatusOK, news.Version, news, news.Locale, format)
+	}
+}
+
+// SearchNews godoc
+// @Summary Search news
+// @Description Full-text search of news by title, content and category ranked by relevance
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param q query string true "websearch query" Format(q)
+// @Param lang query string false "text search configuration" Format(lang)
 // @Param page query int false "page number" Format(page)
 // @Param size query int false "number of elements per page" Format(size)
-// @Param orderBy query int false "filter name" Format(orderBy)
-// @Success 200 {object} entity.NewsList
+// @Param cursor query string false "next_cursor or prev_cursor of another page"
+// @Param total query bool false "count total of the list"
+// @Param filter query string false "conditions field:operator:value separated by commas, e.g. category:eq:tech" Format(filter)
+// @Param sort query string false "fields separated by commas, descending with -, e.g. -created_at,title" Format(sort)
+// @Param fields query string false "fields of news separated by commas, e.g. news_id,title,author" Format(fields)
+// @Param include query string false "relations to embed: author, comment_count" Format(include)
+// @Param format query string false "content format: markdown, html or text"
+// @Success 200 {object} entity.NewsSearchList
+// @Header 200 {string} Link "RFC 8288 links of next and prev pages"
 // @Router /news/search [get]
 func (h *NewsHandler) SearchNews() echo.HandlerFunc {
 	return func(c echo.Context) error {
@@ -200,13 +340,85 @@ func (h *NewsHandler) SearchNews() echo.HandlerFunc {
 			return c.JSON(httpe.ErrorResponse(err))
 		}
 
-		title := c.QueryParam("title")
+		query := c.QueryParam("q")
+		if query == "" {
+			query = c.QueryParam("title")
+		}
+		if query == "" {
+			return c.JSON(http.StatusBadRequest, httpe.NewBadRequestError("q query param is required"))
+		}
+
+		format, err := utils.GetContentFormat(c)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
 
-		newsList, err := h.newsService.SearchNews(ctx, title, pq)
+		fields, include, err := newsListFields(c, entity.NewsSearch{})
 		if err != nil {
 			return c.JSON(httpe.ErrorResponse(err))
 		}
 
-		return c.JSON(http.StatusOK, newsList)
+		newsList, err := h.newsService.SearchNews(ctx, &entity.NewsSearchQuery{
+			Query:    query,
+			Language: c.QueryParam("lang"),
+		}, pq)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		if len(include) > 0 {
+			news := make([]*entity.News, 0, len(newsList.News))
+			for _, found := range newsList.News {
+				news = append(news, &found.News)
+			}
+			if err := h.newsService.IncludeRelations(ctx, news, include); err != nil {
+				return c.JSON(httpe.ErrorResponse(err))
+			}
+		}
+		for _, news := range newsList.News {
+			news.Content, news.ContentHTML = markdown.Format(format, news.Content, news.ContentHTML)
+		}
+
+		response, err := utils.SparseList(newsList, "news", fields)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		utils.SetCursorLinks(c, newsList.NextCursor, newsList.PrevCursor)
+		return c.JSON(http.StatusOK, response)
+	}
+}
+
+// Sparse fieldset and relations of news list items, relations named in
+// the fields are included as well
+func newsListFields(c echo.Context, item interface{}) ([]string, []string, error) {
+	fields, err := utils.GetFieldsFromCtx(c, item)
+	if err != nil {
+		return nil, nil, err
+	}
+	include, err := utils.GetIncludeFromCtx(c, entity.NewsIncludes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	included := make(map[string]bool, len(include))
+	for _, relation := range include {
+		included[relation] = true
+	}
+	for _, field := range fields {
+		for _, relation := range entity.NewsIncludes {
+			if field == relation && !included[relation] {
+				included[relation] = true
+				include = append(include, relation)
+			}
+		}
 	}
-}
\ No newline at end of file
+	return fields, include, nil
+}
+
+// News in the locale negotiated by Accept-Language
+func setContentLanguage(c echo.Context, locale string) {
+	c.Response().Header().Add(echo.HeaderVary, "Accept-Language")
+	if locale != "" {
+		c.Response().Header().Set("Content-Language", locale)
+	}
+}
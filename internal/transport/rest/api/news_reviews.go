@@ -0,0 +1,137 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// SubmitForReview godoc
+// @Summary Submit news for review
+// @Description Submit draft or news with requested changes for review by editors, only the authors can submit news
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param news_id path string true "news id"
+// @Param review body entity.NewsReview false "optional comment for editors"
+// @Success 200 {object} entity.News
+// @Failure 400 {object} httpe.RestError
+// @Failure 403 {object} httpe.RestError
+// @Router /news/{news_id}/submit [post]
+func (h *NewsHandler) SubmitForReview() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		newsUUID, err := uuid.Parse(c.Param("news_id"))
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		review := &entity.NewsReview{}
+		if err := c.Bind(review); err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		news, err := h.newsService.SubmitForReview(ctx, newsUUID, review.Comment)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, news)
+	}
+}
+
+// ReviewNews godoc
+// @Summary Review news
+// @Description Approve news pending review, request changes or reject it, for editors only. Approved news get published or scheduled, the author is notified of decision.
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param news_id path string true "news id"
+// @Param review body entity.NewsReview true "action: approved, changes_requested or rejected, comment is required unless approved"
+// @Success 200 {object} entity.News
+// @Failure 400 {object} httpe.RestError
+// @Failure 403 {object} httpe.RestError
+// @Router /news/{news_id}/review [post]
+func (h *NewsHandler) ReviewNews() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		newsUUID, err := uuid.Parse(c.Param("news_id"))
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		review := &entity.NewsReview{}
+		if err := c.Bind(review); err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		review.NewsID = newsUUID
+
+		news, err := h.newsService.ReviewNews(ctx, review)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, news)
+	}
+}
+
+// GetReviewQueue godoc
+// @Summary Get review queue
+// @Description Get news waiting for review, longest waiting first, for editors only
+// @Tags News
+// @Produce json
+// @Param page query int false "page number" Format(page)
+// @Param size query int false "number of elements per page" Format(size)
+// @Success 200 {object} entity.NewsList
+// @Failure 403 {object} httpe.RestError
+// @Router /news/review-queue [get]
+func (h *NewsHandler) GetReviewQueue() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		pq, err := utils.GetPaginationFromCtx(c)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		newsList, err := h.newsService.GetReviewQueue(ctx, pq)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, newsList)
+	}
+}
+
+// GetReviews godoc
+// @Summary Get news review history
+// @Description Get submissions and editor decisions of news, oldest first, visible to the author and editors
+// @Tags News
+// @Produce json
+// @Param news_id path string true "news id"
+// @Success 200 {array} entity.NewsReview
+// @Failure 404 {object} httpe.RestError
+// @Router /news/{news_id}/reviews [get]
+func (h *NewsHandler) GetReviews() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		newsUUID, err := uuid.Parse(c.Param("news_id"))
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		reviews, err := h.newsService.GetReviews(ctx, newsUUID)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, reviews)
+	}
+}
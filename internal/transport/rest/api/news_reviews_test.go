@@ -0,0 +1,78 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	mockservice "github.com/Edbeer/restapi/internal/service/mock"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewsHandler_Reviews(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsService := mockservice.NewMockNews(ctrl)
+	newsHandler := NewNewsHandler(mockNewsService, nil, apiLogger)
+
+	e := echo.New()
+	e.POST("/api/news/:news_id/submit", newsHandler.SubmitForReview())
+	e.POST("/api/news/:news_id/review", newsHandler.ReviewNews())
+	e.GET("/api/news/:news_id/reviews", newsHandler.GetReviews())
+
+	newsID := uuid.New()
+
+	t.Run("SubmitForReview", func(t *testing.T) {
+		news := &entity.News{NewsID: newsID, Status: entity.NewsStatusPendingReview}
+		mockNewsService.EXPECT().SubmitForReview(gomock.Any(), newsID, "ready").Return(news, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/news/"+newsID.String()+"/submit", strings.NewReader(`{"comment": "ready"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusOK, res.Code)
+		result := &entity.News{}
+		require.NoError(t, json.Unmarshal(res.Body.Bytes(), result))
+		require.Equal(t, entity.NewsStatusPendingReview, result.Status)
+	})
+
+	t.Run("ReviewNews", func(t *testing.T) {
+		review := &entity.NewsReview{NewsID: newsID, Action: entity.ReviewChangesRequested, Comment: "add sources"}
+		news := &entity.News{NewsID: newsID, Status: entity.NewsStatusChangesRequested}
+		mockNewsService.EXPECT().ReviewNews(gomock.Any(), review).Return(news, nil)
+
+		body := `{"action": "changes_requested", "comment": "add sources"}`
+		req := httptest.NewRequest(http.MethodPost, "/api/news/"+newsID.String()+"/review", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("GetReviews", func(t *testing.T) {
+		reviews := []*entity.NewsReview{{NewsID: newsID, Action: entity.ReviewSubmitted}}
+		mockNewsService.EXPECT().GetReviews(gomock.Any(), newsID).Return(reviews, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/news/"+newsID.String()+"/reviews", nil)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusOK, res.Code)
+		result := []*entity.NewsReview{}
+		require.NoError(t, json.Unmarshal(res.Body.Bytes(), &result))
+		require.Len(t, result, 1)
+	})
+}
@@ -0,0 +1,151 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// GetRevisions godoc
+// @Summary Get news revisions
+// @Description Get revisions of news without content, newest first
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param id path string true "news_id"
+// @Param page query int false "page number" Format(page)
+// @Param size query int false "number of elements per page" Format(size)
+// @Success 200 {object} entity.NewsRevisionsList
+// @Router /news/{id}/revisions [get]
+func (h *NewsHandler) GetRevisions() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		newsUUID, err := uuid.Parse(c.Param("news_id"))
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		pq, err := utils.GetPaginationFromCtx(c)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		revisions, err := h.newsService.GetRevisions(ctx, newsUUID, pq)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, revisions)
+	}
+}
+
+// GetRevision godoc
+// @Summary Get news revision
+// @Description Get single revision of news with content
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param id path string true "news_id"
+// @Param revision path int true "revision number"
+// @Success 200 {object} entity.NewsRevision
+// @Router /news/{id}/revisions/{revision} [get]
+func (h *NewsHandler) GetRevision() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		newsUUID, err := uuid.Parse(c.Param("news_id"))
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		revision, err := strconv.Atoi(c.Param("revision"))
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, httpe.NewBadRequestError(err.Error()))
+		}
+
+		rev, err := h.newsService.GetRevision(ctx, newsUUID, revision)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, rev)
+	}
+}
+
+// DiffRevisions godoc
+// @Summary Diff news revisions
+// @Description Word-level diff of title and content between two revisions
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param id path string true "news_id"
+// @Param from query int true "old revision number" Format(from)
+// @Param to query int true "new revision number" Format(to)
+// @Success 200 {object} entity.NewsRevisionDiff
+// @Failure 400 {object} httpe.RestError
+// @Router /news/{id}/revisions/diff [get]
+func (h *NewsHandler) DiffRevisions() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		newsUUID, err := uuid.Parse(c.Param("news_id"))
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		from, err := strconv.Atoi(c.QueryParam("from"))
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, httpe.NewBadRequestError("from query param must be a revision number"))
+		}
+		to, err := strconv.Atoi(c.QueryParam("to"))
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, httpe.NewBadRequestError("to query param must be a revision number"))
+		}
+
+		diff, err := h.newsService.DiffRevisions(ctx, newsUUID, from, to)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, diff)
+	}
+}
+
+// RollbackRevision godoc
+// @Summary Roll back news
+// @Description Restore title and content of a previous revision as a new revision.
+// @Description Rollbacks of published or scheduled news by contributors move it to pending_review like edits.
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param id path string true "news_id"
+// @Param revision path int true "revision number"
+// @Success 200 {object} entity.News
+// @Router /news/{id}/revisions/{revision}/rollback [post]
+func (h *NewsHandler) RollbackRevision() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		newsUUID, err := uuid.Parse(c.Param("news_id"))
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		revision, err := strconv.Atoi(c.Param("revision"))
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, httpe.NewBadRequestError(err.Error()))
+		}
+
+		news, err := h.newsService.RollbackRevision(ctx, newsUUID, revision)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, news)
+	}
+}
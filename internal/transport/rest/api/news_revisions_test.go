@@ -0,0 +1,89 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	mockservice "github.com/Edbeer/restapi/internal/service/mock"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewsHandlers_DiffRevisions(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsService := mockservice.NewMockNews(ctrl)
+	newsHandlers := NewNewsHandler(mockNewsService, nil, apiLogger)
+
+	handlerFunc := newsHandlers.DiffRevisions()
+
+	newsID := uuid.New()
+
+	t.Run("DiffRevisions", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/news/"+newsID.String()+"/revisions/diff?from=1&to=2", nil)
+		res := httptest.NewRecorder()
+		e := echo.New()
+		ctx := e.NewContext(req, res)
+		ctx.SetParamNames("news_id")
+		ctx.SetParamValues(newsID.String())
+		ctxWithReqID := utils.GetRequestCtx(ctx)
+
+		mockNewsService.EXPECT().DiffRevisions(ctxWithReqID, newsID, 1, 2).Return(&entity.NewsRevisionDiff{NewsID: newsID}, nil)
+
+		err := handlerFunc(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("Invalid revision", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/news/"+newsID.String()+"/revisions/diff?from=first&to=2", nil)
+		res := httptest.NewRecorder()
+		e := echo.New()
+		ctx := e.NewContext(req, res)
+		ctx.SetParamNames("news_id")
+		ctx.SetParamValues(newsID.String())
+
+		err := handlerFunc(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func TestNewsHandlers_RollbackRevision(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsService := mockservice.NewMockNews(ctrl)
+	newsHandlers := NewNewsHandler(mockNewsService, nil, apiLogger)
+
+	handlerFunc := newsHandlers.RollbackRevision()
+
+	newsID := uuid.New()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/news/"+newsID.String()+"/revisions/1/rollback", nil)
+	res := httptest.NewRecorder()
+	e := echo.New()
+	ctx := e.NewContext(req, res)
+	ctx.SetParamNames("news_id", "revision")
+	ctx.SetParamValues(newsID.String(), "1")
+	ctxWithReqID := utils.GetRequestCtx(ctx)
+
+	mockNewsService.EXPECT().RollbackRevision(ctxWithReqID, newsID, 1).Return(&entity.News{NewsID: newsID}, nil)
+
+	err := handlerFunc(ctx)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.Code)
+}
@@ -2,19 +2,23 @@ package api
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
 
+	"github.com/Edbeer/restapi/config"
 	"github.com/Edbeer/restapi/internal/entity"
 	mockservice "github.com/Edbeer/restapi/internal/service/mock"
 	"github.com/Edbeer/restapi/pkg/converter"
+	"github.com/Edbeer/restapi/pkg/httpe"
 	"github.com/Edbeer/restapi/pkg/logger"
 	"github.com/Edbeer/restapi/pkg/utils"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 )
 
@@ -144,7 +148,7 @@ func TestHandlers_GetNewsByID(t *testing.T) {
 	ctx := e.NewContext(req, res)
 	ctx.SetParamNames("news_id")
 	ctx.SetParamValues(newsID.String())
-	ctxWithReqID := utils.GetRequestCtx(ctx)
+	ctxWithReqID, _ := utils.GetLocalesCtx(utils.GetVisitorCtx(ctx), ctx)
 
 	mockNews := &entity.NewsBase{
 		NewsID:   newsID,
@@ -159,3 +163,427 @@ func TestHandlers_GetNewsByID(t *testing.T) {
 	require.NoError(t, err)
 }
 
+
+func TestHandlers_GetNewsByIDFormat(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsService := mockservice.NewMockNews(ctrl)
+	newsHandlers := NewNewsHandler(mockNewsService, nil, apiLogger)
+
+	handlerFunc := newsHandlers.GetNewsByID()
+
+	newsID := uuid.New()
+
+	t.Run("Text", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/news/"+newsID.String()+"?format=text", nil)
+		res := httptest.NewRecorder()
+		e := echo.New()
+		ctx := e.NewContext(req, res)
+		ctx.SetParamNames("news_id")
+		ctx.SetParamValues(newsID.String())
+		ctxWithReqID, _ := utils.GetLocalesCtx(utils.GetVisitorCtx(ctx), ctx)
+
+		mockNewsService.EXPECT().GetNewsByID(ctxWithReqID, newsID).Return(&entity.NewsBase{
+			NewsID:      newsID,
+			Content:     "Some **bold** text",
+			ContentHTML: "<p>Some <strong>bold</strong> text</p>\n",
+		}, nil)
+
+		err := handlerFunc(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.Code)
+
+		news := &entity.NewsBase{}
+		require.NoError(t, json.Unmarshal(res.Body.Bytes(), news))
+		require.Equal(t, "Some bold text", news.Content)
+		require.Empty(t, news.ContentHTML)
+	})
+
+	t.Run("Unknown format", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/news/"+newsID.String()+"?format=pdf", nil)
+		res := httptest.NewRecorder()
+		e := echo.New()
+		ctx := e.NewContext(req, res)
+		ctx.SetParamNames("news_id")
+		ctx.SetParamValues(newsID.String())
+
+		err := handlerFunc(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func TestHandlers_GetNewsByIDLocale(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsService := mockservice.NewMockNews(ctrl)
+	newsHandlers := NewNewsHandler(mockNewsService, nil, apiLogger)
+
+	handlerFunc := newsHandlers.GetNewsByID()
+
+	newsID := uuid.New()
+
+	t.Run("Accept-Language", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/news/"+newsID.String(), nil)
+		req.Header.Set("Accept-Language", "fr;q=0.5, de-AT")
+		res := httptest.NewRecorder()
+		e := echo.New()
+		ctx := e.NewContext(req, res)
+		ctx.SetParamNames("news_id")
+		ctx.SetParamValues(newsID.String())
+		ctxWithLocales := context.WithValue(utils.GetVisitorCtx(ctx), utils.LocalesCtxKey{}, []string{"de-AT", "de", "fr", "en"})
+
+		mockNewsService.EXPECT().GetNewsByID(ctxWithLocales, newsID).Return(&entity.NewsBase{
+			NewsID:  newsID,
+			Locale:  "de",
+			Locales: entity.Locales{"de", "en"},
+		}, nil)
+
+		err := handlerFunc(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.Code)
+		require.Equal(t, "de", res.Header().Get("Content-Language"))
+		require.Equal(t, "Accept-Language", res.Header().Get(echo.HeaderVary))
+	})
+
+	t.Run("Invalid lang", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/news/"+newsID.String()+"?lang=d", nil)
+		res := httptest.NewRecorder()
+		e := echo.New()
+		ctx := e.NewContext(req, res)
+		ctx.SetParamNames("news_id")
+		ctx.SetParamValues(newsID.String())
+
+		err := handlerFunc(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func TestHandlers_GetNewsBySlug(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsService := mockservice.NewMockNews(ctrl)
+	newsHandlers := NewNewsHandler(mockNewsService, nil, apiLogger)
+
+	handlerFunc := newsHandlers.GetNewsBySlug()
+
+	mockNews := &entity.NewsBase{
+		NewsID: uuid.New(),
+		Title:  "Breaking news title",
+		Slug:   "breaking-news-title",
+	}
+
+	t.Run("Current slug", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/news/by-slug/breaking-news-title", nil)
+		res := httptest.NewRecorder()
+		e := echo.New()
+		ctx := e.NewContext(req, res)
+		ctx.SetParamNames("slug")
+		ctx.SetParamValues("breaking-news-title")
+		ctxWithReqID, _ := utils.GetLocalesCtx(utils.GetVisitorCtx(ctx), ctx)
+
+		mockNewsService.EXPECT().GetNewsBySlug(ctxWithReqID, "breaking-news-title").Return(mockNews, nil)
+
+		err := handlerFunc(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("Old slug", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/news/by-slug/news-title?lang=en", nil)
+		res := httptest.NewRecorder()
+		e := echo.New()
+		ctx := e.NewContext(req, res)
+		ctx.SetParamNames("slug")
+		ctx.SetParamValues("news-title")
+		ctxWithReqID, _ := utils.GetLocalesCtx(utils.GetVisitorCtx(ctx), ctx)
+
+		mockNewsService.EXPECT().GetNewsBySlug(ctxWithReqID, "news-title").Return(mockNews, nil)
+
+		err := handlerFunc(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusMovedPermanently, res.Code)
+		require.Equal(t, "/api/news/by-slug/breaking-news-title?lang=en", res.Header().Get(echo.HeaderLocation))
+	})
+}
+
+func TestHandlers_SearchNews(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsService := mockservice.NewMockNews(ctrl)
+	newsHandlers := NewNewsHandler(mockNewsService, nil, apiLogger)
+
+	handlerFunc := newsHandlers.SearchNews()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/news/search?q=golang+-java&lang=english", nil)
+	res := httptest.NewRecorder()
+	e := echo.New()
+	ctx := e.NewContext(req, res)
+	ctxWithReqID := utils.GetRequestCtx(ctx)
+
+	search := &entity.NewsSearchQuery{
+		Query:    "golang -java",
+		Language: "english",
+	}
+
+	mockNewsService.EXPECT().SearchNews(ctxWithReqID, search, gomock.Any()).Return(&entity.NewsSearchList{}, nil)
+
+	err := handlerFunc(ctx)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.Code)
+}
+
+func TestHandlers_GetNewsCursor(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsService := mockservice.NewMockNews(ctrl)
+	newsHandlers := NewNewsHandler(mockNewsService, nil, apiLogger)
+
+	handlerFunc := newsHandlers.GetNews()
+
+	t.Run("Links", func(t *testing.T) {
+		cursor := &utils.Cursor{ID: uuid.New()}
+		req := httptest.NewRequest(http.MethodGet, "/api/news/all?size=2&page=1&cursor="+cursor.Encode(), nil)
+		res := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, res)
+
+		mockNewsService.EXPECT().GetNews(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, pq *utils.PaginationQuery) (*entity.NewsList, error) {
+				require.Equal(t, cursor.ID, pq.Cursor.ID)
+				require.False(t, pq.WithTotal)
+				return &entity.NewsList{NextCursor: "next", PrevCursor: "prev"}, nil
+			})
+
+		err := handlerFunc(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.Code)
+		require.Equal(t, `</api/news/all?cursor=next&size=2>; rel="next", </api/news/all?cursor=prev&size=2>; rel="prev"`,
+			res.Header().Get("Link"))
+	})
+
+	t.Run("Invalid cursor", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/news/all?cursor=abc", nil)
+		res := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, res)
+
+		err := handlerFunc(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func TestHandlers_GetNewsFilter(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsService := mockservice.NewMockNews(ctrl)
+	newsHandlers := NewNewsHandler(mockNewsService, nil, apiLogger)
+
+	handlerFunc := newsHandlers.GetNews()
+
+	t.Run("Filter", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/news/all?filter=category:eq:tech&sort=-created_at,title", nil)
+		res := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, res)
+
+		mockNewsService.EXPECT().GetNews(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, pq *utils.PaginationQuery) (*entity.NewsList, error) {
+				require.Len(t, pq.Filter.Conditions, 1)
+				require.Equal(t, "category", pq.Filter.Conditions[0].Field)
+				require.Len(t, pq.Filter.Sort, 2)
+				return &entity.NewsList{}, nil
+			})
+
+		err := handlerFunc(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.Code)
+	})
+
+	for name, target := range map[string]string{
+		"Bad syntax":         "/api/news/all?filter=category:eq",
+		"Sort with cursor":   "/api/news/all?sort=title&cursor=" + (&utils.Cursor{ID: uuid.New()}).Encode(),
+		"Sort field missing": "/api/news/all?sort=title,-",
+	} {
+		target := target
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, target, nil)
+			res := httptest.NewRecorder()
+			ctx := echo.New().NewContext(req, res)
+
+			err := handlerFunc(ctx)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusBadRequest, res.Code)
+			require.Contains(t, res.Body.String(), "invalid field")
+		})
+	}
+}
+
+func TestHandlers_GetNewsFields(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsService := mockservice.NewMockNews(ctrl)
+	newsHandlers := NewNewsHandler(mockNewsService, nil, apiLogger)
+
+	handlerFunc := newsHandlers.GetNews()
+
+	t.Run("Fields with include", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/news/all?fields=news_id,title,author", nil)
+		res := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, res)
+
+		news := &entity.News{NewsID: uuid.New(), Title: "title", Content: "content"}
+		mockNewsService.EXPECT().GetNews(gomock.Any(), gomock.Any()).Return(&entity.NewsList{News: []*entity.News{news}}, nil)
+		mockNewsService.EXPECT().IncludeRelations(gomock.Any(), []*entity.News{news}, []string{entity.NewsIncludeAuthor}).DoAndReturn(
+			func(_ context.Context, newsList []*entity.News, _ []string) error {
+				newsList[0].Author = &entity.NewsAuthor{UserID: uuid.New(), FirstName: "Pavel"}
+				return nil
+			})
+
+		err := handlerFunc(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.Code)
+
+		var body struct {
+			News []map[string]interface{} `json:"news"`
+		}
+		require.NoError(t, json.Unmarshal(res.Body.Bytes(), &body))
+		require.Len(t, body.News, 1)
+		require.Len(t, body.News[0], 3)
+		require.Equal(t, "title", body.News[0]["title"])
+		require.Contains(t, body.News[0], "author")
+		require.NotContains(t, body.News[0], "content")
+	})
+
+	for name, target := range map[string]string{
+		"Unknown field":   "/api/news/all?fields=news_id,password",
+		"Unknown include": "/api/news/all?include=tags",
+	} {
+		target := target
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, target, nil)
+			res := httptest.NewRecorder()
+			ctx := echo.New().NewContext(req, res)
+
+			err := handlerFunc(ctx)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusBadRequest, res.Code)
+		})
+	}
+}
+
+func TestHandlers_NewsConditional(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsService := mockservice.NewMockNews(ctrl)
+	newsHandlers := NewNewsHandler(mockNewsService, &config.Config{Server: config.ServerConfig{RequireIfMatch: true}}, apiLogger)
+
+	newsID := uuid.New()
+	newsContext := func(method string, header string, value string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(method, "/api/news/"+newsID.String(), strings.NewReader(`{"title":"new title of the news"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		if header != "" {
+			req.Header.Set(header, value)
+		}
+		res := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, res)
+		ctx.SetParamNames("news_id")
+		ctx.SetParamValues(newsID.String())
+		return ctx, res
+	}
+
+	var etag string
+	t.Run("Get with ETag", func(t *testing.T) {
+		ctx, res := newsContext(http.MethodGet, "", "")
+		mockNewsService.EXPECT().GetNewsByID(gomock.Any(), newsID).Return(&entity.NewsBase{NewsID: newsID, Version: 2}, nil)
+
+		require.NoError(t, newsHandlers.GetNewsByID()(ctx))
+		require.Equal(t, http.StatusOK, res.Code)
+		etag = res.Header().Get("ETag")
+		require.Equal(t, `"2"`, etag)
+	})
+
+	t.Run("Get not modified", func(t *testing.T) {
+		ctx, res := newsContext(http.MethodGet, "If-None-Match", etag)
+		mockNewsService.EXPECT().GetNewsByID(gomock.Any(), newsID).Return(&entity.NewsBase{NewsID: newsID, Version: 2}, nil)
+
+		require.NoError(t, newsHandlers.GetNewsByID()(ctx))
+		require.Equal(t, http.StatusNotModified, res.Code)
+		require.Empty(t, res.Body.String())
+	})
+
+	t.Run("Update with If-Match", func(t *testing.T) {
+		ctx, res := newsContext(http.MethodPut, "If-Match", etag)
+		mockNewsService.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, news *entity.News) (*entity.News, error) {
+				require.Equal(t, 2, news.Version)
+				news.Version++
+				return news, nil
+			})
+
+		require.NoError(t, newsHandlers.Update()(ctx))
+		require.Equal(t, http.StatusOK, res.Code)
+		require.Equal(t, `"3"`, res.Header().Get("ETag"))
+	})
+
+	t.Run("Update stale", func(t *testing.T) {
+		ctx, res := newsContext(http.MethodPut, "If-Match", etag)
+		mockNewsService.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil, errors.Wrap(httpe.PreconditionFailed, "NewsStoragePsql.Update.StructScan"))
+
+		require.NoError(t, newsHandlers.Update()(ctx))
+		require.Equal(t, http.StatusPreconditionFailed, res.Code)
+	})
+
+	t.Run("Update without If-Match", func(t *testing.T) {
+		ctx, res := newsContext(http.MethodPut, "", "")
+
+		require.NoError(t, newsHandlers.Update()(ctx))
+		require.Equal(t, http.StatusPreconditionRequired, res.Code)
+	})
+
+	t.Run("Delete with If-Match", func(t *testing.T) {
+		ctx, res := newsContext(http.MethodDelete, "If-Match", etag)
+		mockNewsService.EXPECT().Delete(gomock.Any(), newsID, 2).Return(nil)
+
+		require.NoError(t, newsHandlers.Delete()(ctx))
+		require.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("Delete without If-Match", func(t *testing.T) {
+		ctx, res := newsContext(http.MethodDelete, "", "")
+
+		require.NoError(t, newsHandlers.Delete()(ctx))
+		require.Equal(t, http.StatusPreconditionRequired, res.Code)
+	})
+}
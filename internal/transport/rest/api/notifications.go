@@ -0,0 +1,117 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// Notifications service interface
+type NotificationsService interface {
+	GetNotifications(ctx context.Context, unreadOnly bool, pq *utils.PaginationQuery) (*entity.NotificationsList, error)
+	MarkRead(ctx context.Context, notificationID uuid.UUID) error
+	MarkAllRead(ctx context.Context) (int64, error)
+}
+
+// NotificationsHandler
+type NotificationsHandler struct {
+	notificationsService NotificationsService
+	config               *config.Config
+	logger               logger.Logger
+}
+
+// NotificationsHandler constructor
+func NewNotificationsHandler(notificationsService NotificationsService, config *config.Config, logger logger.Logger) *NotificationsHandler {
+	return &NotificationsHandler{
+		notificationsService: notificationsService,
+		config:               config,
+		logger:               logger,
+	}
+}
+
+// GetNotifications godoc
+// @Summary Get notifications
+// @Description Get notifications of current user, newest first
+// @Tags Notifications
+// @Produce json
+// @Param unread query bool false "unread notifications only"
+// @Param page query int false "page number" Format(page)
+// @Param size query int false "number of elements per page" Format(size)
+// @Success 200 {object} entity.NotificationsList
+// @Failure 401 {object} httpe.RestError
+// @Router /notifications [get]
+func (h *NotificationsHandler) GetNotifications() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		pq, err := utils.GetPaginationFromCtx(c)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		var unreadOnly bool
+		if unread := c.QueryParam("unread"); unread != "" {
+			if unreadOnly, err = strconv.ParseBool(unread); err != nil {
+				return c.JSON(http.StatusBadRequest, httpe.NewBadRequestError("invalid unread: "+unread))
+			}
+		}
+
+		notifications, err := h.notificationsService.GetNotifications(ctx, unreadOnly, pq)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		return c.JSON(http.StatusOK, notifications)
+	}
+}
+
+// MarkRead godoc
+// @Summary Mark notification read
+// @Description Mark notification of current user read
+// @Tags Notifications
+// @Param notification_id path string true "notification id"
+// @Success 200 {string} string	"ok"
+// @Failure 404 {object} httpe.RestError
+// @Router /notifications/{notification_id}/read [put]
+func (h *NotificationsHandler) MarkRead() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		notificationID, err := uuid.Parse(c.Param("notification_id"))
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		if err := h.notificationsService.MarkRead(ctx, notificationID); err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		return c.NoContent(http.StatusOK)
+	}
+}
+
+// MarkAllRead godoc
+// @Summary Mark all notifications read
+// @Description Mark all notifications of current user read
+// @Tags Notifications
+// @Produce json
+// @Success 200 {object} map[string]int64
+// @Failure 401 {object} httpe.RestError
+// @Router /notifications/read [put]
+func (h *NotificationsHandler) MarkAllRead() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		marked, err := h.notificationsService.MarkAllRead(ctx)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		return c.JSON(http.StatusOK, map[string]int64{"marked": marked})
+	}
+}
@@ -0,0 +1,71 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	mockservice "github.com/Edbeer/restapi/internal/service/mock"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationsHandler(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNotificationsService := mockservice.NewMockNotifications(ctrl)
+	notificationsHandler := NewNotificationsHandler(mockNotificationsService, nil, apiLogger)
+
+	e := echo.New()
+	e.GET("/api/notifications", notificationsHandler.GetNotifications())
+	e.PUT("/api/notifications/read", notificationsHandler.MarkAllRead())
+	e.PUT("/api/notifications/:notification_id/read", notificationsHandler.MarkRead())
+
+	t.Run("GetNotifications", func(t *testing.T) {
+		mockNotificationsService.EXPECT().GetNotifications(gomock.Any(), true, gomock.Any()).Return(&entity.NotificationsList{Unread: 2}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/notifications?unread=true", nil)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("Invalid unread", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/notifications?unread=maybe", nil)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("MarkRead", func(t *testing.T) {
+		notificationID := uuid.New()
+		mockNotificationsService.EXPECT().MarkRead(gomock.Any(), notificationID).Return(nil)
+
+		req := httptest.NewRequest(http.MethodPut, "/api/notifications/"+notificationID.String()+"/read", nil)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("MarkAllRead", func(t *testing.T) {
+		mockNotificationsService.EXPECT().MarkAllRead(gomock.Any()).Return(int64(2), nil)
+
+		req := httptest.NewRequest(http.MethodPut, "/api/notifications/read", nil)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusOK, res.Code)
+		require.JSONEq(t, `{"marked": 2}`, res.Body.String())
+	})
+}
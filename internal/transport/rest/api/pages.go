@@ -0,0 +1,98 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/labstack/echo/v4"
+)
+
+// Pages service interface
+type PagesService interface {
+	RenderArticle(ctx context.Context, slug string) (*entity.PageDocument, error)
+	RenderList(ctx context.Context, page int) (*entity.PageDocument, error)
+	RenderCategory(ctx context.Context, slug string, page int) (*entity.PageDocument, error)
+}
+
+// PagesHandler serves public html pages
+type PagesHandler struct {
+	pagesService PagesService
+	config       *config.Config
+	logger       logger.Logger
+}
+
+// PagesHandler constructor
+func NewPagesHandler(pagesService PagesService, config *config.Config, logger logger.Logger) *PagesHandler {
+	return &PagesHandler{
+		pagesService: pagesService,
+		config:       config,
+		logger:       logger,
+	}
+}
+
+// Article page: /news/{slug}
+func (h *PagesHandler) GetArticle() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		page, err := h.pagesService.RenderArticle(ctx, c.Param("slug"))
+		if err != nil {
+			return pageError(c, err)
+		}
+		return pageResponse(c, page)
+	}
+}
+
+// Listing page of latest news: /news?page=
+func (h *PagesHandler) GetList() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		page, err := h.pagesService.RenderList(ctx, getPageNumber(c))
+		if err != nil {
+			return pageError(c, err)
+		}
+		return pageResponse(c, page)
+	}
+}
+
+// Category page: /categories/{slug}?page=
+func (h *PagesHandler) GetCategory() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		page, err := h.pagesService.RenderCategory(ctx, c.Param("slug"), getPageNumber(c))
+		if err != nil {
+			return pageError(c, err)
+		}
+		return pageResponse(c, page)
+	}
+}
+
+// Page numbers start from one, anything else shows the first page
+func getPageNumber(c echo.Context) int {
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil || page < 1 {
+		return 1
+	}
+	return page
+}
+
+func pageResponse(c echo.Context, page *entity.PageDocument) error {
+	if page.Redirect != "" {
+		return c.Redirect(http.StatusMovedPermanently, page.Redirect)
+	}
+	c.Response().Header().Set("Cache-Control", "public, max-age=60")
+	return c.HTMLBlob(http.StatusOK, page.Body)
+}
+
+func pageError(c echo.Context, err error) error {
+	status := httpe.ParseErrors(err).Status()
+	return c.String(status, http.StatusText(status))
+}
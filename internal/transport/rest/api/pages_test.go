@@ -0,0 +1,63 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	mockservice "github.com/Edbeer/restapi/internal/service/mock"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPagesHandler_GetArticle(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockPagesService := mockservice.NewMockPages(ctrl)
+	pagesHandler := NewPagesHandler(mockPagesService, nil, apiLogger)
+
+	e := echo.New()
+	e.GET("/news/:slug", pagesHandler.GetArticle())
+
+	t.Run("OK", func(t *testing.T) {
+		mockPagesService.EXPECT().RenderArticle(gomock.Any(), "rain").Return(&entity.PageDocument{Body: []byte("<html></html>")}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/news/rain", nil)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusOK, res.Code)
+		require.Equal(t, echo.MIMETextHTMLCharsetUTF8, res.Header().Get(echo.HeaderContentType))
+		require.Equal(t, "<html></html>", res.Body.String())
+	})
+
+	t.Run("Redirect", func(t *testing.T) {
+		mockPagesService.EXPECT().RenderArticle(gomock.Any(), "old-rain").Return(&entity.PageDocument{Redirect: "/news/rain"}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/news/old-rain", nil)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusMovedPermanently, res.Code)
+		require.Equal(t, "/news/rain", res.Header().Get(echo.HeaderLocation))
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockPagesService.EXPECT().RenderArticle(gomock.Any(), "missing").Return(nil, httpe.NewNotFoundError(errors.New("missing")))
+
+		req := httptest.NewRequest(http.MethodGet, "/news/missing", nil)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusNotFound, res.Code)
+	})
+}
@@ -0,0 +1,157 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// Reactions service interface
+type ReactionsService interface {
+	React(ctx context.Context, targetType string, targetID uuid.UUID, kind string) (*entity.Reactions, error)
+	Unreact(ctx context.Context, targetType string, targetID uuid.UUID, kind string) (*entity.Reactions, error)
+	GetReactions(ctx context.Context, targetType string, targetID uuid.UUID) (*entity.Reactions, error)
+}
+
+// ReactionsHandler
+type ReactionsHandler struct {
+	reactionsService ReactionsService
+	config           *config.Config
+	logger           logger.Logger
+}
+
+// ReactionsHandler constructor
+func NewReactionsHandler(reactionsService ReactionsService, config *config.Config, logger logger.Logger) *ReactionsHandler {
+	return &ReactionsHandler{
+		reactionsService: reactionsService,
+		config:           config,
+		logger:           logger,
+	}
+}
+
+// GetNewsReactions godoc
+// @Summary Get news reactions
+// @Description Reaction counts of news with reactions of current user
+// @Tags Reactions
+// @Produce json
+// @Param news_id path string true "news id"
+// @Success 200 {object} entity.Reactions
+// @Router /news/{news_id}/reactions [get]
+func (h *ReactionsHandler) GetNewsReactions() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return h.getReactions(c, entity.ReactionTargetNews, "news_id")
+	}
+}
+
+// ReactNews godoc
+// @Summary React on news
+// @Description Add reaction of current user on news, once per kind
+// @Tags Reactions
+// @Produce json
+// @Param news_id path string true "news id"
+// @Param kind path string true "reaction kind"
+// @Success 200 {object} entity.Reactions
+// @Router /news/{news_id}/reactions/{kind} [put]
+func (h *ReactionsHandler) ReactNews() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return h.react(c, entity.ReactionTargetNews, "news_id", h.reactionsService.React)
+	}
+}
+
+// UnreactNews godoc
+// @Summary Remove news reaction
+// @Description Remove reaction of current user from news
+// @Tags Reactions
+// @Produce json
+// @Param news_id path string true "news id"
+// @Param kind path string true "reaction kind"
+// @Success 200 {object} entity.Reactions
+// @Router /news/{news_id}/reactions/{kind} [delete]
+func (h *ReactionsHandler) UnreactNews() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return h.react(c, entity.ReactionTargetNews, "news_id", h.reactionsService.Unreact)
+	}
+}
+
+// GetCommentReactions godoc
+// @Summary Get comment reactions
+// @Description Reaction counts of comment with reactions of current user
+// @Tags Reactions
+// @Produce json
+// @Param comments_id path string true "comment id"
+// @Success 200 {object} entity.Reactions
+// @Router /comments/{comments_id}/reactions [get]
+func (h *ReactionsHandler) GetCommentReactions() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return h.getReactions(c, entity.ReactionTargetComment, "comments_id")
+	}
+}
+
+// ReactComment godoc
+// @Summary React on comment
+// @Description Add reaction of current user on comment, likes are counted in comment likes
+// @Tags Reactions
+// @Produce json
+// @Param comments_id path string true "comment id"
+// @Param kind path string true "reaction kind"
+// @Success 200 {object} entity.Reactions
+// @Router /comments/{comments_id}/reactions/{kind} [put]
+func (h *ReactionsHandler) ReactComment() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return h.react(c, entity.ReactionTargetComment, "comments_id", h.reactionsService.React)
+	}
+}
+
+// UnreactComment godoc
+// @Summary Remove comment reaction
+// @Description Remove reaction of current user from comment
+// @Tags Reactions
+// @Produce json
+// @Param comments_id path string true "comment id"
+// @Param kind path string true "reaction kind"
+// @Success 200 {object} entity.Reactions
+// @Router /comments/{comments_id}/reactions/{kind} [delete]
+func (h *ReactionsHandler) UnreactComment() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return h.react(c, entity.ReactionTargetComment, "comments_id", h.reactionsService.Unreact)
+	}
+}
+
+func (h *ReactionsHandler) getReactions(c echo.Context, targetType string, param string) error {
+	ctx := utils.GetRequestCtx(c)
+
+	targetID, err := uuid.Parse(c.Param(param))
+	if err != nil {
+		return c.JSON(httpe.ErrorResponse(err))
+	}
+
+	reactions, err := h.reactionsService.GetReactions(ctx, targetType, targetID)
+	if err != nil {
+		return c.JSON(httpe.ErrorResponse(err))
+	}
+	return c.JSON(http.StatusOK, reactions)
+}
+
+type reactFunc func(ctx context.Context, targetType string, targetID uuid.UUID, kind string) (*entity.Reactions, error)
+
+func (h *ReactionsHandler) react(c echo.Context, targetType string, param string, react reactFunc) error {
+	ctx := utils.GetRequestCtx(c)
+
+	targetID, err := uuid.Parse(c.Param(param))
+	if err != nil {
+		return c.JSON(httpe.ErrorResponse(err))
+	}
+
+	reactions, err := react(ctx, targetType, targetID, c.Param("kind"))
+	if err != nil {
+		return c.JSON(httpe.ErrorResponse(err))
+	}
+	return c.JSON(http.StatusOK, reactions)
+}
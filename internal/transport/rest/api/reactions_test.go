@@ -0,0 +1,78 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	mockservice "github.com/Edbeer/restapi/internal/service/mock"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReactionsHandler(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockReactionsService := mockservice.NewMockReactions(ctrl)
+	reactionsHandler := NewReactionsHandler(mockReactionsService, nil, apiLogger)
+
+	e := echo.New()
+	e.GET("/api/news/:news_id/reactions", reactionsHandler.GetNewsReactions())
+	e.PUT("/api/news/:news_id/reactions/:kind", reactionsHandler.ReactNews())
+	e.DELETE("/api/comments/:comments_id/reactions/:kind", reactionsHandler.UnreactComment())
+
+	reactions := &entity.Reactions{Counts: map[string]int64{entity.ReactionLike: 1}, Mine: []string{entity.ReactionLike}}
+
+	t.Run("ReactNews", func(t *testing.T) {
+		newsID := uuid.New()
+		mockReactionsService.EXPECT().React(gomock.Any(), entity.ReactionTargetNews, newsID, entity.ReactionLike).Return(reactions, nil)
+
+		req := httptest.NewRequest(http.MethodPut, "/api/news/"+newsID.String()+"/reactions/like", nil)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusOK, res.Code)
+		result := &entity.Reactions{}
+		require.NoError(t, json.Unmarshal(res.Body.Bytes(), result))
+		require.Equal(t, reactions, result)
+	})
+
+	t.Run("UnreactComment", func(t *testing.T) {
+		commentID := uuid.New()
+		mockReactionsService.EXPECT().Unreact(gomock.Any(), entity.ReactionTargetComment, commentID, "wow").Return(reactions, nil)
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/comments/"+commentID.String()+"/reactions/wow", nil)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("GetNewsReactions", func(t *testing.T) {
+		newsID := uuid.New()
+		mockReactionsService.EXPECT().GetReactions(gomock.Any(), entity.ReactionTargetNews, newsID).Return(reactions, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/news/"+newsID.String()+"/reactions", nil)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("Invalid id", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/news/abc/reactions", nil)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
@@ -0,0 +1,72 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// Related service interface
+type RelatedService interface {
+	GetRelated(ctx context.Context, query *entity.RelatedQuery) (*entity.RelatedList, error)
+}
+
+// RelatedHandler
+type RelatedHandler struct {
+	relatedService RelatedService
+	config         *config.Config
+	logger         logger.Logger
+}
+
+// RelatedHandler constructor
+func NewRelatedHandler(relatedService RelatedService, config *config.Config, logger logger.Logger) *RelatedHandler {
+	return &RelatedHandler{
+		relatedService: relatedService,
+		config:         config,
+		logger:         logger,
+	}
+}
+
+// GetRelated godoc
+// @Summary Get related news
+// @Description Published news similar to news by title, content, tags and category, most related first. Latest news of the same category fill up missing ones.
+// @Tags News
+// @Produce json
+// @Param news_id path string true "news id"
+// @Param size query int false "number of news, 5 by default"
+// @Success 200 {object} entity.RelatedList
+// @Failure 404 {object} httpe.RestError
+// @Router /news/{news_id}/related [get]
+func (h *RelatedHandler) GetRelated() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		newsID, err := uuid.Parse(c.Param("news_id"))
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		query := &entity.RelatedQuery{NewsID: newsID}
+		if size := c.QueryParam("size"); size != "" {
+			n, err := strconv.Atoi(size)
+			if err != nil {
+				return c.JSON(http.StatusBadRequest, httpe.NewBadRequestError("invalid size: "+size))
+			}
+			query.Size = n
+		}
+
+		related, err := h.relatedService.GetRelated(ctx, query)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		return c.JSON(http.StatusOK, related)
+	}
+}
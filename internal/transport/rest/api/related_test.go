@@ -0,0 +1,53 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	mockservice "github.com/Edbeer/restapi/internal/service/mock"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelatedHandler_GetRelated(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockRelatedService := mockservice.NewMockRelated(ctrl)
+	relatedHandler := NewRelatedHandler(mockRelatedService, nil, apiLogger)
+
+	e := echo.New()
+	e.GET("/api/news/:news_id/related", relatedHandler.GetRelated())
+
+	t.Run("GetRelated", func(t *testing.T) {
+		newsID, relatedID := uuid.New(), uuid.New()
+		related := &entity.RelatedList{NewsID: newsID, News: []*entity.News{{NewsID: relatedID, Title: "related"}}}
+		mockRelatedService.EXPECT().GetRelated(gomock.Any(), &entity.RelatedQuery{NewsID: newsID, Size: 3}).Return(related, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/news/"+newsID.String()+"/related?size=3", nil)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusOK, res.Code)
+		result := &entity.RelatedList{}
+		require.NoError(t, json.Unmarshal(res.Body.Bytes(), result))
+		require.Equal(t, relatedID, result.News[0].NewsID)
+	})
+
+	t.Run("Invalid size", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/news/"+uuid.New().String()+"/related?size=abc", nil)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
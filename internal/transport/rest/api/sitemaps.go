@@ -0,0 +1,92 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/sitemap"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+// Sitemaps service interface
+type SitemapsService interface {
+	GetIndex(ctx context.Context) (*entity.SitemapDocument, error)
+	GetPage(ctx context.Context, page int) (*entity.SitemapDocument, error)
+	GetLatest(ctx context.Context) (*entity.SitemapDocument, error)
+}
+
+// SitemapsHandler
+type SitemapsHandler struct {
+	sitemapsService SitemapsService
+	config          *config.Config
+	logger          logger.Logger
+}
+
+// SitemapsHandler constructor
+func NewSitemapsHandler(sitemapsService SitemapsService, config *config.Config, logger logger.Logger) *SitemapsHandler {
+	return &SitemapsHandler{
+		sitemapsService: sitemapsService,
+		config:          config,
+		logger:          logger,
+	}
+}
+
+// Sitemap index: /sitemap.xml
+func (h *SitemapsHandler) GetIndex() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		document, err := h.sitemapsService.GetIndex(ctx)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		return sitemapResponse(c, document)
+	}
+}
+
+// Sitemap page of published news: /sitemaps/news/{page}.xml
+func (h *SitemapsHandler) GetPage() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		param := c.Param("page")
+		page, err := strconv.Atoi(strings.TrimSuffix(param, ".xml"))
+		if err != nil || !strings.HasSuffix(param, ".xml") {
+			return c.JSON(httpe.ErrorResponse(httpe.NewNotFoundError(errors.Errorf("sitemap %s not found", param))))
+		}
+
+		document, err := h.sitemapsService.GetPage(ctx, page)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		return sitemapResponse(c, document)
+	}
+}
+
+// News sitemap of latest news: /sitemaps/latest.xml
+func (h *SitemapsHandler) GetLatest() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		document, err := h.sitemapsService.GetLatest(ctx)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		return sitemapResponse(c, document)
+	}
+}
+
+func sitemapResponse(c echo.Context, document *entity.SitemapDocument) error {
+	if !document.LastModified.IsZero() {
+		c.Response().Header().Set("Last-Modified", document.LastModified.UTC().Format(http.TimeFormat))
+	}
+	return c.Blob(http.StatusOK, sitemap.ContentType, document.Body)
+}
@@ -0,0 +1,55 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	mockservice "github.com/Edbeer/restapi/internal/service/mock"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/sitemap"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSitemapsHandler_GetPage(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockSitemapsService := mockservice.NewMockSitemaps(ctrl)
+	sitemapsHandler := NewSitemapsHandler(mockSitemapsService, nil, apiLogger)
+
+	e := echo.New()
+	e.GET("/sitemaps/news/:page", sitemapsHandler.GetPage())
+
+	t.Run("OK", func(t *testing.T) {
+		mockSitemapsService.EXPECT().GetPage(gomock.Any(), 3).Return(&entity.SitemapDocument{
+			Body:         []byte("<urlset></urlset>"),
+			LastModified: time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC),
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/sitemaps/news/3.xml", nil)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusOK, res.Code)
+		require.Equal(t, sitemap.ContentType, res.Header().Get(echo.HeaderContentType))
+		require.Equal(t, "Mon, 19 Oct 2026 12:00:00 GMT", res.Header().Get("Last-Modified"))
+		require.Equal(t, "<urlset></urlset>", res.Body.String())
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		for _, path := range []string{"/sitemaps/news/3", "/sitemaps/news/x.xml"} {
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+			res := httptest.NewRecorder()
+			e.ServeHTTP(res, req)
+			require.Equal(t, http.StatusNotFound, res.Code)
+		}
+	})
+}
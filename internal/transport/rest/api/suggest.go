@@ -0,0 +1,96 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/labstack/echo/v4"
+)
+
+// Suggest service interface
+type SuggestService interface {
+	Suggest(ctx context.Context, prefix string, kind string, limit int) (*entity.SuggestList, error)
+	Rebuild(ctx context.Context) error
+}
+
+// SuggestHandler
+type SuggestHandler struct {
+	suggestService SuggestService
+	config         *config.Config
+	logger         logger.Logger
+}
+
+// SuggestHandler constructor
+func NewSuggestHandler(suggestService SuggestService, config *config.Config, logger logger.Logger) *SuggestHandler {
+	return &SuggestHandler{
+		suggestService: suggestService,
+		config:         config,
+		logger:         logger,
+	}
+}
+
+// Suggest godoc
+// @Summary Search-as-you-type suggestions
+// @Description Top news title and author name completions for prefix ranked by popularity
+// @Tags Suggest
+// @Accept json
+// @Produce json
+// @Param q query string true "prefix" Format(q)
+// @Param type query string false "news or authors, both by default" Format(type)
+// @Param limit query int false "number of suggestions per type" Format(limit)
+// @Success 200 {object} entity.SuggestList
+// @Failure 400 {object} httpe.RestError
+// @Router /suggest [get]
+func (h *SuggestHandler) Suggest() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		prefix := c.QueryParam("q")
+		if prefix == "" {
+			return c.JSON(http.StatusBadRequest, httpe.NewBadRequestError("q query param is required"))
+		}
+
+		var limit int
+		if c.QueryParam("limit") != "" {
+			n, err := strconv.Atoi(c.QueryParam("limit"))
+			if err != nil {
+				return c.JSON(http.StatusBadRequest, httpe.NewBadRequestError(err.Error()))
+			}
+			limit = n
+		}
+
+		suggestions, err := h.suggestService.Suggest(ctx, prefix, c.QueryParam("type"), limit)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, suggestions)
+	}
+}
+
+// Rebuild godoc
+// @Summary Rebuild suggestions index
+// @Description Rebuild news title and author name suggestions index from database, admin only
+// @Tags Suggest
+// @Accept json
+// @Produce json
+// @Success 200 {string} string	"ok"
+// @Failure 500 {object} httpe.RestError
+// @Router /suggest/rebuild [post]
+func (h *SuggestHandler) Rebuild() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		if err := h.suggestService.Rebuild(ctx); err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		return c.NoContent(http.StatusOK)
+	}
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	mockservice "github.com/Edbeer/restapi/internal/service/mock"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuggestHandler_Suggest(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockSuggestService := mockservice.NewMockSuggest(ctrl)
+	suggestHandlers := NewSuggestHandler(mockSuggestService, nil, apiLogger)
+
+	handlerFunc := suggestHandlers.Suggest()
+
+	t.Run("Suggest", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/suggest?q=gol&limit=5", nil)
+		res := httptest.NewRecorder()
+		e := echo.New()
+		ctx := e.NewContext(req, res)
+		ctxWithReqID := utils.GetRequestCtx(ctx)
+
+		mockSuggestService.EXPECT().Suggest(ctxWithReqID, "gol", "", 5).Return(&entity.SuggestList{Prefix: "gol"}, nil)
+
+		err := handlerFunc(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("Empty prefix", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/suggest", nil)
+		res := httptest.NewRecorder()
+		e := echo.New()
+		ctx := e.NewContext(req, res)
+
+		err := handlerFunc(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
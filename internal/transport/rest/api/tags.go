@@ -0,0 +1,151 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/labstack/echo/v4"
+)
+
+// Tags service interface
+type TagsService interface {
+	GetTags(ctx context.Context, pq *utils.PaginationQuery) (*entity.TagsList, error)
+	GetNewsByTag(ctx context.Context, slug string, pq *utils.PaginationQuery) (*entity.NewsList, error)
+	RenameTag(ctx context.Context, slug string, name string) (*entity.Tag, error)
+	MergeTags(ctx context.Context, slug string, merge *entity.TagMerge) (*entity.Tag, error)
+}
+
+// TagsHandler
+type TagsHandler struct {
+	tagsService TagsService
+	config      *config.Config
+	logger      logger.Logger
+}
+
+// TagsHandler constructor
+func NewTagsHandler(tagsService TagsService, config *config.Config, logger logger.Logger) *TagsHandler {
+	return &TagsHandler{
+		tagsService: tagsService,
+		config:      config,
+		logger:      logger,
+	}
+}
+
+// GetTags godoc
+// @Summary Get tags
+// @Description Get tags with published news counts, most used first
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param page query int false "page number" Format(page)
+// @Param size query int false "number of elements per page" Format(size)
+// @Success 200 {object} entity.TagsList
+// @Router /tags [get]
+func (h *TagsHandler) GetTags() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		pq, err := utils.GetPaginationFromCtx(c)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		tags, err := h.tagsService.GetTags(ctx, pq)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, tags)
+	}
+}
+
+// GetNewsByTag godoc
+// @Summary Get news by tag
+// @Description Get published news of tag, newest first
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param slug path string true "tag slug"
+// @Param page query int false "page number" Format(page)
+// @Param size query int false "number of elements per page" Format(size)
+// @Success 200 {object} entity.NewsList
+// @Router /tags/{slug}/news [get]
+func (h *TagsHandler) GetNewsByTag() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		pq, err := utils.GetPaginationFromCtx(c)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		newsList, err := h.tagsService.GetNewsByTag(ctx, c.Param("slug"), pq)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, newsList)
+	}
+}
+
+// RenameTag godoc
+// @Summary Rename tag
+// @Description Rename tag, admin only
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param slug path string true "tag slug"
+// @Success 200 {object} entity.Tag
+// @Failure 400 {object} httpe.RestError
+// @Router /tags/{slug} [put]
+func (h *TagsHandler) RenameTag() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		tag := &entity.Tag{}
+		if err := c.Bind(tag); err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		renamed, err := h.tagsService.RenameTag(ctx, c.Param("slug"), tag.Name)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, renamed)
+	}
+}
+
+// MergeTags godoc
+// @Summary Merge tags
+// @Description Move news of tag to the target tag and delete it, admin only
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param slug path string true "tag slug"
+// @Param merge body entity.TagMerge true "target tag"
+// @Success 200 {object} entity.Tag
+// @Failure 400 {object} httpe.RestError
+// @Router /tags/{slug}/merge [post]
+func (h *TagsHandler) MergeTags() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		merge := &entity.TagMerge{}
+		if err := c.Bind(merge); err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		tag, err := h.tagsService.MergeTags(ctx, c.Param("slug"), merge)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, tag)
+	}
+}
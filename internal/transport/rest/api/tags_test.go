@@ -0,0 +1,74 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	mockservice "github.com/Edbeer/restapi/internal/service/mock"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagsHandler_GetNewsByTag(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockTagsService := mockservice.NewMockTags(ctrl)
+	tagsHandler := NewTagsHandler(mockTagsService, nil, apiLogger)
+
+	handlerFunc := tagsHandler.GetNewsByTag()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tags/golang/news?page=1&size=5", nil)
+	res := httptest.NewRecorder()
+	e := echo.New()
+	ctx := e.NewContext(req, res)
+	ctx.SetParamNames("slug")
+	ctx.SetParamValues("golang")
+	ctxWithReqID := utils.GetRequestCtx(ctx)
+
+	mockTagsService.EXPECT().GetNewsByTag(ctxWithReqID, "golang", &utils.PaginationQuery{
+		Size: 5,
+		Page: 1,
+	}).Return(&entity.NewsList{}, nil)
+
+	err := handlerFunc(ctx)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.Code)
+}
+
+func TestTagsHandler_MergeTags(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockTagsService := mockservice.NewMockTags(ctrl)
+	tagsHandler := NewTagsHandler(mockTagsService, nil, apiLogger)
+
+	handlerFunc := tagsHandler.MergeTags()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tags/go-lang/merge", strings.NewReader(`{"target":"golang"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	res := httptest.NewRecorder()
+	e := echo.New()
+	ctx := e.NewContext(req, res)
+	ctx.SetParamNames("slug")
+	ctx.SetParamValues("go-lang")
+	ctxWithReqID := utils.GetRequestCtx(ctx)
+
+	mockTagsService.EXPECT().MergeTags(ctxWithReqID, "go-lang", &entity.TagMerge{Target: "golang"}).Return(&entity.Tag{Slug: "golang"}, nil)
+
+	err := handlerFunc(ctx)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.Code)
+}
@@ -0,0 +1,190 @@
+package api
+
+import (
+	"context"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/labstack/echo/v4"
+)
+
+// Content types of transfer formats
+var transferContentTypes = map[string]string{
+	entity.TransferNDJSON: "application/x-ndjson",
+	entity.TransferCSV:    "text/csv; charset=utf-8",
+}
+
+// Transfer service interface
+type TransferService interface {
+	Export(ctx context.Context, format string, withComments bool, w io.Writer) error
+	Import(ctx context.Context, format string, r io.Reader, dryRun bool) (*entity.ImportReport, error)
+}
+
+// TransferHandler
+type TransferHandler struct {
+	transferService TransferService
+	config          *config.Config
+	logger          logger.Logger
+}
+
+// TransferHandler constructor
+func NewTransferHandler(transferService TransferService, config *config.Config, logger logger.Logger) *TransferHandler {
+	return &TransferHandler{
+		transferService: transferService,
+		config:          config,
+		logger:          logger,
+	}
+}
+
+// Export godoc
+// @Summary Export news
+// @Description Stream all news as NDJSON or CSV, admin only. Comments are added with comments=true.
+// @Tags News
+// @Produce application/x-ndjson
+// @Produce text/csv
+// @Param format query string false "ndjson or csv, ndjson by default"
+// @Param comments query bool false "export comments of news"
+// @Success 200 {string} string
+// @Failure 400 {object} httpe.RestError
+// @Router /news/export [get]
+func (h *TransferHandler) Export() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		format := c.QueryParam("format")
+		if format == "" {
+			format = entity.TransferNDJSON
+		}
+		contentType, ok := transferContentTypes[format]
+		if !ok {
+			return c.JSON(http.StatusBadRequest, httpe.NewBadRequestError("invalid format: "+format))
+		}
+
+		var withComments bool
+		if comments := c.QueryParam("comments"); comments != "" {
+			var err error
+			if withComments, err = strconv.ParseBool(comments); err != nil {
+				return c.JSON(http.StatusBadRequest, httpe.NewBadRequestError("invalid comments: "+comments))
+			}
+		}
+
+		c.Response().Header().Set(echo.HeaderContentType, contentType)
+		c.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="news.`+format+`"`)
+		if err := h.transferService.Export(ctx, format, withComments, c.Response()); err != nil {
+			// the export can't be turned into error once streaming started
+			if c.Response().Committed {
+				h.logger.Errorf("TransferHandler.Export: %v", err)
+				return nil
+			}
+			c.Response().Header().Del(echo.HeaderContentDisposition)
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		if !c.Response().Committed {
+			c.Response().WriteHeader(http.StatusOK)
+		}
+		return nil
+	}
+}
+
+// Import godoc
+// @Summary Import news
+// @Description Import news from NDJSON or CSV as file upload or request body, admin only. Format is taken from format query, file extension or content type. Dry run validates the upload without saving news.
+// @Tags News
+// @Accept multipart/form-data
+// @Accept application/x-ndjson
+// @Accept text/csv
+// @Produce json
+// @Param format query string false "ndjson or csv"
+// @Param dry_run query bool false "validate only"
+// @Param file formData file false "upload"
+// @Success 200 {object} entity.ImportReport
+// @Failure 400 {object} httpe.RestError
+// @Failure 413 {object} httpe.RestError
+// @Router /news/import [post]
+func (h *TransferHandler) Import() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		var dryRun bool
+		if value := c.QueryParam("dry_run"); value != "" {
+			var err error
+			if dryRun, err = strconv.ParseBool(value); err != nil {
+				return c.JSON(http.StatusBadRequest, httpe.NewBadRequestError("invalid dry_run: "+value))
+			}
+		}
+
+		body, filename, mediaType, err := importUpload(c, h.config.Transfer.MaxUploadSize)
+		if err != nil {
+			return c.JSON(transferUploadError(err))
+		}
+		defer body.Close()
+
+		format := c.QueryParam("format")
+		if format == "" && filename != "" {
+			format = strings.TrimPrefix(strings.ToLower(filepath.Ext(filename)), ".")
+		}
+		if format == "" {
+			format = transferFormat(mediaType)
+		}
+		if _, ok := transferContentTypes[format]; !ok {
+			return c.JSON(http.StatusBadRequest, httpe.NewBadRequestError("invalid format: "+format))
+		}
+
+		report, err := h.transferService.Import(ctx, format, body, dryRun)
+		if err != nil {
+			return c.JSON(transferUploadError(err))
+		}
+		return c.JSON(http.StatusOK, report)
+	}
+}
+
+// Upload of import as the file field of form or request body, limited to
+// max upload size in megabytes. Name and content type of upload are returned.
+func importUpload(c echo.Context, maxUploadSize int) (io.ReadCloser, string, string, error) {
+	req := c.Request()
+	req.Body = http.MaxBytesReader(c.Response(), req.Body, int64(maxUploadSize)<<20)
+
+	mediaType, _, _ := mime.ParseMediaType(req.Header.Get(echo.HeaderContentType))
+	if mediaType != echo.MIMEMultipartForm {
+		return req.Body, "", mediaType, nil
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		return nil, "", "", httpe.NewBadRequestError(err.Error())
+	}
+	src, err := file.Open()
+	if err != nil {
+		return nil, "", "", err
+	}
+	mediaType, _, _ = mime.ParseMediaType(file.Header.Get(echo.HeaderContentType))
+	return src, file.Filename, mediaType, nil
+}
+
+// Format of upload by content type
+func transferFormat(mediaType string) string {
+	switch mediaType {
+	case "application/x-ndjson", "application/ndjson", "application/jsonl":
+		return entity.TransferNDJSON
+	case "text/csv":
+		return entity.TransferCSV
+	}
+	return ""
+}
+
+// Uploads over the limit are rejected with 413
+func transferUploadError(err error) (int, interface{}) {
+	if strings.Contains(err.Error(), "http: request body too large") {
+		return http.StatusRequestEntityTooLarge, httpe.NewRestError(http.StatusRequestEntityTooLarge, "Request Entity Too Large", err)
+	}
+	return httpe.ErrorResponse(err)
+}
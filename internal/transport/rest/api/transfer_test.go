@@ -0,0 +1,117 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	mockservice "github.com/Edbeer/restapi/internal/service/mock"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferHandler(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	config := &config.Config{
+		Transfer: config.TransferConfig{
+			MaxUploadSize: 1,
+		},
+	}
+	apiLogger := logger.NewApiLogger(nil)
+	mockTransferService := mockservice.NewMockTransfer(ctrl)
+	transferHandler := NewTransferHandler(mockTransferService, config, apiLogger)
+
+	e := echo.New()
+	e.GET("/api/news/export", transferHandler.Export())
+	e.POST("/api/news/import", transferHandler.Import())
+
+	t.Run("Export", func(t *testing.T) {
+		mockTransferService.EXPECT().Export(gomock.Any(), entity.TransferCSV, true, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, format string, withComments bool, w io.Writer) error {
+				_, err := w.Write([]byte("news_id\n"))
+				return err
+			},
+		)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/news/export?format=csv&comments=true", nil)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusOK, res.Code)
+		require.Equal(t, "text/csv; charset=utf-8", res.Header().Get(echo.HeaderContentType))
+		require.Equal(t, "news_id\n", res.Body.String())
+	})
+
+	t.Run("Export invalid format", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/news/export?format=xml", nil)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("Import body", func(t *testing.T) {
+		report := &entity.ImportReport{DryRun: true, Total: 1, Imported: 1, Errors: []*entity.ImportError{}}
+		mockTransferService.EXPECT().Import(gomock.Any(), entity.TransferNDJSON, gomock.Any(), true).Return(report, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/news/import?dry_run=true", strings.NewReader("{}\n"))
+		req.Header.Set(echo.HeaderContentType, "application/x-ndjson")
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusOK, res.Code)
+		result := &entity.ImportReport{}
+		require.NoError(t, json.Unmarshal(res.Body.Bytes(), result))
+		require.Equal(t, report, result)
+	})
+
+	t.Run("Import file", func(t *testing.T) {
+		report := &entity.ImportReport{Total: 1, Imported: 1, Errors: []*entity.ImportError{}}
+		mockTransferService.EXPECT().Import(gomock.Any(), entity.TransferCSV, gomock.Any(), false).DoAndReturn(
+			func(ctx context.Context, format string, r io.Reader, dryRun bool) (*entity.ImportReport, error) {
+				upload, err := io.ReadAll(r)
+				require.NoError(t, err)
+				require.Equal(t, "title,content,author_id\n", string(upload))
+				return report, nil
+			},
+		)
+
+		var body bytes.Buffer
+		writer := multipart.NewWriter(&body)
+		part, err := writer.CreateFormFile("file", "news.csv")
+		require.NoError(t, err)
+		_, err = part.Write([]byte("title,content,author_id\n"))
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+
+		req := httptest.NewRequest(http.MethodPost, "/api/news/import", &body)
+		req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("Import unknown format", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/news/import", strings.NewReader("{}"))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
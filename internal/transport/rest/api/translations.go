@@ -0,0 +1,162 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// Translations service interface
+type TranslationsService interface {
+	GetTranslations(ctx context.Context, newsID uuid.UUID) ([]*entity.NewsTranslation, error)
+	Create(ctx context.Context, translation *entity.NewsTranslation) (*entity.NewsTranslation, error)
+	Update(ctx context.Context, translation *entity.NewsTranslation) (*entity.NewsTranslation, error)
+	Delete(ctx context.Context, newsID uuid.UUID, locale string) error
+}
+
+// TranslationsHandler
+type TranslationsHandler struct {
+	translationsService TranslationsService
+	config              *config.Config
+	logger              logger.Logger
+}
+
+// TranslationsHandler constructor
+func NewTranslationsHandler(translationsService TranslationsService, config *config.Config, logger logger.Logger) *TranslationsHandler {
+	return &TranslationsHandler{
+		translationsService: translationsService,
+		config:              config,
+		logger:              logger,
+	}
+}
+
+// GetTranslations godoc
+// @Summary Get news translations
+// @Description Get translations of news by locale, translations of unpublished news are visible to the authors only
+// @Tags News
+// @Produce json
+// @Param news_id path string true "news id"
+// @Success 200 {array} entity.NewsTranslation
+// @Failure 404 {object} httpe.RestError
+// @Router /news/{news_id}/translations [get]
+func (h *TranslationsHandler) GetTranslations() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		newsID, err := uuid.Parse(c.Param("news_id"))
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		translations, err := h.translationsService.GetTranslations(ctx, newsID)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		return c.JSON(http.StatusOK, translations)
+	}
+}
+
+// Create godoc
+// @Summary Translate news
+// @Description Add translation of news to another locale than the original one, only the authors of news can translate it, editors once it is published or scheduled
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param news_id path string true "news id"
+// @Param translation body entity.NewsTranslation true "translation"
+// @Success 201 {object} entity.NewsTranslation
+// @Failure 400 {object} httpe.RestError
+// @Failure 403 {object} httpe.RestError
+// @Router /news/{news_id}/translations [post]
+func (h *TranslationsHandler) Create() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		newsID, err := uuid.Parse(c.Param("news_id"))
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		translation := &entity.NewsTranslation{}
+		if err := c.Bind(translation); err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		translation.NewsID = newsID
+
+		createdTranslation, err := h.translationsService.Create(ctx, translation)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		return c.JSON(http.StatusCreated, createdTranslation)
+	}
+}
+
+// Update godoc
+// @Summary Update news translation
+// @Description Replace title and content of translation, only the authors of news can update it, editors once it is published or scheduled
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param news_id path string true "news id"
+// @Param locale path string true "locale"
+// @Param translation body entity.NewsTranslation true "translation"
+// @Success 200 {object} entity.NewsTranslation
+// @Failure 403 {object} httpe.RestError
+// @Failure 404 {object} httpe.RestError
+// @Router /news/{news_id}/translations/{locale} [put]
+func (h *TranslationsHandler) Update() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		newsID, err := uuid.Parse(c.Param("news_id"))
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		translation := &entity.NewsTranslation{}
+		if err := c.Bind(translation); err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		translation.NewsID = newsID
+		translation.Locale = c.Param("locale")
+
+		updatedTranslation, err := h.translationsService.Update(ctx, translation)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		return c.JSON(http.StatusOK, updatedTranslation)
+	}
+}
+
+// Delete godoc
+// @Summary Delete news translation
+// @Description Delete translation, only the authors of news can delete it, editors once it is published or scheduled
+// @Tags News
+// @Param news_id path string true "news id"
+// @Param locale path string true "locale"
+// @Success 200 {string} string	"ok"
+// @Failure 403 {object} httpe.RestError
+// @Failure 404 {object} httpe.RestError
+// @Router /news/{news_id}/translations/{locale} [delete]
+func (h *TranslationsHandler) Delete() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		newsID, err := uuid.Parse(c.Param("news_id"))
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+
+		if err := h.translationsService.Delete(ctx, newsID, c.Param("locale")); err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		return c.NoContent(http.StatusOK)
+	}
+}
@@ -0,0 +1,74 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	mockservice "github.com/Edbeer/restapi/internal/service/mock"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslationsHandler(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockTranslationsService := mockservice.NewMockTranslations(ctrl)
+	translationsHandler := NewTranslationsHandler(mockTranslationsService, nil, apiLogger)
+
+	e := echo.New()
+	e.POST("/api/news/:news_id/translations", translationsHandler.Create())
+	e.PUT("/api/news/:news_id/translations/:locale", translationsHandler.Update())
+	e.DELETE("/api/news/:news_id/translations/:locale", translationsHandler.Delete())
+
+	newsID := uuid.New()
+
+	t.Run("Create", func(t *testing.T) {
+		translation := &entity.NewsTranslation{NewsID: newsID, Locale: "de", Title: "Regen in Berlin", Content: "Es regnet in Berlin"}
+		mockTranslationsService.EXPECT().Create(gomock.Any(), translation).Return(translation, nil)
+
+		body := `{"locale": "de", "title": "Regen in Berlin", "content": "Es regnet in Berlin"}`
+		req := httptest.NewRequest(http.MethodPost, "/api/news/"+newsID.String()+"/translations", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusCreated, res.Code)
+		result := &entity.NewsTranslation{}
+		require.NoError(t, json.Unmarshal(res.Body.Bytes(), result))
+		require.Equal(t, "de", result.Locale)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		translation := &entity.NewsTranslation{NewsID: newsID, Locale: "fr", Title: "Pluie à Berlin", Content: "Il pleut à Berlin"}
+		mockTranslationsService.EXPECT().Update(gomock.Any(), translation).Return(translation, nil)
+
+		body := `{"title": "Pluie à Berlin", "content": "Il pleut à Berlin"}`
+		req := httptest.NewRequest(http.MethodPut, "/api/news/"+newsID.String()+"/translations/fr", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		mockTranslationsService.EXPECT().Delete(gomock.Any(), newsID, "fr").Return(nil)
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/news/"+newsID.String()+"/translations/fr", nil)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusOK, res.Code)
+	})
+}
@@ -0,0 +1,69 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/labstack/echo/v4"
+)
+
+// Views service interface
+type ViewsService interface {
+	GetTrending(ctx context.Context, query *entity.TrendingQuery) (*entity.TrendingList, error)
+}
+
+// ViewsHandler
+type ViewsHandler struct {
+	viewsService ViewsService
+	config       *config.Config
+	logger       logger.Logger
+}
+
+// ViewsHandler constructor
+func NewViewsHandler(viewsService ViewsService, config *config.Config, logger logger.Logger) *ViewsHandler {
+	return &ViewsHandler{
+		viewsService: viewsService,
+		config:       config,
+		logger:       logger,
+	}
+}
+
+// GetTrending godoc
+// @Summary Get trending news
+// @Description Published news ranked by unique views within window, recent views weigh more
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param window query string false "window: 1h, 24h or 7d, 24h by default"
+// @Param size query int false "number of news"
+// @Success 200 {object} entity.TrendingList
+// @Router /news/trending [get]
+func (h *ViewsHandler) GetTrending() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		query := &entity.TrendingQuery{Window: c.QueryParam("window")}
+		if query.Window == "" {
+			query.Window = entity.TrendingDay
+		}
+		if size := c.QueryParam("size"); size != "" {
+			n, err := strconv.Atoi(size)
+			if err != nil {
+				return c.JSON(http.StatusBadRequest, httpe.NewBadRequestError("invalid size: "+size))
+			}
+			query.Size = n
+		}
+
+		trending, err := h.viewsService.GetTrending(ctx, query)
+		if err != nil {
+			return c.JSON(httpe.ErrorResponse(err))
+		}
+		return c.JSON(http.StatusOK, trending)
+	}
+}
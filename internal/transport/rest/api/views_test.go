@@ -0,0 +1,67 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	mockservice "github.com/Edbeer/restapi/internal/service/mock"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestViewsHandler_GetTrending(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockViewsService := mockservice.NewMockViews(ctrl)
+	viewsHandler := NewViewsHandler(mockViewsService, nil, apiLogger)
+
+	e := echo.New()
+	e.GET("/api/news/trending", viewsHandler.GetTrending())
+
+	t.Run("OK", func(t *testing.T) {
+		newsID := uuid.New()
+		mockViewsService.EXPECT().GetTrending(gomock.Any(), &entity.TrendingQuery{Window: "1h", Size: 5}).Return(&entity.TrendingList{
+			Window: "1h",
+			News:   []*entity.TrendingNews{{News: entity.News{NewsID: newsID}, Views: 12, Score: 3.5}},
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/news/trending?window=1h&size=5", nil)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusOK, res.Code)
+		trending := &entity.TrendingList{}
+		require.NoError(t, json.Unmarshal(res.Body.Bytes(), trending))
+		require.Len(t, trending.News, 1)
+		require.Equal(t, newsID, trending.News[0].NewsID)
+		require.Equal(t, int64(12), trending.News[0].Views)
+	})
+
+	t.Run("Default window", func(t *testing.T) {
+		mockViewsService.EXPECT().GetTrending(gomock.Any(), &entity.TrendingQuery{Window: entity.TrendingDay}).Return(&entity.TrendingList{Window: "24h"}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/news/trending", nil)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("Invalid size", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/news/trending?size=ten", nil)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
@@ -0,0 +1,64 @@
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/utils"
+	"github.com/labstack/echo/v4"
+)
+
+// WordPress service interface
+type WordPressService interface {
+	Import(ctx context.Context, r io.Reader) (*entity.WordPressReport, error)
+}
+
+// WordPressHandler
+type WordPressHandler struct {
+	wordPressService WordPressService
+	config           *config.Config
+	logger           logger.Logger
+}
+
+// WordPressHandler constructor
+func NewWordPressHandler(wordPressService WordPressService, config *config.Config, logger logger.Logger) *WordPressHandler {
+	return &WordPressHandler{
+		wordPressService: wordPressService,
+		config:           config,
+		logger:           logger,
+	}
+}
+
+// Import godoc
+// @Summary Import WordPress export
+// @Description Import posts, categories, tags, authors and approved comments from WordPress eXtended RSS export as file upload or request body, admin only. Authors and commenters become placeholder users, entities imported before are skipped.
+// @Tags News
+// @Accept multipart/form-data
+// @Accept application/xml
+// @Produce json
+// @Param file formData file false "WordPress export"
+// @Success 200 {object} entity.WordPressReport
+// @Failure 400 {object} httpe.RestError
+// @Failure 413 {object} httpe.RestError
+// @Router /news/import/wordpress [post]
+func (h *WordPressHandler) Import() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+
+		body, _, _, err := importUpload(c, h.config.Transfer.MaxUploadSize)
+		if err != nil {
+			return c.JSON(transferUploadError(err))
+		}
+		defer body.Close()
+
+		report, err := h.wordPressService.Import(ctx, body)
+		if err != nil {
+			return c.JSON(transferUploadError(err))
+		}
+		return c.JSON(http.StatusOK, report)
+	}
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/internal/entity"
+	mockservice "github.com/Edbeer/restapi/internal/service/mock"
+	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWordPressHandler(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	config := &config.Config{
+		Transfer: config.TransferConfig{
+			MaxUploadSize: 1,
+		},
+	}
+	apiLogger := logger.NewApiLogger(nil)
+	mockWordPressService := mockservice.NewMockWordPress(ctrl)
+	wordPressHandler := NewWordPressHandler(mockWordPressService, config, apiLogger)
+
+	e := echo.New()
+	e.POST("/api/news/import/wordpress", wordPressHandler.Import())
+
+	t.Run("Import", func(t *testing.T) {
+		report := &entity.WordPressReport{
+			Source: "wordpress:example.com",
+			News:   entity.ImportCount{Created: 1},
+			Errors: []*entity.WordPressError{},
+		}
+		mockWordPressService.EXPECT().Import(gomock.Any(), gomock.Any()).Return(report, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/news/import/wordpress", strings.NewReader("<rss></rss>"))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationXML)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusOK, res.Code)
+		result := &entity.WordPressReport{}
+		require.NoError(t, json.Unmarshal(res.Body.Bytes(), result))
+		require.Equal(t, report, result)
+	})
+
+	t.Run("Too large", func(t *testing.T) {
+		mockWordPressService.EXPECT().Import(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, r io.Reader) (*entity.WordPressReport, error) {
+				_, err := io.ReadAll(r)
+				return nil, err
+			},
+		)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/news/import/wordpress", strings.NewReader(strings.Repeat("x", 2<<20)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationXML)
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusRequestEntityTooLarge, res.Code)
+	})
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/Edbeer/restapi/internal/storage/redis"
 	"github.com/Edbeer/restapi/internal/transport/rest/api"
 	"github.com/Edbeer/restapi/pkg/logger"
+	"github.com/Edbeer/restapi/pkg/scheduler"
 	"github.com/go-redis/redis/v9"
 	"github.com/jmoiron/sqlx"
 	"github.com/labstack/echo/v4"
@@ -55,15 +56,32 @@ func (s *Server) Run() error {
 			PsqlStorage:  psql,
 			RedisStorage: redis})
 		handler := api.NewHandlers(api.Deps{
-			AuthService:     service.Auth,
-			NewsService:     service.News,
-			CommentsService: service.Comments,
-			Config:          cfg,
-			Logger:          s.logger,
+			AuthService:          service.Auth,
+			NewsService:          service.News,
+			CommentsService:      service.Comments,
+			SessionService:       service.Session,
+			SuggestService:       service.Suggest,
+			TagsService:          service.Tags,
+			CategoriesService:    service.Categories,
+			FeedsService:         service.Feeds,
+			SitemapsService:      service.Sitemaps,
+			PagesService:         service.Pages,
+			ViewsService:         service.Views,
+			ReactionsService:     service.Reactions,
+			BookmarksService:     service.Bookmarks,
+			RelatedService:       service.Related,
+			TranslationsService:  service.Translations,
+			NotificationsService: service.Notifications,
+			ContributorsService:  service.Contributors,
+			TransferService:      service.Transfer,
+			WordPressService:     service.WordPress,
+			Config:               cfg,
+			Logger:               s.logger,
 		})
 		if err := handler.Init(s.echo); err != nil {
 			s.logger.Fatal(err)
 		}
+		jobs := s.startScheduler(service)
 
 		s.echo.Server.ReadTimeout = time.Second * time.Duration(s.config.Server.ReadTimeout)
 		s.echo.Server.WriteTimeout = time.Second * time.Duration(s.config.Server.WriteTimeout)
@@ -80,6 +98,7 @@ func (s *Server) Run() error {
 		signal.Notify(quit, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
 
 		<-quit
+		jobs.Stop()
 
 		ctx, shutdown := context.WithTimeout(context.Background(), 5*time.Second)
 		defer shutdown()
@@ -100,15 +119,32 @@ func (s *Server) Run() error {
 			PsqlStorage:  psql,
 			RedisStorage: redis})
 		handler := api.NewHandlers(api.Deps{
-			AuthService:     service.Auth,
-			NewsService:     service.News,
-			CommentsService: service.Comments,
-			Config:          cfg,
-			Logger:          s.logger,
+			AuthService:          service.Auth,
+			NewsService:          service.News,
+			CommentsService:      service.Comments,
+			SessionService:       service.Session,
+			SuggestService:       service.Suggest,
+			TagsService:          service.Tags,
+			CategoriesService:    service.Categories,
+			FeedsService:         service.Feeds,
+			SitemapsService:      service.Sitemaps,
+			PagesService:         service.Pages,
+			ViewsService:         service.Views,
+			ReactionsService:     service.Reactions,
+			BookmarksService:     service.Bookmarks,
+			RelatedService:       service.Related,
+			TranslationsService:  service.Translations,
+			NotificationsService: service.Notifications,
+			ContributorsService:  service.Contributors,
+			TransferService:      service.Transfer,
+			WordPressService:     service.WordPress,
+			Config:               cfg,
+			Logger:               s.logger,
 		})
 		if err := handler.Init(e); err != nil {
 			s.logger.Fatal(err)
 		}
+		jobs := s.startScheduler(service)
 
 		server := &http.Server{
 			Addr:           s.config.Server.Port,
@@ -129,6 +165,7 @@ func (s *Server) Run() error {
 		signal.Notify(quit, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
 
 		<-quit
+		jobs.Stop()
 
 		ctx, shutdown := context.WithTimeout(context.Background(), 5*time.Second)
 		defer shutdown()
@@ -137,3 +174,26 @@ func (s *Server) Run() error {
 		return s.echo.Server.Shutdown(ctx)
 	}
 }
+
+// Start background jobs of services
+func (s *Server) startScheduler(services *service.Services) *scheduler.Scheduler {
+	jobs := scheduler.NewScheduler(s.logger)
+	jobs.Add("PublishScheduledNews", time.Second*time.Duration(s.config.Scheduler.PublishInterval), func(ctx context.Context) error {
+		_, err := services.News.PublishScheduled(ctx)
+		return err
+	})
+	jobs.Add("FlushViews", time.Second*time.Duration(s.config.Scheduler.ViewsFlushInterval), func(ctx context.Context) error {
+		_, err := services.Views.FlushViews(ctx)
+		return err
+	})
+	jobs.Add("ReconcileReactions", time.Second*time.Duration(s.config.Scheduler.ReactionsReconcileInterval), func(ctx context.Context) error {
+		_, err := services.Reactions.Reconcile(ctx)
+		return err
+	})
+	jobs.Add("RefreshRelated", time.Second*time.Duration(s.config.Scheduler.RelatedRefreshInterval), func(ctx context.Context) error {
+		_, err := services.Related.Refresh(ctx)
+		return err
+	})
+	jobs.Start(context.Background())
+	return jobs
+}
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS news_search_vector_idx;
+DROP TRIGGER IF EXISTS news_search_vector_trigger ON news;
+DROP FUNCTION IF EXISTS news_search_vector_update();
+ALTER TABLE news DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE news DROP COLUMN IF EXISTS language;
@@ -0,0 +1,24 @@
+ALTER TABLE news ADD COLUMN IF NOT EXISTS language VARCHAR(32) NOT NULL DEFAULT 'english';
+ALTER TABLE news ADD COLUMN IF NOT EXISTS search_vector TSVECTOR;
+
+CREATE OR REPLACE FUNCTION news_search_vector_update() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.search_vector :=
+            setweight(to_tsvector(NEW.language::regconfig, COALESCE(NEW.title, '')), 'A') ||
+            setweight(to_tsvector(NEW.language::regconfig, COALESCE(NEW.category, '')), 'B') ||
+            setweight(to_tsvector(NEW.language::regconfig, COALESCE(NEW.content, '')), 'C');
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS news_search_vector_trigger ON news;
+CREATE TRIGGER news_search_vector_trigger
+    BEFORE INSERT OR UPDATE OF title, content, category, language
+    ON news
+    FOR EACH ROW
+EXECUTE FUNCTION news_search_vector_update();
+
+UPDATE news SET language = language;
+
+CREATE INDEX IF NOT EXISTS news_search_vector_idx ON news USING GIN (search_vector);
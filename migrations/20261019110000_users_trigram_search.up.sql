@@ -0,0 +1,7 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS users_first_name_trgm_idx ON users USING GIN (first_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS users_last_name_trgm_idx ON users USING GIN (last_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS users_email_trgm_idx ON users USING GIN (email gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS users_role_idx ON users (role);
+CREATE INDEX IF NOT EXISTS users_created_at_idx ON users (created_at);
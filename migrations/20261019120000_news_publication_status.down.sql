@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS news_author_status_idx;
+DROP INDEX IF EXISTS news_status_publish_at_idx;
+
+ALTER TABLE news DROP COLUMN IF EXISTS publish_at;
+ALTER TABLE news DROP COLUMN IF EXISTS status;
@@ -0,0 +1,8 @@
+ALTER TABLE news ADD COLUMN IF NOT EXISTS status VARCHAR(16) NOT NULL DEFAULT 'published'
+    CHECK ( status IN ('draft', 'scheduled', 'published', 'archived') );
+ALTER TABLE news ADD COLUMN IF NOT EXISTS publish_at TIMESTAMP WITH TIME ZONE;
+
+UPDATE news SET publish_at = created_at WHERE publish_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS news_status_publish_at_idx ON news (status, publish_at);
+CREATE INDEX IF NOT EXISTS news_author_status_idx ON news (author_id, status);
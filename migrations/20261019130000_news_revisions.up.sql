@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS news_revisions
+(
+    revision_id UUID PRIMARY KEY                  DEFAULT uuid_generate_v4(),
+    news_id     UUID                     NOT NULL REFERENCES news (news_id) ON DELETE CASCADE,
+    revision    INTEGER                  NOT NULL CHECK ( revision > 0 ),
+    title       VARCHAR(250)             NOT NULL,
+    content     TEXT                     NOT NULL,
+    editor_id   UUID                     REFERENCES users (user_id) ON DELETE SET NULL,
+    rollback_of INTEGER,
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    UNIQUE (news_id, revision)
+);
+
+INSERT INTO news_revisions (news_id, revision, title, content, editor_id, created_at)
+SELECT news_id, 1, title, content, author_id, COALESCE(updated_at, created_at)
+FROM news
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS tags
+(
+    tag_id     UUID PRIMARY KEY                  DEFAULT uuid_generate_v4(),
+    name       VARCHAR(32)              NOT NULL CHECK ( name <> '' ),
+    slug       VARCHAR(64)              NOT NULL UNIQUE CHECK ( slug <> '' ),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS news_tags
+(
+    news_id UUID NOT NULL REFERENCES news (news_id) ON DELETE CASCADE,
+    tag_id  UUID NOT NULL REFERENCES tags (tag_id) ON DELETE CASCADE,
+    PRIMARY KEY (news_id, tag_id)
+);
+
+CREATE INDEX IF NOT EXISTS news_tags_tag_id_idx ON news_tags (tag_id);
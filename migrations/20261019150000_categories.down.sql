@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS news_category_id_idx;
+ALTER TABLE news DROP COLUMN IF EXISTS category_id;
+
+DROP TABLE IF EXISTS categories;
@@ -0,0 +1,44 @@
+CREATE TABLE IF NOT EXISTS categories
+(
+    category_id UUID PRIMARY KEY                  DEFAULT uuid_generate_v4(),
+    parent_id   UUID REFERENCES categories (category_id) ON DELETE RESTRICT,
+    name        VARCHAR(64)              NOT NULL CHECK ( name <> '' ),
+    slug        VARCHAR(80)              NOT NULL UNIQUE CHECK ( slug <> '' ),
+    description VARCHAR(1024),
+    position    INTEGER                  NOT NULL DEFAULT 0,
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    CHECK ( parent_id <> category_id )
+);
+
+CREATE INDEX IF NOT EXISTS categories_parent_id_idx ON categories (parent_id, position);
+
+ALTER TABLE news ADD COLUMN IF NOT EXISTS category_id UUID REFERENCES categories (category_id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS news_category_id_idx ON news (category_id);
+
+-- Map free-text categories: case and spacing variants fall into one root category
+CREATE TEMPORARY TABLE news_category_map AS
+SELECT DISTINCT category,
+                lower(regexp_replace(trim(category), '\s+', ' ', 'g')) AS name,
+                COALESCE(
+                        NULLIF(trim(BOTH '-' FROM regexp_replace(lower(trim(category)), '[^a-z0-9]+', '-', 'g')), ''),
+                        'category-' || left(md5(lower(trim(category))), 8)
+                    )                                                  AS slug
+FROM news
+WHERE category IS NOT NULL
+  AND trim(category) <> '';
+
+INSERT INTO categories (name, slug)
+SELECT DISTINCT ON (slug) left(name, 64), slug
+FROM news_category_map
+ORDER BY slug, name
+ON CONFLICT (slug) DO NOTHING;
+
+UPDATE news n
+SET category_id = c.category_id,
+    category    = c.name
+FROM news_category_map m
+         JOIN categories c ON c.slug = m.slug
+WHERE n.category = m.category;
+
+DROP TABLE news_category_map;
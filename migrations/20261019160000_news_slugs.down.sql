@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS news_slug_redirects;
+
+DROP INDEX IF EXISTS news_slug_idx;
+ALTER TABLE news DROP COLUMN IF EXISTS slug;
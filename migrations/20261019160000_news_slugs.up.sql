@@ -0,0 +1,36 @@
+ALTER TABLE news ADD COLUMN IF NOT EXISTS slug VARCHAR(96);
+
+-- Backfill slugs from titles, later duplicates get a suffix from news id so they
+-- can't collide with slugs of other titles
+WITH base AS (
+    SELECT news_id,
+           created_at,
+           COALESCE(
+                   NULLIF(trim(BOTH '-' FROM left(regexp_replace(lower(title), '[^a-z0-9]+', '-', 'g'), 80)), ''),
+                   'news'
+               ) AS slug
+    FROM news
+    WHERE slug IS NULL
+),
+     numbered AS (
+         SELECT news_id,
+                slug,
+                row_number() OVER (PARTITION BY slug ORDER BY created_at, news_id) AS n
+         FROM base
+     )
+UPDATE news
+SET slug = CASE WHEN numbered.n = 1 THEN numbered.slug ELSE numbered.slug || '-' || left(numbered.news_id::text, 8) END
+FROM numbered
+WHERE news.news_id = numbered.news_id;
+
+ALTER TABLE news ALTER COLUMN slug SET NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS news_slug_idx ON news (slug);
+
+CREATE TABLE IF NOT EXISTS news_slug_redirects
+(
+    slug       VARCHAR(96) PRIMARY KEY,
+    news_id    UUID                     NOT NULL REFERENCES news (news_id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS news_slug_redirects_news_id_idx ON news_slug_redirects (news_id);
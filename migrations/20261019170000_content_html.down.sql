@@ -0,0 +1,2 @@
+ALTER TABLE comments DROP COLUMN IF EXISTS message_html;
+ALTER TABLE news DROP COLUMN IF EXISTS content_html;
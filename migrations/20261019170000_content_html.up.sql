@@ -0,0 +1,4 @@
+-- Sanitized HTML rendered from markdown on save, empty for rows saved before
+-- rendering existed, those are rendered on read
+ALTER TABLE news ADD COLUMN IF NOT EXISTS content_html TEXT NOT NULL DEFAULT '';
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS message_html TEXT NOT NULL DEFAULT '';
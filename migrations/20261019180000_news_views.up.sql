@@ -0,0 +1,2 @@
+-- Unique views flushed periodically from per-hour counters in redis
+ALTER TABLE news ADD COLUMN IF NOT EXISTS views BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS reaction_counts;
+
+DROP INDEX IF EXISTS reactions_user_id_idx;
+DROP TABLE IF EXISTS reactions;
@@ -0,0 +1,23 @@
+-- Reactions of users on news and comments, one per user and kind.
+-- Reactions of deleted targets are removed by background reconciliation.
+CREATE TABLE IF NOT EXISTS reactions
+(
+    target_type VARCHAR(16)              NOT NULL CHECK ( target_type IN ('news', 'comment') ),
+    target_id   UUID                     NOT NULL,
+    user_id     UUID                     NOT NULL REFERENCES users (user_id) ON DELETE CASCADE,
+    kind        VARCHAR(32)              NOT NULL CHECK ( kind <> '' ),
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    PRIMARY KEY (target_type, target_id, user_id, kind)
+);
+
+CREATE INDEX IF NOT EXISTS reactions_user_id_idx ON reactions (user_id);
+
+-- Counters maintained together with reactions
+CREATE TABLE IF NOT EXISTS reaction_counts
+(
+    target_type VARCHAR(16) NOT NULL,
+    target_id   UUID        NOT NULL,
+    kind        VARCHAR(32) NOT NULL,
+    count       BIGINT      NOT NULL DEFAULT 0 CHECK ( count >= 0 ),
+    PRIMARY KEY (target_type, target_id, kind)
+);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS reading_list_items_list_id_position_idx;
+DROP TABLE IF EXISTS reading_list_items;
+
+DROP TABLE IF EXISTS reading_lists;
+
+DROP INDEX IF EXISTS bookmarks_user_id_created_at_idx;
+DROP TABLE IF EXISTS bookmarks;
@@ -0,0 +1,32 @@
+CREATE TABLE IF NOT EXISTS bookmarks
+(
+    user_id    UUID                     NOT NULL REFERENCES users (user_id) ON DELETE CASCADE,
+    news_id    UUID                     NOT NULL REFERENCES news (news_id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, news_id)
+);
+
+CREATE INDEX IF NOT EXISTS bookmarks_user_id_created_at_idx ON bookmarks (user_id, created_at DESC);
+
+-- Named reading lists, public lists are readable by anyone
+CREATE TABLE IF NOT EXISTS reading_lists
+(
+    list_id    UUID PRIMARY KEY                  DEFAULT uuid_generate_v4(),
+    user_id    UUID                     NOT NULL REFERENCES users (user_id) ON DELETE CASCADE,
+    name       VARCHAR(64)              NOT NULL CHECK ( name <> '' ),
+    is_public  BOOLEAN                  NOT NULL DEFAULT false,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    UNIQUE (user_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS reading_list_items
+(
+    list_id    UUID                     NOT NULL REFERENCES reading_lists (list_id) ON DELETE CASCADE,
+    news_id    UUID                     NOT NULL REFERENCES news (news_id) ON DELETE CASCADE,
+    position   INTEGER                  NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    PRIMARY KEY (list_id, news_id)
+);
+
+CREATE INDEX IF NOT EXISTS reading_list_items_list_id_position_idx ON reading_list_items (list_id, position);
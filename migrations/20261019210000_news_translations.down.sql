@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS news_translations;
+
+ALTER TABLE news DROP COLUMN IF EXISTS locale;
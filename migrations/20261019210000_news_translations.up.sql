@@ -0,0 +1,27 @@
+-- Locale of original news text, existing news take it from their text search language
+ALTER TABLE news ADD COLUMN IF NOT EXISTS locale VARCHAR(16) NOT NULL DEFAULT 'en';
+
+UPDATE news
+SET locale = CASE language
+                 WHEN 'arabic' THEN 'ar' WHEN 'danish' THEN 'da' WHEN 'dutch' THEN 'nl'
+                 WHEN 'finnish' THEN 'fi' WHEN 'french' THEN 'fr' WHEN 'german' THEN 'de'
+                 WHEN 'greek' THEN 'el' WHEN 'hungarian' THEN 'hu' WHEN 'indonesian' THEN 'id'
+                 WHEN 'irish' THEN 'ga' WHEN 'italian' THEN 'it' WHEN 'lithuanian' THEN 'lt'
+                 WHEN 'nepali' THEN 'ne' WHEN 'norwegian' THEN 'no' WHEN 'portuguese' THEN 'pt'
+                 WHEN 'romanian' THEN 'ro' WHEN 'russian' THEN 'ru' WHEN 'spanish' THEN 'es'
+                 WHEN 'swedish' THEN 'sv' WHEN 'tamil' THEN 'ta' WHEN 'turkish' THEN 'tr'
+                 ELSE locale
+    END;
+
+-- Translations of news title and content, one per locale besides the original one
+CREATE TABLE IF NOT EXISTS news_translations
+(
+    news_id      UUID                     NOT NULL REFERENCES news (news_id) ON DELETE CASCADE,
+    locale       VARCHAR(16)              NOT NULL CHECK ( locale <> '' ),
+    title        VARCHAR(250)             NOT NULL CHECK ( title <> '' ),
+    content      TEXT                     NOT NULL CHECK ( content <> '' ),
+    content_html TEXT                     NOT NULL DEFAULT '',
+    created_at   TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at   TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    PRIMARY KEY (news_id, locale)
+);
@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS notifications_user_id_created_at_idx;
+DROP TABLE IF EXISTS notifications;
+
+DROP INDEX IF EXISTS news_reviews_news_id_created_at_idx;
+DROP TABLE IF EXISTS news_reviews;
+
+UPDATE news SET status = 'draft' WHERE status IN ('pending_review', 'changes_requested', 'rejected');
+ALTER TABLE news DROP CONSTRAINT IF EXISTS news_status_check;
+ALTER TABLE news ADD CONSTRAINT news_status_check
+    CHECK ( status IN ('draft', 'scheduled', 'published', 'archived') );
+ALTER TABLE news ALTER COLUMN status TYPE VARCHAR(16);
@@ -0,0 +1,32 @@
+-- Review statuses of news submitted by contributors
+ALTER TABLE news ALTER COLUMN status TYPE VARCHAR(20);
+ALTER TABLE news DROP CONSTRAINT IF EXISTS news_status_check;
+ALTER TABLE news ADD CONSTRAINT news_status_check
+    CHECK ( status IN ('draft', 'pending_review', 'changes_requested', 'rejected', 'scheduled', 'published', 'archived') );
+
+-- History of submissions and editor decisions
+CREATE TABLE IF NOT EXISTS news_reviews
+(
+    review_id   UUID PRIMARY KEY                  DEFAULT uuid_generate_v4(),
+    news_id     UUID                     NOT NULL REFERENCES news (news_id) ON DELETE CASCADE,
+    reviewer_id UUID                     REFERENCES users (user_id) ON DELETE SET NULL,
+    action      VARCHAR(20)              NOT NULL
+        CHECK ( action IN ('submitted', 'approved', 'changes_requested', 'rejected') ),
+    comment     TEXT                     NOT NULL DEFAULT '',
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS news_reviews_news_id_created_at_idx ON news_reviews (news_id, created_at);
+
+CREATE TABLE IF NOT EXISTS notifications
+(
+    notification_id UUID PRIMARY KEY                  DEFAULT uuid_generate_v4(),
+    user_id         UUID                     NOT NULL REFERENCES users (user_id) ON DELETE CASCADE,
+    kind            VARCHAR(32)              NOT NULL,
+    news_id         UUID                     REFERENCES news (news_id) ON DELETE CASCADE,
+    message         TEXT                     NOT NULL DEFAULT '',
+    read_at         TIMESTAMP WITH TIME ZONE,
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS notifications_user_id_created_at_idx ON notifications (user_id, created_at DESC);
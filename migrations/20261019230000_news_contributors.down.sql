@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS news_contributors_user_id_status_idx;
+DROP TABLE IF EXISTS news_contributors;
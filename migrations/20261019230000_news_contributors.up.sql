@@ -0,0 +1,16 @@
+-- Co-authors and other credited contributors of news, invitees accept first
+CREATE TABLE IF NOT EXISTS news_contributors
+(
+    news_id     UUID                     NOT NULL REFERENCES news (news_id) ON DELETE CASCADE,
+    user_id     UUID                     NOT NULL REFERENCES users (user_id) ON DELETE CASCADE,
+    role        VARCHAR(20)              NOT NULL
+        CHECK ( role IN ('author', 'editor', 'photographer') ),
+    status      VARCHAR(20)              NOT NULL DEFAULT 'invited'
+        CHECK ( status IN ('invited', 'accepted') ),
+    invited_by  UUID                     REFERENCES users (user_id) ON DELETE SET NULL,
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    accepted_at TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (news_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS news_contributors_user_id_status_idx ON news_contributors (user_id, status);
@@ -0,0 +1,15 @@
+-- Replies of comments
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS parent_id UUID REFERENCES comments (comment_id) ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS comments_parent_id_idx ON comments (parent_id);
+
+-- Entities imported from other systems by their source ids, re-runs skip them
+CREATE TABLE IF NOT EXISTS import_sources
+(
+    source     VARCHAR(255)             NOT NULL CHECK ( source <> '' ),
+    kind       VARCHAR(20)              NOT NULL
+        CHECK ( kind IN ('user', 'category', 'news', 'comment') ),
+    source_id  VARCHAR(255)             NOT NULL CHECK ( source_id <> '' ),
+    target_id  UUID                     NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    PRIMARY KEY (source, kind, source_id)
+);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS comments_news_id_created_at_comment_id_idx;
+DROP INDEX IF EXISTS users_created_at_user_id_idx;
+DROP INDEX IF EXISTS news_created_at_news_id_idx;
@@ -0,0 +1,4 @@
+-- Keyset pagination walks lists by their sort key and id
+CREATE INDEX IF NOT EXISTS news_created_at_news_id_idx ON news (created_at, news_id);
+CREATE INDEX IF NOT EXISTS users_created_at_user_id_idx ON users (created_at, user_id);
+CREATE INDEX IF NOT EXISTS comments_news_id_created_at_comment_id_idx ON comments (news_id, created_at, comment_id);
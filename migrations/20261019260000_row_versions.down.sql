@@ -0,0 +1,3 @@
+ALTER TABLE users DROP COLUMN IF EXISTS version;
+ALTER TABLE comments DROP COLUMN IF EXISTS version;
+ALTER TABLE news DROP COLUMN IF EXISTS version;
@@ -0,0 +1,5 @@
+-- Version of a row is bumped by every edit, counters leave it alone. ETags
+-- carry it and If-Match preconditions are checked against it
+ALTER TABLE news ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
@@ -0,0 +1,76 @@
+package feed
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testFeed() *Feed {
+	published := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	return &Feed{
+		ID:      "https://example.com/feeds/news",
+		Title:   "News",
+		Link:    "https://example.com",
+		FeedURL: "https://example.com/feeds/news.atom",
+		Updated: published.Add(time.Hour),
+		Items: []*Item{
+			{
+				ID:          "urn:uuid:1",
+				Title:       "Fish & chips",
+				Link:        "https://example.com/news/fish-chips",
+				ContentHTML: "<p>Tasty <b>food</b></p>",
+				Author:      "John Doe",
+				Categories:  []string{"food"},
+				Published:   published,
+				Updated:     published.Add(time.Hour),
+			},
+		},
+	}
+}
+
+func TestFeed_RSS(t *testing.T) {
+	t.Parallel()
+
+	body, contentType, err := testFeed().Render(FormatRSS)
+	require.NoError(t, err)
+	require.Equal(t, ContentTypeRSS, contentType)
+	require.True(t, strings.HasPrefix(string(body), xml.Header))
+	require.Contains(t, string(body), "<title>Fish &amp; chips</title>")
+	require.Contains(t, string(body), "<description><![CDATA[<p>Tasty <b>food</b></p>]]></description>")
+	require.Contains(t, string(body), "<pubDate>Mon, 19 Oct 2026 12:00:00 +0000</pubDate>")
+	require.Contains(t, string(body), "<dc:creator>John Doe</dc:creator>")
+}
+
+func TestFeed_Atom(t *testing.T) {
+	t.Parallel()
+
+	body, contentType, err := testFeed().Render(FormatAtom)
+	require.NoError(t, err)
+	require.Equal(t, ContentTypeAtom, contentType)
+	require.Contains(t, string(body), "<updated>2026-10-19T13:00:00Z</updated>")
+	require.Contains(t, string(body), `<content type="html">&lt;p&gt;Tasty &lt;b&gt;food&lt;/b&gt;&lt;/p&gt;</content>`)
+	require.Contains(t, string(body), `<link href="https://example.com/feeds/news.atom" rel="self" type="application/atom+xml"></link>`)
+}
+
+func TestFeed_JSON(t *testing.T) {
+	t.Parallel()
+
+	body, contentType, err := testFeed().Render(FormatJSON)
+	require.NoError(t, err)
+	require.Equal(t, ContentTypeJSON, contentType)
+
+	doc := &jsonFeed{}
+	require.NoError(t, json.Unmarshal(body, doc))
+	require.Equal(t, jsonFeedVersion, doc.Version)
+	require.Len(t, doc.Items, 1)
+	require.Equal(t, "2026-10-19T12:00:00Z", doc.Items[0].DatePublished)
+	require.Equal(t, "John Doe", doc.Items[0].Authors[0].Name)
+
+	_, _, err = testFeed().Render("yaml")
+	require.Error(t, err)
+}
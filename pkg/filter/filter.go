@@ -0,0 +1,118 @@
+package filter
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Edbeer/restapi/pkg/httpe"
+)
+
+// Operators of filter conditions
+const (
+	OpEq   = "eq"
+	OpNe   = "ne"
+	OpGt   = "gt"
+	OpGte  = "gte"
+	OpLt   = "lt"
+	OpLte  = "lte"
+	OpLike = "like"
+	OpIn   = "in"
+)
+
+// Separators of filter and sort query params
+const (
+	listSeparator  = ","
+	termSeparator  = ":"
+	valueSeparator = "|"
+	descPrefix     = "-"
+)
+
+// Filter and sort of a list, fields are checked by the schema of the list
+type Query struct {
+	Conditions []*Condition
+	Sort       []*Order
+}
+
+// Condition field:op:value, values of in are separated by |
+type Condition struct {
+	Field string
+	Op    string
+	Value string
+}
+
+// Sort key, descending when prefixed with -
+type Order struct {
+	Field string
+	Desc  bool
+}
+
+// Error of filter or sort with the offending field
+type FieldError struct {
+	Field  string
+	Reason string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("invalid field %q: %s", e.Field, e.Reason)
+}
+
+// Bad request of filter or sort, its causes are the FieldError
+func NewFieldError(field, reason string) error {
+	fieldErr := &FieldError{Field: field, Reason: reason}
+	return httpe.NewRestError(http.StatusBadRequest, fieldErr.Error(), fieldErr)
+}
+
+// Parse filter like category:eq:tech,created_at:gte:2026-01-01 and sort
+// like -created_at,title, nil when both are empty
+func Parse(filter, sort string) (*Query, error) {
+	if filter == "" && sort == "" {
+		return nil, nil
+	}
+
+	q := &Query{}
+	if filter != "" {
+		for _, term := range strings.Split(filter, listSeparator) {
+			// values may hold colons of times
+			parts := strings.SplitN(term, termSeparator, 3)
+			if len(parts) != 3 || parts[0] == "" || parts[2] == "" {
+				return nil, NewFieldError(parts[0], "want field:operator:value")
+			}
+			q.Conditions = append(q.Conditions, &Condition{
+				Field: parts[0],
+				Op:    strings.ToLower(parts[1]),
+				Value: parts[2],
+			})
+		}
+	}
+
+	if sort != "" {
+		seen := make(map[string]bool)
+		for _, term := range strings.Split(sort, listSeparator) {
+			order := &Order{Field: strings.TrimPrefix(term, descPrefix), Desc: strings.HasPrefix(term, descPrefix)}
+			if order.Field == "" {
+				return nil, NewFieldError(term, "empty sort field")
+			}
+			if seen[order.Field] {
+				return nil, NewFieldError(order.Field, "sorted twice")
+			}
+			seen[order.Field] = true
+			q.Sort = append(q.Sort, order)
+		}
+	}
+
+	return q, nil
+}
+
+// Is list sorted by other keys than its default order
+func (q *Query) IsSorted() bool {
+	return q != nil && len(q.Sort) > 0
+}
+
+// Values of in condition
+func (c *Condition) Values() []string {
+	if c.Op != OpIn {
+		return []string{c.Value}
+	}
+	return strings.Split(c.Value, valueSeparator)
+}
@@ -0,0 +1,120 @@
+package filter
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+var testSchema = Schema{
+	"category":   {Column: "category", Type: TypeString, Sortable: true},
+	"author_id":  {Column: "author_id", Type: TypeUUID},
+	"likes":      {Column: "likes", Type: TypeInt, Sortable: true},
+	"created_at": {Column: "created_at", Type: TypeTime, Sortable: true},
+}
+
+func requireFieldError(t *testing.T, err error) *FieldError {
+	t.Helper()
+
+	var restErr httpe.RestErr
+	require.True(t, errors.As(err, &restErr))
+	require.Equal(t, http.StatusBadRequest, restErr.Status())
+	fieldErr, ok := restErr.Causes().(*FieldError)
+	require.True(t, ok)
+	return fieldErr
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	q, err := Parse("", "")
+	require.NoError(t, err)
+	require.Nil(t, q)
+	require.False(t, q.IsSorted())
+
+	q, err = Parse("category:eq:tech,created_at:GTE:2026-01-01T10:00:00Z,likes:in:1|2", "-created_at,category")
+	require.NoError(t, err)
+	require.Equal(t, []*Condition{
+		{Field: "category", Op: OpEq, Value: "tech"},
+		{Field: "created_at", Op: OpGte, Value: "2026-01-01T10:00:00Z"},
+		{Field: "likes", Op: OpIn, Value: "1|2"},
+	}, q.Conditions)
+	require.Equal(t, []*Order{{Field: "created_at", Desc: true}, {Field: "category"}}, q.Sort)
+	require.Equal(t, []string{"1", "2"}, q.Conditions[2].Values())
+	require.True(t, q.IsSorted())
+
+	for _, c := range []struct{ filter, sort, field string }{
+		{"category:eq", "", "category"},
+		{"category:eq:", "", "category"},
+		{"", "title,-title", "title"},
+		{"", "-", "-"},
+	} {
+		_, err := Parse(c.filter, c.sort)
+		require.Equal(t, c.field, requireFieldError(t, err).Field)
+	}
+}
+
+func TestSchema_Compile(t *testing.T) {
+	t.Parallel()
+
+	compiled, err := testSchema.Compile(nil, "n", 1)
+	require.NoError(t, err)
+	require.Equal(t, &SQL{}, compiled)
+
+	authorID := uuid.New()
+	q, err := Parse("category:like:50%_off,author_id:ne:"+authorID.String()+",likes:in:1|2,created_at:lt:2026-01-01", "-likes")
+	require.NoError(t, err)
+
+	compiled, err = testSchema.Compile(q, "n", 3)
+	require.NoError(t, err)
+	require.Equal(t, " AND n.category ILIKE $3 AND n.author_id IS DISTINCT FROM $4 AND n.likes IN ($5, $6) AND n.created_at < $7", compiled.Where)
+	require.Equal(t, []interface{}{`%50\%\_off%`, authorID, int64(1), int64(2), time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}, compiled.Args)
+	require.Equal(t, "n.likes DESC NULLS LAST, ", compiled.OrderBy)
+
+	for _, c := range []struct{ filter, sort, field string }{
+		{"password:eq:secret", "", "password"},
+		{"category:gt:tech", "", "category"},
+		{"likes:eq:many", "", "likes"},
+		{"created_at:gte:yesterday", "", "created_at"},
+		{"", "author_id", "author_id"},
+		{"", "password", "password"},
+	} {
+		q, err := Parse(c.filter, c.sort)
+		require.NoError(t, err)
+		_, err = testSchema.Compile(q, "n", 1)
+		require.Equal(t, c.field, requireFieldError(t, err).Field)
+		require.Contains(t, err.Error(), `"`+c.field+`"`)
+	}
+}
+
+func TestSchema_CompileMatch(t *testing.T) {
+	t.Parallel()
+
+	schema := Schema{
+		"author_id": {Column: "author_id", Type: TypeUUID, Match: "(%[1]s.author_id %[2]s OR %[1]s.editor_id %[2]s)"},
+	}
+
+	authorID := uuid.New()
+	q, err := Parse("author_id:eq:"+authorID.String(), "")
+	require.NoError(t, err)
+	compiled, err := schema.Compile(q, "n", 2)
+	require.NoError(t, err)
+	require.Equal(t, " AND (n.author_id = $2 OR n.editor_id = $2)", compiled.Where)
+	require.Equal(t, []interface{}{authorID}, compiled.Args)
+
+	q, err = Parse("author_id:in:"+authorID.String()+"|"+authorID.String(), "")
+	require.NoError(t, err)
+	compiled, err = schema.Compile(q, "n", 1)
+	require.NoError(t, err)
+	require.Equal(t, " AND (n.author_id IN ($1, $2) OR n.editor_id IN ($1, $2))", compiled.Where)
+
+	q, err = Parse("author_id:ne:"+authorID.String(), "")
+	require.NoError(t, err)
+	_, err = schema.Compile(q, "n", 1)
+	require.Equal(t, "author_id", requireFieldError(t, err).Field)
+}
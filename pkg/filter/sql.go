@@ -0,0 +1,162 @@
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Types of field values
+type Type int
+
+const (
+	TypeString Type = iota
+	TypeInt
+	TypeTime
+	TypeUUID
+	TypeBool
+)
+
+// Operators allowed for values of each type
+var typeOps = map[Type][]string{
+	TypeString: {OpEq, OpNe, OpLike, OpIn},
+	TypeInt:    {OpEq, OpNe, OpGt, OpGte, OpLt, OpLte, OpIn},
+	TypeTime:   {OpEq, OpNe, OpGt, OpGte, OpLt, OpLte},
+	TypeUUID:   {OpEq, OpNe, OpIn},
+	TypeBool:   {OpEq, OpNe},
+}
+
+var opSQL = map[string]string{
+	OpEq:  "=",
+	OpNe:  "IS DISTINCT FROM",
+	OpGt:  ">",
+	OpGte: ">=",
+	OpLt:  "<",
+	OpLte: "<=",
+}
+
+// Field of list schema with its column
+type Field struct {
+	Column   string
+	Type     Type
+	Sortable bool
+	// condition used instead of comparing the column, %[1]s is the table
+	// alias and %[2]s the comparison, such fields take eq and in only
+	Match string
+}
+
+// Fields a list can be filtered and sorted by, others are rejected
+type Schema map[string]Field
+
+// Parameterized SQL of query
+type SQL struct {
+	// conditions each prefixed with AND
+	Where string
+	Args  []interface{}
+	// sort terms each followed by a comma, so default order breaks ties
+	OrderBy string
+}
+
+// Check query against schema and compile it to SQL on columns of the
+// table alias with placeholders numbered from next
+func (s Schema) Compile(q *Query, alias string, next int) (*SQL, error) {
+	compiled := &SQL{}
+	if q == nil {
+		return compiled, nil
+	}
+
+	var where strings.Builder
+	for _, cond := range q.Conditions {
+		field, ok := s[cond.Field]
+		if !ok {
+			return nil, NewFieldError(cond.Field, "unknown filter field")
+		}
+		if !allowedOp(field, cond.Op) {
+			return nil, NewFieldError(cond.Field, fmt.Sprintf("operator %q is not allowed", cond.Op))
+		}
+
+		var placeholders []string
+		for _, value := range cond.Values() {
+			arg, err := parseValue(field.Type, value)
+			if err != nil {
+				return nil, NewFieldError(cond.Field, fmt.Sprintf("invalid value %q", value))
+			}
+			if cond.Op == OpLike {
+				arg = "%" + escapeLike(value) + "%"
+			}
+			compiled.Args = append(compiled.Args, arg)
+			placeholders = append(placeholders, "$"+strconv.Itoa(next))
+			next++
+		}
+
+		var comparison string
+		switch cond.Op {
+		case OpLike:
+			comparison = "ILIKE " + placeholders[0]
+		case OpIn:
+			comparison = "IN (" + strings.Join(placeholders, ", ") + ")"
+		default:
+			comparison = opSQL[cond.Op] + " " + placeholders[0]
+		}
+		if field.Match != "" {
+			fmt.Fprintf(&where, " AND "+field.Match, alias, comparison)
+		} else {
+			fmt.Fprintf(&where, " AND %s.%s %s", alias, field.Column, comparison)
+		}
+	}
+	compiled.Where = where.String()
+
+	var orderBy strings.Builder
+	for _, order := range q.Sort {
+		field, ok := s[order.Field]
+		if !ok || !field.Sortable {
+			return nil, NewFieldError(order.Field, "unknown sort field")
+		}
+		direction := "ASC"
+		if order.Desc {
+			direction = "DESC"
+		}
+		fmt.Fprintf(&orderBy, "%s.%s %s NULLS LAST, ", alias, field.Column, direction)
+	}
+	compiled.OrderBy = orderBy.String()
+
+	return compiled, nil
+}
+
+func allowedOp(field Field, op string) bool {
+	if field.Match != "" && op != OpEq && op != OpIn {
+		return false
+	}
+	for _, allowed := range typeOps[field.Type] {
+		if op == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+func parseValue(typ Type, value string) (interface{}, error) {
+	switch typ {
+	case TypeInt:
+		return strconv.ParseInt(value, 10, 64)
+	case TypeTime:
+		if t, err := time.Parse(time.RFC3339, value); err == nil {
+			return t, nil
+		}
+		return time.Parse("2006-01-02", value)
+	case TypeUUID:
+		return uuid.Parse(value)
+	case TypeBool:
+		return strconv.ParseBool(value)
+	default:
+		return value, nil
+	}
+}
+
+// Match like patterns literally
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
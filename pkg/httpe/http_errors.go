@@ -40,6 +40,8 @@ var (
 	InvalidJWTClaims      = errors.New("Invalid JWT claims")
 	NotAllowedImageHeader = errors.New("Not allowed image header")
 	NoCookie              = errors.New("not found cookie header")
+	PreconditionFailed    = errors.New("Precondition Failed")
+	PreconditionRequired  = errors.New("Precondition Required")
 )
 
 // Rest error interface
@@ -146,11 +148,16 @@ func NewInternalServerError(causes interface{}) RestErr {
 
 // Parser of error string messages returns RestError
 func ParseErrors(err error) RestErr {
+	var restErr RestErr
 	switch {
 	case errors.Is(err, sql.ErrNoRows):
 		return NewRestError(http.StatusNotFound, NotFound.Error(), err)
 	case errors.Is(err, context.DeadlineExceeded):
 		return NewRestError(http.StatusRequestTimeout, RequestTimeoutError.Error(), err)
+	case errors.As(err, &restErr):
+		return restErr
+	case errors.Is(err, PreconditionFailed):
+		return NewRestError(http.StatusPreconditionFailed, PreconditionFailed.Error(), err)
 	case strings.Contains(err.Error(), "SQLSTATE"):
 		return parseSqlErrors(err)
 	case strings.Contains(err.Error(), "Field validation"):
@@ -166,9 +173,6 @@ func ParseErrors(err error) RestErr {
 	case strings.Contains(strings.ToLower(err.Error()), "bcrypt"):
 		return NewRestError(http.StatusBadRequest, BadRequest.Error(), err)
 	default:
-		if restErr, ok := err.(RestErr); ok {
-			return restErr
-		}
 		return NewInternalServerError(err)
 	}
 }
@@ -0,0 +1,101 @@
+package markdown
+
+import (
+	"bytes"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/pkg/errors"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	gmhtml "github.com/yuin/goldmark/renderer/html"
+)
+
+// Content formats of text fields in responses
+const (
+	FormatMarkdown = "markdown"
+	FormatHTML     = "html"
+	FormatText     = "text"
+)
+
+var (
+	// CommonMark with GFM tables, strikethrough, autolinks and task lists,
+	// raw HTML is passed through and cleaned by the sanitizer
+	renderer = goldmark.New(
+		goldmark.WithExtensions(extension.GFM),
+		goldmark.WithRendererOptions(gmhtml.WithUnsafe()),
+	)
+
+	policy    = newPolicy()
+	strip     = bluemonday.StrictPolicy()
+	blankLine = regexp.MustCompile(`\n{3,}`)
+)
+
+// Allow-list of user generated content with GFM code languages and task list checkboxes
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.RequireNoFollowOnLinks(true)
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[\w+-]+$`)).OnElements("code")
+	p.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+	p.AllowAttrs("checked", "disabled").OnElements("input")
+	return p
+}
+
+// Check content format is known
+func ValidFormat(format string) bool {
+	switch format {
+	case FormatMarkdown, FormatHTML, FormatText:
+		return true
+	}
+	return false
+}
+
+// Render markdown to sanitized HTML
+func ToHTML(source string) (string, error) {
+	var buf bytes.Buffer
+	if err := renderer.Convert([]byte(source), &buf); err != nil {
+		return "", errors.Wrap(err, "markdown.ToHTML.Convert")
+	}
+	return policy.Sanitize(buf.String()), nil
+}
+
+// Strip tags of rendered HTML leaving plain text
+func ToText(content string) string {
+	text := html.UnescapeString(strip.Sanitize(content))
+	return strings.TrimSpace(blankLine.ReplaceAllString(text, "\n\n"))
+}
+
+// Put content in requested format, empty format keeps markdown with its html.
+// Content saved before rendering existed has no html and is rendered here.
+func Format(format string, source, rendered string) (string, string) {
+	if rendered == "" && source != "" {
+		rendered, _ = ToHTML(source)
+	}
+
+	switch format {
+	case FormatMarkdown:
+		return source, ""
+	case FormatHTML:
+		return rendered, ""
+	case FormatText:
+		return ToText(rendered), ""
+	default:
+		return source, rendered
+	}
+}
+
+// Single line plain text of rendered HTML cut on a word boundary
+func Excerpt(content string, max int) string {
+	text := []rune(strings.Join(strings.Fields(ToText(content)), " "))
+	if len(text) <= max {
+		return string(text)
+	}
+
+	cut := string(text[:max])
+	if i := strings.LastIndexByte(cut, ' '); i > 0 {
+		cut = cut[:i]
+	}
+	return strings.TrimRight(cut, ".,;:!?-") + "…"
+}
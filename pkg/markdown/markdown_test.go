@@ -0,0 +1,71 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestToHTML(t *testing.T) {
+	t.Parallel()
+
+	t.Run("GFM", func(t *testing.T) {
+		out, err := ToHTML("# Title\n\n~~old~~ **new**\n\n| a | b |\n|---|---|\n| 1 | 2 |\n\n- [x] done\n")
+		require.NoError(t, err)
+		require.Contains(t, out, "<h1>Title</h1>")
+		require.Contains(t, out, "<del>old</del> <strong>new</strong>")
+		require.Contains(t, out, "<td>1</td>")
+		require.Contains(t, out, `<input checked="" disabled="" type="checkbox"`)
+	})
+
+	t.Run("Sanitize", func(t *testing.T) {
+		out, err := ToHTML("hi <script>alert(1)</script> [x](javascript:alert(1)) <img src=x onerror=alert(1)> https://example.com")
+		require.NoError(t, err)
+		require.NotContains(t, out, "<script")
+		require.NotContains(t, out, "javascript:")
+		require.NotContains(t, out, "onerror")
+		require.Contains(t, out, `<a href="https://example.com" rel="nofollow">https://example.com</a>`)
+	})
+
+	t.Run("Code language", func(t *testing.T) {
+		out, err := ToHTML("```go\nfmt.Println(\"<b>\")\n```\n")
+		require.NoError(t, err)
+		require.Contains(t, out, `<code class="language-go">`)
+		require.Contains(t, out, "&lt;b&gt;")
+	})
+}
+
+func TestToText(t *testing.T) {
+	t.Parallel()
+
+	out, err := ToHTML("# Title\n\nSome *text* &amp; more\n")
+	require.NoError(t, err)
+	require.Equal(t, "Title\nSome text & more", ToText(out))
+}
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+
+	source := "Some *text*"
+	rendered := "<p>Some <em>text</em></p>\n"
+
+	content, contentHTML := Format("", source, "")
+	require.Equal(t, source, content)
+	require.Equal(t, rendered, contentHTML)
+
+	content, contentHTML = Format(FormatHTML, source, rendered)
+	require.Equal(t, rendered, content)
+	require.Empty(t, contentHTML)
+
+	content, _ = Format(FormatText, source, rendered)
+	require.Equal(t, "Some text", content)
+}
+
+func TestExcerpt(t *testing.T) {
+	t.Parallel()
+
+	content := "<h1>Title</h1>\n<p>First paragraph, with <b>bold</b> text.</p>\n<p>Second one</p>"
+	require.Equal(t, "Title First paragraph, with bold text. Second one", Excerpt(content, 100))
+	require.Equal(t, "Title First paragraph…", Excerpt(content, 25))
+	require.Equal(t, "Пер…", Excerpt("<p>Перво второе</p>", 3))
+}
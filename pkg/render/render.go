@@ -0,0 +1,120 @@
+package render
+
+import (
+	"bytes"
+	"html/template"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Shared layout of pages, partials start with an underscore
+const (
+	layoutFile    = "layout.html"
+	partialPrefix = "_"
+)
+
+// Renderer of html pages from templates on disk. Every page template
+// is parsed together with the layout and partials, pages define
+// the "content" block which the layout executes.
+type Renderer struct {
+	dir       string
+	reload    bool
+	mu        sync.RWMutex
+	templates map[string]*template.Template
+}
+
+// Renderer constructor, templates are parsed on first render
+// and on every render when reload is set
+func NewRenderer(dir string, reload bool) *Renderer {
+	return &Renderer{dir: dir, reload: reload}
+}
+
+// Render page by name of its template file without extension
+func (r *Renderer) Render(name string, data interface{}) ([]byte, error) {
+	templates, err := r.load()
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, ok := templates[name]
+	if !ok {
+		return nil, errors.Errorf("render: unknown page %q", name)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buf, layoutFile, data); err != nil {
+		return nil, errors.Wrap(err, "render.Render.ExecuteTemplate")
+	}
+	return buf.Bytes(), nil
+}
+
+func (r *Renderer) load() (map[string]*template.Template, error) {
+	if !r.reload {
+		r.mu.RLock()
+		templates := r.templates
+		r.mu.RUnlock()
+		if templates != nil {
+			return templates, nil
+		}
+	}
+
+	templates, err := parse(r.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.templates = templates
+	r.mu.Unlock()
+	return templates, nil
+}
+
+func parse(dir string) (map[string]*template.Template, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.html"))
+	if err != nil {
+		return nil, errors.Wrap(err, "render.parse.Glob")
+	}
+
+	shared := []string{filepath.Join(dir, layoutFile)}
+	pages := make([]string, 0, len(files))
+	for _, file := range files {
+		base := filepath.Base(file)
+		switch {
+		case base == layoutFile:
+		case strings.HasPrefix(base, partialPrefix):
+			shared = append(shared, file)
+		default:
+			pages = append(pages, file)
+		}
+	}
+	if _, err := os.Stat(shared[0]); err != nil {
+		return nil, errors.Wrap(err, "render.parse.Stat")
+	}
+
+	templates := make(map[string]*template.Template, len(pages))
+	for _, page := range pages {
+		tmpl, err := template.New(layoutFile).Funcs(funcs).ParseFiles(append(shared, page)...)
+		if err != nil {
+			return nil, errors.Wrap(err, "render.parse.ParseFiles")
+		}
+		templates[strings.TrimSuffix(filepath.Base(page), ".html")] = tmpl
+	}
+	return templates, nil
+}
+
+var funcs = template.FuncMap{
+	"date": func(t time.Time) string {
+		return t.UTC().Format("January 2, 2006")
+	},
+	"isodate": func(t time.Time) string {
+		return t.UTC().Format(time.RFC3339)
+	},
+	"add": func(a, b int) int {
+		return a + b
+	},
+}
@@ -0,0 +1,46 @@
+package render
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeTemplate(t *testing.T, dir string, name string, text string) {
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(text), 0o600))
+}
+
+func TestRenderer_Render(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeTemplate(t, dir, "layout.html", `<title>{{ .Title }}</title>{{ template "content" . }}`)
+	writeTemplate(t, dir, "_date.html", `{{ define "published" }}<time>{{ date .Published }}</time>{{ end }}`)
+	writeTemplate(t, dir, "page.html", `{{ define "content" }}<p>{{ .Title }}</p>{{ template "published" . }}{{ end }}`)
+
+	data := struct {
+		Title     string
+		Published time.Time
+	}{"A & B", time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)}
+
+	renderer := NewRenderer(dir, false)
+	body, err := renderer.Render("page", data)
+	require.NoError(t, err)
+	require.Equal(t, "<title>A &amp; B</title><p>A &amp; B</p><time>October 19, 2026</time>", string(body))
+
+	_, err = renderer.Render("missing", data)
+	require.Error(t, err)
+
+	// parsed templates are kept unless reload is set
+	writeTemplate(t, dir, "page.html", `{{ define "content" }}changed{{ end }}`)
+	body, err = renderer.Render("page", data)
+	require.NoError(t, err)
+	require.Contains(t, string(body), "<p>A &amp; B</p>")
+
+	body, err = NewRenderer(dir, true).Render("page", data)
+	require.NoError(t, err)
+	require.Equal(t, "<title>A &amp; B</title>changed", string(body))
+}
@@ -0,0 +1,73 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Edbeer/restapi/pkg/logger"
+)
+
+// Periodic background job
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Scheduler runs jobs periodically in the server process
+type Scheduler struct {
+	jobs   []Job
+	logger logger.Logger
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// Scheduler constructor
+func NewScheduler(logger logger.Logger) *Scheduler {
+	return &Scheduler{logger: logger}
+}
+
+// Add job, must be called before Start
+func (s *Scheduler) Add(name string, interval time.Duration, run func(ctx context.Context) error) {
+	s.jobs = append(s.jobs, Job{
+		Name:     name,
+		Interval: interval,
+		Run:      run,
+	})
+}
+
+// Start every job in its own goroutine
+func (s *Scheduler) Start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go s.run(ctx, job)
+	}
+}
+
+// Stop jobs and wait for running ones to finish
+func (s *Scheduler) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+}
+
+func (s *Scheduler) run(ctx context.Context, job Job) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := job.Run(ctx); err != nil {
+				s.logger.Errorf("Scheduler.%s: %v", job.Name, err)
+			}
+		}
+	}
+}
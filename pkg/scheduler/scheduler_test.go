@@ -0,0 +1,31 @@
+package scheduler
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduler_Run(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	s := NewScheduler(nil)
+	s.Add("count", time.Millisecond, func(ctx context.Context) error {
+		atomic.AddInt32(&calls, 1)
+		return nil
+	})
+
+	s.Start(context.Background())
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&calls) >= 3
+	}, time.Second, time.Millisecond)
+	s.Stop()
+
+	stopped := atomic.LoadInt32(&calls)
+	time.Sleep(5 * time.Millisecond)
+	require.Equal(t, stopped, atomic.LoadInt32(&calls))
+}
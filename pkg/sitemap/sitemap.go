@@ -0,0 +1,128 @@
+package sitemap
+
+import (
+	"encoding/xml"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Sitemaps protocol limits
+const (
+	MaxURLs     = 50000
+	MaxNewsURLs = 1000
+)
+
+// Content type of sitemaps
+const ContentType = "application/xml; charset=utf-8"
+
+const (
+	xmlns     = "http://www.sitemaps.org/schemas/sitemap/0.9"
+	xmlnsNews = "http://www.google.com/schemas/sitemap-news/0.9"
+)
+
+// Child sitemap reference of sitemap index
+type Sitemap struct {
+	Loc     string
+	LastMod time.Time
+}
+
+// Sitemap url, News is set only in news sitemaps
+type URL struct {
+	Loc     string
+	LastMod time.Time
+	News    *News
+}
+
+// Google News entry of url
+type News struct {
+	PublicationName string
+	Language        string
+	PublicationDate time.Time
+	Title           string
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name     `xml:"sitemapindex"`
+	Xmlns    string       `xml:"xmlns,attr"`
+	Sitemaps []sitemapRef `xml:"sitemap"`
+}
+
+type sitemapRef struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+type urlSet struct {
+	XMLName   xml.Name `xml:"urlset"`
+	Xmlns     string   `xml:"xmlns,attr"`
+	XmlnsNews string   `xml:"xmlns:news,attr,omitempty"`
+	URLs      []url    `xml:"url"`
+}
+
+type url struct {
+	Loc     string    `xml:"loc"`
+	LastMod string    `xml:"lastmod,omitempty"`
+	News    *newsItem `xml:"news:news,omitempty"`
+}
+
+type newsItem struct {
+	Publication     publication `xml:"news:publication"`
+	PublicationDate string      `xml:"news:publication_date"`
+	Title           string      `xml:"news:title"`
+}
+
+type publication struct {
+	Name     string `xml:"news:name"`
+	Language string `xml:"news:language"`
+}
+
+// Render sitemap index
+func Index(sitemaps []*Sitemap) ([]byte, error) {
+	doc := sitemapIndex{Xmlns: xmlns, Sitemaps: make([]sitemapRef, 0, len(sitemaps))}
+	for _, s := range sitemaps {
+		doc.Sitemaps = append(doc.Sitemaps, sitemapRef{Loc: s.Loc, LastMod: formatTime(s.LastMod)})
+	}
+	return marshalXML(doc)
+}
+
+// Render url set, with news namespace when any url has a news entry
+func URLSet(urls []*URL) ([]byte, error) {
+	if len(urls) > MaxURLs {
+		return nil, errors.Errorf("sitemap has %d urls, at most %d allowed", len(urls), MaxURLs)
+	}
+
+	doc := urlSet{Xmlns: xmlns, URLs: make([]url, 0, len(urls))}
+	for _, u := range urls {
+		item := url{Loc: u.Loc, LastMod: formatTime(u.LastMod)}
+		if u.News != nil {
+			doc.XmlnsNews = xmlnsNews
+			item.News = &newsItem{
+				Publication: publication{
+					Name:     u.News.PublicationName,
+					Language: u.News.Language,
+				},
+				PublicationDate: formatTime(u.News.PublicationDate),
+				Title:           u.News.Title,
+			}
+		}
+		doc.URLs = append(doc.URLs, item)
+	}
+	return marshalXML(doc)
+}
+
+// W3C datetime in UTC
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func marshalXML(doc interface{}) ([]byte, error) {
+	body, err := xml.Marshal(doc)
+	if err != nil {
+		return nil, errors.Wrap(err, "sitemap.marshalXML.Marshal")
+	}
+	return append([]byte(xml.Header), body...), nil
+}
@@ -0,0 +1,56 @@
+package sitemap
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndex(t *testing.T) {
+	t.Parallel()
+
+	body, err := Index([]*Sitemap{
+		{Loc: "https://example.com/sitemaps/news/1.xml", LastMod: time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)},
+		{Loc: "https://example.com/sitemaps/latest.xml"},
+	})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(body), "<?xml"))
+	require.Contains(t, string(body), `<sitemapindex xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">`)
+	require.Contains(t, string(body), "<sitemap><loc>https://example.com/sitemaps/news/1.xml</loc><lastmod>2026-10-19T12:00:00Z</lastmod></sitemap>")
+	require.Contains(t, string(body), "<sitemap><loc>https://example.com/sitemaps/latest.xml</loc></sitemap>")
+}
+
+func TestURLSet(t *testing.T) {
+	t.Parallel()
+
+	published := time.Date(2026, 10, 19, 15, 0, 0, 0, time.FixedZone("MSK", 3*3600))
+
+	t.Run("Plain", func(t *testing.T) {
+		body, err := URLSet([]*URL{{Loc: "https://example.com/news/a?x=1&y=2", LastMod: published}})
+		require.NoError(t, err)
+		require.NotContains(t, string(body), "xmlns:news")
+		require.Contains(t, string(body), "<url><loc>https://example.com/news/a?x=1&amp;y=2</loc><lastmod>2026-10-19T12:00:00Z</lastmod></url>")
+	})
+
+	t.Run("News", func(t *testing.T) {
+		body, err := URLSet([]*URL{{
+			Loc: "https://example.com/news/a",
+			News: &News{
+				PublicationName: "Example",
+				Language:        "en",
+				PublicationDate: published,
+				Title:           "Fish & chips",
+			},
+		}})
+		require.NoError(t, err)
+		require.Contains(t, string(body), `xmlns:news="http://www.google.com/schemas/sitemap-news/0.9"`)
+		require.Contains(t, string(body), "<news:news><news:publication><news:name>Example</news:name><news:language>en</news:language></news:publication><news:publication_date>2026-10-19T12:00:00Z</news:publication_date><news:title>Fish &amp; chips</news:title></news:news>")
+	})
+
+	t.Run("TooMany", func(t *testing.T) {
+		_, err := URLSet(make([]*URL, MaxURLs+1))
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,182 @@
+package tfidf
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Shortest word which is indexed
+const minWordLength = 2
+
+// Common english words which carry no meaning for similarity
+var stopWords = map[string]struct{}{
+	"a": {}, "about": {}, "after": {}, "all": {}, "also": {}, "an": {}, "and": {}, "any": {}, "are": {},
+	"as": {}, "at": {}, "be": {}, "been": {}, "but": {}, "by": {}, "can": {}, "could": {}, "did": {},
+	"do": {}, "does": {}, "for": {}, "from": {}, "had": {}, "has": {}, "have": {}, "he": {}, "her": {},
+	"his": {}, "how": {}, "if": {}, "in": {}, "into": {}, "is": {}, "it": {}, "its": {}, "more": {},
+	"most": {}, "new": {}, "no": {}, "not": {}, "of": {}, "on": {}, "one": {}, "or": {}, "our": {},
+	"out": {}, "over": {}, "she": {}, "so": {}, "some": {}, "than": {}, "that": {}, "the": {}, "their": {},
+	"them": {}, "then": {}, "there": {}, "these": {}, "they": {}, "this": {}, "to": {}, "up": {},
+	"was": {}, "we": {}, "were": {}, "what": {}, "when": {}, "which": {}, "who": {}, "will": {},
+	"with": {}, "would": {}, "you": {}, "your": {},
+}
+
+// Field of document, terms of field count weight times
+type Field struct {
+	Text   string
+	Weight float64
+	// Keyword fields are indexed as whole terms, e.g. tags
+	Keyword bool
+}
+
+// Similar document
+type Match struct {
+	ID    string
+	Score float64
+}
+
+type posting struct {
+	doc    int
+	weight float64
+}
+
+// TF-IDF index of documents. Documents are added first, Build computes
+// unit vectors of documents which Similar compares by cosine similarity.
+type Index struct {
+	ids      []string
+	docs     map[string]int
+	terms    []map[string]float64
+	vectors  []map[string]float64
+	postings map[string][]posting
+	built    bool
+}
+
+// Index constructor
+func NewIndex() *Index {
+	return &Index{docs: make(map[string]int)}
+}
+
+// Add document, adding id again replaces the document
+func (i *Index) Add(id string, fields ...Field) {
+	terms := make(map[string]float64)
+	for _, field := range fields {
+		if field.Keyword {
+			if term := strings.ToLower(strings.TrimSpace(field.Text)); term != "" {
+				terms["#"+term] += field.Weight
+			}
+			continue
+		}
+		for _, term := range Tokenize(field.Text) {
+			terms[term] += field.Weight
+		}
+	}
+
+	if doc, ok := i.docs[id]; ok {
+		i.terms[doc] = terms
+	} else {
+		i.docs[id] = len(i.ids)
+		i.ids = append(i.ids, id)
+		i.terms = append(i.terms, terms)
+	}
+	i.built = false
+}
+
+// Number of documents
+func (i *Index) Len() int {
+	return len(i.ids)
+}
+
+// Compute document vectors, term frequency is dampened logarithmically
+func (i *Index) Build() {
+	df := make(map[string]int)
+	for _, terms := range i.terms {
+		for term := range terms {
+			df[term]++
+		}
+	}
+
+	n := float64(len(i.ids))
+	i.vectors = make([]map[string]float64, len(i.ids))
+	i.postings = make(map[string][]posting, len(df))
+	for doc, terms := range i.terms {
+		vector := make(map[string]float64, len(terms))
+		var norm float64
+		for term, tf := range terms {
+			weight := (1 + math.Log(tf)) * math.Log(1+n/float64(df[term]))
+			if weight <= 0 {
+				continue
+			}
+			vector[term] = weight
+			norm += weight * weight
+		}
+		if norm == 0 {
+			continue
+		}
+		norm = math.Sqrt(norm)
+		for term, weight := range vector {
+			vector[term] = weight / norm
+			i.postings[term] = append(i.postings[term], posting{doc: doc, weight: vector[term]})
+		}
+		i.vectors[doc] = vector
+	}
+	i.built = true
+}
+
+// Most similar documents to document by id with score of at least min,
+// most similar first. Nil if document is unknown.
+func (i *Index) Similar(id string, limit int, min float64) []Match {
+	doc, ok := i.docs[id]
+	if !ok {
+		return nil
+	}
+	if !i.built {
+		i.Build()
+	}
+
+	scores := make(map[int]float64)
+	for term, weight := range i.vectors[doc] {
+		for _, p := range i.postings[term] {
+			if p.doc != doc {
+				scores[p.doc] += weight * p.weight
+			}
+		}
+	}
+
+	matches := make([]Match, 0, len(scores))
+	for other, score := range scores {
+		if score >= min {
+			matches = append(matches, Match{ID: i.ids[other], Score: score})
+		}
+	}
+	sort.Slice(matches, func(a, b int) bool {
+		if matches[a].Score != matches[b].Score {
+			return matches[a].Score > matches[b].Score
+		}
+		return matches[a].ID < matches[b].ID
+	})
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
+
+// Split text into lower case words without stop words and short words
+func Tokenize(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	tokens := words[:0]
+	for _, word := range words {
+		if len([]rune(word)) < minWordLength {
+			continue
+		}
+		if _, ok := stopWords[word]; ok {
+			continue
+		}
+		tokens = append(tokens, word)
+	}
+	return tokens
+}
@@ -0,0 +1,53 @@
+package tfidf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenize(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, []string{"rain", "berlin", "2022", "über"}, Tokenize("The Rain in Berlin, 2022: über a"))
+	require.Empty(t, Tokenize("a an the"))
+}
+
+func TestIndex_Similar(t *testing.T) {
+	t.Parallel()
+
+	index := NewIndex()
+	index.Add("rain", Field{Text: "Heavy rain floods Berlin streets", Weight: 3}, Field{Text: "weather", Weight: 2, Keyword: true})
+	index.Add("storm", Field{Text: "Storm and rain expected in Berlin", Weight: 3}, Field{Text: "weather", Weight: 2, Keyword: true})
+	index.Add("match", Field{Text: "Berlin wins the football match", Weight: 3}, Field{Text: "sport", Weight: 2, Keyword: true})
+	index.Add("chess", Field{Text: "Chess championship opens", Weight: 3})
+	index.Build()
+	require.Equal(t, 4, index.Len())
+
+	matches := index.Similar("rain", 10, 0)
+	require.Len(t, matches, 2)
+	require.Equal(t, "storm", matches[0].ID)
+	require.Equal(t, "match", matches[1].ID)
+	require.Greater(t, matches[0].Score, matches[1].Score)
+	require.LessOrEqual(t, matches[0].Score, 1.0)
+
+	require.Len(t, index.Similar("rain", 1, 0), 1)
+	require.Len(t, index.Similar("rain", 10, matches[0].Score), 1)
+	require.Empty(t, index.Similar("chess", 10, 0))
+	require.Nil(t, index.Similar("missing", 10, 0))
+}
+
+func TestIndex_Replace(t *testing.T) {
+	t.Parallel()
+
+	index := NewIndex()
+	index.Add("rain", Field{Text: "rain in Berlin", Weight: 1})
+	index.Add("storm", Field{Text: "storm in Berlin", Weight: 1})
+	index.Add("other", Field{Text: "chess championship", Weight: 1})
+	require.Len(t, index.Similar("rain", 10, 0), 1)
+
+	index.Add("other", Field{Text: "rain in Paris", Weight: 1})
+	require.Equal(t, 3, index.Len())
+	matches := index.Similar("rain", 10, 0)
+	require.Len(t, matches, 2)
+}
@@ -0,0 +1,127 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// Position in a keyset ordered list: sort key and id of the row the page
+// starts after, or ends before when it goes back
+type Cursor struct {
+	Time     time.Time `json:"t"`
+	Rank     float64   `json:"r,omitempty"`
+	ID       uuid.UUID `json:"id"`
+	Backward bool      `json:"b,omitempty"`
+}
+
+// Encode cursor as opaque url safe token
+func (c *Cursor) Encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// Decode cursor token of Encode
+func DecodeCursor(token string) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, httpe.NewBadRequestError(fmt.Sprintf("invalid cursor: %q", token))
+	}
+	c := &Cursor{}
+	if err := json.Unmarshal(b, c); err != nil || c.ID == uuid.Nil {
+		return nil, httpe.NewBadRequestError(fmt.Sprintf("invalid cursor: %q", token))
+	}
+	return c, nil
+}
+
+// Are pages walked by cursor, lists sorted by other keys are paged by offset
+func (q *PaginationQuery) IsKeyset() bool {
+	return !q.Filter.IsSorted()
+}
+
+// Cursor of keyset page
+func (q *PaginationQuery) cursor() *Cursor {
+	if !q.IsKeyset() {
+		return nil
+	}
+	return q.Cursor
+}
+
+// Get cursor id and sort key time as query args, nil without cursor
+func (q *PaginationQuery) GetCursorArgs() (*uuid.UUID, *time.Time) {
+	if q.cursor() == nil {
+		return nil, nil
+	}
+	return &q.Cursor.ID, &q.Cursor.Time
+}
+
+// Get cursor rank as query arg, nil without cursor
+func (q *PaginationQuery) GetCursorRank() *float64 {
+	if q.cursor() == nil {
+		return nil
+	}
+	return &q.Cursor.Rank
+}
+
+// Get limit of keyset page, the row over the size tells the list goes on
+func (q *PaginationQuery) GetKeysetLimit() int {
+	return q.Size + 1
+}
+
+// Get offset of keyset page, pages of cursor start right at it
+func (q *PaginationQuery) GetKeysetOffset() int {
+	if q.cursor() != nil {
+		return 0
+	}
+	return q.GetOffset()
+}
+
+// Is page going back from its cursor against the list order
+func (q *PaginationQuery) IsBackward() bool {
+	return q.cursor() != nil && q.Cursor.Backward
+}
+
+// Trim the row over the size of keyset page and put rows of a page going
+// back in list order, tells if there are rows after and before the page
+func KeysetPage[T any](q *PaginationQuery, rows []T) (page []T, hasNext bool, hasPrev bool) {
+	more := len(rows) > q.GetSize()
+	if more {
+		rows = rows[:q.GetSize()]
+	}
+	if !q.IsBackward() {
+		return rows, more, len(rows) > 0 && (q.cursor() != nil || q.GetOffset() > 0)
+	}
+	for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+		rows[i], rows[j] = rows[j], rows[i]
+	}
+	return rows, len(rows) > 0, more
+}
+
+// Set RFC 8288 Link header with next and prev pages of the request url
+func SetCursorLinks(c echo.Context, nextCursor, prevCursor string) {
+	var links []string
+	if nextCursor != "" {
+		links = append(links, fmt.Sprintf(`<%s>; rel="next"`, cursorURL(c.Request().URL, nextCursor)))
+	}
+	if prevCursor != "" {
+		links = append(links, fmt.Sprintf(`<%s>; rel="prev"`, cursorURL(c.Request().URL, prevCursor)))
+	}
+	if len(links) > 0 {
+		c.Response().Header().Set("Link", strings.Join(links, ", "))
+	}
+}
+
+// Request url with cursor instead of page
+func cursorURL(u *url.URL, cursor string) string {
+	query := u.Query()
+	query.Del("page")
+	query.Set("cursor", cursor)
+	return (&url.URL{Path: u.Path, RawQuery: query.Encode()}).String()
+}
@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor(t *testing.T) {
+	t.Parallel()
+
+	cursor := &Cursor{Time: time.Date(2026, 10, 19, 12, 0, 0, 123456000, time.UTC), Rank: 0.25, ID: uuid.New(), Backward: true}
+	decoded, err := DecodeCursor(cursor.Encode())
+	require.NoError(t, err)
+	require.Equal(t, cursor, decoded)
+
+	for _, token := range []string{"not base64!", "bm90IGpzb24", "e30"} {
+		_, err := DecodeCursor(token)
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, httpe.ParseErrors(err).Status())
+	}
+}
+
+func TestKeysetPage(t *testing.T) {
+	t.Parallel()
+
+	page, hasNext, hasPrev := KeysetPage(&PaginationQuery{Size: 2}, []int{1, 2, 3})
+	require.Equal(t, []int{1, 2}, page)
+	require.True(t, hasNext)
+	require.False(t, hasPrev)
+
+	page, hasNext, hasPrev = KeysetPage(&PaginationQuery{Size: 2, Cursor: &Cursor{}}, []int{1})
+	require.Equal(t, []int{1}, page)
+	require.False(t, hasNext)
+	require.True(t, hasPrev)
+
+	// rows of a page going back come against the list order
+	page, hasNext, hasPrev = KeysetPage(&PaginationQuery{Size: 2, Cursor: &Cursor{Backward: true}}, []int{3, 2, 1})
+	require.Equal(t, []int{2, 3}, page)
+	require.True(t, hasNext)
+	require.True(t, hasPrev)
+
+	page, hasNext, hasPrev = KeysetPage(&PaginationQuery{Size: 2, Cursor: &Cursor{Backward: true}}, []int{})
+	require.Empty(t, page)
+	require.False(t, hasNext)
+	require.False(t, hasPrev)
+}
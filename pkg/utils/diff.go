@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/sergi/go-diff/diffmatchpatch"
+)
+
+var wordRegexp = regexp.MustCompile(`\s+|[^\s]+`)
+
+// Word-level diff of two texts, whitespace is kept so chunks join back into the texts
+func DiffWords(from, to string) []*entity.DiffChunk {
+	tokens := make([]string, 0)
+	index := make(map[string]rune)
+	encode := func(text string) []rune {
+		words := wordRegexp.FindAllString(text, -1)
+		runes := make([]rune, 0, len(words))
+		for _, word := range words {
+			r, ok := index[word]
+			if !ok {
+				r = tokenRune(len(tokens))
+				index[word] = r
+				tokens = append(tokens, word)
+			}
+			runes = append(runes, r)
+		}
+		return runes
+	}
+	fromRunes, toRunes := encode(from), encode(to)
+
+	dmp := diffmatchpatch.New()
+	diffs := dmp.DiffMainRunes(fromRunes, toRunes, false)
+
+	chunks := make([]*entity.DiffChunk, 0, len(diffs))
+	for _, d := range diffs {
+		var text strings.Builder
+		for _, r := range d.Text {
+			text.WriteString(tokens[runeToken(r)])
+		}
+
+		op := entity.DiffEqual
+		switch d.Type {
+		case diffmatchpatch.DiffInsert:
+			op = entity.DiffInsert
+		case diffmatchpatch.DiffDelete:
+			op = entity.DiffDelete
+		}
+		chunks = append(chunks, &entity.DiffChunk{Op: op, Text: text.String()})
+	}
+	return chunks
+}
+
+// Tokens are encoded as runes, skipping the surrogate range which is not valid in strings
+func tokenRune(i int) rune {
+	if i >= 0xD800 {
+		return rune(i + 0x800)
+	}
+	return rune(i)
+}
+
+func runeToken(r rune) int {
+	if r >= 0xE000 {
+		return int(r - 0x800)
+	}
+	return int(r)
+}
@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffWords(t *testing.T) {
+	t.Parallel()
+
+	from := "The quick brown fox jumps over the lazy dog"
+	to := "The quick red fox jumps over the dog"
+
+	chunks := DiffWords(from, to)
+	require.Equal(t, []*entity.DiffChunk{
+		{Op: entity.DiffEqual, Text: "The quick "},
+		{Op: entity.DiffDelete, Text: "brown"},
+		{Op: entity.DiffInsert, Text: "red"},
+		{Op: entity.DiffEqual, Text: " fox jumps over the"},
+		{Op: entity.DiffDelete, Text: " lazy"},
+		{Op: entity.DiffEqual, Text: " dog"},
+	}, chunks)
+
+	var oldText, newText strings.Builder
+	for _, c := range chunks {
+		if c.Op != entity.DiffInsert {
+			oldText.WriteString(c.Text)
+		}
+		if c.Op != entity.DiffDelete {
+			newText.WriteString(c.Text)
+		}
+	}
+	require.Equal(t, from, oldText.String())
+	require.Equal(t, to, newText.String())
+}
@@ -0,0 +1,111 @@
+package utils
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/markdown"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+// Entity tags of ETag, the variant is checked by validVariant
+var ifMatchTag = regexp.MustCompile(`^"([1-9][0-9]*)(?:-([A-Za-z0-9-]+))?"$`)
+
+// Strong ETag of a stored version, so If-Match is checked against the row.
+// Fields outside of the version like views and bookmarks of the viewer don't
+// change it, the locale and format of the representation follow it, e.g.
+// "3-de-html".
+func ETag(version int, variants ...string) string {
+	tag := strconv.Itoa(version)
+	for _, variant := range variants {
+		if variant != "" {
+			tag += "-" + variant
+		}
+	}
+	return `"` + tag + `"`
+}
+
+// Respond with json and its ETag of the version and variants of
+// representation, reads with a matching If-None-Match get 304 without body
+func JSONWithETag(c echo.Context, code int, version int, i interface{}, variants ...string) error {
+	body, err := json.Marshal(i)
+	if err != nil {
+		return c.JSON(httpe.ErrorResponse(err))
+	}
+	etag := ETag(version, variants...)
+	c.Response().Header().Set("ETag", etag)
+
+	r := c.Request()
+	if (r.Method == http.MethodGet || r.Method == http.MethodHead) && NoneMatch(r.Header.Get("If-None-Match"), etag) {
+		return c.NoContent(http.StatusNotModified)
+	}
+	return c.JSONBlob(code, body)
+}
+
+// Weak comparison of If-None-Match header with etag as in RFC 7232
+func NoneMatch(header string, etag string) bool {
+	if header == "" {
+		return false
+	}
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == "*" || tag == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// Get version of If-Match precondition of write, 0 lets any version through:
+// without the header unless it is required, or with *
+func GetIfMatchVersion(c echo.Context, cfg *config.Config) (int, error) {
+	match := strings.TrimSpace(c.Request().Header.Get("If-Match"))
+	switch {
+	case match == "":
+		if cfg != nil && cfg.Server.RequireIfMatch {
+			return 0, httpe.NewRestError(http.StatusPreconditionRequired, httpe.PreconditionRequired.Error(), "If-Match header is required")
+		}
+		return 0, nil
+	case match == "*":
+		return 0, nil
+	case strings.Contains(match, ","):
+		return 0, httpe.NewBadRequestError("If-Match takes one entity tag")
+	}
+
+	// strong comparison, weak tags never match
+	if !strings.HasPrefix(match, `"`) || !strings.HasSuffix(match, `"`) || len(match) < 2 {
+		return 0, errors.WithMessage(httpe.PreconditionFailed, "If-Match needs a strong entity tag")
+	}
+	parts := ifMatchTag.FindStringSubmatch(match)
+	if parts == nil || !validVariant(parts[2]) {
+		return 0, errors.WithMessage(httpe.PreconditionFailed, "unknown If-Match entity tag")
+	}
+	version, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, errors.WithMessage(httpe.PreconditionFailed, "unknown If-Match entity tag")
+	}
+	return version, nil
+}
+
+// Variant of ETag: locale, format or the locale followed by format
+func validVariant(variant string) bool {
+	if variant == "" {
+		return true
+	}
+	for _, format := range []string{markdown.FormatMarkdown, markdown.FormatHTML, markdown.FormatText} {
+		if variant == format {
+			return true
+		}
+		if locale := strings.TrimSuffix(variant, "-"+format); locale != variant {
+			variant = locale
+			break
+		}
+	}
+	return NormalizeLocale(variant) == variant
+}
@@ -0,0 +1,114 @@
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Edbeer/restapi/config"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONWithETag(t *testing.T) {
+	t.Parallel()
+
+	body := map[string]string{"title": "title"}
+	etag := ETag(3)
+	require.Equal(t, `"3"`, etag)
+	require.Equal(t, `"3-de-html"`, ETag(3, "de", "html"))
+	require.Equal(t, `"3-text"`, ETag(3, "", "text"))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	res := httptest.NewRecorder()
+	require.NoError(t, JSONWithETag(echo.New().NewContext(req, res), http.StatusOK, 3, body))
+	require.Equal(t, http.StatusOK, res.Code)
+	require.Equal(t, etag, res.Header().Get("ETag"))
+	require.JSONEq(t, `{"title":"title"}`, res.Body.String())
+
+	for _, match := range []string{etag, `"2", W/` + etag, "*"} {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("If-None-Match", match)
+		res := httptest.NewRecorder()
+		require.NoError(t, JSONWithETag(echo.New().NewContext(req, res), http.StatusOK, 3, body))
+		require.Equal(t, http.StatusNotModified, res.Code)
+		require.Empty(t, res.Body.String())
+	}
+
+	// fields of the viewer outside of the version keep the tag
+	req = httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("If-None-Match", etag)
+	res = httptest.NewRecorder()
+	require.NoError(t, JSONWithETag(echo.New().NewContext(req, res), http.StatusOK, 3, map[string]interface{}{"title": "title", "bookmarked": true}))
+	require.Equal(t, http.StatusNotModified, res.Code)
+
+	// other locales and formats of the version are other representations
+	req = httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("If-None-Match", etag)
+	res = httptest.NewRecorder()
+	require.NoError(t, JSONWithETag(echo.New().NewContext(req, res), http.StatusOK, 3, body, "de", "html"))
+	require.Equal(t, http.StatusOK, res.Code)
+	require.Equal(t, `"3-de-html"`, res.Header().Get("ETag"))
+
+	// writes respond with the new representation anyway
+	req = httptest.NewRequest(http.MethodPut, "/", nil)
+	req.Header.Set("If-None-Match", etag)
+	res = httptest.NewRecorder()
+	require.NoError(t, JSONWithETag(echo.New().NewContext(req, res), http.StatusOK, 3, body))
+	require.Equal(t, http.StatusOK, res.Code)
+}
+
+func TestNoneMatch(t *testing.T) {
+	t.Parallel()
+
+	require.False(t, NoneMatch("", `"3"`))
+	require.False(t, NoneMatch(`"2", "3-de"`, `"3"`))
+	require.True(t, NoneMatch(`"2", W/"3"`, `"3"`))
+	require.True(t, NoneMatch(" * ", `"3"`))
+}
+
+func TestGetIfMatchVersion(t *testing.T) {
+	t.Parallel()
+
+	strict := &config.Config{Server: config.ServerConfig{RequireIfMatch: true}}
+
+	for _, c := range []struct {
+		name    string
+		match   string
+		cfg     *config.Config
+		version int
+		status  int
+	}{
+		{name: "Missing", cfg: nil},
+		{name: "Missing strict", cfg: strict, status: http.StatusPreconditionRequired},
+		{name: "Any", match: "*", cfg: strict},
+		{name: "ETag", match: ETag(7), cfg: strict, version: 7},
+		{name: "Weak", match: `W/"7"`, status: http.StatusPreconditionFailed},
+		{name: "Unknown", match: `"abc"`, status: http.StatusPreconditionFailed},
+		{name: "Locale and format", match: ETag(7, "pt-BR", "html"), version: 7},
+		{name: "Format", match: ETag(7, "", "markdown"), version: 7},
+		{name: "Format before locale", match: `"7-html-de"`, status: http.StatusPreconditionFailed},
+		{name: "Garbage hash", match: `"7-garbage"`, status: http.StatusPreconditionFailed},
+		{name: "Trailing dash", match: `"7-"`, status: http.StatusPreconditionFailed},
+		{name: "Zero", match: `"0"`, status: http.StatusPreconditionFailed},
+		{name: "Hashed", match: `"7-0123456789abcdef"`, status: http.StatusPreconditionFailed},
+		{name: "List", match: `"7", "8"`, status: http.StatusBadRequest},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, "/", nil)
+			if c.match != "" {
+				req.Header.Set("If-Match", c.match)
+			}
+			version, err := GetIfMatchVersion(echo.New().NewContext(req, httptest.NewRecorder()), c.cfg)
+			if c.status != 0 {
+				require.Error(t, err)
+				require.Equal(t, c.status, httpe.ParseErrors(err).Status())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.version, version)
+		})
+	}
+}
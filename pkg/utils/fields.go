@@ -0,0 +1,105 @@
+package utils
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/Edbeer/restapi/pkg/filter"
+	"github.com/labstack/echo/v4"
+)
+
+// Get sparse fieldset of list items from ?fields=, nil keeps all fields
+func GetFieldsFromCtx(c echo.Context, item interface{}) ([]string, error) {
+	return parseFieldList(c.QueryParam("fields"), JSONFields(item))
+}
+
+// Get relations to embed in list items from ?include=
+func GetIncludeFromCtx(c echo.Context, relations []string) ([]string, error) {
+	return parseFieldList(c.QueryParam("include"), relations)
+}
+
+func parseFieldList(value string, allowed []string) ([]string, error) {
+	if value == "" {
+		return nil, nil
+	}
+	known := make(map[string]bool, len(allowed))
+	for _, field := range allowed {
+		known[field] = true
+	}
+
+	var fields []string
+	seen := make(map[string]bool)
+	for _, field := range strings.Split(value, ",") {
+		field = strings.TrimSpace(field)
+		if !known[field] {
+			return nil, filter.NewFieldError(field, "unknown field")
+		}
+		if !seen[field] {
+			seen[field] = true
+			fields = append(fields, field)
+		}
+	}
+	return fields, nil
+}
+
+// Names of json fields of struct, fields of embedded structs are promoted
+func JSONFields(item interface{}) []string {
+	t := reflect.TypeOf(item)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	var fields []string
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if f.Anonymous && name == "" {
+			fields = append(fields, JSONFields(reflect.New(f.Type).Interface())...)
+			continue
+		}
+		if name == "-" || !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields = append(fields, name)
+	}
+	return fields
+}
+
+// Cut items of list under key to the fields, list is kept as is without fields
+func SparseList(list interface{}, key string, fields []string) (interface{}, error) {
+	if fields == nil {
+		return list, nil
+	}
+
+	b, err := json.Marshal(list)
+	if err != nil {
+		return nil, err
+	}
+	var sparse map[string]json.RawMessage
+	if err := json.Unmarshal(b, &sparse); err != nil {
+		return nil, err
+	}
+	var items []map[string]json.RawMessage
+	if err := json.Unmarshal(sparse[key], &items); err != nil {
+		return nil, err
+	}
+
+	sparseItems := make([]map[string]json.RawMessage, 0, len(items))
+	for _, item := range items {
+		sparseItem := make(map[string]json.RawMessage, len(fields))
+		for _, field := range fields {
+			if value, ok := item[field]; ok {
+				sparseItem[field] = value
+			}
+		}
+		sparseItems = append(sparseItems, sparseItem)
+	}
+	if sparse[key], err = json.Marshal(sparseItems); err != nil {
+		return nil, err
+	}
+	return sparse, nil
+}
@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+type fieldsItem struct {
+	ID      int    `json:"id"`
+	Title   string `json:"title,omitempty"`
+	Content string `json:"content"`
+	Secret  string `json:"-"`
+}
+
+type fieldsSearchItem struct {
+	fieldsItem
+	Rank float64 `json:"rank"`
+}
+
+type fieldsList struct {
+	Size  int                 `json:"size"`
+	Items []*fieldsSearchItem `json:"items"`
+}
+
+func TestJSONFields(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, []string{"id", "title", "content"}, JSONFields(fieldsItem{}))
+	require.Equal(t, []string{"id", "title", "content", "rank"}, JSONFields(&fieldsSearchItem{}))
+}
+
+func TestGetFieldsFromCtx(t *testing.T) {
+	t.Parallel()
+
+	c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/?fields=id,rank,id&include=author", nil), httptest.NewRecorder())
+	fields, err := GetFieldsFromCtx(c, fieldsSearchItem{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"id", "rank"}, fields)
+
+	include, err := GetIncludeFromCtx(c, []string{"author"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"author"}, include)
+
+	c = echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/?fields=id,Secret", nil), httptest.NewRecorder())
+	_, err = GetFieldsFromCtx(c, fieldsItem{})
+	require.Error(t, err)
+	require.Equal(t, http.StatusBadRequest, httpe.ParseErrors(err).Status())
+	require.Contains(t, httpe.ParseErrors(err).Error(), "Secret")
+}
+
+func TestSparseList(t *testing.T) {
+	t.Parallel()
+
+	list := &fieldsList{Size: 10, Items: []*fieldsSearchItem{
+		{fieldsItem: fieldsItem{ID: 1, Title: "title", Content: "content"}, Rank: 0.5},
+		{fieldsItem: fieldsItem{ID: 2, Content: "content"}},
+	}}
+
+	same, err := SparseList(list, "items", nil)
+	require.NoError(t, err)
+	require.Equal(t, list, same)
+
+	sparse, err := SparseList(list, "items", []string{"id", "title"})
+	require.NoError(t, err)
+	b, err := json.Marshal(sparse)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"size":10,"items":[{"id":1,"title":"title"},{"id":2}]}`, string(b))
+}
@@ -2,11 +2,16 @@ package utils
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/Edbeer/restapi/config"
 	"github.com/Edbeer/restapi/internal/entity"
 	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/Edbeer/restapi/pkg/markdown"
 	"github.com/labstack/echo/v4"
 )
 
@@ -70,20 +75,58 @@ func GetUserFromCtx(ctx context.Context) (*entity.User, error) {
 	return user, nil
 }
 
+// Get content format of text fields from query, empty when not set
+func GetContentFormat(c echo.Context) (string, error) {
+	format := c.QueryParam("format")
+	if format != "" && !markdown.ValidFormat(format) {
+		return "", httpe.NewBadRequestError(fmt.Sprintf("unknown format: %q", format))
+	}
+	return format, nil
+}
+
 // Get user IP address
 func GetIP(c echo.Context) string {
 	return c.Request().RemoteAddr
 }
 
+// VisitorCtxKey is a key used for the anonymous visitor id in the context
+type VisitorCtxKey struct{}
+
+// Get anonymous visitor id from ip address and user agent
+func GetVisitorID(c echo.Context) string {
+	sum := sha256.Sum256([]byte(c.RealIP() + "|" + c.Request().UserAgent()))
+	return hex.EncodeToString(sum[:16])
+}
+
 // Get context  with request id
 func GetRequestCtx(c echo.Context) context.Context {
 	return context.WithValue(c.Request().Context(), ReqIDCtxKey{}, GetRequestID(c))
 }
 
+// Get context with request id and anonymous visitor id
+func GetVisitorCtx(c echo.Context) context.Context {
+	return context.WithValue(GetRequestCtx(c), VisitorCtxKey{}, GetVisitorID(c))
+}
+
 // Read request body and validate
 func ReadRequest(ctx echo.Context, request interface{}) error {
 	if err := ctx.Bind(request); err != nil {
 		return err
 	}
 	return validate.StructCtx(ctx.Request().Context(), request)
-}
\ No newline at end of file
+}
+
+// Parse optional RFC3339 or YYYY-MM-DD time query param
+func ParseTimeQuery(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return &t, nil
+	}
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, httpe.NewBadRequestError("invalid time: " + value)
+	}
+	return &t, nil
+}
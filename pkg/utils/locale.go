@@ -0,0 +1,150 @@
+package utils
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Edbeer/restapi/internal/entity"
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/labstack/echo/v4"
+)
+
+// Most preferred locales taken from Accept-Language
+const maxAcceptLanguages = 10
+
+// LocalesCtxKey is a key used for the preferred locales in the context
+type LocalesCtxKey struct{}
+
+// Normalize BCP 47 language tag: lower case language, title case script,
+// upper case region, e.g. "pt_br" is "pt-BR". Empty when tag is invalid.
+func NormalizeLocale(tag string) string {
+	parts := strings.Split(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"), "-")
+	if len(parts[0]) < 2 || len(parts[0]) > 3 || !isAlpha(parts[0]) {
+		return ""
+	}
+	parts[0] = strings.ToLower(parts[0])
+	for i, part := range parts[1:] {
+		if part == "" || len(part) > 8 || !isAlphanumeric(part) {
+			return ""
+		}
+		switch {
+		case len(part) == 4 && isAlpha(part):
+			parts[i+1] = strings.ToUpper(part[:1]) + strings.ToLower(part[1:])
+		case len(part) == 2 && isAlpha(part):
+			parts[i+1] = strings.ToUpper(part)
+		default:
+			parts[i+1] = strings.ToLower(part)
+		}
+	}
+	return strings.Join(parts, "-")
+}
+
+// Parse Accept-Language header into normalized locales by descending
+// quality. Wildcard, invalid tags and tags with zero quality are skipped.
+func ParseAcceptLanguage(header string) []string {
+	type weighted struct {
+		locale string
+		q      float64
+	}
+	var tags []weighted
+	for _, item := range strings.Split(header, ",") {
+		params := strings.Split(item, ";")
+		locale := NormalizeLocale(params[0])
+		if locale == "" {
+			continue
+		}
+		q := 1.0
+		for _, param := range params[1:] {
+			name, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if !ok || strings.TrimSpace(name) != "q" {
+				continue
+			}
+			parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil || parsed < 0 || parsed > 1 {
+				parsed = 0
+			}
+			q = parsed
+		}
+		if q > 0 {
+			tags = append(tags, weighted{locale: locale, q: q})
+		}
+	}
+	sort.SliceStable(tags, func(i, j int) bool {
+		return tags[i].q > tags[j].q
+	})
+
+	locales := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if len(locales) == maxAcceptLanguages {
+			break
+		}
+		locales = append(locales, tag.locale)
+	}
+	return locales
+}
+
+// Locale fallback chain: each preferred locale followed by its base
+// language, then the default locale. Empty without preferences.
+func LocaleChain(preferred []string) []string {
+	if len(preferred) == 0 {
+		return nil
+	}
+	seen := make(map[string]struct{}, 2*len(preferred)+1)
+	chain := make([]string, 0, 2*len(preferred)+1)
+	add := func(locale string) {
+		if _, ok := seen[locale]; !ok {
+			seen[locale] = struct{}{}
+			chain = append(chain, locale)
+		}
+	}
+	for _, locale := range preferred {
+		add(locale)
+		if base, _, ok := strings.Cut(locale, "-"); ok {
+			add(base)
+		}
+	}
+	add(entity.DefaultNewsLocale)
+	return chain
+}
+
+// Get context with locale fallback chain preferred by client. The lang
+// query param wins over Accept-Language header.
+func GetLocalesCtx(ctx context.Context, c echo.Context) (context.Context, error) {
+	var preferred []string
+	if lang := c.QueryParam("lang"); lang != "" {
+		locale := NormalizeLocale(lang)
+		if locale == "" {
+			return nil, httpe.NewBadRequestError("invalid lang: " + lang)
+		}
+		preferred = []string{locale}
+	} else {
+		preferred = ParseAcceptLanguage(c.Request().Header.Get("Accept-Language"))
+	}
+	return context.WithValue(ctx, LocalesCtxKey{}, LocaleChain(preferred)), nil
+}
+
+// Get locale fallback chain from context, empty when client has no preference
+func GetLocalesFromCtx(ctx context.Context) []string {
+	locales, _ := ctx.Value(LocalesCtxKey{}).([]string)
+	return locales
+}
+
+func isAlpha(s string) bool {
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlphanumeric(s string) bool {
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeLocale(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "en", NormalizeLocale("EN"))
+	require.Equal(t, "pt-BR", NormalizeLocale("pt_br"))
+	require.Equal(t, "zh-Hant-TW", NormalizeLocale("zh-hant-tw"))
+	require.Equal(t, "es-419", NormalizeLocale("es-419"))
+	require.Empty(t, NormalizeLocale("e"))
+	require.Empty(t, NormalizeLocale("*"))
+	require.Empty(t, NormalizeLocale("en-"))
+	require.Empty(t, NormalizeLocale("en-toolongsubtag"))
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, []string{"de-AT", "de", "en"}, ParseAcceptLanguage("en;q=0.5, de-at, de;q=0.8"))
+	require.Equal(t, []string{"fr"}, ParseAcceptLanguage("*, fr;q=0.1, it;q=0"))
+	require.Equal(t, []string{"es"}, ParseAcceptLanguage("es, ru;q=abc"))
+	require.Empty(t, ParseAcceptLanguage(""))
+}
+
+func TestLocaleChain(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, LocaleChain(nil))
+	require.Equal(t, []string{"pt-BR", "pt", "de", "en"}, LocaleChain([]string{"pt-BR", "de", "pt"}))
+	require.Equal(t, []string{"en-GB", "en"}, LocaleChain([]string{"en-GB"}))
+}
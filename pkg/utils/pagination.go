@@ -1,22 +1,28 @@
 package utils
 
 import (
+	"fmt"
 	"math"
 	"strconv"
 
+	"github.com/Edbeer/restapi/pkg/filter"
+	"github.com/Edbeer/restapi/pkg/httpe"
 	"github.com/labstack/echo/v4"
 )
 
 const (
 	defaultSize = 10
+	maxSize     = 100
 )
 
-// Pagination with out offset
+// Pagination with offset of page or keyset cursor
 type PaginationQuery struct {
-	Size       int    `json:"size,omitempty"`
-	Page       int    `json:"page,omitempty"`
-	Difference int    `json:"difference,omitempty"`
-	OrderBy    string `json:"orderBy,omitempty"`
+	Size       int           `json:"size,omitempty"`
+	Page       int           `json:"page,omitempty"`
+	Difference int           `json:"difference,omitempty"`
+	Cursor     *Cursor       `json:"cursor,omitempty"`
+	WithTotal  bool          `json:"total,omitempty"`
+	Filter     *filter.Query `json:"filter,omitempty"`
 }
 
 // Get pagination query struct from
@@ -28,6 +34,15 @@ func GetPaginationFromCtx(c echo.Context) (*PaginationQuery, error) {
 	if err := q.SetSize(c.QueryParam("size")); err != nil {
 		return nil, err
 	}
+	if err := q.SetCursor(c.QueryParam("cursor")); err != nil {
+		return nil, err
+	}
+	if err := q.SetWithTotal(c.QueryParam("total")); err != nil {
+		return nil, err
+	}
+	if err := q.SetFilter(c.QueryParam("filter"), c.QueryParam("sort")); err != nil {
+		return nil, err
+	}
 
 	return q, nil
 }
@@ -43,7 +58,7 @@ func GetHasMore(currentPage int, totalCount int, pageSize int) bool {
 	return currentPage < totalCount/pageSize
 }
 
-// Set page size
+// Set page size, 1 to maxSize
 func (q *PaginationQuery) SetSize(sizeQuery string) error {
 	if sizeQuery == "" {
 		q.Size = defaultSize
@@ -53,6 +68,9 @@ func (q *PaginationQuery) SetSize(sizeQuery string) error {
 	if err != nil {
 		return err
 	}
+	if n < 1 || n > maxSize {
+		return httpe.NewBadRequestError(fmt.Sprintf("size must be between 1 and %d", maxSize))
+	}
 	q.Size = n
 
 	return nil
@@ -88,9 +106,46 @@ func (q *PaginationQuery) SetDifference(differenceQuery string) error {
 	return nil
 }
 
-// Set order by
-func (q *PaginationQuery) SetOrderBy(orderByQuery string) {
-	q.OrderBy = orderByQuery
+// Set cursor of keyset page
+func (q *PaginationQuery) SetCursor(cursorQuery string) error {
+	if cursorQuery == "" {
+		return nil
+	}
+	cursor, err := DecodeCursor(cursorQuery)
+	if err != nil {
+		return err
+	}
+	q.Cursor = cursor
+
+	return nil
+}
+
+// Set if total count is wanted
+func (q *PaginationQuery) SetWithTotal(totalQuery string) error {
+	if totalQuery == "" {
+		return nil
+	}
+	withTotal, err := strconv.ParseBool(totalQuery)
+	if err != nil {
+		return httpe.NewBadRequestError("invalid total: " + totalQuery)
+	}
+	q.WithTotal = withTotal
+
+	return nil
+}
+
+// Set filter and sort, sorted lists are paged by page only
+func (q *PaginationQuery) SetFilter(filterQuery, sortQuery string) error {
+	query, err := filter.Parse(filterQuery, sortQuery)
+	if err != nil {
+		return err
+	}
+	if query.IsSorted() && q.Cursor != nil {
+		return filter.NewFieldError(query.Sort[0].Field, "sorted lists are paged by page, not cursor")
+	}
+	q.Filter = query
+
+	return nil
 }
 
 // Get limit
@@ -113,7 +168,10 @@ func (q *PaginationQuery) GetDifference() int {
 	return q.Difference
 }
 
-// Get order by
-func (q *PaginationQuery) GetOrderBy() string {
-	return q.OrderBy
-}
\ No newline at end of file
+// Get offset
+func (q *PaginationQuery) GetOffset() int {
+	if q.Page <= 0 {
+		return 0
+	}
+	return q.Page * q.Size
+}
@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Edbeer/restapi/pkg/httpe"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPaginationFromCtx(t *testing.T) {
+	t.Parallel()
+
+	c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/?size=5&page=2", nil), httptest.NewRecorder())
+	pq, err := GetPaginationFromCtx(c)
+	require.NoError(t, err)
+	require.Equal(t, 5, pq.GetSize())
+	require.Equal(t, 10, pq.GetOffset())
+
+	c = echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+	pq, err = GetPaginationFromCtx(c)
+	require.NoError(t, err)
+	require.Equal(t, defaultSize, pq.GetSize())
+
+	for _, size := range []string{"0", "-1", "101"} {
+		c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/?size="+size, nil), httptest.NewRecorder())
+		_, err := GetPaginationFromCtx(c)
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, httpe.ParseErrors(err).Status())
+	}
+}
@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gosimple/slug"
+)
+
+const (
+	maxSlugLength   = 80
+	defaultNewsSlug = "news"
+)
+
+// Make URL slug from text, non-Latin letters are transliterated
+func Slugify(text string) string {
+	return slug.Make(text)
+}
+
+// Normalize tag name: trimmed, lower case, single spaces
+func NormalizeTag(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+// Make slug of news title cut on a word boundary
+func NewsSlug(title string) string {
+	s := Slugify(title)
+	if len(s) > maxSlugLength {
+		s = s[:maxSlugLength]
+		if i := strings.LastIndexByte(s, '-'); i > 0 {
+			s = s[:i]
+		}
+		s = strings.Trim(s, "-")
+	}
+	if s == "" {
+		return defaultNewsSlug
+	}
+	return s
+}
+
+// Pick base slug or the first free one with -2, -3... suffix
+func UniqueSlug(base string, taken []string) string {
+	used := make(map[string]bool, len(taken))
+	for _, t := range taken {
+		used[t] = true
+	}
+	if !used[base] {
+		return base
+	}
+	for i := 2; ; i++ {
+		s := base + "-" + strconv.Itoa(i)
+		if !used[s] {
+			return s
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewsSlug(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "privet-mir", NewsSlug("Привет, мир!"))
+	require.Equal(t, "news", NewsSlug("!!!"))
+
+	long := NewsSlug(strings.Repeat("breaking ", 20))
+	require.LessOrEqual(t, len(long), maxSlugLength)
+	require.False(t, strings.HasSuffix(long, "-"))
+	require.True(t, strings.HasSuffix(long, "breaking"))
+}
+
+func TestUniqueSlug(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "title", UniqueSlug("title", nil))
+	require.Equal(t, "title-2", UniqueSlug("title", []string{"title"}))
+	require.Equal(t, "title-4", UniqueSlug("title", []string{"title", "title-2", "title-3", "title-other"}))
+}
@@ -9,11 +9,38 @@ import (
 // Use a single instance of Validate, it caches struct info
 var validate *validator.Validate
 
+// Built-in postgres text search configurations allowed for news
+var newsLanguages = map[string]struct{}{
+	"simple": {}, "arabic": {}, "danish": {}, "dutch": {}, "english": {},
+	"finnish": {}, "french": {}, "german": {}, "greek": {}, "hungarian": {},
+	"indonesian": {}, "irish": {}, "italian": {}, "lithuanian": {}, "nepali": {},
+	"norwegian": {}, "portuguese": {}, "romanian": {}, "russian": {}, "spanish": {},
+	"swedish": {}, "tamil": {}, "turkish": {},
+}
+
 func init() {
 	validate = validator.New()
+	if err := validate.RegisterValidation("news_language", validateNewsLanguage); err != nil {
+		panic(err)
+	}
+	if err := validate.RegisterValidation("locale", validateLocale); err != nil {
+		panic(err)
+	}
 }
 
 // Validate struct fields
 func ValidateStruct(ctx context.Context, s interface{}) error {
 	return validate.StructCtx(ctx, s)
-}
\ No newline at end of file
+}
+
+// Validate text search configuration name
+func validateNewsLanguage(fl validator.FieldLevel) bool {
+	_, ok := newsLanguages[fl.Field().String()]
+	return ok
+}
+
+// Validate normalized BCP 47 language tag
+func validateLocale(fl validator.FieldLevel) bool {
+	locale := fl.Field().String()
+	return locale != "" && NormalizeLocale(locale) == locale
+}
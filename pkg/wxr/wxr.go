@@ -0,0 +1,212 @@
+package wxr
+
+import (
+	"encoding/xml"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Post types and statuses used by importers
+const (
+	PostTypePost       = "post"
+	PostTypeAttachment = "attachment"
+
+	StatusPublish = "publish"
+	StatusFuture  = "future"
+	StatusDraft   = "draft"
+	StatusPending = "pending"
+	StatusPrivate = "private"
+
+	DomainCategory = "category"
+	DomainTag      = "post_tag"
+)
+
+// Layout of WordPress dates, zero dates are written for unpublished posts
+const dateLayout = "2006-01-02 15:04:05"
+
+// WordPress eXtended RSS export
+type Export struct {
+	Title       string      `xml:"channel>title"`
+	Link        string      `xml:"channel>link"`
+	Language    string      `xml:"channel>language"`
+	BaseSiteURL string      `xml:"channel>base_site_url"`
+	BaseBlogURL string      `xml:"channel>base_blog_url"`
+	Authors     []*Author   `xml:"channel>author"`
+	Categories  []*Category `xml:"channel>category"`
+	Tags        []*Tag      `xml:"channel>tag"`
+	Items       []*Item     `xml:"channel>item"`
+}
+
+// Blog author
+type Author struct {
+	ID          string `xml:"author_id"`
+	Login       string `xml:"author_login"`
+	Email       string `xml:"author_email"`
+	DisplayName string `xml:"author_display_name"`
+	FirstName   string `xml:"author_first_name"`
+	LastName    string `xml:"author_last_name"`
+}
+
+// Blog category, parent is the nicename of parent category
+type Category struct {
+	ID          string `xml:"term_id"`
+	Nicename    string `xml:"category_nicename"`
+	Parent      string `xml:"category_parent"`
+	Name        string `xml:"cat_name"`
+	Description string `xml:"category_description"`
+}
+
+// Blog tag
+type Tag struct {
+	ID   string `xml:"term_id"`
+	Slug string `xml:"tag_slug"`
+	Name string `xml:"tag_name"`
+}
+
+// Post, page or attachment. Content and excerpt share the local name so
+// content is matched with its namespace.
+type Item struct {
+	Title         string     `xml:"title"`
+	Link          string     `xml:"link"`
+	GUID          string     `xml:"guid"`
+	Creator       string     `xml:"http://purl.org/dc/elements/1.1/ creator"`
+	Content       string     `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+	PostID        string     `xml:"post_id"`
+	PostDateGMT   string     `xml:"post_date_gmt"`
+	PostDate      string     `xml:"post_date"`
+	ModifiedGMT   string     `xml:"post_modified_gmt"`
+	PostName      string     `xml:"post_name"`
+	Status        string     `xml:"status"`
+	PostType      string     `xml:"post_type"`
+	AttachmentURL string     `xml:"attachment_url"`
+	Terms         []*Term    `xml:"category"`
+	Meta          []*Meta    `xml:"postmeta"`
+	Comments      []*Comment `xml:"comment"`
+}
+
+// Category or tag of item
+type Term struct {
+	Domain   string `xml:"domain,attr"`
+	Nicename string `xml:"nicename,attr"`
+	Name     string `xml:",chardata"`
+}
+
+// Custom field of item
+type Meta struct {
+	Key   string `xml:"meta_key"`
+	Value string `xml:"meta_value"`
+}
+
+// Comment of item, parent and user are zero when not set
+type Comment struct {
+	ID          string `xml:"comment_id"`
+	Author      string `xml:"comment_author"`
+	AuthorEmail string `xml:"comment_author_email"`
+	DateGMT     string `xml:"comment_date_gmt"`
+	Date        string `xml:"comment_date"`
+	Content     string `xml:"comment_content"`
+	Approved    string `xml:"comment_approved"`
+	Type        string `xml:"comment_type"`
+	Parent      string `xml:"comment_parent"`
+	UserID      string `xml:"comment_user_id"`
+}
+
+// Parse WordPress export
+func Parse(r io.Reader) (*Export, error) {
+	export := &Export{}
+	decoder := xml.NewDecoder(r)
+	// exports of old blogs declare legacy charsets which are compatible enough
+	decoder.CharsetReader = func(charset string, input io.Reader) (io.Reader, error) {
+		return input, nil
+	}
+	if err := decoder.Decode(export); err != nil {
+		return nil, errors.Wrap(err, "wxr.Parse.Decode")
+	}
+	if export.BaseSiteURL == "" && export.BaseBlogURL == "" && export.Link == "" {
+		return nil, errors.New("wxr.Parse: not a WordPress export")
+	}
+
+	// rss categories of channel share the local name with wp categories
+	categories := export.Categories[:0]
+	for _, category := range export.Categories {
+		if category.Nicename != "" {
+			categories = append(categories, category)
+		}
+	}
+	export.Categories = categories
+	return export, nil
+}
+
+// URL of blog
+func (e *Export) BlogURL() string {
+	for _, link := range []string{e.BaseBlogURL, e.Link, e.BaseSiteURL} {
+		if link != "" {
+			return strings.TrimSuffix(link, "/")
+		}
+	}
+	return ""
+}
+
+// Slug of post, non-ASCII slugs are percent-encoded in exports
+func (i *Item) Slug() string {
+	if slug, err := url.PathUnescape(i.PostName); err == nil {
+		return slug
+	}
+	return i.PostName
+}
+
+// Publication date of item, nil for unpublished drafts
+func (i *Item) Published() *time.Time {
+	return parseDate(i.PostDateGMT, i.PostDate)
+}
+
+// Modification date of item
+func (i *Item) Modified() *time.Time {
+	return parseDate(i.ModifiedGMT, "")
+}
+
+// Value of custom field
+func (i *Item) MetaValue(key string) string {
+	for _, meta := range i.Meta {
+		if meta.Key == key {
+			return meta.Value
+		}
+	}
+	return ""
+}
+
+// Terms of item in domain
+func (i *Item) TermsOf(domain string) []*Term {
+	var terms []*Term
+	for _, term := range i.Terms {
+		if term.Domain == domain {
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
+
+// Date of comment
+func (c *Comment) Created() *time.Time {
+	return parseDate(c.DateGMT, c.Date)
+}
+
+// Approved comments and not pingbacks or trackbacks
+func (c *Comment) IsApproved() bool {
+	return c.Approved == "1" && (c.Type == "" || c.Type == "comment")
+}
+
+// Parse GMT date, local date is taken as GMT when the GMT one is zero
+func parseDate(gmt string, local string) *time.Time {
+	for _, value := range []string{gmt, local} {
+		t, err := time.Parse(dateLayout, strings.TrimSpace(value))
+		if err == nil && t.Year() > 1 {
+			return &t
+		}
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package wxr
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testExport = `<?xml version="1.0" encoding="UTF-8" ?>
+<rss version="2.0"
+	xmlns:excerpt="http://wordpress.org/export/1.2/excerpt/"
+	xmlns:content="http://purl.org/rss/1.0/modules/content/"
+	xmlns:dc="http://purl.org/dc/elements/1.1/"
+	xmlns:wp="http://wordpress.org/export/1.2/">
+<channel>
+	<title>Old blog</title>
+	<link>https://www.example.com</link>
+	<language>en-US</language>
+	<wp:base_site_url>https://www.example.com</wp:base_site_url>
+	<wp:base_blog_url>https://www.example.com/</wp:base_blog_url>
+	<wp:author>
+		<wp:author_id>1</wp:author_id>
+		<wp:author_login><![CDATA[jane]]></wp:author_login>
+		<wp:author_email><![CDATA[jane@example.com]]></wp:author_email>
+		<wp:author_display_name><![CDATA[Jane Doe]]></wp:author_display_name>
+	</wp:author>
+	<wp:category>
+		<wp:term_id>3</wp:term_id>
+		<wp:category_nicename><![CDATA[europe]]></wp:category_nicename>
+		<wp:category_parent><![CDATA[travel]]></wp:category_parent>
+		<wp:cat_name><![CDATA[Europe]]></wp:cat_name>
+	</wp:category>
+	<item>
+		<title>Rain in Berlin</title>
+		<link>https://www.example.com/2020/05/regen-in-k%c3%b6ln/</link>
+		<dc:creator><![CDATA[jane]]></dc:creator>
+		<content:encoded><![CDATA[<p>Content</p>]]></content:encoded>
+		<excerpt:encoded><![CDATA[Excerpt]]></excerpt:encoded>
+		<wp:post_id>10</wp:post_id>
+		<wp:post_date><![CDATA[2020-05-01 12:00:00]]></wp:post_date>
+		<wp:post_date_gmt><![CDATA[2020-05-01 10:00:00]]></wp:post_date_gmt>
+		<wp:post_modified_gmt><![CDATA[0000-00-00 00:00:00]]></wp:post_modified_gmt>
+		<wp:post_name><![CDATA[regen-in-k%c3%b6ln]]></wp:post_name>
+		<wp:status><![CDATA[publish]]></wp:status>
+		<wp:post_type><![CDATA[post]]></wp:post_type>
+		<category domain="category" nicename="europe"><![CDATA[Europe]]></category>
+		<category domain="post_tag" nicename="rain"><![CDATA[Rain]]></category>
+		<wp:postmeta>
+			<wp:meta_key><![CDATA[_thumbnail_id]]></wp:meta_key>
+			<wp:meta_value><![CDATA[12]]></wp:meta_value>
+		</wp:postmeta>
+		<wp:comment>
+			<wp:comment_id>100</wp:comment_id>
+			<wp:comment_author><![CDATA[Bob]]></wp:comment_author>
+			<wp:comment_date><![CDATA[2020-05-02 12:00:00]]></wp:comment_date>
+			<wp:comment_date_gmt><![CDATA[0000-00-00 00:00:00]]></wp:comment_date_gmt>
+			<wp:comment_content><![CDATA[Nice post]]></wp:comment_content>
+			<wp:comment_approved><![CDATA[1]]></wp:comment_approved>
+			<wp:comment_type><![CDATA[]]></wp:comment_type>
+			<wp:comment_parent>0</wp:comment_parent>
+		</wp:comment>
+		<wp:comment>
+			<wp:comment_id>101</wp:comment_id>
+			<wp:comment_approved><![CDATA[1]]></wp:comment_approved>
+			<wp:comment_type><![CDATA[pingback]]></wp:comment_type>
+		</wp:comment>
+	</item>
+</channel>
+</rss>`
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	export, err := Parse(strings.NewReader(testExport))
+	require.NoError(t, err)
+	require.Equal(t, "https://www.example.com", export.BlogURL())
+	require.Equal(t, "en-US", export.Language)
+	require.Len(t, export.Authors, 1)
+	require.Equal(t, "jane", export.Authors[0].Login)
+	require.Len(t, export.Categories, 1)
+	require.Equal(t, "travel", export.Categories[0].Parent)
+
+	require.Len(t, export.Items, 1)
+	item := export.Items[0]
+	require.Equal(t, "<p>Content</p>", item.Content)
+	require.Equal(t, "jane", item.Creator)
+	require.Equal(t, "regen-in-köln", item.Slug())
+	require.Equal(t, time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC), *item.Published())
+	require.Nil(t, item.Modified())
+	require.Equal(t, "12", item.MetaValue("_thumbnail_id"))
+	require.Len(t, item.TermsOf(DomainCategory), 1)
+	require.Equal(t, "Rain", item.TermsOf(DomainTag)[0].Name)
+
+	require.Len(t, item.Comments, 2)
+	require.True(t, item.Comments[0].IsApproved())
+	require.False(t, item.Comments[1].IsApproved())
+	require.Equal(t, time.Date(2020, 5, 2, 12, 0, 0, 0, time.UTC), *item.Comments[0].Created())
+}
+
+func TestParse_NotWordPress(t *testing.T) {
+	t.Parallel()
+
+	_, err := Parse(strings.NewReader(`<rss><channel><title>Feed</title></channel></rss>`))
+	require.Error(t, err)
+}